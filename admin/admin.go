@@ -3,6 +3,8 @@ package admin
 import (
 	"context"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	"github.com/google/go-github/v50/github"
@@ -19,18 +21,25 @@ type Options struct {
 	GithubAppID         int64
 	GithubAppPrivateKey string
 	ProvisionerSpec     string
+	JobWorkers          int
+	FrontendURL         string
+	PreviewTTL          time.Duration
 }
 
 type Service struct {
-	DB             database.DB
-	opts           *Options
-	logger         *zap.Logger
-	github         *github.Client
-	provisioner    provisioner.Provisioner
-	issuer         *auth.Issuer
-	closeCtx       context.Context
-	closeCtxCancel context.CancelFunc
-	email          *email.Client
+	DB              database.DB
+	opts            *Options
+	logger          *zap.Logger
+	github          *github.Client
+	githubTransport http.RoundTripper
+	provisioner     provisioner.Provisioner
+	issuer          *auth.Issuer
+	closeCtx        context.Context
+	closeCtxCancel  context.CancelFunc
+	email           *email.Client
+	jobHandlers     map[string]jobHandler
+	jobsWake        chan struct{}
+	jobsWG          sync.WaitGroup
 }
 
 func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Issuer, emailClient *email.Client) (*Service, error) {
@@ -67,35 +76,56 @@ func New(ctx context.Context, opts *Options, logger *zap.Logger, issuer *auth.Is
 	gh := github.NewClient(&http.Client{Transport: itr})
 
 	// Create provisioner
-	prov, err := provisioner.NewStatic(opts.ProvisionerSpec, logger, db, issuer)
+	prov, err := provisioner.New(opts.ProvisionerSpec, logger, db, issuer)
 	if err != nil {
 		return nil, err
 	}
 
-	// Create context that we cancel in Close() (for background reconciles)
+	// Create context that we cancel in Close() (for the job workers)
 	ctx, cancel := context.WithCancel(context.Background())
 
-	return &Service{
-		DB:             db,
-		opts:           opts,
-		logger:         logger,
-		github:         gh,
-		provisioner:    prov,
-		issuer:         issuer,
-		closeCtx:       ctx,
-		closeCtxCancel: cancel,
-		email:          emailClient,
-	}, nil
+	svc := &Service{
+		DB:              db,
+		opts:            opts,
+		logger:          logger,
+		github:          gh,
+		githubTransport: http.DefaultTransport,
+		provisioner:     prov,
+		issuer:          issuer,
+		closeCtx:        ctx,
+		closeCtxCancel:  cancel,
+		email:           emailClient,
+		jobsWake:        make(chan struct{}),
+	}
+
+	// Start workers for the job queue
+	workers := opts.JobWorkers
+	if workers <= 0 {
+		workers = 4
+	}
+	svc.startJobWorkers(workers)
+
+	// Preview deployments of pull requests are torn down if they're not updated for a week by default
+	if opts.PreviewTTL <= 0 {
+		opts.PreviewTTL = 7 * 24 * time.Hour
+	}
+	svc.startPreviewExpiry()
+
+	// Usage of the orgs' deployments is collected from the runtimes, and their ingestion limits are set from the orgs' quotas
+	svc.startUsageCollection()
+
+	return svc, nil
 }
 
 func (s *Service) Close() error {
+	// Stop the job workers. Jobs that are interrupted are retried by other admin servers.
+	s.closeCtxCancel()
+	s.waitForJobWorkers()
+
 	err := s.provisioner.Close()
 	if err != nil {
 		return err
 	}
 
-	s.closeCtxCancel()
-	// TODO: Also wait for background items to finish (up to a timeout)
-
 	return s.DB.Close()
 }
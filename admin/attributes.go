@@ -0,0 +1,51 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+)
+
+// reservedUserAttributes are set by the admin service and can't be overridden by custom member attributes
+var reservedUserAttributes = []string{"name", "email", "domain", "admin", "groups"}
+
+// ValidateMemberAttributes returns an error if custom member attributes use the name of a built-in attribute.
+func ValidateMemberAttributes(attrs map[string]any) error {
+	for _, k := range reservedUserAttributes {
+		if _, ok := attrs[k]; ok {
+			return fmt.Errorf("attribute %q is reserved", k)
+		}
+	}
+	return nil
+}
+
+// UserAttributes returns the attributes of a user that are passed to the runtime to evaluate metrics view policies.
+// They contain the user's custom member attributes in the org, the names of their usergroups in the org, and built-in attributes about the user.
+func (s *Service) UserAttributes(ctx context.Context, orgID string, user *database.User, isAdmin bool) (map[string]any, error) {
+	custom, err := s.DB.FindOrganizationMemberUserAttributes(ctx, orgID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := s.DB.FindUsergroupsForUser(ctx, orgID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	groupNames := make([]any, len(groups))
+	for i, g := range groups {
+		groupNames[i] = g.Name
+	}
+
+	attrs := make(map[string]any, len(custom)+len(reservedUserAttributes))
+	for k, v := range custom {
+		attrs[k] = v
+	}
+	attrs["name"] = user.DisplayName
+	attrs["email"] = user.Email
+	attrs["domain"] = user.Email[strings.LastIndex(user.Email, "@")+1:]
+	attrs["admin"] = isAdmin
+	attrs["groups"] = groupNames
+	return attrs, nil
+}
@@ -0,0 +1,14 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMemberAttributes(t *testing.T) {
+	require.NoError(t, ValidateMemberAttributes(nil))
+	require.NoError(t, ValidateMemberAttributes(map[string]any{"region": "emea", "accounts": []any{"a", "b"}}))
+	require.Error(t, ValidateMemberAttributes(map[string]any{"region": "emea", "email": "jane@example.org"}))
+	require.Error(t, ValidateMemberAttributes(map[string]any{"groups": []any{"finance"}}))
+}
@@ -0,0 +1,103 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/rilldata/rill/admin/database"
+)
+
+// Actions recorded in the audit log
+const (
+	AuditActionOrgCreate                 = "org.create"
+	AuditActionOrgUpdate                 = "org.update"
+	AuditActionOrgDelete                 = "org.delete"
+	AuditActionOrgMemberAdd              = "org.member.add"
+	AuditActionOrgMemberInvite           = "org.member.invite"
+	AuditActionOrgMemberRemove           = "org.member.remove"
+	AuditActionOrgMemberSetRole          = "org.member.set_role"
+	AuditActionOrgMemberSetAttributes    = "org.member.set_attributes"
+	AuditActionOrgQuotasUpdate           = "org.quotas.update"
+	AuditActionOrgSSOUpdate              = "org.sso.update"
+	AuditActionOrgSSODelete              = "org.sso.delete"
+	AuditActionOrgSSOGroupAdd            = "org.sso.group.add"
+	AuditActionOrgSSOGroupRemove         = "org.sso.group.remove"
+	AuditActionOrgSCIMGroupAdd           = "org.scim.group.add"
+	AuditActionOrgSCIMGroupRemove        = "org.scim.group.remove"
+	AuditActionOrgUsergroupMemberAdd     = "org.usergroup.member.add"
+	AuditActionOrgUsergroupMemberRemove  = "org.usergroup.member.remove"
+	AuditActionOrgServiceAccountCreate   = "org.service_account.create"
+	AuditActionOrgServiceAccountDelete   = "org.service_account.delete"
+	AuditActionOrgServiceTokenIssue      = "org.service_account.token.issue"
+	AuditActionOrgServiceTokenRevoke     = "org.service_account.token.revoke"
+	AuditActionProjectCreate             = "project.create"
+	AuditActionProjectUpdate             = "project.update"
+	AuditActionProjectDelete             = "project.delete"
+	AuditActionProjectVariablesUpdate    = "project.variables.update"
+	AuditActionProjectMemberAdd          = "project.member.add"
+	AuditActionProjectMemberInvite       = "project.member.invite"
+	AuditActionProjectMemberRemove       = "project.member.remove"
+	AuditActionProjectMemberSetRole      = "project.member.set_role"
+	AuditActionProjectEnvironmentCreate  = "project.environment.create"
+	AuditActionProjectEnvironmentUpdate  = "project.environment.update"
+	AuditActionProjectEnvironmentDelete  = "project.environment.delete"
+	AuditActionProjectEnvironmentPromote = "project.environment.promote"
+	AuditActionProjectDeploymentRollback = "project.deployment.rollback"
+	AuditActionUserAuthTokenRevoke       = "user.auth_token.revoke"
+)
+
+// Types of the targets of actions recorded in the audit log
+const (
+	AuditTargetOrganization   = "organization"
+	AuditTargetProject        = "project"
+	AuditTargetEnvironment    = "environment"
+	AuditTargetUser           = "user"
+	AuditTargetInvite         = "invite"
+	AuditTargetAuthToken      = "auth_token"
+	AuditTargetUsergroup      = "usergroup"
+	AuditTargetServiceAccount = "service_account"
+)
+
+// auditUserAction records an action by a user in the audit log.
+// It's used by service functions that make changes in their own transaction, and must be called with the transaction's ctx.
+func (s *Service) auditUserAction(ctx context.Context, userID string, opts *database.InsertAuditLogEntryOptions) error {
+	opts.ActorType = database.AuditActorTypeUser
+	opts.ActorID = &userID
+	_, err := s.DB.InsertAuditLogEntry(ctx, opts)
+	return err
+}
+
+// AuditDiff returns the fields that differ between before and after, in the format of database.AuditLogEntry.
+// Fields that are only present in one of them are returned as empty in the other.
+func AuditDiff(before, after map[string]string) (map[string]string, map[string]string) {
+	b := make(map[string]string)
+	a := make(map[string]string)
+	for k, v := range before {
+		if v2, ok := after[k]; !ok || v != v2 {
+			b[k] = v
+			a[k] = after[k]
+		}
+	}
+	for k, v := range after {
+		if _, ok := before[k]; !ok {
+			b[k] = ""
+			a[k] = v
+		}
+	}
+	return b, a
+}
+
+// AuditVariablesDiff is like AuditDiff, but for variables that may contain secrets.
+// It returns the names of the variables that were added, removed or changed, with their values redacted.
+func AuditVariablesDiff(before, after map[string]string) (map[string]string, map[string]string) {
+	b, a := AuditDiff(before, after)
+	for _, m := range []map[string]string{b, a} {
+		for k, v := range m {
+			if v != "" {
+				m[k] = redacted
+			}
+		}
+	}
+	return b, a
+}
+
+const redacted = "[redacted]"
@@ -0,0 +1,25 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditDiff(t *testing.T) {
+	before, after := AuditDiff(
+		map[string]string{"name": "a", "description": "same", "removed": "x"},
+		map[string]string{"name": "b", "description": "same", "added": "y"},
+	)
+	require.Equal(t, map[string]string{"name": "a", "removed": "x", "added": ""}, before)
+	require.Equal(t, map[string]string{"name": "b", "removed": "", "added": "y"}, after)
+}
+
+func TestAuditVariablesDiff(t *testing.T) {
+	before, after := AuditVariablesDiff(
+		map[string]string{"password": "secret", "token": "same", "old": "x"},
+		map[string]string{"password": "secret2", "token": "same", "new": "y"},
+	)
+	require.Equal(t, map[string]string{"password": redacted, "old": redacted, "new": ""}, before)
+	require.Equal(t, map[string]string{"password": redacted, "old": "", "new": redacted}, after)
+}
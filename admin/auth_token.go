@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/pkg/authtoken"
@@ -14,6 +15,18 @@ import (
 type AuthToken interface {
 	Token() *authtoken.Token
 	OwnerID() string
+	// Scope returns the restrictions of the token, or nil if it has the full permissions of its owner.
+	Scope() *AuthTokenScope
+}
+
+// AuthTokenScope restricts the permissions of an AuthToken.
+type AuthTokenScope struct {
+	// OrganizationID is the org the token can access
+	OrganizationID string
+	// ProjectIDs are the projects the token can access, or empty for all projects of the org
+	ProjectIDs []string
+	// Scopes are the permissions of the token (see ServiceTokenScopes)
+	Scopes []string
 }
 
 // userAuthToken implements AuthToken for tokens belonging to a user.
@@ -30,6 +43,33 @@ func (t *userAuthToken) OwnerID() string {
 	return t.model.UserID
 }
 
+func (t *userAuthToken) Scope() *AuthTokenScope {
+	return nil
+}
+
+// serviceAuthToken implements AuthToken for tokens belonging to a service account.
+type serviceAuthToken struct {
+	model   *database.ServiceAuthToken
+	account *database.ServiceAccount
+	token   *authtoken.Token
+}
+
+func (t *serviceAuthToken) Token() *authtoken.Token {
+	return t.token
+}
+
+func (t *serviceAuthToken) OwnerID() string {
+	return t.model.ServiceAccountID
+}
+
+func (t *serviceAuthToken) Scope() *AuthTokenScope {
+	return &AuthTokenScope{
+		OrganizationID: t.account.OrganizationID,
+		ProjectIDs:     t.model.ProjectIDs,
+		Scopes:         t.model.Scopes,
+	}
+}
+
 // IssueUserAuthToken generates and persists a new auth token for a user.
 func (s *Service) IssueUserAuthToken(ctx context.Context, userID, clientID, displayName string) (AuthToken, error) {
 	tkn := authtoken.NewRandom(authtoken.TypeUser)
@@ -48,6 +88,32 @@ func (s *Service) IssueUserAuthToken(ctx context.Context, userID, clientID, disp
 	return &userAuthToken{model: uat, token: tkn}, nil
 }
 
+// IssueServiceAuthToken generates and persists a new auth token for a service account.
+// The token can only access the given projects (or all projects of the service account's org if empty) with the permissions in scopes.
+func (s *Service) IssueServiceAuthToken(ctx context.Context, account *database.ServiceAccount, displayName string, projectIDs, scopes []string, expiresOn *time.Time) (AuthToken, *database.ServiceAuthToken, error) {
+	err := ValidateServiceTokenScopes(scopes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tkn := authtoken.NewRandom(authtoken.TypeService)
+
+	sat, err := s.DB.InsertServiceAuthToken(ctx, &database.InsertServiceAuthTokenOptions{
+		ID:               tkn.ID.String(),
+		SecretHash:       tkn.SecretHash(),
+		ServiceAccountID: account.ID,
+		DisplayName:      displayName,
+		ProjectIDs:       projectIDs,
+		Scopes:           scopes,
+		ExpiresOn:        expiresOn,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &serviceAuthToken{model: sat, account: account, token: tkn}, sat, nil
+}
+
 // ValidateAuthToken validates an auth token against persistent storage.
 func (s *Service) ValidateAuthToken(ctx context.Context, token string) (AuthToken, error) {
 	parsed, err := authtoken.FromString(token)
@@ -70,6 +136,29 @@ func (s *Service) ValidateAuthToken(ctx context.Context, token string) (AuthToke
 		}
 
 		return &userAuthToken{model: uat, token: parsed}, nil
+	case authtoken.TypeService:
+		sat, err := s.DB.FindServiceAuthToken(ctx, parsed.ID.String())
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, fmt.Errorf("auth token not found")
+			}
+			return nil, err
+		}
+
+		if !bytes.Equal(sat.SecretHash, parsed.SecretHash()) {
+			return nil, fmt.Errorf("invalid auth token")
+		}
+
+		if sat.ExpiresOn != nil && sat.ExpiresOn.Before(time.Now()) {
+			return nil, fmt.Errorf("auth token expired")
+		}
+
+		account, err := s.DB.FindServiceAccount(ctx, sat.ServiceAccountID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &serviceAuthToken{model: sat, account: account, token: parsed}, nil
 	default:
 		return nil, fmt.Errorf("unknown auth token type %q", parsed.Type)
 	}
@@ -84,6 +173,8 @@ func (s *Service) RevokeAuthToken(ctx context.Context, token string) error {
 	switch parsed.Type {
 	case authtoken.TypeUser:
 		return s.DB.DeleteUserAuthToken(ctx, parsed.ID.String())
+	case authtoken.TypeService:
+		return s.DB.DeleteServiceAuthToken(ctx, parsed.ID.String())
 	default:
 		return fmt.Errorf("unknown auth token type %q", parsed.Type)
 	}
@@ -57,6 +57,28 @@ type DB interface {
 	DeleteOrganization(ctx context.Context, name string) error
 	UpdateOrganization(ctx context.Context, id string, opts *UpdateOrganizationOptions) (*Organization, error)
 	UpdateOrganizationAllUsergroup(ctx context.Context, orgID, groupID string) (*Organization, error)
+	UpdateOrganizationQuotas(ctx context.Context, orgID string, opts *UpdateOrganizationQuotasOptions) (*Organization, error)
+	ResolveOrganizationUsage(ctx context.Context, orgID string, periodStart time.Time) (*OrganizationUsage, error)
+	IncrementOrganizationQueries(ctx context.Context, orgID string, periodStart time.Time, queries int64) error
+
+	FindOrganizationSSO(ctx context.Context, orgID string) (*OrganizationSSO, error)
+	FindOrganizationSSOByDomain(ctx context.Context, domain string) (*OrganizationSSO, error)
+	UpsertOrganizationSSO(ctx context.Context, opts *UpsertOrganizationSSOOptions) (*OrganizationSSO, error)
+	DeleteOrganizationSSO(ctx context.Context, orgID string) error
+	FindOrganizationSSOGroups(ctx context.Context, orgID string) ([]*OrganizationSSOGroup, error)
+	FindOrganizationSSOGroup(ctx context.Context, orgID, idpGroup string) (*OrganizationSSOGroup, error)
+	InsertOrganizationSSOGroup(ctx context.Context, opts *InsertOrganizationSSOGroupOptions) (*OrganizationSSOGroup, error)
+
+	FindOrganizationSCIMUsers(ctx context.Context, orgID string) ([]*OrganizationSCIMUser, error)
+	FindOrganizationSCIMUser(ctx context.Context, orgID, userID string) (*OrganizationSCIMUser, error)
+	InsertOrganizationSCIMUser(ctx context.Context, opts *InsertOrganizationSCIMUserOptions) (*OrganizationSCIMUser, error)
+	UpdateOrganizationSCIMUser(ctx context.Context, orgID, userID string, opts *UpdateOrganizationSCIMUserOptions) (*OrganizationSCIMUser, error)
+	DeleteOrganizationSCIMUser(ctx context.Context, orgID, userID string) error
+	FindOrganizationSCIMGroups(ctx context.Context, orgID string) ([]*OrganizationSCIMGroup, error)
+	FindOrganizationSCIMGroup(ctx context.Context, orgID, usergroupID string) (*OrganizationSCIMGroup, error)
+	InsertOrganizationSCIMGroup(ctx context.Context, opts *InsertOrganizationSCIMGroupOptions) (*OrganizationSCIMGroup, error)
+	UpdateOrganizationSCIMGroup(ctx context.Context, usergroupID string, opts *UpdateOrganizationSCIMGroupOptions) (*OrganizationSCIMGroup, error)
+	DeleteOrganizationSCIMGroup(ctx context.Context, usergroupID string) error
 
 	FindProjects(ctx context.Context, orgName string) ([]*Project, error)
 	FindProjectsForUser(ctx context.Context, userID string) ([]*Project, error)
@@ -71,14 +93,51 @@ type DB interface {
 	DeleteProject(ctx context.Context, id string) error
 	UpdateProject(ctx context.Context, id string, opts *UpdateProjectOptions) (*Project, error)
 
+	FindEnvironments(ctx context.Context, projectID string) ([]*Environment, error)
+	FindEnvironmentByName(ctx context.Context, projectID, name string) (*Environment, error)
+	InsertEnvironment(ctx context.Context, opts *InsertEnvironmentOptions) (*Environment, error)
+	DeleteEnvironment(ctx context.Context, id string) error
+	UpdateEnvironment(ctx context.Context, id string, opts *UpdateEnvironmentOptions) (*Environment, error)
+	FindEnvironmentForDeployment(ctx context.Context, deploymentID string) (*Environment, error)
+
 	FindDeployments(ctx context.Context, projectID string) ([]*Deployment, error)
 	FindDeployment(ctx context.Context, id string) (*Deployment, error)
+	FindDeploymentForPullRequest(ctx context.Context, projectID string, pullRequestNumber int) (*Deployment, error)
+	FindExpiredDeployments(ctx context.Context) ([]*Deployment, error)
 	InsertDeployment(ctx context.Context, opts *InsertDeploymentOptions) (*Deployment, error)
 	DeleteDeployment(ctx context.Context, id string) error
 	UpdateDeploymentStatus(ctx context.Context, id string, status DeploymentStatus, logs string) (*Deployment, error)
+	UpdateDeploymentExpiry(ctx context.Context, id string, expiresOn time.Time) (*Deployment, error)
+	UpdateDeploymentBranch(ctx context.Context, id, branch string) (*Deployment, error)
+	FindDeploymentsForRuntime(ctx context.Context, host string) ([]*Deployment, error)
+	UpdateDeploymentRuntime(ctx context.Context, id string, opts *UpdateDeploymentRuntimeOptions) (*Deployment, error)
+	FindDeploymentsForOrganization(ctx context.Context, orgID string) ([]*Deployment, error)
+
+	FindDeploymentUsage(ctx context.Context, deploymentID string) (*DeploymentUsage, error)
+	UpsertDeploymentUsage(ctx context.Context, opts *UpsertDeploymentUsageOptions) (*DeploymentUsage, error)
+
+	FindDeploymentRevisions(ctx context.Context, deploymentID string, limit int) ([]*DeploymentRevision, error)
+	FindDeploymentRevision(ctx context.Context, id string) (*DeploymentRevision, error)
+	InsertDeploymentRevision(ctx context.Context, opts *InsertDeploymentRevisionOptions) (*DeploymentRevision, error)
+	UpdateDeploymentRevision(ctx context.Context, id string, opts *UpdateDeploymentRevisionOptions) (*DeploymentRevision, error)
 
 	ResolveRuntimeSlotsUsed(ctx context.Context) ([]*RuntimeSlotsUsed, error)
 
+	FindRuntimes(ctx context.Context) ([]*Runtime, error)
+	FindRuntime(ctx context.Context, host string) (*Runtime, error)
+	UpsertRuntime(ctx context.Context, opts *UpsertRuntimeOptions) (*Runtime, error)
+	UpdateRuntimeStatus(ctx context.Context, host string, status RuntimeStatus) (*Runtime, error)
+	UpdateRuntimeDraining(ctx context.Context, host string, draining bool) (*Runtime, error)
+
+	FindJob(ctx context.Context, id string) (*Job, error)
+	FindJobsForProject(ctx context.Context, projectID string, limit int) ([]*Job, error)
+	InsertJob(ctx context.Context, opts *InsertJobOptions) (*Job, error)
+	LeaseJob(ctx context.Context, workerID string, lease time.Duration) (*Job, error)
+	RenewJobLease(ctx context.Context, id, workerID string, lease time.Duration) error
+	CompleteJob(ctx context.Context, id, workerID string) error
+	RetryJob(ctx context.Context, id, workerID, errMsg string, runAfter time.Time) error
+	FailJob(ctx context.Context, id, workerID, errMsg string) error
+
 	FindUsers(ctx context.Context) ([]*User, error)
 	FindUser(ctx context.Context, id string) (*User, error)
 	FindUserByEmail(ctx context.Context, email string) (*User, error)
@@ -89,12 +148,32 @@ type DB interface {
 	InsertUsergroup(ctx context.Context, opts *InsertUsergroupOptions) (*Usergroup, error)
 	InsertUsergroupMember(ctx context.Context, groupID, userID string) error
 	DeleteUsergroupMember(ctx context.Context, groupID, userID string) error
+	DeleteUsergroup(ctx context.Context, id string) error
+	FindUsergroupByName(ctx context.Context, orgID, name string) (*Usergroup, error)
+	FindUsergroupMemberUsers(ctx context.Context, groupID string) ([]*User, error)
+	FindUsergroupsForUser(ctx context.Context, orgID, userID string) ([]*Usergroup, error)
+	DeleteUsergroupMembersForOrganization(ctx context.Context, orgID, userID string) error
+	FindProjectRolesForUsergroup(ctx context.Context, groupID string) ([]*UsergroupProjectRole, error)
 
 	FindUserAuthTokens(ctx context.Context, userID string) ([]*UserAuthToken, error)
 	FindUserAuthToken(ctx context.Context, id string) (*UserAuthToken, error)
 	InsertUserAuthToken(ctx context.Context, opts *InsertUserAuthTokenOptions) (*UserAuthToken, error)
 	DeleteUserAuthToken(ctx context.Context, id string) error
 
+	FindServiceAccounts(ctx context.Context, orgID string) ([]*ServiceAccount, error)
+	FindServiceAccount(ctx context.Context, id string) (*ServiceAccount, error)
+	FindServiceAccountByName(ctx context.Context, orgID, name string) (*ServiceAccount, error)
+	InsertServiceAccount(ctx context.Context, opts *InsertServiceAccountOptions) (*ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, id string) error
+
+	FindServiceAuthTokens(ctx context.Context, serviceAccountID string) ([]*ServiceAuthToken, error)
+	FindServiceAuthToken(ctx context.Context, id string) (*ServiceAuthToken, error)
+	InsertServiceAuthToken(ctx context.Context, opts *InsertServiceAuthTokenOptions) (*ServiceAuthToken, error)
+	DeleteServiceAuthToken(ctx context.Context, id string) error
+
+	FindAuditLogEntries(ctx context.Context, opts *FindAuditLogEntriesOptions) ([]*AuditLogEntry, error)
+	InsertAuditLogEntry(ctx context.Context, opts *InsertAuditLogEntryOptions) (*AuditLogEntry, error)
+
 	FindDeviceAuthCodeByDeviceCode(ctx context.Context, deviceCode string) (*DeviceAuthCode, error)
 	FindDeviceAuthCodeByUserCode(ctx context.Context, userCode string) (*DeviceAuthCode, error)
 	InsertDeviceAuthCode(ctx context.Context, deviceCode, userCode, clientID string, expiresOn time.Time) (*DeviceAuthCode, error)
@@ -109,13 +188,17 @@ type DB interface {
 	FindOrganizationMemberUsers(ctx context.Context, orgID string) ([]*Member, error)
 	FindOrganizationMemberUsersByRole(ctx context.Context, orgID, roleID string) ([]*User, error)
 	InsertOrganizationMemberUser(ctx context.Context, orgID, userID, roleID string) error
+	InsertOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error
 	DeleteOrganizationMemberUser(ctx context.Context, orgID, userID string) error
 	UpdateOrganizationMemberUserRole(ctx context.Context, orgID, userID, roleID string) error
+	FindOrganizationMemberUserAttributes(ctx context.Context, orgID, userID string) (MemberAttributes, error)
+	UpdateOrganizationMemberUserAttributes(ctx context.Context, orgID, userID string, attrs MemberAttributes) error
 
 	FindProjectMemberUsers(ctx context.Context, projectID string) ([]*Member, error)
 	InsertProjectMemberUser(ctx context.Context, projectID, userID, roleID string) error
 	InsertProjectMemberUsergroup(ctx context.Context, groupID, projectID, roleID string) error
 	DeleteProjectMemberUser(ctx context.Context, projectID, userID string) error
+	DeleteProjectMemberUsersForOrganization(ctx context.Context, orgID, userID string) error
 	UpdateProjectMemberUserRole(ctx context.Context, projectID, userID, roleID string) error
 
 	FindOrganizationInvites(ctx context.Context, orgID string) ([]*Invite, error)
@@ -156,6 +239,128 @@ type Organization struct {
 	AllUsergroupID *string   `db:"all_usergroup_id"`
 	CreatedOn      time.Time `db:"created_on"`
 	UpdatedOn      time.Time `db:"updated_on"`
+	OrganizationQuotas
+}
+
+// OrganizationQuotas are limits on the resources used by an org. A negative quota means the resource is not limited.
+type OrganizationQuotas struct {
+	// QuotaProjects is the max number of projects
+	QuotaProjects int `db:"quota_projects"`
+	// QuotaSlotsTotal is the max sum of slots of the org's deployments, including preview deployments
+	QuotaSlotsTotal int `db:"quota_slots_total"`
+	// QuotaIngestedBytes is the max sum of bytes ingested by the org's deployments
+	QuotaIngestedBytes int64 `db:"quota_ingested_bytes"`
+	// QuotaQueriesPerMonth is the max number of queries served by the org's deployments in a calendar month
+	QuotaQueriesPerMonth int64 `db:"quota_queries_per_month"`
+}
+
+// UpdateOrganizationQuotasOptions defines options for updating the quotas of an org
+type UpdateOrganizationQuotasOptions struct {
+	Projects        int
+	SlotsTotal      int
+	IngestedBytes   int64
+	QueriesPerMonth int64
+}
+
+// OrganizationUsage is the result of a ResolveOrganizationUsage query.
+type OrganizationUsage struct {
+	Projects      int   `db:"projects"`
+	Slots         int   `db:"slots"`
+	BytesIngested int64 `db:"bytes_ingested"`
+	Queries       int64 `db:"queries"`
+}
+
+// OrganizationSSO is the OIDC identity provider of an org.
+// Users with an email address in one of its domains sign in with the identity provider instead of the default one.
+type OrganizationSSO struct {
+	OrgID        string      `db:"org_id"`
+	IssuerURL    string      `db:"issuer_url"`
+	ClientID     string      `db:"client_id"`
+	ClientSecret string      `db:"client_secret"`
+	GroupsClaim  string      `db:"groups_claim"`
+	Domains      StringSlice `db:"domains"`
+	CreatedOn    time.Time   `db:"created_on"`
+	UpdatedOn    time.Time   `db:"updated_on"`
+}
+
+// UpsertOrganizationSSOOptions defines options for configuring the identity provider of an org.
+// Domains must be lower case, and replace the org's current domains.
+type UpsertOrganizationSSOOptions struct {
+	OrgID        string   `validate:"required"`
+	IssuerURL    string   `validate:"url"`
+	ClientID     string   `validate:"required"`
+	ClientSecret string   `validate:"required"`
+	GroupsClaim  string   `validate:"required"`
+	Domains      []string `validate:"min=1,dive,fqdn"`
+}
+
+// OrganizationSSOGroup maps a group claim of an org's identity provider to a usergroup of the org.
+type OrganizationSSOGroup struct {
+	UsergroupID   string    `db:"usergroup_id"`
+	UsergroupName string    `db:"usergroup_name"`
+	OrgID         string    `db:"org_id"`
+	IDPGroup      string    `db:"idp_group"`
+	OrgRoleName   *string   `db:"org_role_name"`
+	CreatedOn     time.Time `db:"created_on"`
+}
+
+// InsertOrganizationSSOGroupOptions defines options for mapping a group claim to a usergroup.
+type InsertOrganizationSSOGroupOptions struct {
+	OrgID       string `validate:"required"`
+	UsergroupID string `validate:"required"`
+	IDPGroup    string `validate:"required"`
+}
+
+// OrganizationSCIMUser is a user provisioned in an org by its identity provider with SCIM.
+// Only active users are members of the org.
+type OrganizationSCIMUser struct {
+	OrgID       string    `db:"org_id"`
+	UserID      string    `db:"user_id"`
+	Email       string    `db:"email"`
+	DisplayName string    `db:"display_name"`
+	ExternalID  string    `db:"external_id"`
+	Active      bool      `db:"active"`
+	CreatedOn   time.Time `db:"created_on"`
+	UpdatedOn   time.Time `db:"updated_on"`
+}
+
+// InsertOrganizationSCIMUserOptions defines options for provisioning a user in an org with SCIM.
+type InsertOrganizationSCIMUserOptions struct {
+	OrgID      string `validate:"required"`
+	UserID     string `validate:"required"`
+	ExternalID string
+	Active     bool
+}
+
+// UpdateOrganizationSCIMUserOptions defines options for updating a user provisioned with SCIM.
+type UpdateOrganizationSCIMUserOptions struct {
+	ExternalID string
+	Active     bool
+}
+
+// OrganizationSCIMGroup is a group provisioned in an org with SCIM. It's backed by a usergroup of the org.
+type OrganizationSCIMGroup struct {
+	UsergroupID   string    `db:"usergroup_id"`
+	UsergroupName string    `db:"usergroup_name"`
+	OrgID         string    `db:"org_id"`
+	DisplayName   string    `db:"display_name"`
+	ExternalID    string    `db:"external_id"`
+	CreatedOn     time.Time `db:"created_on"`
+	UpdatedOn     time.Time `db:"updated_on"`
+}
+
+// InsertOrganizationSCIMGroupOptions defines options for provisioning a group in an org with SCIM.
+type InsertOrganizationSCIMGroupOptions struct {
+	OrgID       string `validate:"required"`
+	UsergroupID string `validate:"required"`
+	DisplayName string `validate:"required"`
+	ExternalID  string
+}
+
+// UpdateOrganizationSCIMGroupOptions defines options for updating a group provisioned with SCIM.
+type UpdateOrganizationSCIMGroupOptions struct {
+	DisplayName string `validate:"required"`
+	ExternalID  string
 }
 
 // InsertOrganizationOptions defines options for inserting a new org
@@ -181,17 +386,11 @@ type Project struct {
 	Region               string
 	GithubURL            *string   `db:"github_url"`
 	GithubInstallationID *int64    `db:"github_installation_id"`
-	ProdBranch           string    `db:"prod_branch"`
-	ProdVariables        Variables `db:"prod_variables"`
-	ProdOLAPDriver       string    `db:"prod_olap_driver"`
-	ProdOLAPDSN          string    `db:"prod_olap_dsn"`
-	ProdSlots            int       `db:"prod_slots"`
-	ProdDeploymentID     *string   `db:"prod_deployment_id"`
 	CreatedOn            time.Time `db:"created_on"`
 	UpdatedOn            time.Time `db:"updated_on"`
 }
 
-// Variables implements JSON SQL encoding of variables in Project.
+// Variables implements JSON SQL encoding of variables in Environment.
 type Variables map[string]string
 
 func (e *Variables) Scan(value interface{}) error {
@@ -212,11 +411,6 @@ type InsertProjectOptions struct {
 	Region               string
 	GithubURL            *string `validate:"omitempty,http_url"`
 	GithubInstallationID *int64  `validate:"omitempty,ne=0"`
-	ProdBranch           string
-	ProdVariables        map[string]string
-	ProdOLAPDriver       string
-	ProdOLAPDSN          string
-	ProdSlots            int
 }
 
 // UpdateProjectOptions defines options for updating a Project.
@@ -226,9 +420,47 @@ type UpdateProjectOptions struct {
 	Public               bool
 	GithubURL            *string `validate:"omitempty,http_url"`
 	GithubInstallationID *int64  `validate:"omitempty,ne=0"`
-	ProdBranch           string
-	ProdVariables        map[string]string
-	ProdDeploymentID     *string
+}
+
+// EnvironmentNameProd is the name of the environment that every project has, which serves the project's dashboards.
+const EnvironmentNameProd = "prod"
+
+// Environment is a named target that a project is deployed to, such as "prod" or "staging".
+// Each environment has its own branch, variables and OLAP settings, and at most one deployment.
+// Environments belong to a project.
+type Environment struct {
+	ID           string    `db:"id"`
+	ProjectID    string    `db:"project_id"`
+	Name         string    `db:"name"`
+	Branch       string    `db:"branch"`
+	Commit       *string   `db:"git_commit"`
+	Variables    Variables `db:"variables"`
+	OLAPDriver   string    `db:"olap_driver"`
+	OLAPDSN      string    `db:"olap_dsn"`
+	Slots        int       `db:"slots"`
+	DeploymentID *string   `db:"deployment_id"`
+	CreatedOn    time.Time `db:"created_on"`
+	UpdatedOn    time.Time `db:"updated_on"`
+}
+
+// InsertEnvironmentOptions defines options for inserting a new Environment.
+type InsertEnvironmentOptions struct {
+	ProjectID  string `validate:"required"`
+	Name       string `validate:"slug"`
+	Branch     string `validate:"required"`
+	Variables  map[string]string
+	OLAPDriver string `validate:"required"`
+	OLAPDSN    string
+	Slots      int
+}
+
+// UpdateEnvironmentOptions defines options for updating an Environment.
+// If Commit is set, the environment is deployed from that commit instead of the head of its branch.
+type UpdateEnvironmentOptions struct {
+	Branch       string `validate:"required"`
+	Commit       *string
+	Variables    map[string]string
+	DeploymentID *string
 }
 
 // DeploymentStatus is an enum representing the state of a deployment
@@ -244,6 +476,7 @@ const (
 
 // Deployment is a single deployment of a git branch.
 // Deployments belong to a project.
+// Preview deployments are deployments of the branch of a pull request, which are deleted when the pull request is closed or they expire.
 type Deployment struct {
 	ID                string           `db:"id"`
 	ProjectID         string           `db:"project_id"`
@@ -252,8 +485,11 @@ type Deployment struct {
 	RuntimeHost       string           `db:"runtime_host"`
 	RuntimeInstanceID string           `db:"runtime_instance_id"`
 	RuntimeAudience   string           `db:"runtime_audience"`
+	OLAPDriver        string           `db:"olap_driver"`
 	Status            DeploymentStatus `db:"status"`
 	Logs              string           `db:"logs"`
+	PullRequestNumber *int             `db:"pull_request_number"`
+	ExpiresOn         *time.Time       `db:"expires_on"`
 	CreatedOn         time.Time        `db:"created_on"`
 	UpdatedOn         time.Time        `db:"updated_on"`
 }
@@ -266,8 +502,90 @@ type InsertDeploymentOptions struct {
 	RuntimeHost       string `validate:"required"`
 	RuntimeInstanceID string `validate:"required"`
 	RuntimeAudience   string
+	OLAPDriver        string
 	Status            DeploymentStatus
 	Logs              string
+	PullRequestNumber *int
+	ExpiresOn         *time.Time
+}
+
+// UpdateDeploymentRuntimeOptions defines options for moving a Deployment to another runtime instance.
+type UpdateDeploymentRuntimeOptions struct {
+	RuntimeHost       string `validate:"required"`
+	RuntimeInstanceID string `validate:"required"`
+	RuntimeAudience   string
+}
+
+// DeploymentRevision is a record of a reconcile of a deployment.
+// It's inserted when the reconcile starts and updated with the outcome when it finishes.
+// Revisions belong to a deployment.
+type DeploymentRevision struct {
+	ID           string                    `db:"id"`
+	DeploymentID string                    `db:"deployment_id"`
+	Commit       string                    `db:"git_commit"`
+	TriggeredBy  string                    `db:"triggered_by"`
+	Status       DeploymentStatus          `db:"status"`
+	Results      DeploymentRevisionResults `db:"results"`
+	Logs         string                    `db:"logs"`
+	StartedOn    time.Time                 `db:"started_on"`
+	FinishedOn   *time.Time                `db:"finished_on"`
+}
+
+// DeploymentRevisionResult is the outcome of reconciling one file of a deployment.
+// Errors that are not tied to a file have an empty Path.
+type DeploymentRevisionResult struct {
+	Path   string   `json:"path"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// DeploymentRevisionResults implements JSON SQL encoding of results in DeploymentRevision.
+type DeploymentRevisionResults []*DeploymentRevisionResult
+
+func (r *DeploymentRevisionResults) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &r)
+}
+
+// InsertDeploymentRevisionOptions defines options for inserting a new DeploymentRevision.
+type InsertDeploymentRevisionOptions struct {
+	DeploymentID string `validate:"required"`
+	Commit       string
+	TriggeredBy  string
+	Status       DeploymentStatus
+}
+
+// UpdateDeploymentRevisionOptions defines options for updating a DeploymentRevision when its reconcile finishes.
+// Commit is the commit that was reconciled, which may not be known until the runtime has pulled the repo.
+type UpdateDeploymentRevisionOptions struct {
+	Commit     string
+	Status     DeploymentStatus
+	Results    DeploymentRevisionResults
+	Logs       string
+	FinishedOn *time.Time
+}
+
+// DeploymentUsage is the usage last reported by the runtime instance of a deployment.
+// QueriesCounter is the instance's cumulative count of queries, which is used to compute the queries served since the last report.
+// QueriesCounterStartedOn is when the instance started counting, which changes when the counter is reset (nil if it wasn't reported).
+type DeploymentUsage struct {
+	DeploymentID            string     `db:"deployment_id"`
+	RuntimeInstanceID       string     `db:"runtime_instance_id"`
+	BytesIngested           int64      `db:"bytes_ingested"`
+	QueriesCounter          int64      `db:"queries_counter"`
+	QueriesCounterStartedOn *time.Time `db:"queries_counter_started_on"`
+	UpdatedOn               time.Time  `db:"updated_on"`
+}
+
+// UpsertDeploymentUsageOptions defines options for recording the usage reported by a deployment's runtime instance.
+type UpsertDeploymentUsageOptions struct {
+	DeploymentID            string `validate:"required"`
+	RuntimeInstanceID       string `validate:"required"`
+	BytesIngested           int64  `validate:"min=0"`
+	QueriesCounter          int64  `validate:"min=0"`
+	QueriesCounterStartedOn *time.Time
 }
 
 // RuntimeSlotsUsed is the result of a ResolveRuntimeSlotsUsed query.
@@ -276,6 +594,91 @@ type RuntimeSlotsUsed struct {
 	SlotsUsed   int    `db:"slots_used"`
 }
 
+// RuntimeStatus is an enum representing the health of a runtime
+type RuntimeStatus int
+
+const (
+	RuntimeStatusUnspecified RuntimeStatus = 0
+	RuntimeStatusHealthy     RuntimeStatus = 1
+	RuntimeStatusUnhealthy   RuntimeStatus = 2
+)
+
+// Runtime is a runtime server that registered itself with heartbeats.
+// Deployments are only provisioned on runtimes that are healthy and not draining.
+type Runtime struct {
+	Host            string        `db:"host"`
+	Audience        string        `db:"audience_url"`
+	Region          string        `db:"region"`
+	Slots           int           `db:"slots"`
+	DataDir         string        `db:"data_dir"`
+	Status          RuntimeStatus `db:"status"`
+	Draining        bool          `db:"draining"`
+	LastHeartbeatOn time.Time     `db:"last_heartbeat_on"`
+	CreatedOn       time.Time     `db:"created_on"`
+	UpdatedOn       time.Time     `db:"updated_on"`
+}
+
+// Schedulable returns true if new deployments can be provisioned on the runtime
+func (r *Runtime) Schedulable() bool {
+	return r.Status == RuntimeStatusHealthy && !r.Draining
+}
+
+// UpsertRuntimeOptions defines options for registering a Runtime or recording a heartbeat from it.
+// A new runtime is registered as healthy. A heartbeat doesn't change the status of a registered runtime (only the health check does),
+// nor whether it's draining.
+type UpsertRuntimeOptions struct {
+	Host     string `validate:"required"`
+	Audience string
+	Region   string
+	Slots    int `validate:"min=0"`
+	DataDir  string
+}
+
+// JobStatus is an enum representing the state of a job
+type JobStatus int
+
+const (
+	JobStatusUnspecified JobStatus = 0
+	JobStatusPending     JobStatus = 1
+	JobStatusRunning     JobStatus = 2
+	JobStatusSucceeded   JobStatus = 3
+	JobStatusFailed      JobStatus = 4
+)
+
+// Job is a background operation in the job queue.
+// Workers lease pending jobs, and jobs that fail are retried until they run out of attempts.
+// A job whose lease expires (e.g. because the admin server was restarted) can be leased again by another worker.
+type Job struct {
+	ID             string
+	Type           string
+	Payload        []byte
+	IdempotencyKey *string    `db:"idempotency_key"`
+	OrganizationID *string    `db:"org_id"`
+	ProjectID      *string    `db:"project_id"`
+	Status         JobStatus  `db:"status"`
+	Attempts       int        `db:"attempts"`
+	MaxAttempts    int        `db:"max_attempts"`
+	RunAfter       time.Time  `db:"run_after"`
+	LockedBy       *string    `db:"locked_by"`
+	LockedUntil    *time.Time `db:"locked_until"`
+	Error          string     `db:"error"`
+	CreatedOn      time.Time  `db:"created_on"`
+	UpdatedOn      time.Time  `db:"updated_on"`
+}
+
+// InsertJobOptions defines options for inserting a new Job.
+// If IdempotencyKey is set and a job with the same key is pending, InsertJob updates the pending job's payload and returns it instead.
+// Jobs with the same key also don't run concurrently.
+type InsertJobOptions struct {
+	Type           string `validate:"required"`
+	Payload        []byte
+	IdempotencyKey string
+	OrganizationID *string
+	ProjectID      *string
+	MaxAttempts    int `validate:"min=1"`
+	RunAfter       time.Time
+}
+
 // User is a person registered in Rill.
 // Users may belong to multiple organizations and projects.
 type User struct {
@@ -315,6 +718,13 @@ type InsertUsergroupOptions struct {
 	Name  string `validate:"slug"`
 }
 
+// UsergroupProjectRole is a role that a usergroup has on a project.
+type UsergroupProjectRole struct {
+	ProjectID   string `db:"project_id"`
+	ProjectName string `db:"project_name"`
+	RoleName    string `db:"role_name"`
+}
+
 // UserAuthToken is a persistent API token for a user.
 type UserAuthToken struct {
 	ID           string
@@ -334,6 +744,133 @@ type InsertUserAuthTokenOptions struct {
 	AuthClientID *string
 }
 
+// ServiceAccount is a non-human identity of an organization, which authenticates with ServiceAuthTokens.
+type ServiceAccount struct {
+	ID             string
+	OrganizationID string    `db:"org_id"`
+	Name           string    `db:"name"`
+	Description    string    `db:"description"`
+	CreatedOn      time.Time `db:"created_on"`
+	UpdatedOn      time.Time `db:"updated_on"`
+}
+
+// InsertServiceAccountOptions defines options for creating a ServiceAccount.
+type InsertServiceAccountOptions struct {
+	OrganizationID string `validate:"required"`
+	Name           string `validate:"slug"`
+	Description    string
+}
+
+// ServiceAuthToken is a persistent API token for a service account.
+// It's restricted to the projects in ProjectIDs (or all projects of the org if it's empty) and the permissions in Scopes.
+type ServiceAuthToken struct {
+	ID               string
+	SecretHash       []byte      `db:"secret_hash"`
+	ServiceAccountID string      `db:"service_account_id"`
+	DisplayName      string      `db:"display_name"`
+	ProjectIDs       StringSlice `db:"project_ids"`
+	Scopes           StringSlice `db:"scopes"`
+	ExpiresOn        *time.Time  `db:"expires_on"`
+	CreatedOn        time.Time   `db:"created_on"`
+}
+
+// InsertServiceAuthTokenOptions defines options for creating a ServiceAuthToken.
+type InsertServiceAuthTokenOptions struct {
+	ID               string `validate:"required"`
+	SecretHash       []byte `validate:"required"`
+	ServiceAccountID string `validate:"required"`
+	DisplayName      string
+	ProjectIDs       []string
+	Scopes           []string `validate:"min=1"`
+	ExpiresOn        *time.Time
+}
+
+// StringSlice implements JSON SQL encoding of lists of strings, such as the scopes of a ServiceAuthToken.
+type StringSlice []string
+
+func (s *StringSlice) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &s)
+}
+
+// AuditActorType identifies the kind of principal that performed an action in the audit log.
+type AuditActorType string
+
+const (
+	AuditActorTypeUser    AuditActorType = "user"
+	AuditActorTypeService AuditActorType = "service"
+	AuditActorTypeSystem  AuditActorType = "system"
+)
+
+// AuditLogEntry records an administrative action, such as adding a member or deleting a project.
+// Before and After contain the fields that the action changed. Entries are never changed or deleted.
+type AuditLogEntry struct {
+	ID             string
+	OrganizationID *string        `db:"org_id"`
+	ProjectID      *string        `db:"project_id"`
+	ActorType      AuditActorType `db:"actor_type"`
+	ActorID        *string        `db:"actor_id"`
+	// ActorName is the email of a user actor or the name of a service account actor. It's empty if the actor has been deleted.
+	ActorName  string      `db:"actor_name"`
+	Action     string      `db:"action"`
+	TargetType string      `db:"target_type"`
+	TargetID   string      `db:"target_id"`
+	TargetName string      `db:"target_name"`
+	Before     AuditFields `db:"before"`
+	After      AuditFields `db:"after"`
+	CreatedOn  time.Time   `db:"created_on"`
+}
+
+// InsertAuditLogEntryOptions defines options for inserting an AuditLogEntry.
+type InsertAuditLogEntryOptions struct {
+	OrganizationID *string
+	ProjectID      *string
+	ActorType      AuditActorType `validate:"required"`
+	ActorID        *string
+	Action         string `validate:"required"`
+	TargetType     string `validate:"required"`
+	TargetID       string
+	TargetName     string
+	Before         map[string]string
+	After          map[string]string
+}
+
+// FindAuditLogEntriesOptions filters the entries returned by FindAuditLogEntries.
+// Entries are returned newest first. Empty filters match all entries.
+type FindAuditLogEntriesOptions struct {
+	OrganizationID string `validate:"required"`
+	ProjectID      *string
+	ActorID        *string
+	After          *time.Time
+	Before         *time.Time
+	Limit          int `validate:"min=1"`
+}
+
+// AuditFields implements JSON SQL encoding of the fields changed by an action in AuditLogEntry.
+type AuditFields map[string]string
+
+func (f *AuditFields) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &f)
+}
+
+// MemberAttributes implements JSON SQL encoding of the custom attributes of an org member.
+type MemberAttributes map[string]any
+
+func (a *MemberAttributes) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed type assertion to []byte")
+	}
+	return json.Unmarshal(b, &a)
+}
+
 // AuthClient is a client that requests and consumes auth tokens.
 type AuthClient struct {
 	ID          string
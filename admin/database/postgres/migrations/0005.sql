@@ -0,0 +1,25 @@
+-- project_id is not a foreign key, so the jobs of a project are kept after it's deleted (e.g. the teardown job)
+CREATE TABLE jobs (
+	id UUID NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+	type TEXT NOT NULL,
+	payload JSONB DEFAULT '{}'::jsonb NOT NULL,
+	idempotency_key TEXT,
+	org_id UUID REFERENCES orgs (id) ON DELETE CASCADE,
+	project_id UUID,
+	status INTEGER NOT NULL,
+	attempts INTEGER DEFAULT 0 NOT NULL,
+	max_attempts INTEGER NOT NULL,
+	run_after TIMESTAMPTZ DEFAULT now() NOT NULL,
+	locked_by TEXT,
+	locked_until TIMESTAMPTZ,
+	error TEXT DEFAULT '' NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- There can be at most one pending job for each idempotency key
+CREATE UNIQUE INDEX jobs_idempotency_key_pending_idx ON jobs (idempotency_key) WHERE status = 1;
+
+CREATE INDEX jobs_status_run_after_idx ON jobs (status, run_after);
+
+CREATE INDEX jobs_project_id_idx ON jobs (project_id, created_on);
@@ -0,0 +1,5 @@
+ALTER TABLE deployments ADD COLUMN pull_request_number INTEGER;
+ALTER TABLE deployments ADD COLUMN expires_on TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX deployments_pull_request_idx ON deployments (project_id, pull_request_number) WHERE pull_request_number IS NOT NULL;
+CREATE INDEX deployments_expires_on_idx ON deployments (expires_on) WHERE expires_on IS NOT NULL;
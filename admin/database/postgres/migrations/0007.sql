@@ -0,0 +1,30 @@
+CREATE TABLE environments (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	branch TEXT NOT NULL,
+	git_commit TEXT,
+	variables JSONB DEFAULT '{}'::jsonb NOT NULL,
+	olap_driver TEXT NOT NULL,
+	olap_dsn TEXT NOT NULL,
+	slots INTEGER NOT NULL,
+	deployment_id UUID REFERENCES deployments ON DELETE SET NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX environments_name_idx ON environments (project_id, lower(name));
+CREATE UNIQUE INDEX environments_deployment_id_idx ON environments (deployment_id);
+
+INSERT INTO environments (project_id, name, branch, variables, olap_driver, olap_dsn, slots, deployment_id)
+SELECT id, 'prod', prod_branch, prod_variables, prod_olap_driver, prod_olap_dsn, prod_slots, prod_deployment_id FROM projects;
+
+ALTER TABLE deployments ADD COLUMN olap_driver TEXT DEFAULT '' NOT NULL;
+UPDATE deployments d SET olap_driver = p.prod_olap_driver FROM projects p WHERE d.project_id = p.id;
+
+ALTER TABLE projects DROP COLUMN prod_branch;
+ALTER TABLE projects DROP COLUMN prod_variables;
+ALTER TABLE projects DROP COLUMN prod_olap_driver;
+ALTER TABLE projects DROP COLUMN prod_olap_dsn;
+ALTER TABLE projects DROP COLUMN prod_slots;
+ALTER TABLE projects DROP COLUMN prod_deployment_id;
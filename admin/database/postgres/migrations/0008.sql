@@ -0,0 +1,14 @@
+-- Revisions record every reconcile of a deployment, including the commit it was reconciled from and its results
+CREATE TABLE deployment_revisions (
+	id UUID NOT NULL DEFAULT uuid_generate_v4() PRIMARY KEY,
+	deployment_id UUID NOT NULL REFERENCES deployments (id) ON DELETE CASCADE,
+	git_commit TEXT DEFAULT '' NOT NULL,
+	triggered_by TEXT DEFAULT '' NOT NULL,
+	status INTEGER NOT NULL,
+	results JSONB DEFAULT '[]'::jsonb NOT NULL,
+	logs TEXT DEFAULT '' NOT NULL,
+	started_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	finished_on TIMESTAMPTZ
+);
+
+CREATE INDEX deployment_revisions_deployment_id_idx ON deployment_revisions (deployment_id, started_on);
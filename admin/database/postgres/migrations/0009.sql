@@ -0,0 +1,15 @@
+-- Runtimes register themselves with heartbeats when the admin server uses the dynamic provisioner
+CREATE TABLE runtimes (
+	host TEXT NOT NULL PRIMARY KEY,
+	audience_url TEXT DEFAULT '' NOT NULL,
+	region TEXT DEFAULT '' NOT NULL,
+	slots INTEGER NOT NULL,
+	data_dir TEXT DEFAULT '' NOT NULL,
+	status INTEGER NOT NULL,
+	draining BOOLEAN DEFAULT false NOT NULL,
+	last_heartbeat_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX deployments_runtime_host_idx ON deployments (runtime_host);
@@ -0,0 +1,25 @@
+-- Service accounts belong to an org and authenticate with scoped tokens, e.g. for CI pipelines
+CREATE TABLE service_accounts (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	description TEXT DEFAULT '' NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX service_accounts_name_idx ON service_accounts (org_id, lower(name));
+
+-- An empty list of project IDs gives the token access to all the projects of the org
+CREATE TABLE service_auth_tokens (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	secret_hash BYTEA NOT NULL,
+	service_account_id UUID NOT NULL REFERENCES service_accounts (id) ON DELETE CASCADE,
+	display_name TEXT NOT NULL,
+	project_ids JSONB DEFAULT '[]'::jsonb NOT NULL,
+	scopes JSONB DEFAULT '[]'::jsonb NOT NULL,
+	expires_on TIMESTAMPTZ,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX service_auth_tokens_service_account_idx ON service_auth_tokens (service_account_id);
@@ -0,0 +1,32 @@
+-- The audit log records administrative actions. It doesn't reference orgs, projects or actors with foreign keys,
+-- since entries must outlive what they describe.
+CREATE TABLE audit_log (
+	id UUID DEFAULT uuid_generate_v4() PRIMARY KEY,
+	org_id UUID,
+	project_id UUID,
+	actor_type TEXT NOT NULL,
+	actor_id UUID,
+	action TEXT NOT NULL,
+	target_type TEXT NOT NULL,
+	target_id TEXT DEFAULT '' NOT NULL,
+	target_name TEXT DEFAULT '' NOT NULL,
+	before JSONB DEFAULT '{}'::jsonb NOT NULL,
+	after JSONB DEFAULT '{}'::jsonb NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE INDEX audit_log_org_idx ON audit_log (org_id, created_on);
+
+CREATE INDEX audit_log_project_idx ON audit_log (project_id, created_on);
+
+CREATE INDEX audit_log_actor_idx ON audit_log (actor_id, created_on);
+
+-- The audit log is append-only
+CREATE FUNCTION audit_log_append_only() RETURNS TRIGGER AS $$
+BEGIN
+	RAISE EXCEPTION 'audit log entries cannot be changed or deleted';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+	FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
@@ -0,0 +1,24 @@
+-- Quotas of an org. A negative quota means the resource is not limited.
+ALTER TABLE orgs ADD quota_projects INTEGER DEFAULT -1 NOT NULL;
+ALTER TABLE orgs ADD quota_slots_total INTEGER DEFAULT -1 NOT NULL;
+ALTER TABLE orgs ADD quota_ingested_bytes BIGINT DEFAULT -1 NOT NULL;
+ALTER TABLE orgs ADD quota_queries_per_month BIGINT DEFAULT -1 NOT NULL;
+
+-- Usage last reported by the runtime instance of a deployment.
+-- The queries counter is cumulative since the runtime started, so it's used to compute the queries served since the last report.
+CREATE TABLE deployments_usage (
+	deployment_id UUID PRIMARY KEY REFERENCES deployments (id) ON DELETE CASCADE,
+	runtime_instance_id TEXT NOT NULL,
+	bytes_ingested BIGINT DEFAULT 0 NOT NULL,
+	queries_counter BIGINT DEFAULT 0 NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- Queries served by the deployments of an org per calendar month.
+-- It's kept per org, so it isn't lost when deployments are torn down.
+CREATE TABLE orgs_queries_usage (
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	period_start DATE NOT NULL,
+	queries BIGINT DEFAULT 0 NOT NULL,
+	PRIMARY KEY (org_id, period_start)
+);
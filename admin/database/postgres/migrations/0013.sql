@@ -0,0 +1,30 @@
+-- OIDC identity provider that the members of an org sign in with
+CREATE TABLE orgs_sso (
+	org_id UUID PRIMARY KEY REFERENCES orgs (id) ON DELETE CASCADE,
+	issuer_url TEXT NOT NULL,
+	client_id TEXT NOT NULL,
+	client_secret TEXT NOT NULL,
+	groups_claim TEXT DEFAULT 'groups' NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+-- Users with an email address in one of these domains are routed to the org's identity provider.
+-- Domains are stored in lower case, and a domain can only be routed to one org.
+CREATE TABLE orgs_sso_domains (
+	domain TEXT PRIMARY KEY,
+	org_id UUID NOT NULL REFERENCES orgs_sso (org_id) ON DELETE CASCADE
+);
+
+CREATE INDEX orgs_sso_domains_org_idx ON orgs_sso_domains (org_id);
+
+-- Maps a group claim of the identity provider to a usergroup of the org.
+-- The usergroup's members are synced with the claim when users sign in.
+CREATE TABLE orgs_sso_groups (
+	usergroup_id UUID PRIMARY KEY REFERENCES usergroups (id) ON DELETE CASCADE,
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	idp_group TEXT NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX orgs_sso_groups_idp_group_idx ON orgs_sso_groups (org_id, idp_group);
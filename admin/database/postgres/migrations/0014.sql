@@ -0,0 +1,23 @@
+-- Users provisioned in an org by its identity provider with SCIM.
+-- Inactive users keep their row, but are not members of the org.
+CREATE TABLE orgs_scim_users (
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	external_id TEXT DEFAULT '' NOT NULL,
+	active BOOLEAN DEFAULT true NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	PRIMARY KEY (org_id, user_id)
+);
+
+-- Groups provisioned in an org with SCIM. Each group is backed by a usergroup of the org.
+CREATE TABLE orgs_scim_groups (
+	usergroup_id UUID PRIMARY KEY REFERENCES usergroups (id) ON DELETE CASCADE,
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	display_name TEXT NOT NULL,
+	external_id TEXT DEFAULT '' NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+
+CREATE UNIQUE INDEX orgs_scim_groups_display_name_idx ON orgs_scim_groups (org_id, lower(display_name));
@@ -0,0 +1,11 @@
+-- Custom attributes that org admins attach to members of the org.
+-- They are passed to the runtime along with the built-in user attributes for use in metrics view policies.
+-- They are kept when a user leaves the org, so they apply again if the user is re-added.
+CREATE TABLE orgs_users_attributes (
+	org_id UUID NOT NULL REFERENCES orgs (id) ON DELETE CASCADE,
+	user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+	attributes JSONB NOT NULL,
+	created_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	updated_on TIMESTAMPTZ DEFAULT now() NOT NULL,
+	PRIMARY KEY (org_id, user_id)
+);
@@ -0,0 +1,3 @@
+-- Time the runtime instance's query counter started, so a reset of the counter is detected even if it has grown past the previous report.
+-- It's NULL for usage reported before the column was added.
+ALTER TABLE deployments_usage ADD queries_counter_started_on TIMESTAMPTZ;
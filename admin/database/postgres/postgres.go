@@ -155,6 +155,263 @@ func (c *connection) UpdateOrganizationAllUsergroup(ctx context.Context, orgID,
 	return res, nil
 }
 
+func (c *connection) UpdateOrganizationQuotas(ctx context.Context, orgID string, opts *database.UpdateOrganizationQuotasOptions) (*database.Organization, error) {
+	res := &database.Organization{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE orgs SET quota_projects=$1, quota_slots_total=$2, quota_ingested_bytes=$3, quota_queries_per_month=$4, updated_on=now()
+		WHERE id=$5 RETURNING *`,
+		opts.Projects, opts.SlotsTotal, opts.IngestedBytes, opts.QueriesPerMonth, orgID,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+// ResolveOrganizationUsage resolves the current usage of an org. Slots of preview deployments are not counted.
+// The queries are the queries served in the period starting on periodStart.
+func (c *connection) ResolveOrganizationUsage(ctx context.Context, orgID string, periodStart time.Time) (*database.OrganizationUsage, error) {
+	res := &database.OrganizationUsage{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM projects p WHERE p.org_id=$1) AS projects,
+			(SELECT COALESCE(SUM(d.slots), 0) FROM deployments d JOIN projects p ON d.project_id=p.id WHERE p.org_id=$1) AS slots,
+			(SELECT COALESCE(SUM(u.bytes_ingested), 0)::BIGINT FROM deployments_usage u JOIN deployments d ON u.deployment_id=d.id JOIN projects p ON d.project_id=p.id WHERE p.org_id=$1) AS bytes_ingested,
+			(SELECT COALESCE(SUM(q.queries), 0)::BIGINT FROM orgs_queries_usage q WHERE q.org_id=$1 AND q.period_start=$2) AS queries
+	`, orgID, periodStart).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) IncrementOrganizationQueries(ctx context.Context, orgID string, periodStart time.Time, queries int64) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, `
+		INSERT INTO orgs_queries_usage (org_id, period_start, queries) VALUES ($1, $2, $3)
+		ON CONFLICT (org_id, period_start) DO UPDATE SET queries=orgs_queries_usage.queries+EXCLUDED.queries
+	`, orgID, periodStart, queries)
+	return parseErr(err)
+}
+
+// selectOrganizationSSO selects the SSO config of orgs with their domains. It must be followed by a WHERE clause on s.
+const selectOrganizationSSO = `
+	SELECT s.*, (SELECT COALESCE(jsonb_agg(d.domain ORDER BY d.domain), '[]'::jsonb) FROM orgs_sso_domains d WHERE d.org_id=s.org_id) AS domains
+	FROM orgs_sso s
+`
+
+func (c *connection) FindOrganizationSSO(ctx context.Context, orgID string) (*database.OrganizationSSO, error) {
+	res := &database.OrganizationSSO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, selectOrganizationSSO+"WHERE s.org_id=$1", orgID).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOrganizationSSOByDomain(ctx context.Context, domain string) (*database.OrganizationSSO, error) {
+	res := &database.OrganizationSSO{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, selectOrganizationSSO+"WHERE s.org_id=(SELECT d.org_id FROM orgs_sso_domains d WHERE d.domain=lower($1))", domain).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+// UpsertOrganizationSSO inserts or updates the SSO config of an org and replaces its domains.
+// It runs several statements, so it should be called in a transaction.
+// It returns database.ErrNotUnique if one of the domains is used by another org.
+func (c *connection) UpsertOrganizationSSO(ctx context.Context, opts *database.UpsertOrganizationSSOOptions) (*database.OrganizationSSO, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	_, err := c.getDB(ctx).ExecContext(ctx, `
+		INSERT INTO orgs_sso (org_id, issuer_url, client_id, client_secret, groups_claim) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (org_id) DO UPDATE SET issuer_url=EXCLUDED.issuer_url, client_id=EXCLUDED.client_id, client_secret=EXCLUDED.client_secret, groups_claim=EXCLUDED.groups_claim, updated_on=now()
+	`, opts.OrgID, opts.IssuerURL, opts.ClientID, opts.ClientSecret, opts.GroupsClaim)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+
+	_, err = c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_sso_domains WHERE org_id=$1", opts.OrgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+
+	for _, domain := range opts.Domains {
+		_, err = c.getDB(ctx).ExecContext(ctx, "INSERT INTO orgs_sso_domains (domain, org_id) VALUES (lower($1), $2)", domain, opts.OrgID)
+		if err != nil {
+			return nil, parseErr(err)
+		}
+	}
+
+	return c.FindOrganizationSSO(ctx, opts.OrgID)
+}
+
+func (c *connection) DeleteOrganizationSSO(ctx context.Context, orgID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_sso WHERE org_id=$1", orgID)
+	return parseErr(err)
+}
+
+// selectOrganizationSSOGroups selects group mappings with the name and org role of their usergroup. It must be followed by a WHERE clause on g.
+const selectOrganizationSSOGroups = `
+	SELECT g.*, ug.name AS usergroup_name, r.name AS org_role_name
+	FROM orgs_sso_groups g
+	JOIN usergroups ug ON g.usergroup_id=ug.id
+	LEFT JOIN usergroups_orgs_roles ugor ON g.usergroup_id=ugor.usergroup_id AND g.org_id=ugor.org_id
+	LEFT JOIN org_roles r ON ugor.org_role_id=r.id
+`
+
+func (c *connection) FindOrganizationSSOGroups(ctx context.Context, orgID string) ([]*database.OrganizationSSOGroup, error) {
+	var res []*database.OrganizationSSOGroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, selectOrganizationSSOGroups+"WHERE g.org_id=$1 ORDER BY g.idp_group", orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOrganizationSSOGroup(ctx context.Context, orgID, idpGroup string) (*database.OrganizationSSOGroup, error) {
+	res := &database.OrganizationSSOGroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, selectOrganizationSSOGroups+"WHERE g.org_id=$1 AND g.idp_group=$2", orgID, idpGroup).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOrganizationSSOGroup(ctx context.Context, opts *database.InsertOrganizationSSOGroupOptions) (*database.OrganizationSSOGroup, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO orgs_sso_groups (usergroup_id, org_id, idp_group) VALUES ($1, $2, $3)", opts.UsergroupID, opts.OrgID, opts.IDPGroup)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return c.FindOrganizationSSOGroup(ctx, opts.OrgID, opts.IDPGroup)
+}
+
+// selectOrganizationSCIMUsers selects SCIM users with their email and name. It must be followed by a WHERE clause on su.
+const selectOrganizationSCIMUsers = `
+	SELECT su.*, u.email, u.display_name
+	FROM orgs_scim_users su
+	JOIN users u ON su.user_id=u.id
+`
+
+func (c *connection) FindOrganizationSCIMUsers(ctx context.Context, orgID string) ([]*database.OrganizationSCIMUser, error) {
+	var res []*database.OrganizationSCIMUser
+	err := c.getDB(ctx).SelectContext(ctx, &res, selectOrganizationSCIMUsers+"WHERE su.org_id=$1 ORDER BY su.created_on, su.user_id", orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOrganizationSCIMUser(ctx context.Context, orgID, userID string) (*database.OrganizationSCIMUser, error) {
+	res := &database.OrganizationSCIMUser{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, selectOrganizationSCIMUsers+"WHERE su.org_id=$1 AND su.user_id=$2", orgID, userID).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOrganizationSCIMUser(ctx context.Context, opts *database.InsertOrganizationSCIMUserOptions) (*database.OrganizationSCIMUser, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO orgs_scim_users (org_id, user_id, external_id, active) VALUES ($1, $2, $3, $4)", opts.OrgID, opts.UserID, opts.ExternalID, opts.Active)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return c.FindOrganizationSCIMUser(ctx, opts.OrgID, opts.UserID)
+}
+
+func (c *connection) UpdateOrganizationSCIMUser(ctx context.Context, orgID, userID string, opts *database.UpdateOrganizationSCIMUserOptions) (*database.OrganizationSCIMUser, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res, err := c.getDB(ctx).ExecContext(ctx, "UPDATE orgs_scim_users SET external_id=$3, active=$4, updated_on=now() WHERE org_id=$1 AND user_id=$2", orgID, userID, opts.ExternalID, opts.Active)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, database.ErrNotFound
+	}
+	return c.FindOrganizationSCIMUser(ctx, orgID, userID)
+}
+
+func (c *connection) DeleteOrganizationSCIMUser(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_scim_users WHERE org_id=$1 AND user_id=$2", orgID, userID)
+	return parseErr(err)
+}
+
+// selectOrganizationSCIMGroups selects SCIM groups with the name of their usergroup. It must be followed by a WHERE clause on sg.
+const selectOrganizationSCIMGroups = `
+	SELECT sg.*, ug.name AS usergroup_name
+	FROM orgs_scim_groups sg
+	JOIN usergroups ug ON sg.usergroup_id=ug.id
+`
+
+func (c *connection) FindOrganizationSCIMGroups(ctx context.Context, orgID string) ([]*database.OrganizationSCIMGroup, error) {
+	var res []*database.OrganizationSCIMGroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, selectOrganizationSCIMGroups+"WHERE sg.org_id=$1 ORDER BY sg.created_on, sg.usergroup_id", orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindOrganizationSCIMGroup(ctx context.Context, orgID, usergroupID string) (*database.OrganizationSCIMGroup, error) {
+	res := &database.OrganizationSCIMGroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, selectOrganizationSCIMGroups+"WHERE sg.org_id=$1 AND sg.usergroup_id=$2", orgID, usergroupID).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertOrganizationSCIMGroup(ctx context.Context, opts *database.InsertOrganizationSCIMGroupOptions) (*database.OrganizationSCIMGroup, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO orgs_scim_groups (usergroup_id, org_id, display_name, external_id) VALUES ($1, $2, $3, $4)", opts.UsergroupID, opts.OrgID, opts.DisplayName, opts.ExternalID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return c.FindOrganizationSCIMGroup(ctx, opts.OrgID, opts.UsergroupID)
+}
+
+func (c *connection) UpdateOrganizationSCIMGroup(ctx context.Context, usergroupID string, opts *database.UpdateOrganizationSCIMGroupOptions) (*database.OrganizationSCIMGroup, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.OrganizationSCIMGroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE orgs_scim_groups sg SET display_name=$2, external_id=$3, updated_on=now()
+		FROM usergroups ug WHERE sg.usergroup_id=$1 AND ug.id=sg.usergroup_id
+		RETURNING sg.*, ug.name AS usergroup_name
+	`, usergroupID, opts.DisplayName, opts.ExternalID).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteOrganizationSCIMGroup(ctx context.Context, usergroupID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_scim_groups WHERE usergroup_id=$1", usergroupID)
+	return parseErr(err)
+}
+
 func (c *connection) FindProjects(ctx context.Context, orgName string) ([]*database.Project, error) {
 	var res []*database.Project
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p JOIN orgs o ON p.org_id = o.id WHERE lower(o.name)=lower($1) ORDER BY lower(p.name)", orgName)
@@ -180,88 +437,427 @@ func (c *connection) FindProjectsForUser(ctx context.Context, userID string) ([]
 	return res, nil
 }
 
-func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID string) ([]*database.Project, error) {
-	var res []*database.Project
-	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE p.org_id=$1 ORDER BY lower(p.name)", orgID)
+func (c *connection) FindProjectsForOrganization(ctx context.Context, orgID string) ([]*database.Project, error) {
+	var res []*database.Project
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE p.org_id=$1 ORDER BY lower(p.name)", orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userID string) ([]*database.Project, error) {
+	var res []*database.Project
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT p.* FROM projects p
+		WHERE p.org_id = $1 AND p.id IN (
+			SELECT upr.project_id FROM users_projects_roles upr WHERE upr.user_id = $2
+			UNION
+			SELECT ugpr.project_id FROM usergroups_projects_roles ugpr JOIN usergroups_users uug ON ugpr.usergroup_id = uug.usergroup_id WHERE uug.user_id = $2
+		)
+	`, orgID, userID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID string) ([]*database.Project, error) {
+	var res []*database.Project
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE p.org_id = $1 AND p.public = true", orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*database.Project, error) {
+	result := make([]*database.Project, 0)
+	err := c.getDB(ctx).SelectContext(ctx, &result, "SELECT p.* FROM projects p WHERE lower(p.github_url)=lower($1) ", githubURL)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+
+	return result, nil
+}
+
+func (c *connection) FindProjectsByOrgAndGithubURL(ctx context.Context, orgID, githubURL string) ([]*database.Project, error) {
+	result := make([]*database.Project, 0)
+	err := c.getDB(ctx).SelectContext(ctx, &result, "SELECT p.* FROM projects p WHERE lower(p.github_url)=lower($1) AND org_id=$2", githubURL, orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+
+	return result, nil
+}
+
+func (c *connection) FindProject(ctx context.Context, id string) (*database.Project, error) {
+	res := &database.Project{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM projects WHERE id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindProjectByName(ctx context.Context, orgName, name string) (*database.Project, error) {
+	res := &database.Project{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT p.* FROM projects p JOIN orgs o ON p.org_id = o.id WHERE lower(p.name)=lower($1) AND lower(o.name)=lower($2)", name, orgName).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertProject(ctx context.Context, opts *database.InsertProjectOptions) (*database.Project, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Project{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO projects (org_id, name, description, public, region, github_url, github_installation_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`,
+		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.Region, opts.GithubURL, opts.GithubInstallationID,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteProject(ctx context.Context, id string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM projects WHERE id=$1", id)
+	return parseErr(err)
+}
+
+func (c *connection) UpdateProject(ctx context.Context, id string, opts *database.UpdateProjectOptions) (*database.Project, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Project{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE projects SET name=$1, description=$2, public=$3, github_url=$4, github_installation_id=$5, updated_on=now()
+		WHERE id=$6 RETURNING *`,
+		opts.Name, opts.Description, opts.Public, opts.GithubURL, opts.GithubInstallationID, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindEnvironments(ctx context.Context, projectID string) ([]*database.Environment, error) {
+	var res []*database.Environment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM environments e WHERE e.project_id=$1 ORDER BY lower(e.name)", projectID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindEnvironmentByName(ctx context.Context, projectID, name string) (*database.Environment, error) {
+	res := &database.Environment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM environments e WHERE e.project_id=$1 AND lower(e.name)=lower($2)", projectID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertEnvironment(ctx context.Context, opts *database.InsertEnvironmentOptions) (*database.Environment, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Environment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO environments (project_id, name, branch, variables, olap_driver, olap_dsn, slots)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`,
+		opts.ProjectID, opts.Name, opts.Branch, database.Variables(opts.Variables), opts.OLAPDriver, opts.OLAPDSN, opts.Slots,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteEnvironment(ctx context.Context, id string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM environments WHERE id=$1", id)
+	return parseErr(err)
+}
+
+func (c *connection) UpdateEnvironment(ctx context.Context, id string, opts *database.UpdateEnvironmentOptions) (*database.Environment, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Environment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE environments SET branch=$1, git_commit=$2, variables=$3, deployment_id=$4, updated_on=now()
+		WHERE id=$5 RETURNING *`,
+		opts.Branch, opts.Commit, database.Variables(opts.Variables), opts.DeploymentID, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindEnvironmentForDeployment(ctx context.Context, deploymentID string) (*database.Environment, error) {
+	res := &database.Environment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM environments e WHERE e.deployment_id=$1", deploymentID).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindDeployments(ctx context.Context, projectID string) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.project_id=$1", projectID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindDeployment(ctx context.Context, id string) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT d.* FROM deployments d WHERE d.id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindDeploymentForPullRequest(ctx context.Context, projectID string, pullRequestNumber int) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT d.* FROM deployments d WHERE d.project_id=$1 AND d.pull_request_number=$2", projectID, pullRequestNumber).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindExpiredDeployments(ctx context.Context) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT d.* FROM deployments d WHERE d.expires_on < now()")
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertDeployment(ctx context.Context, opts *database.InsertDeploymentOptions) (*database.Deployment, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO deployments (project_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, olap_driver, status, logs, pull_request_number, expires_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) RETURNING *`,
+		opts.ProjectID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.OLAPDriver, opts.Status, opts.Logs, opts.PullRequestNumber, opts.ExpiresOn,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteDeployment(ctx context.Context, id string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM deployments WHERE id=$1", id)
+	return parseErr(err)
+}
+
+func (c *connection) UpdateDeploymentStatus(ctx context.Context, id string, status database.DeploymentStatus, logs string) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET status=$1, logs=$2, updated_on=now() WHERE id=$3 RETURNING *", status, logs, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateDeploymentExpiry(ctx context.Context, id string, expiresOn time.Time) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET expires_on=$1, updated_on=now() WHERE id=$2 RETURNING *", expiresOn, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateDeploymentBranch(ctx context.Context, id, branch string) (*database.Deployment, error) {
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET branch=$1, updated_on=now() WHERE id=$2 RETURNING *", branch, id).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindDeploymentsForRuntime(ctx context.Context, host string) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.runtime_host=$1", host)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpdateDeploymentRuntime(ctx context.Context, id string, opts *database.UpdateDeploymentRuntimeOptions) (*database.Deployment, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.Deployment{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET runtime_host=$1, runtime_instance_id=$2, runtime_audience=$3, updated_on=now() WHERE id=$4 RETURNING *",
+		opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, id,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindDeploymentsForOrganization(ctx context.Context, orgID string) ([]*database.Deployment, error) {
+	var res []*database.Deployment
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT d.* FROM deployments d JOIN projects p ON d.project_id=p.id WHERE p.org_id=$1", orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindDeploymentUsage(ctx context.Context, deploymentID string) (*database.DeploymentUsage, error) {
+	res := &database.DeploymentUsage{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT u.* FROM deployments_usage u WHERE u.deployment_id=$1", deploymentID).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) UpsertDeploymentUsage(ctx context.Context, opts *database.UpsertDeploymentUsageOptions) (*database.DeploymentUsage, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.DeploymentUsage{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO deployments_usage (deployment_id, runtime_instance_id, bytes_ingested, queries_counter, queries_counter_started_on)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (deployment_id) DO UPDATE SET
+			runtime_instance_id=EXCLUDED.runtime_instance_id, bytes_ingested=EXCLUDED.bytes_ingested, queries_counter=EXCLUDED.queries_counter,
+			queries_counter_started_on=EXCLUDED.queries_counter_started_on, updated_on=now()
+		RETURNING *`,
+		opts.DeploymentID, opts.RuntimeInstanceID, opts.BytesIngested, opts.QueriesCounter, opts.QueriesCounterStartedOn,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindDeploymentRevisions(ctx context.Context, deploymentID string, limit int) ([]*database.DeploymentRevision, error) {
+	var res []*database.DeploymentRevision
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT r.* FROM deployment_revisions r WHERE r.deployment_id=$1 ORDER BY r.started_on DESC LIMIT $2", deploymentID, limit)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) FindProjectsForOrgAndUser(ctx context.Context, orgID, userID string) ([]*database.Project, error) {
-	var res []*database.Project
-	err := c.getDB(ctx).SelectContext(ctx, &res, `
-		SELECT p.* FROM projects p
-		WHERE p.org_id = $1 AND p.id IN (
-			SELECT upr.project_id FROM users_projects_roles upr WHERE upr.user_id = $2
-			UNION
-			SELECT ugpr.project_id FROM usergroups_projects_roles ugpr JOIN usergroups_users uug ON ugpr.usergroup_id = uug.usergroup_id WHERE uug.user_id = $2
-		)
-	`, orgID, userID)
+func (c *connection) FindDeploymentRevision(ctx context.Context, id string) (*database.DeploymentRevision, error) {
+	res := &database.DeploymentRevision{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT r.* FROM deployment_revisions r WHERE r.id=$1", id).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) FindPublicProjectsInOrganization(ctx context.Context, orgID string) ([]*database.Project, error) {
-	var res []*database.Project
-	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT p.* FROM projects p WHERE p.org_id = $1 AND p.public = true", orgID)
+func (c *connection) InsertDeploymentRevision(ctx context.Context, opts *database.InsertDeploymentRevisionOptions) (*database.DeploymentRevision, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.DeploymentRevision{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO deployment_revisions (deployment_id, git_commit, triggered_by, status)
+		VALUES ($1, $2, $3, $4) RETURNING *`,
+		opts.DeploymentID, opts.Commit, opts.TriggeredBy, opts.Status,
+	).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*database.Project, error) {
-	result := make([]*database.Project, 0)
-	err := c.getDB(ctx).SelectContext(ctx, &result, "SELECT p.* FROM projects p WHERE lower(p.github_url)=lower($1) ", githubURL)
+func (c *connection) UpdateDeploymentRevision(ctx context.Context, id string, opts *database.UpdateDeploymentRevisionOptions) (*database.DeploymentRevision, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	results := opts.Results
+	if results == nil {
+		results = database.DeploymentRevisionResults{}
+	}
+
+	res := &database.DeploymentRevision{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployment_revisions SET git_commit=$1, status=$2, results=$3, logs=$4, finished_on=$5 WHERE id=$6 RETURNING *",
+		opts.Commit, opts.Status, results, opts.Logs, opts.FinishedOn, id,
+	).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
 	}
-
-	return result, nil
+	return res, nil
 }
 
-func (c *connection) FindProjectsByOrgAndGithubURL(ctx context.Context, orgID, githubURL string) ([]*database.Project, error) {
-	result := make([]*database.Project, 0)
-	err := c.getDB(ctx).SelectContext(ctx, &result, "SELECT p.* FROM projects p WHERE lower(p.github_url)=lower($1) AND org_id=$2", githubURL, orgID)
+func (c *connection) ResolveRuntimeSlotsUsed(ctx context.Context) ([]*database.RuntimeSlotsUsed, error) {
+	var res []*database.RuntimeSlotsUsed
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT d.runtime_host, SUM(d.slots) AS slots_used FROM deployments d GROUP BY d.runtime_host")
 	if err != nil {
 		return nil, parseErr(err)
 	}
-
-	return result, nil
+	return res, nil
 }
 
-func (c *connection) FindProject(ctx context.Context, id string) (*database.Project, error) {
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM projects WHERE id=$1", id).StructScan(res)
+func (c *connection) FindRuntimes(ctx context.Context) ([]*database.Runtime, error) {
+	var res []*database.Runtime
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM runtimes r ORDER BY r.host")
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) FindProjectByName(ctx context.Context, orgName, name string) (*database.Project, error) {
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT p.* FROM projects p JOIN orgs o ON p.org_id = o.id WHERE lower(p.name)=lower($1) AND lower(o.name)=lower($2)", name, orgName).StructScan(res)
+func (c *connection) FindRuntime(ctx context.Context, host string) (*database.Runtime, error) {
+	res := &database.Runtime{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM runtimes r WHERE r.host=$1", host).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) InsertProject(ctx context.Context, opts *database.InsertProjectOptions) (*database.Project, error) {
+func (c *connection) UpsertRuntime(ctx context.Context, opts *database.UpsertRuntimeOptions) (*database.Runtime, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
 	}
 
-	res := &database.Project{}
+	res := &database.Runtime{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO projects (org_id, name, description, public, region, prod_olap_driver, prod_olap_dsn, prod_slots, prod_branch, prod_variables, github_url, github_installation_id)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12) RETURNING *`,
-		opts.OrganizationID, opts.Name, opts.Description, opts.Public, opts.Region, opts.ProdOLAPDriver, opts.ProdOLAPDSN, opts.ProdSlots, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID,
+		INSERT INTO runtimes (host, audience_url, region, slots, data_dir, status)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (host) DO UPDATE SET
+			audience_url=EXCLUDED.audience_url, region=EXCLUDED.region, slots=EXCLUDED.slots, data_dir=EXCLUDED.data_dir,
+			last_heartbeat_on=now(), updated_on=now()
+		RETURNING *`,
+		opts.Host, opts.Audience, opts.Region, opts.Slots, opts.DataDir, database.RuntimeStatusHealthy,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
@@ -269,56 +865,66 @@ func (c *connection) InsertProject(ctx context.Context, opts *database.InsertPro
 	return res, nil
 }
 
-func (c *connection) DeleteProject(ctx context.Context, id string) error {
-	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM projects WHERE id=$1", id)
-	return parseErr(err)
-}
-
-func (c *connection) UpdateProject(ctx context.Context, id string, opts *database.UpdateProjectOptions) (*database.Project, error) {
-	if err := database.Validate(opts); err != nil {
-		return nil, err
+func (c *connection) UpdateRuntimeStatus(ctx context.Context, host string, status database.RuntimeStatus) (*database.Runtime, error) {
+	res := &database.Runtime{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE runtimes SET status=$1, updated_on=now() WHERE host=$2 RETURNING *", status, host).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
 	}
+	return res, nil
+}
 
-	res := &database.Project{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		UPDATE projects SET name=$1, description=$2, public=$3, prod_branch=$4, prod_variables=$5, github_url=$6, github_installation_id=$7, prod_deployment_id=$8, updated_on=now()
-		WHERE id=$9 RETURNING *`,
-		opts.Name, opts.Description, opts.Public, opts.ProdBranch, database.Variables(opts.ProdVariables), opts.GithubURL, opts.GithubInstallationID, opts.ProdDeploymentID, id,
-	).StructScan(res)
+func (c *connection) UpdateRuntimeDraining(ctx context.Context, host string, draining bool) (*database.Runtime, error) {
+	res := &database.Runtime{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE runtimes SET draining=$1, updated_on=now() WHERE host=$2 RETURNING *", draining, host).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) FindDeployments(ctx context.Context, projectID string) ([]*database.Deployment, error) {
-	var res []*database.Deployment
-	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT * FROM deployments d WHERE d.project_id=$1", projectID)
+func (c *connection) FindJob(ctx context.Context, id string) (*database.Job, error) {
+	res := &database.Job{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT j.* FROM jobs j WHERE j.id=$1", id).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) FindDeployment(ctx context.Context, id string) (*database.Deployment, error) {
-	res := &database.Deployment{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT d.* FROM deployments d WHERE d.id=$1", id).StructScan(res)
+func (c *connection) FindJobsForProject(ctx context.Context, projectID string, limit int) ([]*database.Job, error) {
+	var res []*database.Job
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT j.* FROM jobs j WHERE j.project_id=$1 ORDER BY j.created_on DESC LIMIT $2", projectID, limit)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) InsertDeployment(ctx context.Context, opts *database.InsertDeploymentOptions) (*database.Deployment, error) {
+// InsertJob inserts a pending job. If a job with the same idempotency key is already pending, it returns that job instead,
+// after replacing its payload with the new job's and moving it forward if the new job should run sooner.
+func (c *connection) InsertJob(ctx context.Context, opts *database.InsertJobOptions) (*database.Job, error) {
 	if err := database.Validate(opts); err != nil {
 		return nil, err
 	}
 
-	res := &database.Deployment{}
+	payload := opts.Payload
+	if payload == nil {
+		payload = []byte("{}")
+	}
+
+	var runAfter *time.Time
+	if !opts.RunAfter.IsZero() {
+		runAfter = &opts.RunAfter
+	}
+
+	res := &database.Job{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, `
-		INSERT INTO deployments (project_id, slots, branch, runtime_host, runtime_instance_id, runtime_audience, status, logs)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING *`,
-		opts.ProjectID, opts.Slots, opts.Branch, opts.RuntimeHost, opts.RuntimeInstanceID, opts.RuntimeAudience, opts.Status, opts.Logs,
+		INSERT INTO jobs (type, payload, idempotency_key, org_id, project_id, status, max_attempts, run_after)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6, $7, COALESCE($8, now()))
+		ON CONFLICT (idempotency_key) WHERE status=1 DO UPDATE SET payload=EXCLUDED.payload, run_after=LEAST(jobs.run_after, EXCLUDED.run_after), updated_on=now()
+		RETURNING *`,
+		opts.Type, string(payload), opts.IdempotencyKey, opts.OrganizationID, opts.ProjectID, database.JobStatusPending, opts.MaxAttempts, runAfter,
 	).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
@@ -326,27 +932,86 @@ func (c *connection) InsertDeployment(ctx context.Context, opts *database.Insert
 	return res, nil
 }
 
-func (c *connection) DeleteDeployment(ctx context.Context, id string) error {
-	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM deployments WHERE id=$1", id)
-	return parseErr(err)
-}
-
-func (c *connection) UpdateDeploymentStatus(ctx context.Context, id string, status database.DeploymentStatus, logs string) (*database.Deployment, error) {
-	res := &database.Deployment{}
-	err := c.getDB(ctx).QueryRowxContext(ctx, "UPDATE deployments SET status=$1, logs=$2, updated_on=now() WHERE id=$3 RETURNING *", status, logs, id).StructScan(res)
+// LeaseJob marks the next job that is ready to run as running by workerID until the lease expires.
+// Jobs that are running with an expired lease are leased again.
+// A job isn't leased while another job with the same idempotency key is running.
+// It returns database.ErrNotFound if no jobs are ready.
+func (c *connection) LeaseJob(ctx context.Context, workerID string, lease time.Duration) (*database.Job, error) {
+	res := &database.Job{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		UPDATE jobs SET status=$1, attempts=attempts+1, locked_by=$2, locked_until=now()+make_interval(secs => $3), updated_on=now()
+		WHERE id = (
+			SELECT j.id FROM jobs j
+			WHERE (j.status=$4 AND j.run_after<=now() OR j.status=$1 AND j.locked_until<now())
+			AND NOT EXISTS (
+				SELECT 1 FROM jobs r WHERE r.idempotency_key=j.idempotency_key AND r.id<>j.id AND r.status=$1 AND r.locked_until>=now()
+			)
+			ORDER BY j.run_after
+			LIMIT 1
+			FOR UPDATE OF j SKIP LOCKED
+		) RETURNING *`,
+		database.JobStatusRunning, workerID, lease.Seconds(), database.JobStatusPending,
+	).StructScan(res)
 	if err != nil {
 		return nil, parseErr(err)
 	}
 	return res, nil
 }
 
-func (c *connection) ResolveRuntimeSlotsUsed(ctx context.Context) ([]*database.RuntimeSlotsUsed, error) {
-	var res []*database.RuntimeSlotsUsed
-	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT d.runtime_host, SUM(d.slots) AS slots_used FROM deployments d GROUP BY d.runtime_host")
+// RenewJobLease extends the lease of a running job. It returns database.ErrNotFound if workerID no longer holds the lease.
+func (c *connection) RenewJobLease(ctx context.Context, id, workerID string, lease time.Duration) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `
+		UPDATE jobs SET locked_until=now()+make_interval(secs => $1), updated_on=now()
+		WHERE id=$2 AND locked_by=$3 AND status=$4`,
+		lease.Seconds(), id, workerID, database.JobStatusRunning,
+	)
+	return checkLeaseUpdate(res, err)
+}
+
+func (c *connection) CompleteJob(ctx context.Context, id, workerID string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `
+		UPDATE jobs SET status=$1, error='', locked_by=NULL, locked_until=NULL, updated_on=now()
+		WHERE id=$2 AND locked_by=$3 AND status=$4`,
+		database.JobStatusSucceeded, id, workerID, database.JobStatusRunning,
+	)
+	return checkLeaseUpdate(res, err)
+}
+
+// RetryJob makes a running job pending again, so it runs again after runAfter.
+// If another job with the same idempotency key has been enqueued in the meantime, the job fails instead, since the pending job supersedes it.
+func (c *connection) RetryJob(ctx context.Context, id, workerID, errMsg string, runAfter time.Time) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `
+		UPDATE jobs j SET
+			status=CASE WHEN EXISTS (SELECT 1 FROM jobs p WHERE p.idempotency_key=j.idempotency_key AND p.status=$1) THEN $2 ELSE $1 END,
+			error=$3, run_after=$4, locked_by=NULL, locked_until=NULL, updated_on=now()
+		WHERE j.id=$5 AND j.locked_by=$6 AND j.status=$7`,
+		database.JobStatusPending, database.JobStatusFailed, errMsg, runAfter, id, workerID, database.JobStatusRunning,
+	)
+	return checkLeaseUpdate(res, err)
+}
+
+func (c *connection) FailJob(ctx context.Context, id, workerID, errMsg string) error {
+	res, err := c.getDB(ctx).ExecContext(ctx, `
+		UPDATE jobs SET status=$1, error=$2, locked_by=NULL, locked_until=NULL, updated_on=now()
+		WHERE id=$3 AND locked_by=$4 AND status=$5`,
+		database.JobStatusFailed, errMsg, id, workerID, database.JobStatusRunning,
+	)
+	return checkLeaseUpdate(res, err)
+}
+
+// checkLeaseUpdate returns database.ErrNotFound if an update of a leased job didn't match the job, which means the lease was lost.
+func checkLeaseUpdate(res sql.Result, err error) error {
 	if err != nil {
-		return nil, parseErr(err)
+		return parseErr(err)
 	}
-	return res, nil
+	n, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return database.ErrNotFound
+	}
+	return nil
 }
 
 func (c *connection) FindUsers(ctx context.Context) ([]*database.User, error) {
@@ -442,6 +1107,60 @@ func (c *connection) DeleteUsergroupMember(ctx context.Context, groupID, userID
 	return nil
 }
 
+func (c *connection) DeleteUsergroup(ctx context.Context, id string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups WHERE id=$1", id)
+	return parseErr(err)
+}
+
+func (c *connection) FindUsergroupByName(ctx context.Context, orgID, name string) (*database.Usergroup, error) {
+	res := &database.Usergroup{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT ug.id, ug.org_id, ug.name FROM usergroups ug WHERE ug.org_id=$1 AND lower(ug.name)=lower($2)", orgID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindUsergroupMemberUsers(ctx context.Context, groupID string) ([]*database.User, error) {
+	var res []*database.User
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT u.* FROM users u JOIN usergroups_users ugu ON u.id=ugu.user_id WHERE ugu.usergroup_id=$1 ORDER BY lower(u.email)", groupID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+// FindUsergroupsForUser returns the usergroups of an org that a user is a member of.
+func (c *connection) FindUsergroupsForUser(ctx context.Context, orgID, userID string) ([]*database.Usergroup, error) {
+	var res []*database.Usergroup
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT ug.id, ug.org_id, ug.name FROM usergroups ug JOIN usergroups_users ugu ON ug.id=ugu.usergroup_id WHERE ug.org_id=$1 AND ugu.user_id=$2 ORDER BY lower(ug.name)", orgID, userID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+// DeleteUsergroupMembersForOrganization removes a user from all the usergroups of an org.
+func (c *connection) DeleteUsergroupMembersForOrganization(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM usergroups_users WHERE user_id=$1 AND usergroup_id IN (SELECT ug.id FROM usergroups ug WHERE ug.org_id=$2)", userID, orgID)
+	return parseErr(err)
+}
+
+func (c *connection) FindProjectRolesForUsergroup(ctx context.Context, groupID string) ([]*database.UsergroupProjectRole, error) {
+	var res []*database.UsergroupProjectRole
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT p.id AS project_id, p.name AS project_name, r.name AS role_name
+		FROM usergroups_projects_roles ugpr
+		JOIN projects p ON ugpr.project_id=p.id
+		JOIN project_roles r ON ugpr.project_role_id=r.id
+		WHERE ugpr.usergroup_id=$1 ORDER BY lower(p.name)
+	`, groupID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindUserAuthTokens(ctx context.Context, userID string) ([]*database.UserAuthToken, error) {
 	var res []*database.UserAuthToken
 	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM user_auth_tokens t WHERE t.user_id=$1", userID)
@@ -482,6 +1201,156 @@ func (c *connection) DeleteUserAuthToken(ctx context.Context, id string) error {
 	return parseErr(err)
 }
 
+func (c *connection) FindServiceAccounts(ctx context.Context, orgID string) ([]*database.ServiceAccount, error) {
+	var res []*database.ServiceAccount
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT a.* FROM service_accounts a WHERE a.org_id=$1 ORDER BY lower(a.name)", orgID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindServiceAccount(ctx context.Context, id string) (*database.ServiceAccount, error) {
+	res := &database.ServiceAccount{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT a.* FROM service_accounts a WHERE a.id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindServiceAccountByName(ctx context.Context, orgID, name string) (*database.ServiceAccount, error) {
+	res := &database.ServiceAccount{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT a.* FROM service_accounts a WHERE a.org_id=$1 AND lower(a.name)=lower($2)", orgID, name).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertServiceAccount(ctx context.Context, opts *database.InsertServiceAccountOptions) (*database.ServiceAccount, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	res := &database.ServiceAccount{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO service_accounts (org_id, name, description)
+		VALUES ($1, $2, $3) RETURNING *`,
+		opts.OrganizationID, opts.Name, opts.Description,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteServiceAccount(ctx context.Context, id string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM service_accounts WHERE id=$1", id)
+	return parseErr(err)
+}
+
+func (c *connection) FindServiceAuthTokens(ctx context.Context, serviceAccountID string) ([]*database.ServiceAuthToken, error) {
+	var res []*database.ServiceAuthToken
+	err := c.getDB(ctx).SelectContext(ctx, &res, "SELECT t.* FROM service_auth_tokens t WHERE t.service_account_id=$1 ORDER BY t.created_on", serviceAccountID)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) FindServiceAuthToken(ctx context.Context, id string) (*database.ServiceAuthToken, error) {
+	res := &database.ServiceAuthToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT t.* FROM service_auth_tokens t WHERE t.id=$1", id).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertServiceAuthToken(ctx context.Context, opts *database.InsertServiceAuthTokenOptions) (*database.ServiceAuthToken, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	projectIDs := opts.ProjectIDs
+	if projectIDs == nil {
+		projectIDs = []string{}
+	}
+
+	res := &database.ServiceAuthToken{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		INSERT INTO service_auth_tokens (id, secret_hash, service_account_id, display_name, project_ids, scopes, expires_on)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *`,
+		opts.ID, opts.SecretHash, opts.ServiceAccountID, opts.DisplayName, projectIDs, opts.Scopes, opts.ExpiresOn,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) DeleteServiceAuthToken(ctx context.Context, id string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM service_auth_tokens WHERE id=$1", id)
+	return parseErr(err)
+}
+
+// auditLogActorNameJoin resolves the names of the actors of audit log entries aliased as "e"
+const auditLogActorNameJoin = `
+	LEFT JOIN users u ON e.actor_type='user' AND u.id=e.actor_id
+	LEFT JOIN service_accounts sa ON e.actor_type='service' AND sa.id=e.actor_id`
+
+func (c *connection) FindAuditLogEntries(ctx context.Context, opts *database.FindAuditLogEntriesOptions) ([]*database.AuditLogEntry, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	var res []*database.AuditLogEntry
+	err := c.getDB(ctx).SelectContext(ctx, &res, `
+		SELECT e.*, COALESCE(u.email, sa.name, '') AS actor_name FROM audit_log e`+auditLogActorNameJoin+`
+		WHERE e.org_id=$1
+			AND ($2::UUID IS NULL OR e.project_id=$2)
+			AND ($3::UUID IS NULL OR e.actor_id=$3)
+			AND ($4::TIMESTAMPTZ IS NULL OR e.created_on>=$4)
+			AND ($5::TIMESTAMPTZ IS NULL OR e.created_on<$5)
+		ORDER BY e.created_on DESC LIMIT $6`,
+		opts.OrganizationID, opts.ProjectID, opts.ActorID, opts.After, opts.Before, opts.Limit,
+	)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+func (c *connection) InsertAuditLogEntry(ctx context.Context, opts *database.InsertAuditLogEntryOptions) (*database.AuditLogEntry, error) {
+	if err := database.Validate(opts); err != nil {
+		return nil, err
+	}
+
+	before := opts.Before
+	if before == nil {
+		before = map[string]string{}
+	}
+	after := opts.After
+	if after == nil {
+		after = map[string]string{}
+	}
+
+	res := &database.AuditLogEntry{}
+	err := c.getDB(ctx).QueryRowxContext(ctx, `
+		WITH e AS (
+			INSERT INTO audit_log (org_id, project_id, actor_type, actor_id, action, target_type, target_id, target_name, before, after)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING *
+		)
+		SELECT e.*, COALESCE(u.email, sa.name, '') AS actor_name FROM e`+auditLogActorNameJoin,
+		opts.OrganizationID, opts.ProjectID, opts.ActorType, opts.ActorID, opts.Action, opts.TargetType, opts.TargetID, opts.TargetName, before, after,
+	).StructScan(res)
+	if err != nil {
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
 func (c *connection) FindDeviceAuthCodeByDeviceCode(ctx context.Context, deviceCode string) (*database.DeviceAuthCode, error) {
 	authCode := &database.DeviceAuthCode{}
 	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT * FROM device_auth_codes WHERE device_code = $1", deviceCode).StructScan(authCode)
@@ -636,6 +1505,11 @@ func (c *connection) InsertOrganizationMemberUser(ctx context.Context, orgID, us
 	return nil
 }
 
+func (c *connection) InsertOrganizationMemberUsergroup(ctx context.Context, groupID, orgID, roleID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "INSERT INTO usergroups_orgs_roles (usergroup_id, org_id, org_role_id) VALUES ($1, $2, $3)", groupID, orgID, roleID)
+	return parseErr(err)
+}
+
 func (c *connection) DeleteOrganizationMemberUser(ctx context.Context, orgID, userID string) error {
 	res, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_orgs_roles WHERE user_id = $1 AND org_id = $2", userID, orgID)
 	if err != nil {
@@ -657,6 +1531,33 @@ func (c *connection) UpdateOrganizationMemberUserRole(ctx context.Context, orgID
 	return parseErr(err)
 }
 
+// FindOrganizationMemberUserAttributes returns the custom attributes of an org member. It returns an empty map if none are set.
+func (c *connection) FindOrganizationMemberUserAttributes(ctx context.Context, orgID, userID string) (database.MemberAttributes, error) {
+	var res database.MemberAttributes
+	err := c.getDB(ctx).QueryRowxContext(ctx, "SELECT attributes FROM orgs_users_attributes WHERE org_id=$1 AND user_id=$2", orgID, userID).Scan(&res)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return database.MemberAttributes{}, nil
+		}
+		return nil, parseErr(err)
+	}
+	return res, nil
+}
+
+// UpdateOrganizationMemberUserAttributes replaces the custom attributes of an org member. Passing empty attributes clears them.
+func (c *connection) UpdateOrganizationMemberUserAttributes(ctx context.Context, orgID, userID string, attrs database.MemberAttributes) error {
+	if len(attrs) == 0 {
+		_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM orgs_users_attributes WHERE org_id=$1 AND user_id=$2", orgID, userID)
+		return parseErr(err)
+	}
+
+	_, err := c.getDB(ctx).ExecContext(ctx, `
+		INSERT INTO orgs_users_attributes (org_id, user_id, attributes) VALUES ($1, $2, $3)
+		ON CONFLICT (org_id, user_id) DO UPDATE SET attributes=EXCLUDED.attributes, updated_on=now()
+	`, orgID, userID, map[string]any(attrs))
+	return parseErr(err)
+}
+
 func (c *connection) FindProjectMemberUsers(ctx context.Context, projectID string) ([]*database.Member, error) {
 	var res []*database.Member
 	err := c.getDB(ctx).SelectContext(ctx, &res, `SELECT u.id, u.email, u.display_name, u.created_on, u.updated_on, r.name FROM users u 
@@ -706,6 +1607,12 @@ func (c *connection) DeleteProjectMemberUser(ctx context.Context, projectID, use
 	return nil
 }
 
+// DeleteProjectMemberUsersForOrganization removes a user from all the projects of an org.
+func (c *connection) DeleteProjectMemberUsersForOrganization(ctx context.Context, orgID, userID string) error {
+	_, err := c.getDB(ctx).ExecContext(ctx, "DELETE FROM users_projects_roles WHERE user_id=$1 AND project_id IN (SELECT p.id FROM projects p WHERE p.org_id=$2)", userID, orgID)
+	return parseErr(err)
+}
+
 func (c *connection) UpdateProjectMemberUserRole(ctx context.Context, projectID, userID, roleID string) error {
 	_, err := c.getDB(ctx).ExecContext(ctx, `UPDATE users_projects_roles SET project_role_id = $1 WHERE user_id = $2 AND project_id = $3`,
 		roleID, userID, projectID)
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/stretchr/testify/require"
 	"github.com/testcontainers/testcontainers-go"
@@ -46,8 +47,18 @@ func TestPostgres(t *testing.T) {
 
 	t.Run("TestOrganizations", func(t *testing.T) { testOrganizations(t, db) })
 	t.Run("TestProjects", func(t *testing.T) { testProjects(t, db) })
+	t.Run("TestJobs", func(t *testing.T) { testJobs(t, db) })
+	t.Run("TestPreviewDeployments", func(t *testing.T) { testPreviewDeployments(t, db) })
 	// Add new tests here
-	t.Run("TestProjectsWithVariables", func(t *testing.T) { testProjectsWithVariables(t, db) })
+	t.Run("TestEnvironments", func(t *testing.T) { testEnvironments(t, db) })
+	t.Run("TestDeploymentRevisions", func(t *testing.T) { testDeploymentRevisions(t, db) })
+	t.Run("TestRuntimes", func(t *testing.T) { testRuntimes(t, db) })
+	t.Run("TestServiceAccounts", func(t *testing.T) { testServiceAccounts(t, db) })
+	t.Run("TestAuditLog", func(t *testing.T) { testAuditLog(t, db) })
+	t.Run("TestOrganizationUsage", func(t *testing.T) { testOrganizationUsage(t, db) })
+	t.Run("TestOrganizationSSO", func(t *testing.T) { testOrganizationSSO(t, db) })
+	t.Run("TestOrganizationSCIM", func(t *testing.T) { testOrganizationSCIM(t, db) })
+	t.Run("TestOrganizationMemberAttributes", func(t *testing.T) { testOrganizationMemberAttributes(t, db) })
 
 	require.NoError(t, db.Close())
 }
@@ -159,24 +170,783 @@ func testProjects(t *testing.T, db database.DB) {
 	require.Nil(t, org)
 }
 
-func testProjectsWithVariables(t *testing.T, db database.DB) {
+func testEnvironments(t *testing.T, db database.DB) {
 	ctx := context.Background()
 
 	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "foo"})
 	require.NoError(t, err)
 	require.Equal(t, "foo", org.Name)
 
-	opts := &database.InsertProjectOptions{
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{
 		OrganizationID: org.ID,
 		Name:           "bar",
 		Description:    "hello world",
-		ProdVariables:  map[string]string{"hello": "world"},
+	})
+	require.NoError(t, err)
+
+	opts := &database.InsertEnvironmentOptions{
+		ProjectID:  proj.ID,
+		Name:       "staging",
+		Branch:     "main",
+		Variables:  map[string]string{"hello": "world"},
+		OLAPDriver: "duckdb",
+		Slots:      1,
 	}
-	proj, err := db.InsertProject(ctx, opts)
+	env, err := db.InsertEnvironment(ctx, opts)
 	require.NoError(t, err)
-	require.Equal(t, database.Variables(opts.ProdVariables), proj.ProdVariables)
+	require.Equal(t, "staging", env.Name)
+	require.Equal(t, database.Variables(opts.Variables), env.Variables)
+	require.Nil(t, env.Commit)
 
-	proj, err = db.FindProjectByName(ctx, org.Name, proj.Name)
+	// Names are unique in a project
+	opts.Name = "Staging"
+	_, err = db.InsertEnvironment(ctx, opts)
+	require.Error(t, err)
+
+	env, err = db.FindEnvironmentByName(ctx, proj.ID, "STAGING")
+	require.NoError(t, err)
+	require.Equal(t, database.Variables(opts.Variables), env.Variables)
+
+	commit := "abc123"
+	env, err = db.UpdateEnvironment(ctx, env.ID, &database.UpdateEnvironmentOptions{
+		Branch:    "release",
+		Commit:    &commit,
+		Variables: map[string]string{"hello": "again"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "release", env.Branch)
+	require.Equal(t, "abc123", *env.Commit)
+	require.Equal(t, database.Variables{"hello": "again"}, env.Variables)
+
+	envs, err := db.FindEnvironments(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, envs, 1)
+
+	require.NoError(t, db.DeleteEnvironment(ctx, env.ID))
+	_, err = db.FindEnvironmentByName(ctx, proj.ID, "staging")
+	require.Equal(t, database.ErrNotFound, err)
+}
+
+func testJobs(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	// No jobs to lease
+	_, err := db.LeaseJob(ctx, "w1", time.Minute)
+	require.Equal(t, database.ErrNotFound, err)
+
+	opts := &database.InsertJobOptions{
+		Type:           "test",
+		Payload:        []byte(`{"hello":"world"}`),
+		IdempotencyKey: "key",
+		MaxAttempts:    2,
+	}
+	job, err := db.InsertJob(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, database.JobStatusPending, job.Status)
+	require.JSONEq(t, `{"hello":"world"}`, string(job.Payload))
+
+	// Inserting with the same key returns the pending job
+	job2, err := db.InsertJob(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, job2.ID)
+
+	// Lease the job
+	leased, err := db.LeaseJob(ctx, "w1", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, leased.ID)
+	require.Equal(t, database.JobStatusRunning, leased.Status)
+	require.Equal(t, 1, leased.Attempts)
+	require.Equal(t, "w1", *leased.LockedBy)
+
+	// A new job with the same key is enqueued, but doesn't run while the first job is running
+	job2, err = db.InsertJob(ctx, opts)
+	require.NoError(t, err)
+	require.NotEqual(t, job.ID, job2.ID)
+	_, err = db.LeaseJob(ctx, "w2", time.Minute)
+	require.Equal(t, database.ErrNotFound, err)
+
+	// Only the worker holding the lease can update the job
+	require.Equal(t, database.ErrNotFound, db.CompleteJob(ctx, job.ID, "w2"))
+	require.NoError(t, db.RenewJobLease(ctx, job.ID, "w1", time.Minute))
+
+	// Retrying the first job fails it, since the second job supersedes it
+	require.NoError(t, db.RetryJob(ctx, job.ID, "w1", "boom", time.Now()))
+	job, err = db.FindJob(ctx, job.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.JobStatusFailed, job.Status)
+	require.Equal(t, "boom", job.Error)
+
+	// Retry the second job later
+	leased, err = db.LeaseJob(ctx, "w2", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, job2.ID, leased.ID)
+	require.NoError(t, db.RetryJob(ctx, job2.ID, "w2", "boom", time.Now().Add(time.Hour)))
+	_, err = db.LeaseJob(ctx, "w2", time.Minute)
+	require.Equal(t, database.ErrNotFound, err)
+
+	// Inserting with the same key moves the retry forward and replaces the payload
+	opts.Payload = []byte(`{"hello":"again"}`)
+	_, err = db.InsertJob(ctx, opts)
+	require.NoError(t, err)
+	leased, err = db.LeaseJob(ctx, "w2", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, job2.ID, leased.ID)
+	require.Equal(t, 2, leased.Attempts)
+	require.JSONEq(t, `{"hello":"again"}`, string(leased.Payload))
+	require.NoError(t, db.CompleteJob(ctx, job2.ID, "w2"))
+
+	job2, err = db.FindJob(ctx, job2.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.JobStatusSucceeded, job2.Status)
+	require.Equal(t, "", job2.Error)
+	require.Nil(t, job2.LockedBy)
+
+	// Jobs with an expired lease are leased again
+	job, err = db.InsertJob(ctx, &database.InsertJobOptions{Type: "test", MaxAttempts: 1})
+	require.NoError(t, err)
+	_, err = db.LeaseJob(ctx, "w1", time.Millisecond)
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	leased, err = db.LeaseJob(ctx, "w2", time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, job.ID, leased.ID)
+	require.Equal(t, 2, leased.Attempts)
+	require.Equal(t, database.ErrNotFound, db.FailJob(ctx, job.ID, "w1", "lost"))
+	require.NoError(t, db.FailJob(ctx, job.ID, "w2", "failed"))
+}
+
+func testPreviewDeployments(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "previews"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "bar"})
+	require.NoError(t, err)
+
+	_, err = db.FindDeploymentForPullRequest(ctx, proj.ID, 1)
+	require.Equal(t, database.ErrNotFound, err)
+
+	opts := &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Slots:             1,
+		Branch:            "feature",
+		RuntimeHost:       "localhost:9091",
+		RuntimeInstanceID: "instance",
+		Status:            database.DeploymentStatusPending,
+		PullRequestNumber: new(int),
+		ExpiresOn:         new(time.Time),
+	}
+	*opts.PullRequestNumber = 1
+	*opts.ExpiresOn = time.Now().Add(-time.Minute)
+	depl, err := db.InsertDeployment(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, 1, *depl.PullRequestNumber)
+
+	// A pull request has one deployment per project
+	_, err = db.InsertDeployment(ctx, opts)
+	require.Error(t, err)
+
+	found, err := db.FindDeploymentForPullRequest(ctx, proj.ID, 1)
+	require.NoError(t, err)
+	require.Equal(t, depl.ID, found.ID)
+
+	expired, err := db.FindExpiredDeployments(ctx)
+	require.NoError(t, err)
+	require.Len(t, expired, 1)
+	require.Equal(t, depl.ID, expired[0].ID)
+
+	depl, err = db.UpdateDeploymentExpiry(ctx, depl.ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Greater(t, time.Until(*depl.ExpiresOn), time.Minute)
+
+	expired, err = db.FindExpiredDeployments(ctx)
+	require.NoError(t, err)
+	require.Len(t, expired, 0)
+
+	require.NoError(t, db.DeleteDeployment(ctx, depl.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testDeploymentRevisions(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "revisions"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "bar"})
+	require.NoError(t, err)
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Slots:             1,
+		Branch:            "main",
+		RuntimeHost:       "localhost:9091",
+		RuntimeInstanceID: "instance",
+		Status:            database.DeploymentStatusPending,
+	})
+	require.NoError(t, err)
+
+	first, err := db.InsertDeploymentRevision(ctx, &database.InsertDeploymentRevisionOptions{
+		DeploymentID: depl.ID,
+		Commit:       "abc123",
+		TriggeredBy:  "deploy",
+		Status:       database.DeploymentStatusReconciling,
+	})
+	require.NoError(t, err)
+	require.Nil(t, first.FinishedOn)
+	require.Empty(t, first.Results)
+
+	finishedOn := time.Now()
+	first, err = db.UpdateDeploymentRevision(ctx, first.ID, &database.UpdateDeploymentRevisionOptions{
+		Commit: "abc124",
+		Status: database.DeploymentStatusError,
+		Results: database.DeploymentRevisionResults{
+			{Path: "/models/foo.sql"},
+			{Path: "/models/bar.sql", Errors: []string{"syntax error"}},
+		},
+		Logs:       "logs",
+		FinishedOn: &finishedOn,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "abc124", first.Commit)
+	require.Equal(t, database.DeploymentStatusError, first.Status)
+	require.NotNil(t, first.FinishedOn)
+	require.Len(t, first.Results, 2)
+	require.Equal(t, []string{"syntax error"}, first.Results[1].Errors)
+
+	second, err := db.InsertDeploymentRevision(ctx, &database.InsertDeploymentRevisionOptions{
+		DeploymentID: depl.ID,
+		Commit:       "def456",
+		TriggeredBy:  "push by alice",
+		Status:       database.DeploymentStatusReconciling,
+	})
+	require.NoError(t, err)
+
+	// Revisions are listed from newest to oldest
+	revs, err := db.FindDeploymentRevisions(ctx, depl.ID, 10)
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	require.Equal(t, second.ID, revs[0].ID)
+	require.Equal(t, first.ID, revs[1].ID)
+
+	revs, err = db.FindDeploymentRevisions(ctx, depl.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, revs, 1)
+
+	rev, err := db.FindDeploymentRevision(ctx, first.ID)
+	require.NoError(t, err)
+	require.Equal(t, "abc124", rev.Commit)
+	require.Equal(t, "logs", rev.Logs)
+
+	// Revisions are deleted with their deployment
+	require.NoError(t, db.DeleteDeployment(ctx, depl.ID))
+	_, err = db.FindDeploymentRevision(ctx, first.ID)
+	require.Equal(t, database.ErrNotFound, err)
+
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testRuntimes(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	rt, err := db.UpsertRuntime(ctx, &database.UpsertRuntimeOptions{
+		Host:     "http://runtime-1:9090",
+		Audience: "http://runtime-1:8080",
+		Region:   "us-east",
+		Slots:    10,
+		DataDir:  "/data",
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.RuntimeStatusHealthy, rt.Status)
+	require.True(t, rt.Schedulable())
+
+	rt, err = db.UpdateRuntimeStatus(ctx, rt.Host, database.RuntimeStatusUnhealthy)
+	require.NoError(t, err)
+	require.False(t, rt.Schedulable())
+
+	// A heartbeat updates the capacity, but only the health check marks the runtime healthy again
+	heartbeatOn := rt.LastHeartbeatOn
+	rt, err = db.UpsertRuntime(ctx, &database.UpsertRuntimeOptions{
+		Host:     rt.Host,
+		Audience: rt.Audience,
+		Region:   rt.Region,
+		Slots:    20,
+		DataDir:  rt.DataDir,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.RuntimeStatusUnhealthy, rt.Status)
+	require.Equal(t, 20, rt.Slots)
+	require.True(t, rt.LastHeartbeatOn.After(heartbeatOn))
+	require.False(t, rt.Schedulable())
+
+	rt, err = db.UpdateRuntimeStatus(ctx, rt.Host, database.RuntimeStatusHealthy)
+	require.NoError(t, err)
+	require.True(t, rt.Schedulable())
+
+	// A heartbeat doesn't change whether the runtime is draining
+	rt, err = db.UpdateRuntimeDraining(ctx, rt.Host, true)
+	require.NoError(t, err)
+	require.True(t, rt.Draining)
+	rt, err = db.UpsertRuntime(ctx, &database.UpsertRuntimeOptions{
+		Host:     rt.Host,
+		Audience: rt.Audience,
+		Region:   rt.Region,
+		Slots:    20,
+		DataDir:  rt.DataDir,
+	})
+	require.NoError(t, err)
+	require.Equal(t, database.RuntimeStatusHealthy, rt.Status)
+	require.True(t, rt.Draining)
+	require.False(t, rt.Schedulable())
+
+	_, err = db.UpdateRuntimeDraining(ctx, "http://unknown:9090", true)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "runtimes"})
+	require.NoError(t, err)
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "bar"})
+	require.NoError(t, err)
+	depl, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Slots:             1,
+		Branch:            "main",
+		RuntimeHost:       rt.Host,
+		RuntimeInstanceID: "instance",
+		RuntimeAudience:   rt.Audience,
+		Status:            database.DeploymentStatusOK,
+	})
+	require.NoError(t, err)
+
+	ds, err := db.FindDeploymentsForRuntime(ctx, rt.Host)
+	require.NoError(t, err)
+	require.Len(t, ds, 1)
+	require.Equal(t, depl.ID, ds[0].ID)
+
+	depl, err = db.UpdateDeploymentRuntime(ctx, depl.ID, &database.UpdateDeploymentRuntimeOptions{
+		RuntimeHost:       "http://runtime-2:9090",
+		RuntimeInstanceID: "instance-2",
+		RuntimeAudience:   "http://runtime-2:8080",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "http://runtime-2:9090", depl.RuntimeHost)
+	require.Equal(t, "instance-2", depl.RuntimeInstanceID)
+
+	ds, err = db.FindDeploymentsForRuntime(ctx, rt.Host)
+	require.NoError(t, err)
+	require.Len(t, ds, 0)
+
+	rts, err := db.FindRuntimes(ctx)
+	require.NoError(t, err)
+	require.Len(t, rts, 1)
+}
+
+func testServiceAccounts(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "services"})
+	require.NoError(t, err)
+
+	account, err := db.InsertServiceAccount(ctx, &database.InsertServiceAccountOptions{
+		OrganizationID: org.ID,
+		Name:           "ci",
+		Description:    "CI pipeline",
+	})
+	require.NoError(t, err)
+	require.Equal(t, org.ID, account.OrganizationID)
+
+	_, err = db.InsertServiceAccount(ctx, &database.InsertServiceAccountOptions{OrganizationID: org.ID, Name: "CI"})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	found, err := db.FindServiceAccountByName(ctx, org.ID, "CI")
+	require.NoError(t, err)
+	require.Equal(t, account.ID, found.ID)
+
+	accounts, err := db.FindServiceAccounts(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+
+	expiresOn := time.Now().Add(time.Hour)
+	tkn, err := db.InsertServiceAuthToken(ctx, &database.InsertServiceAuthTokenOptions{
+		ID:               uuid.New().String(),
+		SecretHash:       []byte("secret"),
+		ServiceAccountID: account.ID,
+		DisplayName:      "deploy",
+		ProjectIDs:       []string{uuid.New().String()},
+		Scopes:           []string{"manage_prod"},
+		ExpiresOn:        &expiresOn,
+	})
+	require.NoError(t, err)
+	require.Len(t, tkn.ProjectIDs, 1)
+	require.Equal(t, database.StringSlice{"manage_prod"}, tkn.Scopes)
+	require.NotNil(t, tkn.ExpiresOn)
+
+	// Tokens without projects can access all projects of the org
+	all, err := db.InsertServiceAuthToken(ctx, &database.InsertServiceAuthTokenOptions{
+		ID:               uuid.New().String(),
+		SecretHash:       []byte("secret"),
+		ServiceAccountID: account.ID,
+		Scopes:           []string{"read_prod"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, all.ProjectIDs)
+	require.Nil(t, all.ExpiresOn)
+
+	tkns, err := db.FindServiceAuthTokens(ctx, account.ID)
+	require.NoError(t, err)
+	require.Len(t, tkns, 2)
+
+	err = db.DeleteServiceAuthToken(ctx, tkn.ID)
+	require.NoError(t, err)
+	_, err = db.FindServiceAuthToken(ctx, tkn.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// Deleting the account deletes its tokens
+	err = db.DeleteServiceAccount(ctx, account.ID)
+	require.NoError(t, err)
+	_, err = db.FindServiceAuthToken(ctx, all.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testAuditLog(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "audited"})
+	require.NoError(t, err)
+
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "auditor@example.com"})
+	require.NoError(t, err)
+
+	projectID := uuid.New().String()
+	e1, err := db.InsertAuditLogEntry(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		ActorType:      database.AuditActorTypeUser,
+		ActorID:        &user.ID,
+		Action:         "org.update",
+		TargetType:     "organization",
+		TargetID:       org.ID,
+		Before:         map[string]string{"description": ""},
+		After:          map[string]string{"description": "audited org"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, user.Email, e1.ActorName)
+	require.Equal(t, database.AuditFields{"description": "audited org"}, e1.After)
+
+	e2, err := db.InsertAuditLogEntry(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		ProjectID:      &projectID,
+		ActorType:      database.AuditActorTypeSystem,
+		Action:         "project.delete",
+		TargetType:     "project",
+		TargetID:       projectID,
+	})
+	require.NoError(t, err)
+	require.Empty(t, e2.ActorName)
+	require.Empty(t, e2.Before)
+
+	entries, err := db.FindAuditLogEntries(ctx, &database.FindAuditLogEntriesOptions{OrganizationID: org.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, e2.ID, entries[0].ID)
+
+	entries, err = db.FindAuditLogEntries(ctx, &database.FindAuditLogEntriesOptions{OrganizationID: org.ID, ProjectID: &projectID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, e2.ID, entries[0].ID)
+
+	entries, err = db.FindAuditLogEntries(ctx, &database.FindAuditLogEntriesOptions{OrganizationID: org.ID, ActorID: &user.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, e1.ID, entries[0].ID)
+
+	after := e2.CreatedOn.Add(time.Second)
+	entries, err = db.FindAuditLogEntries(ctx, &database.FindAuditLogEntriesOptions{OrganizationID: org.ID, After: &after, Limit: 10})
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	entries, err = db.FindAuditLogEntries(ctx, &database.FindAuditLogEntriesOptions{OrganizationID: org.ID, Before: &after, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
+
+func testOrganizationUsage(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "metered"})
+	require.NoError(t, err)
+	require.Equal(t, -1, org.QuotaProjects)
+	require.Equal(t, int64(-1), org.QuotaIngestedBytes)
+
+	org, err = db.UpdateOrganizationQuotas(ctx, org.ID, &database.UpdateOrganizationQuotasOptions{
+		Projects:        2,
+		SlotsTotal:      4,
+		IngestedBytes:   1000,
+		QueriesPerMonth: -1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, org.QuotaProjects)
+	require.Equal(t, 4, org.QuotaSlotsTotal)
+	require.Equal(t, int64(1000), org.QuotaIngestedBytes)
+	require.Equal(t, int64(-1), org.QuotaQueriesPerMonth)
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "bar"})
+	require.NoError(t, err)
+
+	prod, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Slots:             2,
+		Branch:            "main",
+		RuntimeHost:       "localhost:9091",
+		RuntimeInstanceID: "prod",
+		Status:            database.DeploymentStatusOK,
+	})
+	require.NoError(t, err)
+
+	// Slots of preview deployments are counted too
+	pr := 1
+	preview, err := db.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Slots:             1,
+		Branch:            "feature",
+		RuntimeHost:       "localhost:9091",
+		RuntimeInstanceID: "preview",
+		Status:            database.DeploymentStatusOK,
+		PullRequestNumber: &pr,
+	})
+	require.NoError(t, err)
+
+	ds, err := db.FindDeploymentsForOrganization(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, ds, 2)
+
+	_, err = db.FindDeploymentUsage(ctx, prod.ID)
+	require.Equal(t, database.ErrNotFound, err)
+
+	_, err = db.UpsertDeploymentUsage(ctx, &database.UpsertDeploymentUsageOptions{DeploymentID: prod.ID, RuntimeInstanceID: "prod", BytesIngested: 100, QueriesCounter: 5})
+	require.NoError(t, err)
+	startedOn := time.Date(2023, time.May, 1, 12, 0, 0, 1000, time.UTC)
+	u, err := db.UpsertDeploymentUsage(ctx, &database.UpsertDeploymentUsageOptions{DeploymentID: prod.ID, RuntimeInstanceID: "prod", BytesIngested: 300, QueriesCounter: 8, QueriesCounterStartedOn: &startedOn})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), u.BytesIngested)
+	require.Equal(t, int64(8), u.QueriesCounter)
+	require.True(t, startedOn.Equal(*u.QueriesCounterStartedOn))
+	_, err = db.UpsertDeploymentUsage(ctx, &database.UpsertDeploymentUsageOptions{DeploymentID: preview.ID, RuntimeInstanceID: "preview", BytesIngested: 50})
+	require.NoError(t, err)
+
+	period := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, db.IncrementOrganizationQueries(ctx, org.ID, period, 5))
+	require.NoError(t, db.IncrementOrganizationQueries(ctx, org.ID, period, 3))
+	require.NoError(t, db.IncrementOrganizationQueries(ctx, org.ID, period.AddDate(0, -1, 0), 7))
+
+	usage, err := db.ResolveOrganizationUsage(ctx, org.ID, period)
+	require.NoError(t, err)
+	require.Equal(t, 1, usage.Projects)
+	require.Equal(t, 3, usage.Slots)
+	require.Equal(t, int64(350), usage.BytesIngested)
+	require.Equal(t, int64(8), usage.Queries)
+
+	require.NoError(t, db.DeleteDeployment(ctx, preview.ID))
+	require.NoError(t, db.DeleteDeployment(ctx, prod.ID))
+	require.NoError(t, db.DeleteProject(ctx, proj.ID))
+	require.NoError(t, db.DeleteOrganization(ctx, org.Name))
+}
+
+func testOrganizationSSO(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "acme"})
+	require.NoError(t, err)
+	other, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "acme-other"})
+	require.NoError(t, err)
+
+	_, err = db.FindOrganizationSSO(ctx, org.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	opts := &database.UpsertOrganizationSSOOptions{
+		OrgID:        org.ID,
+		IssuerURL:    "https://idp.acme.com",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		GroupsClaim:  "groups",
+		Domains:      []string{"acme.com", "acme.io"},
+	}
+	sso, err := db.UpsertOrganizationSSO(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, database.StringSlice{"acme.com", "acme.io"}, sso.Domains)
+
+	// Updating the config replaces the domains
+	opts.ClientSecret = "rotated"
+	opts.Domains = []string{"acme.com"}
+	sso, err = db.UpsertOrganizationSSO(ctx, opts)
+	require.NoError(t, err)
+	require.Equal(t, "rotated", sso.ClientSecret)
+	require.Equal(t, database.StringSlice{"acme.com"}, sso.Domains)
+
+	found, err := db.FindOrganizationSSOByDomain(ctx, "ACME.com")
+	require.NoError(t, err)
+	require.Equal(t, org.ID, found.OrgID)
+	_, err = db.FindOrganizationSSOByDomain(ctx, "acme.io")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	// A domain can only be routed to one org
+	_, err = db.UpsertOrganizationSSO(ctx, &database.UpsertOrganizationSSOOptions{
+		OrgID:        other.ID,
+		IssuerURL:    "https://idp.example.com",
+		ClientID:     "client",
+		ClientSecret: "secret",
+		GroupsClaim:  "groups",
+		Domains:      []string{"acme.com"},
+	})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	group, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "engineers"})
+	require.NoError(t, err)
+	role, err := db.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+	require.NoError(t, err)
+	require.NoError(t, db.InsertOrganizationMemberUsergroup(ctx, group.ID, org.ID, role.ID))
+
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "analytics"})
+	require.NoError(t, err)
+	projRole, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	require.NoError(t, db.InsertProjectMemberUsergroup(ctx, group.ID, proj.ID, projRole.ID))
+
+	mapping, err := db.InsertOrganizationSSOGroup(ctx, &database.InsertOrganizationSSOGroupOptions{OrgID: org.ID, UsergroupID: group.ID, IDPGroup: "Engineering"})
+	require.NoError(t, err)
+	require.Equal(t, "engineers", mapping.UsergroupName)
+	require.NotNil(t, mapping.OrgRoleName)
+	require.Equal(t, database.OrganizationRoleNameAdmin, *mapping.OrgRoleName)
+
+	projRoles, err := db.FindProjectRolesForUsergroup(ctx, group.ID)
+	require.NoError(t, err)
+	require.Len(t, projRoles, 1)
+	require.Equal(t, "analytics", projRoles[0].ProjectName)
+	require.Equal(t, database.ProjectRoleNameViewer, projRoles[0].RoleName)
+
+	mappings, err := db.FindOrganizationSSOGroups(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, mappings, 1)
+
+	// Deleting the usergroup deletes the mapping
+	require.NoError(t, db.DeleteUsergroup(ctx, group.ID))
+	_, err = db.FindOrganizationSSOGroup(ctx, org.ID, "Engineering")
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteOrganizationSSO(ctx, org.ID))
+	_, err = db.FindOrganizationSSOByDomain(ctx, "acme.com")
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testOrganizationSCIM(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "scim-org"})
+	require.NoError(t, err)
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "jane@scim.com", DisplayName: "Jane"})
+	require.NoError(t, err)
+
+	_, err = db.FindOrganizationSCIMUser(ctx, org.ID, user.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	su, err := db.InsertOrganizationSCIMUser(ctx, &database.InsertOrganizationSCIMUserOptions{OrgID: org.ID, UserID: user.ID, ExternalID: "00u1", Active: true})
+	require.NoError(t, err)
+	require.Equal(t, "jane@scim.com", su.Email)
+	require.Equal(t, "Jane", su.DisplayName)
+	require.True(t, su.Active)
+
+	_, err = db.InsertOrganizationSCIMUser(ctx, &database.InsertOrganizationSCIMUserOptions{OrgID: org.ID, UserID: user.ID, Active: true})
+	require.ErrorIs(t, err, database.ErrNotUnique)
+
+	su, err = db.UpdateOrganizationSCIMUser(ctx, org.ID, user.ID, &database.UpdateOrganizationSCIMUserOptions{ExternalID: "00u2", Active: false})
+	require.NoError(t, err)
+	require.Equal(t, "00u2", su.ExternalID)
+	require.False(t, su.Active)
+
+	users, err := db.FindOrganizationSCIMUsers(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	group, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "engineering"})
+	require.NoError(t, err)
+	found, err := db.FindUsergroupByName(ctx, org.ID, "Engineering")
+	require.NoError(t, err)
+	require.Equal(t, group.ID, found.ID)
+
+	sg, err := db.InsertOrganizationSCIMGroup(ctx, &database.InsertOrganizationSCIMGroupOptions{OrgID: org.ID, UsergroupID: group.ID, DisplayName: "Engineering"})
+	require.NoError(t, err)
+	require.Equal(t, "engineering", sg.UsergroupName)
+
+	sg, err = db.UpdateOrganizationSCIMGroup(ctx, group.ID, &database.UpdateOrganizationSCIMGroupOptions{DisplayName: "Eng", ExternalID: "00g1"})
+	require.NoError(t, err)
+	require.Equal(t, "Eng", sg.DisplayName)
+	require.Equal(t, "engineering", sg.UsergroupName)
+
+	groups, err := db.FindOrganizationSCIMGroups(ctx, org.ID)
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	// Removing a user from the org's usergroups and projects
+	proj, err := db.InsertProject(ctx, &database.InsertProjectOptions{OrganizationID: org.ID, Name: "scim-proj"})
+	require.NoError(t, err)
+	role, err := db.FindProjectRole(ctx, database.ProjectRoleNameViewer)
+	require.NoError(t, err)
+	require.NoError(t, db.InsertProjectMemberUser(ctx, proj.ID, user.ID, role.ID))
+	require.NoError(t, db.InsertUsergroupMember(ctx, group.ID, user.ID))
+
+	members, err := db.FindUsergroupMemberUsers(ctx, group.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 1)
+	require.Equal(t, user.ID, members[0].ID)
+
+	require.NoError(t, db.DeleteUsergroupMembersForOrganization(ctx, org.ID, user.ID))
+	members, err = db.FindUsergroupMemberUsers(ctx, group.ID)
+	require.NoError(t, err)
+	require.Len(t, members, 0)
+
+	require.NoError(t, db.DeleteProjectMemberUsersForOrganization(ctx, org.ID, user.ID))
+	projMembers, err := db.FindProjectMemberUsers(ctx, proj.ID)
+	require.NoError(t, err)
+	require.Len(t, projMembers, 0)
+
+	require.NoError(t, db.DeleteOrganizationSCIMGroup(ctx, group.ID))
+	_, err = db.FindOrganizationSCIMGroup(ctx, org.ID, group.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+
+	require.NoError(t, db.DeleteOrganizationSCIMUser(ctx, org.ID, user.ID))
+	_, err = db.FindOrganizationSCIMUser(ctx, org.ID, user.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func testOrganizationMemberAttributes(t *testing.T, db database.DB) {
+	ctx := context.Background()
+
+	org, err := db.InsertOrganization(ctx, &database.InsertOrganizationOptions{Name: "attrs-org"})
+	require.NoError(t, err)
+	user, err := db.InsertUser(ctx, &database.InsertUserOptions{Email: "jane@attrs.com", DisplayName: "Jane"})
+	require.NoError(t, err)
+
+	attrs, err := db.FindOrganizationMemberUserAttributes(ctx, org.ID, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, attrs)
+
+	err = db.UpdateOrganizationMemberUserAttributes(ctx, org.ID, user.ID, database.MemberAttributes{"region": "emea", "accounts": []any{"a", "b"}})
+	require.NoError(t, err)
+	err = db.UpdateOrganizationMemberUserAttributes(ctx, org.ID, user.ID, database.MemberAttributes{"region": "apac"})
+	require.NoError(t, err)
+	attrs, err = db.FindOrganizationMemberUserAttributes(ctx, org.ID, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, database.MemberAttributes{"region": "apac"}, attrs)
+
+	err = db.UpdateOrganizationMemberUserAttributes(ctx, org.ID, user.ID, nil)
+	require.NoError(t, err)
+	attrs, err = db.FindOrganizationMemberUserAttributes(ctx, org.ID, user.ID)
+	require.NoError(t, err)
+	require.Empty(t, attrs)
+
+	group, err := db.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: "finance"})
+	require.NoError(t, err)
+	require.NoError(t, db.InsertUsergroupMember(ctx, group.ID, user.ID))
+	groups, err := db.FindUsergroupsForUser(ctx, org.ID, user.ID)
 	require.NoError(t, err)
-	require.Equal(t, database.Variables(opts.ProdVariables), proj.ProdVariables)
+	require.Len(t, groups, 1)
+	require.Equal(t, "finance", groups[0].Name)
 }
@@ -0,0 +1,113 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+)
+
+var (
+	ErrRollbackPreview              = fmt.Errorf("preview deployments can't be rolled back")
+	ErrRollbackUnsuccessfulRevision = fmt.Errorf("can only roll back to a successful revision with a known commit")
+)
+
+// RollbackDeployment redeploys the commit of a previous revision of a deployment.
+// It pins the deployment's environment to the commit, so pushes to its branch are not deployed until the environment's branch is updated.
+func (s *Service) RollbackDeployment(ctx context.Context, proj *database.Project, depl *database.Deployment, rev *database.DeploymentRevision) (*database.Environment, error) {
+	if rev.DeploymentID != depl.ID {
+		return nil, fmt.Errorf("revision %q does not belong to deployment %q", rev.ID, depl.ID)
+	}
+
+	if rev.Status != database.DeploymentStatusOK || rev.Commit == "" {
+		return nil, ErrRollbackUnsuccessfulRevision
+	}
+
+	env, err := s.DB.FindEnvironmentForDeployment(ctx, depl.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, ErrRollbackPreview
+		}
+		return nil, err
+	}
+
+	return s.updateEnvironment(ctx, proj, env, &database.UpdateEnvironmentOptions{
+		Branch:    env.Branch,
+		Commit:    &rev.Commit,
+		Variables: env.Variables,
+	}, "rollback to "+shortCommit(rev.Commit))
+}
+
+// finishRevision records the outcome of a reconcile in its revision.
+// The commit reported by the runtime replaces the revision's commit, since it's the one that was actually reconciled.
+func (s *Service) finishRevision(ctx context.Context, rev *database.DeploymentRevision, commit string, status database.DeploymentStatus, results database.DeploymentRevisionResults, logs string) error {
+	if commit == "" {
+		commit = rev.Commit
+	}
+
+	finishedOn := time.Now()
+	_, err := s.DB.UpdateDeploymentRevision(ctx, rev.ID, &database.UpdateDeploymentRevisionOptions{
+		Commit:     commit,
+		Status:     status,
+		Results:    results,
+		Logs:       logs,
+		FinishedOn: &finishedOn,
+	})
+	return err
+}
+
+// deploymentCommit returns the commit that a deployment is pinned to, if any.
+// Otherwise, the runtime reconciles the head of the branch when it pulls the repo, so the commit isn't known until it reports it.
+func (s *Service) deploymentCommit(ctx context.Context, depl *database.Deployment) string {
+	env, err := s.DB.FindEnvironmentForDeployment(ctx, depl.ID)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			s.logger.Warn("reconcile: could not find environment", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+		}
+		return ""
+	}
+	if env.Commit == nil {
+		return ""
+	}
+	return *env.Commit
+}
+
+// reconcileResults groups the errors of a reconcile by the files they occurred in.
+// Every affected file has a result, so files without errors were reconciled successfully.
+func reconcileResults(res *runtimev1.ReconcileResponse) database.DeploymentRevisionResults {
+	results := make(database.DeploymentRevisionResults, 0, len(res.AffectedPaths))
+	byPath := make(map[string]*database.DeploymentRevisionResult, len(res.AffectedPaths))
+	for _, path := range res.AffectedPaths {
+		if byPath[path] != nil {
+			continue
+		}
+		r := &database.DeploymentRevisionResult{Path: path}
+		byPath[path] = r
+		results = append(results, r)
+	}
+
+	for _, e := range res.Errors {
+		r := byPath[e.FilePath]
+		if r == nil {
+			r = &database.DeploymentRevisionResult{Path: e.FilePath}
+			byPath[e.FilePath] = r
+			results = append(results, r)
+		}
+		r.Errors = append(r.Errors, e.Message)
+	}
+
+	return results
+}
+
+// shortCommit abbreviates a commit SHA for display
+func shortCommit(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,28 @@
+package admin
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileResults(t *testing.T) {
+	res := reconcileResults(&runtimev1.ReconcileResponse{
+		AffectedPaths: []string{"/sources/foo.yaml", "/models/bar.sql"},
+		Errors: []*runtimev1.ReconcileError{
+			{FilePath: "/models/bar.sql", Message: "syntax error"},
+			{FilePath: "/models/bar.sql", Message: "missing source"},
+			{FilePath: "/rill.yaml", Message: "invalid config"},
+			{Message: "timeout"},
+		},
+	})
+
+	require.Equal(t, database.DeploymentRevisionResults{
+		{Path: "/sources/foo.yaml"},
+		{Path: "/models/bar.sql", Errors: []string{"syntax error", "missing source"}},
+		{Path: "/rill.yaml", Errors: []string{"invalid config"}},
+		{Path: "", Errors: []string{"timeout"}},
+	}, res)
+}
@@ -0,0 +1,235 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/provisioner"
+	githubdriver "github.com/rilldata/rill/runtime/drivers/github"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+var ErrDeleteProdEnvironment = fmt.Errorf("the prod environment can't be deleted")
+
+// CreateEnvironment creates a named environment for a project and deploys it.
+func (s *Service) CreateEnvironment(ctx context.Context, proj *database.Project, opts *database.InsertEnvironmentOptions) (*database.Environment, error) {
+	if proj.GithubURL == nil || proj.GithubInstallationID == nil {
+		return nil, fmt.Errorf("cannot create environment for project without github info")
+	}
+
+	opts.ProjectID = proj.ID
+	env, err := s.DB.InsertEnvironment(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	env, err = s.deployEnvironment(ctx, proj, env)
+	if err != nil {
+		err2 := s.DB.DeleteEnvironment(ctx, env.ID)
+		return nil, multierr.Combine(err, err2)
+	}
+
+	return env, nil
+}
+
+// deployEnvironment provisions a runtime instance for an environment, stores the deployment and triggers a reconcile of it.
+// If it returns an error, the environment is left without a deployment.
+func (s *Service) deployEnvironment(ctx context.Context, proj *database.Project, env *database.Environment) (*database.Environment, error) {
+	inst, err := s.provisioner.Provision(ctx, &provisioner.ProvisionOptions{
+		OLAPDriver:           env.OLAPDriver,
+		OLAPDSN:              env.OLAPDSN,
+		Region:               proj.Region,
+		Slots:                env.Slots,
+		GithubURL:            *proj.GithubURL,
+		GitBranch:            env.Branch,
+		GitCommit:            safeStr(env.Commit),
+		GithubInstallationID: *proj.GithubInstallationID,
+		Variables:            env.Variables,
+	})
+	if err != nil {
+		return env, fmt.Errorf("provisioner: %w", err)
+	}
+
+	// Store deployment
+	depl, err := s.DB.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+		ProjectID:         proj.ID,
+		Branch:            env.Branch,
+		Slots:             env.Slots,
+		RuntimeHost:       inst.Host,
+		RuntimeInstanceID: inst.InstanceID,
+		RuntimeAudience:   inst.Audience,
+		OLAPDriver:        env.OLAPDriver,
+		Status:            database.DeploymentStatusPending,
+		Logs:              "",
+	})
+	if err != nil {
+		err2 := s.provisioner.Teardown(ctx, inst.Host, inst.InstanceID, env.OLAPDriver)
+		return env, multierr.Combine(err, err2)
+	}
+
+	// Update deployment of the environment
+	res, err := s.DB.UpdateEnvironment(ctx, env.ID, &database.UpdateEnvironmentOptions{
+		Branch:       env.Branch,
+		Commit:       env.Commit,
+		Variables:    env.Variables,
+		DeploymentID: &depl.ID,
+	})
+	if err != nil {
+		err2 := s.teardownDeployment(ctx, depl)
+		return env, multierr.Combine(err, err2)
+	}
+
+	// Trigger reconcile
+	_, err = s.TriggerReconcile(ctx, depl.ID, "deploy")
+	if err != nil {
+		// This error is weird. But it's safe not to teardown the rest, since the deployment is reconciled on the next push.
+		s.logger.Error("failed to trigger reconcile of new deployment", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+
+	return res, nil
+}
+
+// UpdateEnvironment updates the branch, pinned commit and variables of an environment, and applies them to its deployment.
+// The variables of the prod environment are also applied to the project's preview deployments.
+func (s *Service) UpdateEnvironment(ctx context.Context, proj *database.Project, env *database.Environment, opts *database.UpdateEnvironmentOptions) (*database.Environment, error) {
+	return s.updateEnvironment(ctx, proj, env, opts, "update")
+}
+
+// updateEnvironment implements UpdateEnvironment. The triggeredBy is recorded in the revision of the reconcile of a new branch or commit.
+func (s *Service) updateEnvironment(ctx context.Context, proj *database.Project, env *database.Environment, opts *database.UpdateEnvironmentOptions, triggeredBy string) (*database.Environment, error) {
+	// TODO: Make this actually fault tolerant.
+
+	opts.DeploymentID = env.DeploymentID
+	repoChanged := env.Branch != opts.Branch || safeStr(env.Commit) != safeStr(opts.Commit)
+
+	if env.DeploymentID != nil {
+		depl, err := s.DB.FindDeployment(ctx, *env.DeploymentID)
+		if err != nil {
+			return nil, err
+		}
+
+		var dsn string
+		if repoChanged {
+			dsn, err = repoDSN(proj, opts.Branch, opts.Commit)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		err = s.editInstance(ctx, depl, dsn, opts.Variables)
+		if err != nil {
+			return nil, err
+		}
+
+		if depl.Branch != opts.Branch {
+			_, err = s.DB.UpdateDeploymentBranch(ctx, depl.ID, opts.Branch)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if env.Name == database.EnvironmentNameProd {
+		ds, err := s.DB.FindDeployments(ctx, proj.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range ds {
+			if d.PullRequestNumber == nil {
+				continue
+			}
+			if err := s.editInstance(ctx, d, "", opts.Variables); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	env, err := s.DB.UpdateEnvironment(ctx, env.ID, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// Deploy the new branch or commit
+	if repoChanged && env.DeploymentID != nil {
+		_, err = s.TriggerReconcile(ctx, *env.DeploymentID, triggeredBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return env, nil
+}
+
+// PromoteEnvironment pins the target environment to the commit that the source environment is deployed from.
+// That is the source's pinned commit, or else the current head of its branch.
+func (s *Service) PromoteEnvironment(ctx context.Context, proj *database.Project, source, target *database.Environment) (*database.Environment, error) {
+	commit := safeStr(source.Commit)
+	if commit == "" {
+		if proj.GithubURL == nil || proj.GithubInstallationID == nil {
+			return nil, fmt.Errorf("cannot promote environment of project without github info")
+		}
+
+		var err error
+		commit, err = s.githubBranchHead(ctx, *proj.GithubInstallationID, *proj.GithubURL, source.Branch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return s.updateEnvironment(ctx, proj, target, &database.UpdateEnvironmentOptions{
+		Branch:    target.Branch,
+		Commit:    &commit,
+		Variables: target.Variables,
+	}, "promote from "+source.Name)
+}
+
+// DeleteEnvironment tears down the deployment of an environment and deletes it.
+func (s *Service) DeleteEnvironment(ctx context.Context, env *database.Environment) error {
+	if env.Name == database.EnvironmentNameProd {
+		return ErrDeleteProdEnvironment
+	}
+
+	if env.DeploymentID != nil {
+		depl, err := s.DB.FindDeployment(ctx, *env.DeploymentID)
+		if err != nil {
+			return err
+		}
+
+		err = s.teardownDeployment(ctx, depl)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.DB.DeleteEnvironment(ctx, env.ID)
+}
+
+// repoDSN returns the DSN of the repo of a runtime instance deployed from a branch or commit of a project
+func repoDSN(proj *database.Project, branch string, commit *string) (string, error) {
+	if proj.GithubURL == nil || proj.GithubInstallationID == nil {
+		return "", fmt.Errorf("project does not have github info")
+	}
+
+	dsn, err := json.Marshal(githubdriver.DSN{
+		GithubURL:      *proj.GithubURL,
+		Branch:         branch,
+		InstallationID: *proj.GithubInstallationID,
+		Commit:         safeStr(commit),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(dsn), nil
+}
+
+func safeStr(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
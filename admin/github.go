@@ -86,12 +86,16 @@ func (s *Service) LookupGithubRepoForUser(ctx context.Context, installationID in
 }
 
 // ProcessGithubEvent processes a Github event (usually received over webhooks).
-// After validating that the event is a valid Github event, it moves further processing to the background and returns a nil error.
-func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
+// After validating that the event is a valid Github event, it moves further processing to the job queue and returns a nil error.
+// The deliveryID is the unique ID Github assigns to the event, which is used to ignore redeliveries of events that haven't been processed yet.
+func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any, deliveryID string) error {
 	switch event := rawEvent.(type) {
 	// Triggered on push to repository
 	case *github.PushEvent:
-		return s.processGithubPush(ctx, event)
+		return s.processGithubPush(ctx, event, deliveryID)
+	// Triggered when a pull request is opened, updated or closed
+	case *github.PullRequestEvent:
+		return s.processGithubPullRequest(ctx, event)
 	// Triggered during first installation of app to an account (org or user) or one or more repos
 	case *github.InstallationEvent:
 		return s.processGithubInstallationEvent(ctx, event)
@@ -103,11 +107,43 @@ func (s *Service) ProcessGithubEvent(ctx context.Context, rawEvent any) error {
 	}
 }
 
-func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent) error {
+type githubPushPayload struct {
+	GithubURL string `json:"github_url"`
+	Ref       string `json:"ref"`
+	Pusher    string `json:"pusher,omitempty"`
+}
+
+func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent, deliveryID string) error {
+	// Parse the branch that was pushed to
+	// The format is refs/heads/main or refs/tags/v3.14.1
+	ref := event.GetRef()
+	if !strings.HasPrefix(ref, "refs/heads/") {
+		// We ignore tag pushes
+		return nil
+	}
+
+	var key string
+	if deliveryID != "" {
+		key = "github_push:" + deliveryID
+	}
+
+	_, err := s.enqueueJob(ctx, &enqueueOptions{
+		Type:           JobTypeGithubPush,
+		Payload:        &githubPushPayload{GithubURL: event.GetRepo().GetHTMLURL(), Ref: ref, Pusher: event.GetPusher().GetName()},
+		IdempotencyKey: key,
+	})
+	return err
+}
+
+// processGithubPushJob runs a JobTypeGithubPush job. It triggers reconciles of the environments deployed from the branch that was pushed to.
+func (s *Service) processGithubPushJob(ctx context.Context, job *database.Job) error {
+	payload := &githubPushPayload{}
+	if err := unmarshalPayload(job, payload); err != nil {
+		return err
+	}
+
 	// Find Rill project matching the repo that was pushed to
-	repo := event.GetRepo()
-	githubURL := *repo.HTMLURL
-	projects, err := s.DB.FindProjectsByGithubURL(ctx, githubURL)
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, payload.GithubURL)
 	if err != nil {
 		if errors.Is(err, database.ErrNotFound) {
 			// App is installed on repo not currently deployed. Do nothing.
@@ -116,25 +152,27 @@ func (s *Service) processGithubPush(ctx context.Context, event *github.PushEvent
 		return err
 	}
 
-	// Parse the branch that was pushed to
-	// The format is refs/heads/main or refs/tags/v3.14.1
-	ref := event.GetRef()
-	_, branch, found := strings.Cut(ref, "refs/heads/")
-	if !found {
-		// We ignore tag pushes
-		return nil
+	branch := strings.TrimPrefix(payload.Ref, "refs/heads/")
+	triggeredBy := "push"
+	if payload.Pusher != "" {
+		triggeredBy = "push by " + payload.Pusher
 	}
 
-	// Iterate over all projects and trigger reconcile
+	// Iterate over the environments of all projects and trigger reconciles
 	for _, project := range projects {
-		if branch != project.ProdBranch {
-			// Ignore if push was not to production branch
-			continue
+		envs, err := s.DB.FindEnvironments(ctx, project.ID)
+		if err != nil {
+			return err
 		}
 
-		// Trigger reconcile (runs in the background - err means the deployment wasn't found, which is unlikely)
-		if project.ProdDeploymentID != nil {
-			err = s.TriggerReconcile(ctx, *project.ProdDeploymentID)
+		for _, env := range envs {
+			// Ignore environments of other branches, and environments pinned to a commit
+			if env.Branch != branch || env.Commit != nil || env.DeploymentID == nil {
+				continue
+			}
+
+			// Trigger reconcile (err means the deployment wasn't found, which is unlikely)
+			_, err = s.TriggerReconcile(ctx, *env.DeploymentID, triggeredBy)
 			if err != nil {
 				return err
 			}
@@ -159,10 +197,29 @@ func (s *Service) processGithubInstallationRepositoriesEvent(ctx context.Context
 	return nil
 }
 
+// githubBranchHead returns the SHA of the commit at the head of a branch of a repo
+func (s *Service) githubBranchHead(ctx context.Context, installationID int64, githubURL, branch string) (string, error) {
+	account, repo, ok := gitutil.SplitGithubURL(githubURL)
+	if !ok {
+		return "", fmt.Errorf("invalid Github URL %q", githubURL)
+	}
+
+	gh, err := s.githubInstallationClient(installationID)
+	if err != nil {
+		return "", err
+	}
+
+	b, _, err := gh.Repositories.GetBranch(ctx, account, repo, branch, true)
+	if err != nil {
+		return "", fmt.Errorf("could not find branch %q: %w", branch, err)
+	}
+	return b.GetCommit().GetSHA(), nil
+}
+
 // githubInstallationClient makes a Github client that authenticates as a specific installation.
 // (As opposed to s.github, which authenticates as the Git App, and cannot access the contents of an installation.)
 func (s *Service) githubInstallationClient(installationID int64) (*github.Client, error) {
-	itr, err := ghinstallation.New(http.DefaultTransport, s.opts.GithubAppID, installationID, []byte(s.opts.GithubAppPrivateKey))
+	itr, err := ghinstallation.New(s.githubTransport, s.opts.GithubAppID, installationID, []byte(s.opts.GithubAppPrivateKey))
 	if err != nil {
 		return nil, err
 	}
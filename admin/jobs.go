@@ -0,0 +1,260 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin/database"
+	"go.uber.org/zap"
+)
+
+// Types of jobs in the job queue
+const (
+	JobTypeReconcileDeployment = "reconcile_deployment"
+	JobTypeTeardownProject     = "teardown_project"
+	JobTypeGithubPush          = "github_push"
+	JobTypeGithubPullRequest   = "github_pull_request"
+	JobTypeExpirePreviews      = "expire_previews"
+	JobTypeMigrateDeployment   = "migrate_deployment"
+	JobTypeCollectUsage        = "collect_usage"
+)
+
+const (
+	// jobLease is how long a worker holds a job before other workers can lease it.
+	// Workers renew the lease while a job is running, so it only expires if the worker stops (e.g. the admin server is restarted).
+	jobLease = 2 * time.Minute
+	// jobPollInterval is how often idle workers check for new jobs enqueued by other admin servers
+	jobPollInterval = 5 * time.Second
+	// jobMaxAttempts is the number of times a job runs before it fails
+	jobMaxAttempts = 5
+	// jobBackoff and jobMaxBackoff bound the delay before a failed job is retried, which doubles on every attempt
+	jobBackoff    = 10 * time.Second
+	jobMaxBackoff = 10 * time.Minute
+	// jobShutdownTimeout is how long Close waits for running jobs to stop
+	jobShutdownTimeout = 10 * time.Second
+)
+
+// jobHandler runs a job. Jobs that return an error are retried, unless the error is wrapped with permanentError.
+type jobHandler func(ctx context.Context, job *database.Job) error
+
+// permanentError is an error of a job that should not be retried
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+func permanent(err error) error {
+	return &permanentError{err: err}
+}
+
+// enqueueOptions are the options of a job added with enqueueJob
+type enqueueOptions struct {
+	Type           string
+	Payload        any
+	IdempotencyKey string
+	OrganizationID *string
+	ProjectID      *string
+}
+
+// enqueueJob adds a job to the queue and wakes up a worker to run it.
+// If a job with the same idempotency key is already pending, it returns that job instead, which runs with the new payload.
+func (s *Service) enqueueJob(ctx context.Context, opts *enqueueOptions) (*database.Job, error) {
+	var payload []byte
+	if opts.Payload != nil {
+		var err error
+		payload, err = json.Marshal(opts.Payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	job, err := s.DB.InsertJob(ctx, &database.InsertJobOptions{
+		Type:           opts.Type,
+		Payload:        payload,
+		IdempotencyKey: opts.IdempotencyKey,
+		OrganizationID: opts.OrganizationID,
+		ProjectID:      opts.ProjectID,
+		MaxAttempts:    jobMaxAttempts,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	select {
+	case s.jobsWake <- struct{}{}:
+	default:
+		// all workers are busy, and they check for new jobs when they're done
+	}
+
+	return job, nil
+}
+
+// startJobWorkers starts n workers that run jobs until s.closeCtx is cancelled
+func (s *Service) startJobWorkers(n int) {
+	s.jobHandlers = map[string]jobHandler{
+		JobTypeReconcileDeployment: s.reconcileDeployment,
+		JobTypeTeardownProject:     s.teardownProject,
+		JobTypeGithubPush:          s.processGithubPushJob,
+		JobTypeGithubPullRequest:   s.processGithubPullRequestJob,
+		JobTypeExpirePreviews:      s.expirePreviews,
+		JobTypeMigrateDeployment:   s.migrateDeployment,
+		JobTypeCollectUsage:        s.collectUsage,
+	}
+
+	hostname, _ := os.Hostname()
+	for i := 0; i < n; i++ {
+		workerID := fmt.Sprintf("%s/%s", hostname, uuid.New().String())
+		s.jobsWG.Add(1)
+		go func() {
+			defer s.jobsWG.Done()
+			s.runJobWorker(s.closeCtx, workerID)
+		}()
+	}
+}
+
+// waitForJobWorkers waits for the workers to stop after s.closeCtx is cancelled, up to jobShutdownTimeout
+func (s *Service) waitForJobWorkers() {
+	done := make(chan struct{})
+	go func() {
+		s.jobsWG.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(jobShutdownTimeout):
+		s.logger.Warn("jobs: timed out waiting for workers to stop")
+	}
+}
+
+func (s *Service) runJobWorker(ctx context.Context, workerID string) {
+	for {
+		job, err := s.DB.LeaseJob(ctx, workerID, jobLease)
+		if err == nil {
+			s.runJob(ctx, workerID, job)
+			continue
+		}
+
+		if ctx.Err() != nil {
+			return
+		}
+		if !errors.Is(err, database.ErrNotFound) {
+			s.logger.Error("jobs: could not lease job", zap.Error(err))
+		}
+
+		// wait for a job to be enqueued
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.jobsWake:
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
+
+// runJob runs a leased job and records the outcome in the queue
+func (s *Service) runJob(ctx context.Context, workerID string, job *database.Job) {
+	logger := s.logger.With(zap.String("job_id", job.ID), zap.String("job_type", job.Type), zap.Int("attempt", job.Attempts))
+
+	var err error
+	handler, ok := s.jobHandlers[job.Type]
+	switch {
+	case !ok:
+		err = permanent(fmt.Errorf("unknown job type %q", job.Type))
+	case job.Attempts > job.MaxAttempts:
+		// the job was leased again after the lease of its last attempt expired
+		err = permanent(fmt.Errorf("job did not complete in %d attempts", job.MaxAttempts))
+	default:
+		logger.Info("jobs: starting")
+		err = s.runJobWithLease(ctx, workerID, job, handler)
+	}
+
+	// record the outcome even if the service is closing
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var perr *permanentError
+	switch {
+	case err == nil:
+		logger.Info("jobs: completed")
+		err = s.DB.CompleteJob(ctx, job.ID, workerID)
+	case s.closeCtx.Err() != nil:
+		// the job was interrupted by Close, so it runs again right away on another admin server
+		logger.Info("jobs: interrupted by shutdown", zap.Error(err))
+		err = s.DB.RetryJob(ctx, job.ID, workerID, err.Error(), time.Now())
+	case errors.As(err, &perr) || job.Attempts >= job.MaxAttempts:
+		logger.Error("jobs: failed", zap.Error(err))
+		err = s.DB.FailJob(ctx, job.ID, workerID, err.Error())
+	default:
+		delay := jobRetryDelay(job.Attempts)
+		logger.Warn("jobs: failed, will retry", zap.Error(err), zap.Duration("delay", delay))
+		err = s.DB.RetryJob(ctx, job.ID, workerID, err.Error(), time.Now().Add(delay))
+	}
+	if err != nil {
+		logger.Error("jobs: could not update job", zap.Error(err))
+	}
+}
+
+// runJobWithLease runs a handler and renews the job's lease until it returns.
+// The handler is cancelled if the lease is lost.
+func (s *Service) runJobWithLease(ctx context.Context, workerID string, job *database.Job, handler jobHandler) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		ticker := time.NewTicker(jobLease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			err := s.DB.RenewJobLease(ctx, job.ID, workerID, jobLease)
+			if errors.Is(err, database.ErrNotFound) {
+				s.logger.Error("jobs: lost lease", zap.String("job_id", job.ID))
+				cancel()
+				return
+			}
+			if err != nil && ctx.Err() == nil {
+				s.logger.Warn("jobs: could not renew lease", zap.String("job_id", job.ID), zap.Error(err))
+			}
+		}
+	}()
+
+	return handler(ctx, job)
+}
+
+// jobRetryDelay returns the delay before retrying a job that failed in the given attempt.
+// It doubles on every attempt with some jitter, so jobs that failed together don't retry together.
+func jobRetryDelay(attempt int) time.Duration {
+	delay := jobBackoff
+	for i := 1; i < attempt && delay < jobMaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > jobMaxBackoff {
+		delay = jobMaxBackoff
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// unmarshalPayload parses the payload of a job. Invalid payloads are permanent errors.
+func unmarshalPayload(job *database.Job, v any) error {
+	if err := json.Unmarshal(job.Payload, v); err != nil {
+		return permanent(fmt.Errorf("invalid payload: %w", err))
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package admin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJobRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempt  int
+		min, max time.Duration
+	}{
+		{1, 5 * time.Second, 10 * time.Second},
+		{2, 10 * time.Second, 20 * time.Second},
+		{4, 40 * time.Second, 80 * time.Second},
+		{10, jobMaxBackoff / 2, jobMaxBackoff},
+		{100, jobMaxBackoff / 2, jobMaxBackoff},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			delay := jobRetryDelay(tt.attempt)
+			if delay < tt.min || delay > tt.max {
+				t.Errorf("delay for attempt %d is %s; want between %s and %s", tt.attempt, delay, tt.min, tt.max)
+			}
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/pkg/errors"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/gitutil"
+	"github.com/rilldata/rill/admin/provisioner"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/multierr"
+	"go.uber.org/zap"
+)
+
+const (
+	// previewSlots is the number of slots of preview deployments
+	previewSlots = 1
+	// previewStatusContext identifies the commit status of preview deployments on Github
+	previewStatusContext = "rill/preview"
+	// previewExpiryInterval is how often expired preview deployments are torn down
+	previewExpiryInterval = 10 * time.Minute
+)
+
+type githubPullRequestPayload struct {
+	GithubURL string `json:"github_url"`
+	Number    int    `json:"number"`
+	Branch    string `json:"branch"`
+	HeadSHA   string `json:"head_sha"`
+	Closed    bool   `json:"closed"`
+}
+
+func (s *Service) processGithubPullRequest(ctx context.Context, event *github.PullRequestEvent) error {
+	pr := event.GetPullRequest()
+	githubURL := event.GetRepo().GetHTMLURL()
+
+	var closed bool
+	switch event.GetAction() {
+	case "opened", "reopened", "synchronize":
+		// Pull requests from forks can't be deployed, since the branch is not in the repo of the project
+		if pr.GetHead().GetRepo().GetHTMLURL() != githubURL {
+			return nil
+		}
+	case "closed":
+		closed = true
+	default:
+		return nil
+	}
+
+	// Events for the same pull request are handled one at a time, and only the latest pending event is handled
+	_, err := s.enqueueJob(ctx, &enqueueOptions{
+		Type: JobTypeGithubPullRequest,
+		Payload: &githubPullRequestPayload{
+			GithubURL: githubURL,
+			Number:    pr.GetNumber(),
+			Branch:    pr.GetHead().GetRef(),
+			HeadSHA:   pr.GetHead().GetSHA(),
+			Closed:    closed,
+		},
+		IdempotencyKey: fmt.Sprintf("github_pull_request:%s#%d", githubURL, pr.GetNumber()),
+	})
+	return err
+}
+
+// processGithubPullRequestJob runs a JobTypeGithubPullRequest job.
+// It deploys the pull request's branch for each project of the repo, or tears the deployments down if the pull request was closed.
+func (s *Service) processGithubPullRequestJob(ctx context.Context, job *database.Job) error {
+	pr := &githubPullRequestPayload{}
+	if err := unmarshalPayload(job, pr); err != nil {
+		return err
+	}
+
+	projects, err := s.DB.FindProjectsByGithubURL(ctx, pr.GithubURL)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// App is installed on repo not currently deployed. Do nothing.
+			return nil
+		}
+		return err
+	}
+
+	var errs error
+	for _, proj := range projects {
+		if pr.Closed {
+			err = s.teardownPreview(ctx, proj, pr.Number)
+		} else {
+			err = s.deployPreview(ctx, proj, pr)
+		}
+		errs = multierr.Append(errs, err)
+	}
+	return errs
+}
+
+// deployPreview creates or updates the preview deployment of a pull request, reconciles it and posts the outcome as a commit status.
+func (s *Service) deployPreview(ctx context.Context, proj *database.Project, pr *githubPullRequestPayload) error {
+	if proj.GithubInstallationID == nil {
+		return nil
+	}
+
+	// Previews are deployed with the settings of the prod environment
+	prod, err := s.DB.FindEnvironmentByName(ctx, proj.ID, database.EnvironmentNameProd)
+	if err != nil {
+		return err
+	}
+	if prod.OLAPDriver != "duckdb" {
+		// Previews would share the OLAP database of the production deployment
+		s.logger.Info("preview: skipping project that doesn't use duckdb", zap.String("project_id", proj.ID), observability.ZapCtx(ctx))
+		return nil
+	}
+
+	// Deployments expire if the pull request isn't updated
+	expiresOn := time.Now().Add(s.opts.PreviewTTL)
+
+	depl, err := s.DB.FindDeploymentForPullRequest(ctx, proj.ID, pr.Number)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+
+		// Previews count against the org's slot quota
+		org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+		if err != nil {
+			return err
+		}
+		err = s.CheckQuotas(ctx, org, 0, previewSlots)
+		if err != nil {
+			if errors.Is(err, ErrQuotaExceeded) {
+				// Retrying won't help, so report it on the pull request instead
+				s.postPreviewStatus(ctx, proj, pr, "error", "Preview not deployed: quota exceeded")
+				return nil
+			}
+			return err
+		}
+
+		inst, err := s.provisioner.Provision(ctx, &provisioner.ProvisionOptions{
+			OLAPDriver:           prod.OLAPDriver,
+			Region:               proj.Region,
+			Slots:                previewSlots,
+			GithubURL:            *proj.GithubURL,
+			GitBranch:            pr.Branch,
+			GithubInstallationID: *proj.GithubInstallationID,
+			Variables:            prod.Variables,
+		})
+		if err != nil {
+			return fmt.Errorf("provisioner: %w", err)
+		}
+
+		depl, err = s.DB.InsertDeployment(ctx, &database.InsertDeploymentOptions{
+			ProjectID:         proj.ID,
+			Branch:            pr.Branch,
+			Slots:             previewSlots,
+			RuntimeHost:       inst.Host,
+			RuntimeInstanceID: inst.InstanceID,
+			RuntimeAudience:   inst.Audience,
+			OLAPDriver:        prod.OLAPDriver,
+			Status:            database.DeploymentStatusPending,
+			PullRequestNumber: &pr.Number,
+			ExpiresOn:         &expiresOn,
+		})
+		if err != nil {
+			// A deployment for the pull request may have been created concurrently
+			err2 := s.provisioner.Teardown(ctx, inst.Host, inst.InstanceID, prod.OLAPDriver)
+			return multierr.Combine(err, err2)
+		}
+	} else {
+		depl, err = s.DB.UpdateDeploymentExpiry(ctx, depl.ID, expiresOn)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.postPreviewStatus(ctx, proj, pr, "pending", "Deploying preview")
+
+	depl, err = s.reconcile(ctx, depl.ID, fmt.Sprintf("pull request #%d", pr.Number))
+	if err != nil {
+		s.postPreviewStatus(ctx, proj, pr, "error", "Could not deploy preview")
+		return err
+	}
+
+	if depl.Status == database.DeploymentStatusOK {
+		s.postPreviewStatus(ctx, proj, pr, "success", "Preview is ready")
+	} else {
+		s.postPreviewStatus(ctx, proj, pr, "failure", "Preview has errors")
+	}
+	return nil
+}
+
+// teardownPreview tears down the preview deployment of a pull request if it has one
+func (s *Service) teardownPreview(ctx context.Context, proj *database.Project, number int) error {
+	depl, err := s.DB.FindDeploymentForPullRequest(ctx, proj.ID, number)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.teardownDeployment(ctx, depl)
+}
+
+// postPreviewStatus sets a commit status for the preview deployment on the pull request's head commit.
+// It requires the Github App to have the "Commit statuses" permission. Errors are logged, since the status is informational.
+func (s *Service) postPreviewStatus(ctx context.Context, proj *database.Project, pr *githubPullRequestPayload, state, description string) {
+	err := s.createPreviewStatus(ctx, proj, pr, state, description)
+	if err != nil {
+		s.logger.Warn("preview: could not post commit status", zap.String("project_id", proj.ID), zap.Int("pull_request", pr.Number), zap.Error(err), observability.ZapCtx(ctx))
+	}
+}
+
+func (s *Service) createPreviewStatus(ctx context.Context, proj *database.Project, pr *githubPullRequestPayload, state, description string) error {
+	account, repo, ok := gitutil.SplitGithubURL(pr.GithubURL)
+	if !ok {
+		return fmt.Errorf("invalid Github URL %q", pr.GithubURL)
+	}
+
+	org, err := s.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return err
+	}
+
+	targetURL, err := s.PreviewURL(org.Name, proj.Name, pr.Number)
+	if err != nil {
+		return err
+	}
+
+	gh, err := s.githubInstallationClient(*proj.GithubInstallationID)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = gh.Repositories.CreateStatus(ctx, account, repo, pr.HeadSHA, &github.RepoStatus{
+		State:       github.String(state),
+		TargetURL:   github.String(targetURL),
+		Description: github.String(description),
+		Context:     github.String(previewStatusContext),
+	})
+	return err
+}
+
+// PreviewURL returns the URL of the frontend that shows the preview deployment of a pull request
+func (s *Service) PreviewURL(orgName, projectName string, pullRequestNumber int) (string, error) {
+	u, err := url.JoinPath(s.opts.FrontendURL, orgName, projectName)
+	if err != nil {
+		return "", err
+	}
+	return u + "?pr=" + strconv.Itoa(pullRequestNumber), nil
+}
+
+// startPreviewExpiry periodically enqueues a job that tears down expired preview deployments.
+// The job has an idempotency key, so admin servers don't enqueue it more than once.
+func (s *Service) startPreviewExpiry() {
+	s.jobsWG.Add(1)
+	go func() {
+		defer s.jobsWG.Done()
+		ticker := time.NewTicker(previewExpiryInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.closeCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			_, err := s.enqueueJob(s.closeCtx, &enqueueOptions{
+				Type:           JobTypeExpirePreviews,
+				IdempotencyKey: JobTypeExpirePreviews,
+			})
+			if err != nil && s.closeCtx.Err() == nil {
+				s.logger.Error("preview: could not enqueue expiry", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// expirePreviews runs a JobTypeExpirePreviews job
+func (s *Service) expirePreviews(ctx context.Context, job *database.Job) error {
+	ds, err := s.DB.FindExpiredDeployments(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs error
+	for _, d := range ds {
+		s.logger.Info("preview: tearing down expired deployment", zap.String("deployment_id", d.ID), observability.ZapCtx(ctx))
+		errs = multierr.Append(errs, s.teardownDeployment(ctx, d))
+	}
+	return errs
+}
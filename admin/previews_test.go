@@ -0,0 +1,453 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v50/github"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/provisioner"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestPreviewURL(t *testing.T) {
+	s := &Service{opts: &Options{FrontendURL: "https://ui.rilldata.com/"}}
+	u, err := s.PreviewURL("acme", "sales", 42)
+	require.NoError(t, err)
+	require.Equal(t, "https://ui.rilldata.com/acme/sales?pr=42", u)
+}
+
+func TestPreviewDeploy(t *testing.T) {
+	p := newPreviewTest(t)
+	ctx := context.Background()
+
+	// Opening a pull request deploys its branch with the settings of prod
+	require.NoError(t, p.svc.processGithubPullRequestJob(ctx, p.job(t, false)))
+
+	require.Len(t, p.prov.provisioned, 1)
+	require.Equal(t, "feature", p.prov.provisioned[0].GitBranch)
+	require.Equal(t, previewSlots, p.prov.provisioned[0].Slots)
+	require.Equal(t, map[string]string{"foo": "bar"}, p.prov.provisioned[0].Variables)
+
+	depl, err := p.db.FindDeploymentForPullRequest(ctx, p.proj.ID, 7)
+	require.NoError(t, err)
+	require.Equal(t, "feature", depl.Branch)
+	require.Equal(t, database.DeploymentStatusOK, depl.Status)
+	require.NotNil(t, depl.ExpiresOn)
+	require.WithinDuration(t, time.Now().Add(p.svc.opts.PreviewTTL), *depl.ExpiresOn, time.Minute)
+	require.Len(t, p.db.revisions, 1)
+	require.Equal(t, "pull request #7", p.db.revisions[0].TriggeredBy)
+
+	require.Equal(t, []previewStatus{
+		{SHA: "sha1", State: "pending", Description: "Deploying preview", TargetURL: "https://ui.rilldata.com/acme/sales?pr=7"},
+		{SHA: "sha1", State: "success", Description: "Preview is ready", TargetURL: "https://ui.rilldata.com/acme/sales?pr=7"},
+	}, p.gh.popStatuses())
+
+	// Pushing to the pull request reconciles the existing deployment and extends its expiry
+	expiresOn := *depl.ExpiresOn
+	p.rt.setErrors(&runtimev1.ReconcileError{FilePath: "/models/foo.sql", Message: "syntax error"})
+	pr := p.job(t, false)
+	pr.Payload = []byte(strings.Replace(string(pr.Payload), "sha1", "sha2", 1))
+	require.NoError(t, p.svc.processGithubPullRequestJob(ctx, pr))
+
+	require.Len(t, p.prov.provisioned, 1)
+	depl, err = p.db.FindDeploymentForPullRequest(ctx, p.proj.ID, 7)
+	require.NoError(t, err)
+	require.Equal(t, database.DeploymentStatusError, depl.Status)
+	require.True(t, depl.ExpiresOn.After(expiresOn))
+	require.Len(t, p.db.revisions, 2)
+
+	require.Equal(t, []previewStatus{
+		{SHA: "sha2", State: "pending", Description: "Deploying preview", TargetURL: "https://ui.rilldata.com/acme/sales?pr=7"},
+		{SHA: "sha2", State: "failure", Description: "Preview has errors", TargetURL: "https://ui.rilldata.com/acme/sales?pr=7"},
+	}, p.gh.popStatuses())
+}
+
+func TestPreviewTeardownOnClose(t *testing.T) {
+	p := newPreviewTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, p.svc.processGithubPullRequestJob(ctx, p.job(t, false)))
+	depl, err := p.db.FindDeploymentForPullRequest(ctx, p.proj.ID, 7)
+	require.NoError(t, err)
+	p.gh.popStatuses()
+
+	// Closing the pull request tears the deployment down
+	require.NoError(t, p.svc.processGithubPullRequestJob(ctx, p.job(t, true)))
+	require.Equal(t, []string{depl.RuntimeInstanceID}, p.prov.tornDown)
+	_, err = p.db.FindDeploymentForPullRequest(ctx, p.proj.ID, 7)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	require.Empty(t, p.gh.popStatuses())
+
+	// Closing it again is a no-op
+	require.NoError(t, p.svc.processGithubPullRequestJob(ctx, p.job(t, true)))
+	require.Len(t, p.prov.tornDown, 1)
+}
+
+func TestPreviewExpiry(t *testing.T) {
+	p := newPreviewTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, p.svc.processGithubPullRequestJob(ctx, p.job(t, false)))
+	depl, err := p.db.FindDeploymentForPullRequest(ctx, p.proj.ID, 7)
+	require.NoError(t, err)
+
+	// Deployments that haven't expired are kept
+	require.NoError(t, p.svc.expirePreviews(ctx, &database.Job{}))
+	require.Empty(t, p.prov.tornDown)
+
+	_, err = p.db.UpdateDeploymentExpiry(ctx, depl.ID, time.Now().Add(-time.Minute))
+	require.NoError(t, err)
+	require.NoError(t, p.svc.expirePreviews(ctx, &database.Job{}))
+	require.Equal(t, []string{depl.RuntimeInstanceID}, p.prov.tornDown)
+	_, err = p.db.FindDeployment(ctx, depl.ID)
+	require.ErrorIs(t, err, database.ErrNotFound)
+}
+
+func TestPreviewQuotaExceeded(t *testing.T) {
+	p := newPreviewTest(t)
+	ctx := context.Background()
+
+	p.db.org.QuotaSlotsTotal = 2
+	p.db.usage.Slots = 2
+	require.NoError(t, p.svc.processGithubPullRequestJob(ctx, p.job(t, false)))
+
+	require.Empty(t, p.prov.provisioned)
+	_, err := p.db.FindDeploymentForPullRequest(ctx, p.proj.ID, 7)
+	require.ErrorIs(t, err, database.ErrNotFound)
+	require.Equal(t, []previewStatus{
+		{SHA: "sha1", State: "error", Description: "Preview not deployed: quota exceeded", TargetURL: "https://ui.rilldata.com/acme/sales?pr=7"},
+	}, p.gh.popStatuses())
+}
+
+// previewTest is a Service with fakes of the database, provisioner, runtime and Github API that previews use.
+type previewTest struct {
+	svc  *Service
+	db   *previewDB
+	prov *previewProvisioner
+	rt   *previewRuntime
+	gh   *previewGithub
+	proj *database.Project
+}
+
+func newPreviewTest(t *testing.T) *previewTest {
+	rt := &previewRuntime{}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	runtimev1.RegisterRuntimeServiceServer(srv, rt)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	issuer, err := auth.NewEphemeralIssuer("")
+	require.NoError(t, err)
+
+	githubURL := "https://github.com/acme/sales"
+	installationID := int64(1)
+	proj := &database.Project{ID: "p1", OrganizationID: "o1", Name: "sales", GithubURL: &githubURL, GithubInstallationID: &installationID}
+	db := &previewDB{
+		org:         &database.Organization{ID: "o1", Name: "acme", OrganizationQuotas: database.OrganizationQuotas{QuotaProjects: -1, QuotaSlotsTotal: -1, QuotaIngestedBytes: -1, QuotaQueriesPerMonth: -1}},
+		proj:        proj,
+		prod:        &database.Environment{ID: "e1", ProjectID: "p1", Name: database.EnvironmentNameProd, Branch: "main", OLAPDriver: "duckdb", Variables: database.Variables{"foo": "bar"}},
+		usage:       &database.OrganizationUsage{},
+		deployments: make(map[string]*database.Deployment),
+	}
+	prov := &previewProvisioner{host: "http://" + lis.Addr().String()}
+	gh := &previewGithub{}
+
+	svc := &Service{
+		DB:              db,
+		opts:            &Options{GithubAppID: 1, GithubAppPrivateKey: string(pemKey), FrontendURL: "https://ui.rilldata.com", PreviewTTL: time.Hour},
+		logger:          zap.NewNop(),
+		githubTransport: gh,
+		provisioner:     prov,
+		issuer:          issuer,
+	}
+
+	return &previewTest{svc: svc, db: db, prov: prov, rt: rt, gh: gh, proj: proj}
+}
+
+// job returns a JobTypeGithubPullRequest job for pull request #7 of the project's repo
+func (p *previewTest) job(t *testing.T, closed bool) *database.Job {
+	payload, err := json.Marshal(&githubPullRequestPayload{
+		GithubURL: *p.proj.GithubURL,
+		Number:    7,
+		Branch:    "feature",
+		HeadSHA:   "sha1",
+		Closed:    closed,
+	})
+	require.NoError(t, err)
+	return &database.Job{Type: JobTypeGithubPullRequest, Payload: payload}
+}
+
+// previewDB implements the parts of database.DB that previews use. Calling other methods panics.
+type previewDB struct {
+	database.DB
+	mu          sync.Mutex
+	org         *database.Organization
+	proj        *database.Project
+	prod        *database.Environment
+	usage       *database.OrganizationUsage
+	deployments map[string]*database.Deployment
+	revisions   []*database.DeploymentRevision
+	nextID      int
+}
+
+func (db *previewDB) FindProjectsByGithubURL(ctx context.Context, githubURL string) ([]*database.Project, error) {
+	if githubURL != *db.proj.GithubURL {
+		return nil, database.ErrNotFound
+	}
+	return []*database.Project{db.proj}, nil
+}
+
+func (db *previewDB) FindOrganization(ctx context.Context, id string) (*database.Organization, error) {
+	return db.org, nil
+}
+
+func (db *previewDB) ResolveOrganizationUsage(ctx context.Context, orgID string, periodStart time.Time) (*database.OrganizationUsage, error) {
+	return db.usage, nil
+}
+
+func (db *previewDB) FindEnvironmentByName(ctx context.Context, projectID, name string) (*database.Environment, error) {
+	if name != db.prod.Name {
+		return nil, database.ErrNotFound
+	}
+	return db.prod, nil
+}
+
+func (db *previewDB) FindEnvironmentForDeployment(ctx context.Context, deploymentID string) (*database.Environment, error) {
+	// Preview deployments don't belong to an environment
+	return nil, database.ErrNotFound
+}
+
+func (db *previewDB) FindDeployment(ctx context.Context, id string) (*database.Deployment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	d, ok := db.deployments[id]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	cp := *d
+	return &cp, nil
+}
+
+func (db *previewDB) FindDeploymentForPullRequest(ctx context.Context, projectID string, pullRequestNumber int) (*database.Deployment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, d := range db.deployments {
+		if d.ProjectID == projectID && d.PullRequestNumber != nil && *d.PullRequestNumber == pullRequestNumber {
+			cp := *d
+			return &cp, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+func (db *previewDB) FindExpiredDeployments(ctx context.Context) ([]*database.Deployment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	var res []*database.Deployment
+	for _, d := range db.deployments {
+		if d.ExpiresOn != nil && d.ExpiresOn.Before(time.Now()) {
+			cp := *d
+			res = append(res, &cp)
+		}
+	}
+	return res, nil
+}
+
+func (db *previewDB) InsertDeployment(ctx context.Context, opts *database.InsertDeploymentOptions) (*database.Deployment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.nextID++
+	d := &database.Deployment{
+		ID:                fmt.Sprintf("d%d", db.nextID),
+		ProjectID:         opts.ProjectID,
+		Slots:             opts.Slots,
+		Branch:            opts.Branch,
+		RuntimeHost:       opts.RuntimeHost,
+		RuntimeInstanceID: opts.RuntimeInstanceID,
+		RuntimeAudience:   opts.RuntimeAudience,
+		OLAPDriver:        opts.OLAPDriver,
+		Status:            opts.Status,
+		Logs:              opts.Logs,
+		PullRequestNumber: opts.PullRequestNumber,
+		ExpiresOn:         opts.ExpiresOn,
+	}
+	db.deployments[d.ID] = d
+	cp := *d
+	return &cp, nil
+}
+
+func (db *previewDB) DeleteDeployment(ctx context.Context, id string) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.deployments, id)
+	return nil
+}
+
+func (db *previewDB) UpdateDeploymentStatus(ctx context.Context, id string, status database.DeploymentStatus, logs string) (*database.Deployment, error) {
+	return db.updateDeployment(id, func(d *database.Deployment) {
+		d.Status = status
+		d.Logs = logs
+	})
+}
+
+func (db *previewDB) UpdateDeploymentExpiry(ctx context.Context, id string, expiresOn time.Time) (*database.Deployment, error) {
+	return db.updateDeployment(id, func(d *database.Deployment) {
+		d.ExpiresOn = &expiresOn
+	})
+}
+
+func (db *previewDB) updateDeployment(id string, fn func(d *database.Deployment)) (*database.Deployment, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	d, ok := db.deployments[id]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	fn(d)
+	cp := *d
+	return &cp, nil
+}
+
+func (db *previewDB) InsertDeploymentRevision(ctx context.Context, opts *database.InsertDeploymentRevisionOptions) (*database.DeploymentRevision, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	rev := &database.DeploymentRevision{
+		ID:           fmt.Sprintf("r%d", len(db.revisions)+1),
+		DeploymentID: opts.DeploymentID,
+		Commit:       opts.Commit,
+		TriggeredBy:  opts.TriggeredBy,
+		Status:       opts.Status,
+	}
+	db.revisions = append(db.revisions, rev)
+	return rev, nil
+}
+
+func (db *previewDB) UpdateDeploymentRevision(ctx context.Context, id string, opts *database.UpdateDeploymentRevisionOptions) (*database.DeploymentRevision, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	for _, rev := range db.revisions {
+		if rev.ID == id {
+			rev.Commit = opts.Commit
+			rev.Status = opts.Status
+			rev.Results = opts.Results
+			rev.Logs = opts.Logs
+			rev.FinishedOn = opts.FinishedOn
+			return rev, nil
+		}
+	}
+	return nil, database.ErrNotFound
+}
+
+// previewProvisioner provisions instances on a single runtime host
+type previewProvisioner struct {
+	host        string
+	provisioned []*provisioner.ProvisionOptions
+	tornDown    []string
+}
+
+func (p *previewProvisioner) Provision(ctx context.Context, opts *provisioner.ProvisionOptions) (*provisioner.Instance, error) {
+	p.provisioned = append(p.provisioned, opts)
+	return &provisioner.Instance{Host: p.host, InstanceID: fmt.Sprintf("i%d", len(p.provisioned))}, nil
+}
+
+func (p *previewProvisioner) Teardown(ctx context.Context, host, instanceID, olapDriver string) error {
+	p.tornDown = append(p.tornDown, instanceID)
+	return nil
+}
+
+func (p *previewProvisioner) Close() error {
+	return nil
+}
+
+// previewRuntime is a runtime server that only implements Reconcile
+type previewRuntime struct {
+	runtimev1.UnimplementedRuntimeServiceServer
+	mu     sync.Mutex
+	errors []*runtimev1.ReconcileError
+}
+
+func (r *previewRuntime) setErrors(errs ...*runtimev1.ReconcileError) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors = errs
+}
+
+func (r *previewRuntime) Reconcile(ctx context.Context, req *runtimev1.ReconcileRequest) (*runtimev1.ReconcileResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return &runtimev1.ReconcileResponse{Errors: r.errors, AffectedPaths: []string{"/models/foo.sql"}}, nil
+}
+
+// previewStatus is a commit status posted to the Github API
+type previewStatus struct {
+	SHA         string
+	State       string
+	Description string
+	TargetURL   string
+}
+
+// previewGithub is a transport that serves the Github API requests of previews
+type previewGithub struct {
+	mu       sync.Mutex
+	statuses []previewStatus
+}
+
+func (g *previewGithub) popStatuses() []previewStatus {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	res := g.statuses
+	g.statuses = nil
+	return res
+}
+
+func (g *previewGithub) RoundTrip(req *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	switch {
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/access_tokens"):
+		rec.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(rec).Encode(map[string]any{"token": "token", "expires_at": time.Now().Add(time.Hour)})
+	case req.Method == http.MethodPost && strings.HasPrefix(req.URL.Path, "/repos/acme/sales/statuses/"):
+		st := &github.RepoStatus{}
+		if err := json.NewDecoder(req.Body).Decode(st); err != nil {
+			return nil, err
+		}
+		g.mu.Lock()
+		g.statuses = append(g.statuses, previewStatus{
+			SHA:         strings.TrimPrefix(req.URL.Path, "/repos/acme/sales/statuses/"),
+			State:       st.GetState(),
+			Description: st.GetDescription(),
+			TargetURL:   st.GetTargetURL(),
+		})
+		g.mu.Unlock()
+		rec.WriteHeader(http.StatusCreated)
+		_, _ = rec.WriteString("{}")
+	default:
+		rec.WriteHeader(http.StatusNotFound)
+	}
+	res := rec.Result()
+	res.Request = req
+	return res, nil
+}
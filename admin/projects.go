@@ -3,21 +3,24 @@ package admin
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/rilldata/rill/admin/database"
-	"github.com/rilldata/rill/admin/provisioner"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/client"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
-func (s *Service) CreateProject(ctx context.Context, opts *database.InsertProjectOptions) (*database.Project, error) {
+// CreateProject creates a project with its prod environment and deploys it.
+func (s *Service) CreateProject(ctx context.Context, opts *database.InsertProjectOptions, prodOpts *database.InsertEnvironmentOptions) (*database.Project, error) {
 	// TODO: Make this actually fault tolerant.
 
 	org, err := s.DB.FindOrganization(ctx, opts.OrganizationID)
@@ -58,6 +61,27 @@ func (s *Service) CreateProject(ctx context.Context, opts *database.InsertProjec
 		return nil, err
 	}
 
+	// Create the prod environment
+	prodOpts.ProjectID = proj.ID
+	prodOpts.Name = database.EnvironmentNameProd
+	env, err := s.DB.InsertEnvironment(txCtx, prodOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.auditUserAction(txCtx, opts.UserID, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		ProjectID:      &proj.ID,
+		Action:         AuditActionProjectCreate,
+		TargetType:     AuditTargetProject,
+		TargetID:       proj.ID,
+		TargetName:     proj.Name,
+		After:          ProjectAuditFields(proj, env.Branch),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -67,72 +91,70 @@ func (s *Service) CreateProject(ctx context.Context, opts *database.InsertProjec
 		return nil, fmt.Errorf("cannot create project without github info")
 	}
 
-	// Provision it
-	provOpts := &provisioner.ProvisionOptions{
-		OLAPDriver:           proj.ProdOLAPDriver,
-		OLAPDSN:              proj.ProdOLAPDSN,
-		Region:               proj.Region,
-		Slots:                proj.ProdSlots,
-		GithubURL:            *proj.GithubURL,
-		GitBranch:            proj.ProdBranch,
-		GithubInstallationID: *proj.GithubInstallationID,
-		Variables:            proj.ProdVariables,
-	}
-	// start using original context again since transaction in txCtx is done
-	inst, err := s.provisioner.Provision(ctx, provOpts)
+	// Deploy it (start using original context again since transaction in txCtx is done)
+	_, err = s.deployEnvironment(ctx, proj, env)
 	if err != nil {
-		err = fmt.Errorf("provisioner: %w", err)
 		err2 := s.DB.DeleteProject(ctx, proj.ID)
 		return nil, multierr.Combine(err, err2)
 	}
 
-	// Store deployment
-	depl, err := s.DB.InsertDeployment(ctx, &database.InsertDeploymentOptions{
-		ProjectID:         proj.ID,
-		Branch:            proj.ProdBranch,
-		Slots:             proj.ProdSlots,
-		RuntimeHost:       inst.Host,
-		RuntimeInstanceID: inst.InstanceID,
-		RuntimeAudience:   inst.Audience,
-		Status:            database.DeploymentStatusPending,
-		Logs:              "",
-	})
-	if err != nil {
-		err2 := s.provisioner.Teardown(ctx, inst.Host, inst.InstanceID, proj.ProdOLAPDriver)
-		err3 := s.DB.DeleteProject(ctx, proj.ID)
-		return nil, multierr.Combine(err, err2, err3)
-	}
-
-	// Update prod deployment on project
-	res, err := s.DB.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
-		Name:                 proj.Name,
-		Description:          proj.Description,
-		Public:               proj.Public,
-		ProdBranch:           proj.ProdBranch,
-		ProdVariables:        proj.ProdVariables,
-		GithubURL:            proj.GithubURL,
-		GithubInstallationID: proj.GithubInstallationID,
-		ProdDeploymentID:     &depl.ID,
+	return proj, nil
+}
+
+// TeardownProject enqueues a job that tears down the deployments of a project and then deletes it.
+func (s *Service) TeardownProject(ctx context.Context, p *database.Project) (*database.Job, error) {
+	return s.enqueueJob(ctx, &enqueueOptions{
+		Type:           JobTypeTeardownProject,
+		Payload:        &teardownProjectPayload{ProjectID: p.ID},
+		IdempotencyKey: "teardown:" + p.ID,
+		OrganizationID: &p.OrganizationID,
+		ProjectID:      &p.ID,
 	})
+}
+
+// TriggerReconcile enqueues a job that reconciles a deployment.
+// Reconciles of the same deployment don't run concurrently, and triggers while a reconcile is pending are merged.
+// The triggeredBy describes the cause of the reconcile (e.g. "push by alice"), and is recorded in the deployment's revision history.
+func (s *Service) TriggerReconcile(ctx context.Context, deploymentID, triggeredBy string) (*database.Job, error) {
+	depl, err := s.DB.FindDeployment(ctx, deploymentID)
 	if err != nil {
-		err2 := s.DB.DeleteDeployment(ctx, depl.ID)
-		err3 := s.provisioner.Teardown(ctx, inst.Host, inst.InstanceID, proj.ProdOLAPDriver)
-		err4 := s.DB.DeleteProject(ctx, proj.ID)
-		return nil, multierr.Combine(err, err2, err3, err4)
+		return nil, err
 	}
 
-	// Trigger reconcile
-	err = s.TriggerReconcile(ctx, depl.ID)
+	proj, err := s.DB.FindProject(ctx, depl.ProjectID)
 	if err != nil {
-		// This error is weird. But it's safe not to teardown the rest.
 		return nil, err
 	}
 
-	return res, nil
+	return s.enqueueJob(ctx, &enqueueOptions{
+		Type:           JobTypeReconcileDeployment,
+		Payload:        &reconcileDeploymentPayload{DeploymentID: depl.ID, TriggeredBy: triggeredBy},
+		IdempotencyKey: "reconcile:" + depl.ID,
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+	})
+}
+
+type teardownProjectPayload struct {
+	ProjectID string `json:"project_id"`
 }
 
-func (s *Service) TeardownProject(ctx context.Context, p *database.Project) error {
-	// TODO: Make this actually fault tolerant.
+// teardownProject runs a JobTypeTeardownProject job.
+// It can be retried after it partially succeeded, since deployments are deleted after they're torn down.
+func (s *Service) teardownProject(ctx context.Context, job *database.Job) error {
+	payload := &teardownProjectPayload{}
+	if err := unmarshalPayload(job, payload); err != nil {
+		return err
+	}
+
+	p, err := s.DB.FindProject(ctx, payload.ProjectID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// Already deleted
+			return nil
+		}
+		return err
+	}
 
 	ds, err := s.DB.FindDeployments(ctx, p.ID)
 	if err != nil {
@@ -140,145 +162,155 @@ func (s *Service) TeardownProject(ctx context.Context, p *database.Project) erro
 	}
 
 	for _, d := range ds {
-		err := s.provisioner.Teardown(ctx, d.RuntimeHost, d.RuntimeInstanceID, p.ProdOLAPDriver)
-		if err != nil {
-			return err
-		}
-
-		err = s.DB.DeleteDeployment(ctx, d.ID)
+		err := s.teardownDeployment(ctx, d)
 		if err != nil {
 			return err
 		}
 	}
 
-	err = s.DB.DeleteProject(ctx, p.ID)
-	if err != nil {
+	return s.DB.DeleteProject(ctx, p.ID)
+}
+
+// teardownDeployment deletes a deployment and its runtime instance.
+// It can be retried after it partially succeeded, since the instance not being found is not an error.
+func (s *Service) teardownDeployment(ctx context.Context, d *database.Deployment) error {
+	err := s.provisioner.Teardown(ctx, d.RuntimeHost, d.RuntimeInstanceID, d.OLAPDriver)
+	if err != nil && status.Code(err) != codes.NotFound {
 		return err
 	}
 
-	return nil
+	return s.DB.DeleteDeployment(ctx, d.ID)
 }
 
-func (s *Service) TriggerReconcile(ctx context.Context, deploymentID string) error {
-	// TODO: Make this actually fault tolerant
+type reconcileDeploymentPayload struct {
+	DeploymentID string `json:"deployment_id"`
+	TriggeredBy  string `json:"triggered_by,omitempty"`
+}
 
-	// Run it all in the background
-	go func() {
-		// Use s.closeCtx to cancel if the service is stopped
-		ctx := s.closeCtx
+// reconcileDeployment runs a JobTypeReconcileDeployment job.
+func (s *Service) reconcileDeployment(ctx context.Context, job *database.Job) error {
+	payload := &reconcileDeploymentPayload{}
+	if err := unmarshalPayload(job, payload); err != nil {
+		return err
+	}
 
-		s.logger.Info("reconcile: starting", zap.String("deployment_id", deploymentID), observability.ZapCtx(ctx))
+	_, err := s.reconcile(ctx, payload.DeploymentID, payload.TriggeredBy)
+	if errors.Is(err, database.ErrNotFound) {
+		// The deployment was torn down after the job was enqueued
+		s.logger.Info("reconcile: skipping because the deployment was deleted", zap.String("deployment_id", payload.DeploymentID), observability.ZapCtx(ctx))
+		return nil
+	}
+	return err
+}
 
-		// Get deployment
-		depl, err := s.DB.FindDeployment(ctx, deploymentID)
-		if err != nil {
-			s.logger.Error("reconcile: could not find deployment", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-			return
-		}
+// reconcile reconciles a deployment and returns it with its new status.
+// Errors calling the runtime are returned, so the job is retried. Errors in the project files are reported in the deployment's logs.
+// Every call records a new revision in the deployment's history.
+func (s *Service) reconcile(ctx context.Context, deploymentID, triggeredBy string) (*database.Deployment, error) {
+	// Get deployment
+	depl, err := s.DB.FindDeployment(ctx, deploymentID)
+	if err != nil {
+		return nil, err
+	}
 
-		// Check status
-		if depl.Status == database.DeploymentStatusReconciling && time.Since(depl.UpdatedOn) < 30*time.Minute {
-			s.logger.Error("reconcile: skipping because it is already running", zap.String("deployment_id", deploymentID), observability.ZapCtx(ctx))
-			return
-		}
+	// Set deployment status to reconciling
+	depl, err = s.DB.UpdateDeploymentStatus(ctx, deploymentID, database.DeploymentStatusReconciling, "")
+	if err != nil {
+		return nil, fmt.Errorf("could not update status: %w", err)
+	}
 
-		// Set deployment status to reconciling
-		depl, err = s.DB.UpdateDeploymentStatus(ctx, deploymentID, database.DeploymentStatusReconciling, "")
-		if err != nil {
-			s.logger.Error("reconcile: could not update status", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-			return
-		}
+	// Start a revision
+	rev, err := s.DB.InsertDeploymentRevision(ctx, &database.InsertDeploymentRevisionOptions{
+		DeploymentID: deploymentID,
+		Commit:       s.deploymentCommit(ctx, depl),
+		TriggeredBy:  triggeredBy,
+		Status:       database.DeploymentStatusReconciling,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not insert revision: %w", err)
+	}
 
-		// Get superuser token for runtime host
-		jwt, err := s.issuer.NewToken(auth.TokenOptions{
-			AudienceURL:         depl.RuntimeAudience,
-			TTL:                 time.Hour,
-			InstancePermissions: map[string][]auth.Permission{depl.RuntimeInstanceID: {auth.EditInstance}},
-		})
-		if err != nil {
-			s.logger.Error("reconcile: could not get token", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-			return
-		}
+	// Get superuser token for runtime host
+	jwt, err := s.issuer.NewToken(auth.TokenOptions{
+		AudienceURL:         depl.RuntimeAudience,
+		TTL:                 time.Hour,
+		InstancePermissions: map[string][]auth.Permission{depl.RuntimeInstanceID: {auth.EditInstance}},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get token: %w", err)
+	}
 
-		// Make runtime client
-		rt, err := client.New(depl.RuntimeHost, jwt)
-		if err != nil {
-			s.logger.Error("reconcile: could not create client", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-			return
-		}
+	// Make runtime client
+	rt, err := client.New(depl.RuntimeHost, jwt)
+	if err != nil {
+		return nil, fmt.Errorf("could not create client: %w", err)
+	}
+	defer rt.Close()
 
-		// Call reconcile
-		res, err := rt.Reconcile(ctx, &runtimev1.ReconcileRequest{InstanceId: depl.RuntimeInstanceID})
-		if err != nil {
-			s.logger.Error("reconcile: rpc error", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-			_, err = s.DB.UpdateDeploymentStatus(ctx, deploymentID, database.DeploymentStatusError, err.Error())
-			if err != nil {
-				s.logger.Error("reconcile: could not update logs", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-			}
-			return
-		}
+	// Call reconcile
+	res, err := rt.Reconcile(ctx, &runtimev1.ReconcileRequest{InstanceId: depl.RuntimeInstanceID})
+	if err != nil {
+		_, err2 := s.DB.UpdateDeploymentStatus(ctx, deploymentID, database.DeploymentStatusError, err.Error())
+		err3 := s.finishRevision(ctx, rev, "", database.DeploymentStatusError, nil, err.Error())
+		return nil, multierr.Combine(fmt.Errorf("rpc error: %w", err), err2, err3)
+	}
 
-		// Set status
-		if len(res.Errors) > 0 {
-			json, err := protojson.Marshal(res)
-			if err != nil {
-				s.logger.Error("reconcile: json error", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-				return
-			}
-
-			_, err = s.DB.UpdateDeploymentStatus(ctx, deploymentID, database.DeploymentStatusError, string(json))
-			if err != nil {
-				s.logger.Error("reconcile: could not update logs", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-				return
-			}
-		} else {
-			_, err = s.DB.UpdateDeploymentStatus(ctx, deploymentID, database.DeploymentStatusOK, "")
-			if err != nil {
-				s.logger.Error("reconcile: could not clear logs", zap.String("deployment_id", deploymentID), zap.Error(err), observability.ZapCtx(ctx))
-				return
-			}
+	// Set status
+	deplStatus := database.DeploymentStatusOK
+	logs := ""
+	if len(res.Errors) > 0 {
+		json, err := protojson.Marshal(res)
+		if err != nil {
+			return nil, permanent(fmt.Errorf("json error: %w", err))
 		}
+		deplStatus = database.DeploymentStatusError
+		logs = string(json)
+	}
 
-		s.logger.Info("reconcile: completed", zap.String("deployment_id", deploymentID), observability.ZapCtx(ctx))
-	}()
-	return nil
-}
-
-func (s *Service) UpdateProject(ctx context.Context, projID string, opts *database.UpdateProjectOptions) (*database.Project, error) {
-	// TODO: Make this actually fault tolerant.
-
-	// TODO: Handle if ProdBranch or GithubURL was changed.
-
-	ds, err := s.DB.FindDeployments(ctx, projID)
+	depl, err = s.DB.UpdateDeploymentStatus(ctx, deploymentID, deplStatus, logs)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("could not update logs: %w", err)
 	}
 
-	for _, d := range ds {
-		if err := s.editInstance(ctx, d, opts.ProdVariables); err != nil {
-			return nil, err
-		}
+	err = s.finishRevision(ctx, rev, res.Commit, deplStatus, reconcileResults(res), logs)
+	if err != nil {
+		return nil, fmt.Errorf("could not update revision: %w", err)
 	}
 
-	// Update the project
-	proj, err := s.DB.UpdateProject(ctx, projID, opts)
-	if err != nil {
-		return nil, err
+	return depl, nil
+}
+
+// ProjectAuditFields returns the fields of a project that are recorded in the audit log.
+func ProjectAuditFields(p *database.Project, prodBranch string) map[string]string {
+	return map[string]string{
+		"name":        p.Name,
+		"description": p.Description,
+		"public":      strconv.FormatBool(p.Public),
+		"github_url":  safeStr(p.GithubURL),
+		"prod_branch": prodBranch,
 	}
-	return proj, nil
 }
 
-func (s *Service) editInstance(ctx context.Context, d *database.Deployment, variables map[string]string) error {
-	jwt, err := s.issuer.NewToken(auth.TokenOptions{
-		AudienceURL:       d.RuntimeAudience,
-		TTL:               time.Hour,
-		SystemPermissions: []auth.Permission{auth.ManageInstances, auth.ReadInstance},
-	})
-	if err != nil {
-		return err
+// EnvironmentAuditFields returns the fields of an environment that are recorded in the audit log.
+// The OLAP DSN and variables are omitted, since they may contain credentials.
+func EnvironmentAuditFields(e *database.Environment) map[string]string {
+	return map[string]string{
+		"branch":      e.Branch,
+		"commit":      safeStr(e.Commit),
+		"olap_driver": e.OLAPDriver,
+		"slots":       strconv.Itoa(e.Slots),
 	}
+}
+
+func (s *Service) UpdateProject(ctx context.Context, projID string, opts *database.UpdateProjectOptions) (*database.Project, error) {
+	// TODO: Handle if GithubURL was changed.
 
-	rt, err := client.New(d.RuntimeHost, jwt)
+	return s.DB.UpdateProject(ctx, projID, opts)
+}
+
+// editInstance sets the variables of a deployment's runtime instance, and its repo if repoDSN is not empty.
+func (s *Service) editInstance(ctx context.Context, d *database.Deployment, repoDSN string, variables map[string]string) error {
+	rt, err := s.openRuntimeClient(d)
 	if err != nil {
 		return err
 	}
@@ -293,15 +325,20 @@ func (s *Service) editInstance(ctx context.Context, d *database.Deployment, vari
 
 	// Edit the instance
 	inst := resp.Instance
+	if repoDSN == "" {
+		repoDSN = inst.RepoDsn
+	}
 	_, err = rt.EditInstance(ctx, &runtimev1.EditInstanceRequest{
-		InstanceId:          d.RuntimeInstanceID,
-		OlapDriver:          inst.OlapDriver,
-		OlapDsn:             inst.OlapDsn,
-		RepoDriver:          inst.RepoDriver,
-		RepoDsn:             inst.RepoDsn,
-		EmbedCatalog:        inst.EmbedCatalog,
-		Variables:           variables,
-		IngestionLimitBytes: inst.IngestionLimitBytes,
+		InstanceId:           d.RuntimeInstanceID,
+		OlapDriver:           inst.OlapDriver,
+		OlapDsn:              inst.OlapDsn,
+		RepoDriver:           inst.RepoDriver,
+		RepoDsn:              repoDSN,
+		EmbedCatalog:         inst.EmbedCatalog,
+		Variables:            variables,
+		IngestionLimitBytes:  inst.IngestionLimitBytes,
+		ReconcileConcurrency: inst.ReconcileConcurrency,
+		Profile:              inst.Profile,
 	})
 	return err
 }
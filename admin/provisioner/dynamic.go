@@ -0,0 +1,238 @@
+package provisioner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/client"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultUnhealthyAfter      = time.Minute
+	defaultHealthCheckInterval = 15 * time.Second
+	healthCheckPingTimeout     = 5 * time.Second
+)
+
+// dynamicSpec configures a dynamic provisioner.
+// The durations are strings parsed with time.ParseDuration.
+type dynamicSpec struct {
+	// UnhealthyAfter is how long after its last heartbeat a runtime is considered unhealthy
+	UnhealthyAfter string `json:"unhealthy_after"`
+	// HealthCheckInterval is how often the health of the registered runtimes is checked
+	HealthCheckInterval string `json:"health_check_interval"`
+}
+
+// dynamicProvisioner provisions instances on the runtimes that registered themselves with heartbeats (see database.Runtime).
+// It continuously checks the health of the runtimes and only provisions instances on runtimes that are healthy and not draining.
+type dynamicProvisioner struct {
+	logger              *zap.Logger
+	db                  database.DB
+	issuer              *auth.Issuer
+	unhealthyAfter      time.Duration
+	healthCheckInterval time.Duration
+	cancel              context.CancelFunc
+	wg                  sync.WaitGroup
+}
+
+func NewDynamic(spec string, logger *zap.Logger, db database.DB, issuer *auth.Issuer) (Provisioner, error) {
+	dps := &dynamicSpec{}
+	err := json.Unmarshal([]byte(spec), dps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse provisioner spec: %w", err)
+	}
+
+	unhealthyAfter, err := parseDuration(dps.UnhealthyAfter, defaultUnhealthyAfter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid unhealthy_after: %w", err)
+	}
+
+	healthCheckInterval, err := parseDuration(dps.HealthCheckInterval, defaultHealthCheckInterval)
+	if err != nil {
+		return nil, fmt.Errorf("invalid health_check_interval: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &dynamicProvisioner{
+		logger:              logger,
+		db:                  db,
+		issuer:              issuer,
+		unhealthyAfter:      unhealthyAfter,
+		healthCheckInterval: healthCheckInterval,
+		cancel:              cancel,
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		p.runHealthChecks(ctx)
+	}()
+
+	return p, nil
+}
+
+func (p *dynamicProvisioner) Provision(ctx context.Context, opts *ProvisionOptions) (*Instance, error) {
+	runtimes, err := p.db.FindRuntimes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get slots currently used
+	stats, err := p.db.ResolveRuntimeSlotsUsed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	target := selectRuntime(runtimes, stats, opts.Region, opts.Slots)
+	if target == nil {
+		return nil, fmt.Errorf("no healthy runtimes found with sufficient available slots")
+	}
+
+	return createInstance(ctx, p.issuer, &runtimeTarget{
+		Host:     target.Host,
+		Audience: target.Audience,
+		DataDir:  target.DataDir,
+	}, opts)
+}
+
+func (p *dynamicProvisioner) Teardown(ctx context.Context, host, instanceID, olapDriver string) error {
+	// Teardown also applies to unhealthy and draining runtimes
+	rt, err := p.db.FindRuntime(ctx, host)
+	if err != nil {
+		return fmt.Errorf("could not find runtime %q: %w", host, err)
+	}
+
+	return deleteInstance(ctx, p.issuer, rt.Host, rt.Audience, instanceID, olapDriver)
+}
+
+func (p *dynamicProvisioner) Close() error {
+	p.cancel()
+	p.wg.Wait()
+	return nil
+}
+
+// runHealthChecks checks the health of the registered runtimes until ctx is cancelled
+func (p *dynamicProvisioner) runHealthChecks(ctx context.Context) {
+	ticker := time.NewTicker(p.healthCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkHealth(ctx)
+		}
+	}
+}
+
+// checkHealth marks a runtime unhealthy if it hasn't sent a heartbeat recently or doesn't respond to a ping, and healthy otherwise
+func (p *dynamicProvisioner) checkHealth(ctx context.Context) {
+	runtimes, err := p.db.FindRuntimes(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			p.logger.Error("health check: failed to find runtimes", zap.Error(err))
+		}
+		return
+	}
+
+	for _, rt := range runtimes {
+		var reason error
+		if time.Since(rt.LastHeartbeatOn) > p.unhealthyAfter {
+			reason = fmt.Errorf("no heartbeat since %s", rt.LastHeartbeatOn.Format(time.RFC3339))
+		} else {
+			reason = p.ping(ctx, rt)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		status := database.RuntimeStatusHealthy
+		if reason != nil {
+			status = database.RuntimeStatusUnhealthy
+		}
+		if status == rt.Status {
+			continue
+		}
+
+		_, err := p.db.UpdateRuntimeStatus(ctx, rt.Host, status)
+		if err != nil {
+			p.logger.Error("health check: failed to update runtime status", zap.String("host", rt.Host), zap.Error(err))
+			continue
+		}
+
+		if reason != nil {
+			p.logger.Warn("health check: runtime is unhealthy", zap.String("host", rt.Host), zap.Error(reason))
+		} else {
+			p.logger.Info("health check: runtime is healthy", zap.String("host", rt.Host))
+		}
+	}
+}
+
+// ping checks that a runtime responds to requests
+func (p *dynamicProvisioner) ping(ctx context.Context, rt *database.Runtime) error {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckPingTimeout)
+	defer cancel()
+
+	jwt, err := p.issuer.NewToken(auth.TokenOptions{
+		AudienceURL: rt.Audience,
+		TTL:         time.Minute,
+	})
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(rt.Host, jwt)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	_, err = c.Ping(ctx, &runtimev1.PingRequest{})
+	return err
+}
+
+// selectRuntime returns the schedulable runtime in the region with the most available slots, or nil if none of them have enough available slots.
+// Picking the least loaded runtime spreads new deployments across the runtimes, including runtimes that were just added.
+func selectRuntime(runtimes []*database.Runtime, stats []*database.RuntimeSlotsUsed, region string, slots int) *database.Runtime {
+	used := make(map[string]int, len(stats))
+	for _, stat := range stats {
+		used[stat.RuntimeHost] = stat.SlotsUsed
+	}
+
+	var target *database.Runtime
+	var targetAvailable int
+	for _, candidate := range runtimes {
+		if !candidate.Schedulable() {
+			continue
+		}
+		if region != "" && region != candidate.Region {
+			continue
+		}
+
+		available := candidate.Slots - used[candidate.Host]
+		if available < slots {
+			continue
+		}
+
+		if target == nil || available > targetAvailable {
+			target = candidate
+			targetAvailable = available
+		}
+	}
+
+	return target
+}
+
+func parseDuration(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	return time.ParseDuration(s)
+}
@@ -0,0 +1,49 @@
+package provisioner
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectRuntime(t *testing.T) {
+	runtimes := []*database.Runtime{
+		{Host: "a", Region: "us", Slots: 10, Status: database.RuntimeStatusHealthy},
+		{Host: "b", Region: "us", Slots: 10, Status: database.RuntimeStatusHealthy},
+		{Host: "c", Region: "us", Slots: 100, Status: database.RuntimeStatusUnhealthy},
+		{Host: "d", Region: "us", Slots: 100, Status: database.RuntimeStatusHealthy, Draining: true},
+		{Host: "e", Region: "eu", Slots: 4, Status: database.RuntimeStatusHealthy},
+	}
+	stats := []*database.RuntimeSlotsUsed{
+		{RuntimeHost: "a", SlotsUsed: 8},
+		{RuntimeHost: "b", SlotsUsed: 5},
+		{RuntimeHost: "e", SlotsUsed: 2},
+	}
+
+	tt := []struct {
+		name   string
+		region string
+		slots  int
+		want   string
+	}{
+		{name: "least loaded", region: "us", slots: 2, want: "b"},
+		{name: "only fits one", region: "us", slots: 5, want: "b"},
+		{name: "no capacity", region: "us", slots: 6, want: ""},
+		{name: "other region", region: "eu", slots: 2, want: "e"},
+		{name: "any region", region: "", slots: 1, want: "b"},
+		{name: "unknown region", region: "ap", slots: 1, want: ""},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			rt := selectRuntime(runtimes, stats, tc.region, tc.slots)
+			if tc.want == "" {
+				require.Nil(t, rt)
+				return
+			}
+			require.NotNil(t, rt)
+			require.Equal(t, tc.want, rt.Host)
+		})
+	}
+}
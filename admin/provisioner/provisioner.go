@@ -13,6 +13,7 @@ import (
 	"github.com/rilldata/rill/admin/database"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/client"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/drivers/github"
 	"github.com/rilldata/rill/runtime/server/auth"
 	"go.uber.org/zap"
@@ -30,6 +31,7 @@ type ProvisionOptions struct {
 	Slots                int
 	GithubURL            string
 	GitBranch            string
+	GitCommit            string
 	GithubInstallationID int64
 	Region               string
 	Variables            map[string]string
@@ -41,73 +43,38 @@ type Provisioner interface {
 	Close() error
 }
 
-type staticSpec struct {
-	Runtimes []*staticRuntime `json:"runtimes"`
-}
-
-type staticRuntime struct {
-	Host     string `json:"host"`
-	Region   string `json:"region"`
-	Slots    int    `json:"slots"`
-	DataDir  string `json:"data_dir"`
-	Audience string `json:"audience_url"`
-}
-
-type staticProvisioner struct {
-	spec   *staticSpec
-	logger *zap.Logger
-	db     database.DB
-	issuer *auth.Issuer
-}
-
-func NewStatic(spec string, logger *zap.Logger, db database.DB, issuer *auth.Issuer) (Provisioner, error) {
-	sps := &staticSpec{}
-	err := json.Unmarshal([]byte(spec), sps)
+// New creates a provisioner from a JSON spec.
+// The "type" field of the spec selects the provisioner, which is "static" if it's not set.
+func New(spec string, logger *zap.Logger, db database.DB, issuer *auth.Issuer) (Provisioner, error) {
+	typ := struct {
+		Type string `json:"type"`
+	}{}
+	err := json.Unmarshal([]byte(spec), &typ)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse provisioner spec: %w", err)
 	}
 
-	return &staticProvisioner{
-		spec:   sps,
-		logger: logger,
-		db:     db,
-		issuer: issuer,
-	}, nil
-}
-
-func (p *staticProvisioner) Provision(ctx context.Context, opts *ProvisionOptions) (*Instance, error) {
-	// Get slots currently used
-	stats, err := p.db.ResolveRuntimeSlotsUsed(ctx)
-	if err != nil {
-		return nil, err
+	switch typ.Type {
+	case "", "static":
+		return NewStatic(spec, logger, db, issuer)
+	case "dynamic":
+		return NewDynamic(spec, logger, db, issuer)
+	default:
+		return nil, fmt.Errorf("unknown provisioner type %q", typ.Type)
 	}
+}
 
-	// Find runtime with available capacity
-	var target *staticRuntime
-	for _, candidate := range p.spec.Runtimes {
-		if opts.Region != "" && opts.Region != candidate.Region {
-			continue
-		}
-
-		available := true
-		for _, stat := range stats {
-			if stat.RuntimeHost == candidate.Host && stat.SlotsUsed+opts.Slots > candidate.Slots {
-				available = false
-				break
-			}
-		}
-
-		if available {
-			target = candidate
-			break
-		}
-	}
-	if target == nil {
-		return nil, fmt.Errorf("no runtimes found with sufficient available slots")
-	}
+// runtimeTarget is a runtime server that a provisioner creates an instance on
+type runtimeTarget struct {
+	Host     string
+	Audience string
+	DataDir  string
+}
 
+// createInstance creates an instance on a runtime server
+func createInstance(ctx context.Context, issuer *auth.Issuer, target *runtimeTarget, opts *ProvisionOptions) (*Instance, error) {
 	// Create JWT for runtime client
-	jwt, err := p.issuer.NewToken(auth.TokenOptions{
+	jwt, err := issuer.NewToken(auth.TokenOptions{
 		AudienceURL:       target.Audience,
 		TTL:               time.Hour,
 		SystemPermissions: []auth.Permission{auth.ManageInstances},
@@ -128,6 +95,7 @@ func (p *staticProvisioner) Provision(ctx context.Context, opts *ProvisionOption
 		GithubURL:      opts.GithubURL,
 		Branch:         opts.GitBranch,
 		InstallationID: opts.GithubInstallationID,
+		Commit:         opts.GitCommit,
 	})
 	if err != nil {
 		return nil, err
@@ -146,7 +114,7 @@ func (p *staticProvisioner) Provision(ctx context.Context, opts *ProvisionOption
 
 		embedCatalog = true
 
-		ingestLimit = int64(datasize.GB * datasize.ByteSize(5*opts.Slots)) // 5GB * slots
+		ingestLimit = IngestionLimitBytes(opts.OLAPDriver, opts.Slots)
 		cpus := 1 * opts.Slots
 		memory := 2 * opts.Slots
 
@@ -163,6 +131,7 @@ func (p *staticProvisioner) Provision(ctx context.Context, opts *ProvisionOption
 		EmbedCatalog:        embedCatalog,
 		Variables:           opts.Variables,
 		IngestionLimitBytes: ingestLimit,
+		Profile:             drivers.ProfileProd,
 	})
 	if err != nil {
 		return nil, err
@@ -176,21 +145,19 @@ func (p *staticProvisioner) Provision(ctx context.Context, opts *ProvisionOption
 	return inst, nil
 }
 
-func (p *staticProvisioner) Teardown(ctx context.Context, host, instanceID, olapDriver string) error {
-	// Find audience
-	var audience string
-	for _, candidate := range p.spec.Runtimes {
-		if candidate.Host == host {
-			audience = candidate.Audience
-			break
-		}
-	}
-	if audience == "" {
-		return fmt.Errorf("could not find a runtime matching host %q", host)
+// IngestionLimitBytes returns the default limit on the data ingested by an instance with the given OLAP driver and slots.
+// It's 5GB per slot for DuckDB, and 0 (meaning no limit) for other drivers.
+func IngestionLimitBytes(olapDriver string, slots int) int64 {
+	if olapDriver != "duckdb" {
+		return 0
 	}
+	return int64(datasize.GB * datasize.ByteSize(5*slots))
+}
 
+// deleteInstance deletes an instance from a runtime server
+func deleteInstance(ctx context.Context, issuer *auth.Issuer, host, audience, instanceID, olapDriver string) error {
 	// Create JWT for runtime client
-	jwt, err := p.issuer.NewToken(auth.TokenOptions{
+	jwt, err := issuer.NewToken(auth.TokenOptions{
 		AudienceURL:       audience,
 		TTL:               time.Hour,
 		SystemPermissions: []auth.Permission{auth.ManageInstances},
@@ -223,7 +190,3 @@ func (p *staticProvisioner) Teardown(ctx context.Context, host, instanceID, olap
 
 	return nil
 }
-
-func (p *staticProvisioner) Close() error {
-	return nil
-}
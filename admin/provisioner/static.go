@@ -0,0 +1,103 @@
+package provisioner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.uber.org/zap"
+)
+
+type staticSpec struct {
+	Runtimes []*staticRuntime `json:"runtimes"`
+}
+
+type staticRuntime struct {
+	Host     string `json:"host"`
+	Region   string `json:"region"`
+	Slots    int    `json:"slots"`
+	DataDir  string `json:"data_dir"`
+	Audience string `json:"audience_url"`
+}
+
+type staticProvisioner struct {
+	spec   *staticSpec
+	logger *zap.Logger
+	db     database.DB
+	issuer *auth.Issuer
+}
+
+func NewStatic(spec string, logger *zap.Logger, db database.DB, issuer *auth.Issuer) (Provisioner, error) {
+	sps := &staticSpec{}
+	err := json.Unmarshal([]byte(spec), sps)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse provisioner spec: %w", err)
+	}
+
+	return &staticProvisioner{
+		spec:   sps,
+		logger: logger,
+		db:     db,
+		issuer: issuer,
+	}, nil
+}
+
+func (p *staticProvisioner) Provision(ctx context.Context, opts *ProvisionOptions) (*Instance, error) {
+	// Get slots currently used
+	stats, err := p.db.ResolveRuntimeSlotsUsed(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find runtime with available capacity
+	var target *staticRuntime
+	for _, candidate := range p.spec.Runtimes {
+		if opts.Region != "" && opts.Region != candidate.Region {
+			continue
+		}
+
+		available := true
+		for _, stat := range stats {
+			if stat.RuntimeHost == candidate.Host && stat.SlotsUsed+opts.Slots > candidate.Slots {
+				available = false
+				break
+			}
+		}
+
+		if available {
+			target = candidate
+			break
+		}
+	}
+	if target == nil {
+		return nil, fmt.Errorf("no runtimes found with sufficient available slots")
+	}
+
+	return createInstance(ctx, p.issuer, &runtimeTarget{
+		Host:     target.Host,
+		Audience: target.Audience,
+		DataDir:  target.DataDir,
+	}, opts)
+}
+
+func (p *staticProvisioner) Teardown(ctx context.Context, host, instanceID, olapDriver string) error {
+	// Find audience
+	var audience string
+	for _, candidate := range p.spec.Runtimes {
+		if candidate.Host == host {
+			audience = candidate.Audience
+			break
+		}
+	}
+	if audience == "" {
+		return fmt.Errorf("could not find a runtime matching host %q", host)
+	}
+
+	return deleteInstance(ctx, p.issuer, host, audience, instanceID, olapDriver)
+}
+
+func (p *staticProvisioner) Close() error {
+	return nil
+}
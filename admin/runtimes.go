@@ -0,0 +1,171 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/provisioner"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// HeartbeatRuntime registers a runtime server, or records that a registered runtime is alive.
+// Runtimes that registered themselves are used by the dynamic provisioner.
+func (s *Service) HeartbeatRuntime(ctx context.Context, opts *database.UpsertRuntimeOptions) (*database.Runtime, error) {
+	return s.DB.UpsertRuntime(ctx, opts)
+}
+
+// DrainRuntime stops new deployments from being provisioned on a runtime, and enqueues jobs that migrate its current deployments to other runtimes.
+func (s *Service) DrainRuntime(ctx context.Context, host string) (*database.Runtime, error) {
+	rt, err := s.DB.UpdateRuntimeDraining(ctx, host, true)
+	if err != nil {
+		return nil, err
+	}
+
+	ds, err := s.DB.FindDeploymentsForRuntime(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range ds {
+		proj, err := s.DB.FindProject(ctx, d.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.enqueueJob(ctx, &enqueueOptions{
+			Type:           JobTypeMigrateDeployment,
+			Payload:        &migrateDeploymentPayload{DeploymentID: d.ID, FromHost: host},
+			IdempotencyKey: "migrate:" + d.ID,
+			OrganizationID: &proj.OrganizationID,
+			ProjectID:      &proj.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rt, nil
+}
+
+// UncordonRuntime allows new deployments to be provisioned on a drained runtime again.
+// Deployments that were migrated off the runtime are not moved back.
+func (s *Service) UncordonRuntime(ctx context.Context, host string) (*database.Runtime, error) {
+	return s.DB.UpdateRuntimeDraining(ctx, host, false)
+}
+
+type migrateDeploymentPayload struct {
+	DeploymentID string `json:"deployment_id"`
+	FromHost     string `json:"from_host"`
+}
+
+// migrateDeployment runs a JobTypeMigrateDeployment job.
+// It provisions a new instance for the deployment on another runtime, reconciles it there and tears down the old instance.
+func (s *Service) migrateDeployment(ctx context.Context, job *database.Job) error {
+	payload := &migrateDeploymentPayload{}
+	if err := unmarshalPayload(job, payload); err != nil {
+		return err
+	}
+
+	depl, err := s.DB.FindDeployment(ctx, payload.DeploymentID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			// The deployment was torn down after the job was enqueued
+			return nil
+		}
+		return err
+	}
+	if depl.RuntimeHost != payload.FromHost {
+		// Already migrated
+		return nil
+	}
+
+	proj, err := s.DB.FindProject(ctx, depl.ProjectID)
+	if err != nil {
+		return err
+	}
+	if proj.GithubURL == nil || proj.GithubInstallationID == nil {
+		return permanent(fmt.Errorf("cannot migrate deployment of project without github info"))
+	}
+
+	opts, err := s.migrateProvisionOptions(ctx, proj, depl)
+	if err != nil {
+		return err
+	}
+
+	inst, err := s.provisioner.Provision(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("provisioner: %w", err)
+	}
+
+	old := *depl
+	depl, err = s.DB.UpdateDeploymentRuntime(ctx, depl.ID, &database.UpdateDeploymentRuntimeOptions{
+		RuntimeHost:       inst.Host,
+		RuntimeInstanceID: inst.InstanceID,
+		RuntimeAudience:   inst.Audience,
+	})
+	if err != nil {
+		err2 := s.provisioner.Teardown(ctx, inst.Host, inst.InstanceID, opts.OLAPDriver)
+		if err2 != nil {
+			s.logger.Error("migrate: failed to tear down new instance", zap.String("deployment_id", old.ID), zap.Error(err2), observability.ZapCtx(ctx))
+		}
+		return err
+	}
+
+	s.logger.Info("migrate: moved deployment", zap.String("deployment_id", depl.ID), zap.String("from_host", old.RuntimeHost), zap.String("to_host", depl.RuntimeHost), observability.ZapCtx(ctx))
+
+	_, err = s.TriggerReconcile(ctx, depl.ID, "migrate from "+old.RuntimeHost)
+	if err != nil {
+		s.logger.Error("migrate: failed to trigger reconcile", zap.String("deployment_id", depl.ID), zap.Error(err), observability.ZapCtx(ctx))
+	}
+
+	// The old runtime may be down, which is why it was drained. So failing to tear down its instance is not an error.
+	err = s.provisioner.Teardown(ctx, old.RuntimeHost, old.RuntimeInstanceID, old.OLAPDriver)
+	if err != nil && status.Code(err) != codes.NotFound {
+		s.logger.Warn("migrate: failed to tear down old instance", zap.String("deployment_id", depl.ID), zap.String("host", old.RuntimeHost), zap.Error(err), observability.ZapCtx(ctx))
+	}
+
+	return nil
+}
+
+// migrateProvisionOptions returns the options for provisioning a new instance of a deployment.
+// Preview deployments are provisioned with the settings of the prod environment.
+func (s *Service) migrateProvisionOptions(ctx context.Context, proj *database.Project, depl *database.Deployment) (*provisioner.ProvisionOptions, error) {
+	env, err := s.DB.FindEnvironmentForDeployment(ctx, depl.ID)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return nil, err
+		}
+
+		prod, err := s.DB.FindEnvironmentByName(ctx, proj.ID, database.EnvironmentNameProd)
+		if err != nil {
+			return nil, err
+		}
+
+		return &provisioner.ProvisionOptions{
+			OLAPDriver:           depl.OLAPDriver,
+			Region:               proj.Region,
+			Slots:                depl.Slots,
+			GithubURL:            *proj.GithubURL,
+			GitBranch:            depl.Branch,
+			GithubInstallationID: *proj.GithubInstallationID,
+			Variables:            prod.Variables,
+		}, nil
+	}
+
+	return &provisioner.ProvisionOptions{
+		OLAPDriver:           env.OLAPDriver,
+		OLAPDSN:              env.OLAPDSN,
+		Region:               proj.Region,
+		Slots:                env.Slots,
+		GithubURL:            *proj.GithubURL,
+		GitBranch:            env.Branch,
+		GitCommit:            safeStr(env.Commit),
+		GithubInstallationID: *proj.GithubInstallationID,
+		Variables:            env.Variables,
+	}, nil
+}
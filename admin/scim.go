@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// scimUsergroupNameMaxLen is the max length of a usergroup name (see database.InsertUsergroupOptions)
+const scimUsergroupNameMaxLen = 40
+
+// ErrSCIMInvalidFilter is returned for SCIM filters that are not supported
+var ErrSCIMInvalidFilter = errors.New("invalid filter")
+
+// SCIMFilter is a SCIM filter that matches resources with an attribute equal to a value.
+// Identity providers only use equality filters to look up resources, so other operators are not supported.
+type SCIMFilter struct {
+	Attribute string
+	Value     string
+}
+
+// ParseSCIMFilter parses a filter like `userName eq "jane@example.com"`. It returns nil for an empty filter.
+func ParseSCIMFilter(filter string) (*SCIMFilter, error) {
+	filter = strings.TrimSpace(filter)
+	if filter == "" {
+		return nil, nil
+	}
+
+	parts := strings.SplitN(filter, " ", 3)
+	if len(parts) != 3 || !strings.EqualFold(parts[1], "eq") {
+		return nil, fmt.Errorf("%w: only filters like 'attribute eq \"value\"' are supported", ErrSCIMInvalidFilter)
+	}
+
+	value, err := strconv.Unquote(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: the value must be a quoted string", ErrSCIMInvalidFilter)
+	}
+
+	return &SCIMFilter{Attribute: parts[0], Value: value}, nil
+}
+
+// ParseSCIMValuePath parses a path that selects values of a multi-valued attribute, like `members[value eq "id"]`.
+func ParseSCIMValuePath(path string) (string, *SCIMFilter, error) {
+	i := strings.Index(path, "[")
+	if i < 0 || !strings.HasSuffix(path, "]") {
+		return path, nil, nil
+	}
+
+	filter, err := ParseSCIMFilter(path[i+1 : len(path)-1])
+	if err != nil {
+		return "", nil, err
+	}
+	if filter == nil {
+		return "", nil, fmt.Errorf("%w: empty value filter in path %q", ErrSCIMInvalidFilter, path)
+	}
+	return path[:i], filter, nil
+}
+
+// ParseSCIMBool parses a boolean attribute. Some identity providers send booleans as strings like "False".
+func ParseSCIMBool(v interface{}) (bool, error) {
+	switch v := v.(type) {
+	case bool:
+		return v, nil
+	case string:
+		return strconv.ParseBool(strings.ToLower(v))
+	default:
+		return false, fmt.Errorf("expected a boolean, got %v", v)
+	}
+}
+
+// SCIMUsergroupName returns a usergroup name for a SCIM group, since usergroup names must be slugs.
+// For example, "Data Engineering" becomes "data-engineering".
+func SCIMUsergroupName(displayName string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(displayName) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' {
+			if dash && b.Len() > 0 {
+				b.WriteRune('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	name := b.String()
+	if len(name) > scimUsergroupNameMaxLen {
+		name = strings.TrimRight(name[:scimUsergroupNameMaxLen], "-")
+	}
+	if len(name) < 3 {
+		name = strings.TrimRight("group-"+name, "-")
+	}
+	return name
+}
+
+// SCIMUsergroupNameWithSuffix returns a usergroup name with a numeric suffix, for when the name is already used by another usergroup.
+func SCIMUsergroupNameWithSuffix(name string, n int) string {
+	suffix := "-" + strconv.Itoa(n)
+	if len(name)+len(suffix) > scimUsergroupNameMaxLen {
+		name = strings.TrimRight(name[:scimUsergroupNameMaxLen-len(suffix)], "-")
+	}
+	return name + suffix
+}
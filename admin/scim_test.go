@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSCIMFilter(t *testing.T) {
+	f, err := ParseSCIMFilter(`userName eq "jane@example.com"`)
+	require.NoError(t, err)
+	require.Equal(t, &SCIMFilter{Attribute: "userName", Value: "jane@example.com"}, f)
+
+	f, err = ParseSCIMFilter(`displayName EQ "Data Engineering"`)
+	require.NoError(t, err)
+	require.Equal(t, &SCIMFilter{Attribute: "displayName", Value: "Data Engineering"}, f)
+
+	f, err = ParseSCIMFilter("")
+	require.NoError(t, err)
+	require.Nil(t, f)
+
+	_, err = ParseSCIMFilter(`userName co "jane"`)
+	require.ErrorIs(t, err, ErrSCIMInvalidFilter)
+	_, err = ParseSCIMFilter(`userName eq jane`)
+	require.ErrorIs(t, err, ErrSCIMInvalidFilter)
+	_, err = ParseSCIMFilter(`userName`)
+	require.ErrorIs(t, err, ErrSCIMInvalidFilter)
+}
+
+func TestParseSCIMValuePath(t *testing.T) {
+	attr, f, err := ParseSCIMValuePath(`members[value eq "abc"]`)
+	require.NoError(t, err)
+	require.Equal(t, "members", attr)
+	require.Equal(t, &SCIMFilter{Attribute: "value", Value: "abc"}, f)
+
+	attr, f, err = ParseSCIMValuePath("members")
+	require.NoError(t, err)
+	require.Equal(t, "members", attr)
+	require.Nil(t, f)
+
+	_, _, err = ParseSCIMValuePath("members[]")
+	require.ErrorIs(t, err, ErrSCIMInvalidFilter)
+}
+
+func TestParseSCIMBool(t *testing.T) {
+	for _, v := range []interface{}{true, "true", "True"} {
+		b, err := ParseSCIMBool(v)
+		require.NoError(t, err)
+		require.True(t, b)
+	}
+	for _, v := range []interface{}{false, "False"} {
+		b, err := ParseSCIMBool(v)
+		require.NoError(t, err)
+		require.False(t, b)
+	}
+	_, err := ParseSCIMBool(1.0)
+	require.Error(t, err)
+	_, err = ParseSCIMBool("yes")
+	require.Error(t, err)
+}
+
+func TestSCIMUsergroupName(t *testing.T) {
+	require.Equal(t, "data-engineering", SCIMUsergroupName("Data Engineering"))
+	require.Equal(t, "sales_emea", SCIMUsergroupName("  Sales_EMEA! "))
+	require.Equal(t, "group-qa", SCIMUsergroupName("QA"))
+	require.Equal(t, "group", SCIMUsergroupName("***"))
+
+	long := SCIMUsergroupName(strings.Repeat("a", 39) + " b")
+	require.Equal(t, strings.Repeat("a", 39), long)
+
+	require.Equal(t, "data-engineering-2", SCIMUsergroupNameWithSuffix("data-engineering", 2))
+	require.Equal(t, strings.Repeat("a", 38)+"-2", SCIMUsergroupNameWithSuffix(strings.Repeat("a", 40), 2))
+}
@@ -0,0 +1,152 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultAuditLogPageSize = 100
+
+func (s *Server) ListAuditLogEntries(ctx context.Context, req *adminv1.ListAuditLogEntriesRequest) (*adminv1.ListAuditLogEntriesResponse, error) {
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read the audit log")
+	}
+
+	opts := &database.FindAuditLogEntriesOptions{
+		OrganizationID: org.ID,
+		Limit:          int(req.PageSize),
+	}
+	if opts.Limit == 0 {
+		opts.Limit = defaultAuditLogPageSize
+	}
+
+	if req.Project != "" {
+		proj, err := s.findProject(ctx, org.Name, req.Project)
+		if err != nil {
+			return nil, err
+		}
+		opts.ProjectID = &proj.ID
+	}
+
+	if req.Actor != "" {
+		actorID, err := s.findAuditActorID(ctx, org, req.Actor)
+		if err != nil {
+			return nil, err
+		}
+		opts.ActorID = &actorID
+	}
+
+	if req.After != nil {
+		t := req.After.AsTime()
+		opts.After = &t
+	}
+	if req.Before != nil {
+		t := req.Before.AsTime()
+		opts.Before = &t
+	}
+
+	entries, err := s.admin.DB.FindAuditLogEntries(ctx, opts)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Resolve project names once per project
+	projectNames := make(map[string]string)
+	dtos := make([]*adminv1.AuditLogEntry, len(entries))
+	for i, e := range entries {
+		var project string
+		if e.ProjectID != nil {
+			name, ok := projectNames[*e.ProjectID]
+			if !ok {
+				proj, err := s.admin.DB.FindProject(ctx, *e.ProjectID)
+				if err != nil && !errors.Is(err, database.ErrNotFound) {
+					return nil, status.Error(codes.Internal, err.Error())
+				}
+				if proj != nil {
+					name = proj.Name
+				}
+				projectNames[*e.ProjectID] = name
+			}
+			project = name
+		}
+		dtos[i] = auditLogEntryToDTO(e, project)
+	}
+
+	return &adminv1.ListAuditLogEntriesResponse{Entries: dtos}, nil
+}
+
+// findAuditActorID returns the ID of a user by email or of a service account of the org by name
+func (s *Server) findAuditActorID(ctx context.Context, org *database.Organization, actor string) (string, error) {
+	if strings.Contains(actor, "@") {
+		user, err := s.admin.DB.FindUserByEmail(ctx, actor)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return "", status.Errorf(codes.InvalidArgument, "user %q not found", actor)
+			}
+			return "", status.Error(codes.Internal, err.Error())
+		}
+		return user.ID, nil
+	}
+
+	account, err := s.findServiceAccount(ctx, org, actor)
+	if err != nil {
+		return "", err
+	}
+	return account.ID, nil
+}
+
+// audit records an action by the caller in the audit log.
+// It should be called with the ctx of the transaction that makes the change, so the entry is only recorded if the change is committed.
+func (s *Server) audit(ctx context.Context, opts *database.InsertAuditLogEntryOptions) error {
+	claims := auth.GetClaims(ctx)
+	switch claims.OwnerType() {
+	case auth.OwnerTypeUser:
+		opts.ActorType = database.AuditActorTypeUser
+	case auth.OwnerTypeService:
+		opts.ActorType = database.AuditActorTypeService
+	default:
+		return status.Error(codes.Unauthenticated, "not authenticated")
+	}
+	actorID := claims.OwnerID()
+	opts.ActorID = &actorID
+
+	_, err := s.admin.DB.InsertAuditLogEntry(ctx, opts)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func auditLogEntryToDTO(e *database.AuditLogEntry, project string) *adminv1.AuditLogEntry {
+	return &adminv1.AuditLogEntry{
+		Id:         e.ID,
+		ActorType:  string(e.ActorType),
+		ActorId:    safeStr(e.ActorID),
+		ActorName:  e.ActorName,
+		Action:     e.Action,
+		TargetType: e.TargetType,
+		TargetId:   e.TargetID,
+		TargetName: e.TargetName,
+		Project:    project,
+		Before:     e.Before,
+		After:      e.After,
+		CreatedOn:  timestamppb.New(e.CreatedOn),
+	}
+}
@@ -2,7 +2,6 @@ package auth
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"sync"
 
@@ -16,8 +15,9 @@ import (
 type OwnerType string
 
 const (
-	OwnerTypeAnon OwnerType = "anon"
-	OwnerTypeUser OwnerType = "user"
+	OwnerTypeAnon    OwnerType = "anon"
+	OwnerTypeUser    OwnerType = "user"
+	OwnerTypeService OwnerType = "service"
 )
 
 // Claims resolves permissions for a requester.
@@ -92,6 +92,8 @@ func (c *authTokenClaims) OwnerType() OwnerType {
 	switch t {
 	case authtoken.TypeUser:
 		return OwnerTypeUser
+	case authtoken.TypeService:
+		return OwnerTypeService
 	default:
 		panic(fmt.Errorf("unexpected token type %q", t))
 	}
@@ -110,7 +112,7 @@ func (c *authTokenClaims) OrganizationPermissions(ctx context.Context, orgID str
 	case authtoken.TypeUser:
 		// continue
 	case authtoken.TypeService:
-		panic(errors.New("service tokens not supported"))
+		return scopedOrgPermissions(c.token.Scope(), orgID)
 	default:
 		panic(fmt.Errorf("unexpected token type %q", c.token.Token().Type))
 	}
@@ -141,7 +143,7 @@ func (c *authTokenClaims) ProjectPermissions(ctx context.Context, orgID, project
 	case authtoken.TypeUser:
 		// continue
 	case authtoken.TypeService:
-		panic(errors.New("service tokens not supported"))
+		return scopedProjectPermissions(c.token.Scope(), orgID, projectID)
 	default:
 		panic(fmt.Errorf("unexpected token type %q", c.token.Token().Type))
 	}
@@ -187,6 +189,77 @@ func (c *authTokenClaims) ProjectPermissions(ctx context.Context, orgID, project
 // ensure *authTokenClaims implements Claims
 var _ Claims = &authTokenClaims{}
 
+// scopedOrgPermissions returns the org permissions of a scoped token, which can only read its own org
+func scopedOrgPermissions(scope *admin.AuthTokenScope, orgID string) *adminv1.OrganizationPermissions {
+	if scope == nil || scope.OrganizationID != orgID {
+		return &adminv1.OrganizationPermissions{}
+	}
+	return &adminv1.OrganizationPermissions{ReadOrg: true}
+}
+
+// SCIMOrganizationID returns the org whose users and groups the claims can provision with SCIM.
+// Only service tokens with the admin.ScopeSCIM scope can use SCIM.
+func SCIMOrganizationID(claims Claims) (string, bool) {
+	c, ok := claims.(*authTokenClaims)
+	if !ok || c.token.Token().Type != authtoken.TypeService {
+		return "", false
+	}
+	return scimOrganizationID(c.token.Scope())
+}
+
+// scimOrganizationID returns the org of a scoped token if it has the admin.ScopeSCIM scope
+func scimOrganizationID(scope *admin.AuthTokenScope) (string, bool) {
+	if scope == nil {
+		return "", false
+	}
+	for _, s := range scope.Scopes {
+		if s == admin.ScopeSCIM {
+			return scope.OrganizationID, true
+		}
+	}
+	return "", false
+}
+
+// scopedProjectPermissions returns the project permissions granted by the scopes of a scoped token.
+// The token can read the projects it has access to, but can only do other things if it has the corresponding scope.
+func scopedProjectPermissions(scope *admin.AuthTokenScope, orgID, projectID string) *adminv1.ProjectPermissions {
+	if scope == nil || scope.OrganizationID != orgID {
+		return &adminv1.ProjectPermissions{}
+	}
+
+	if len(scope.ProjectIDs) > 0 {
+		found := false
+		for _, id := range scope.ProjectIDs {
+			if id == projectID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return &adminv1.ProjectPermissions{}
+		}
+	}
+
+	perms := &adminv1.ProjectPermissions{ReadProject: true}
+	for _, s := range scope.Scopes {
+		switch s {
+		case admin.ScopeReadProd:
+			perms.ReadProd = true
+		case admin.ScopeReadProdStatus:
+			perms.ReadProdStatus = true
+		case admin.ScopeManageProd:
+			perms.ManageProd = true
+		case admin.ScopeReadDev:
+			perms.ReadDev = true
+		case admin.ScopeReadDevStatus:
+			perms.ReadDevStatus = true
+		case admin.ScopeManageDev:
+			perms.ManageDev = true
+		}
+	}
+	return perms
+}
+
 func unionOrgRoles(a *adminv1.OrganizationPermissions, b *database.OrganizationRole) *adminv1.OrganizationPermissions {
 	return &adminv1.OrganizationPermissions{
 		ReadOrg:          a.ReadOrg || b.ReadOrg,
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScopedProjectPermissions(t *testing.T) {
+	scope := &admin.AuthTokenScope{
+		OrganizationID: "org",
+		ProjectIDs:     []string{"p1"},
+		Scopes:         []string{admin.ScopeReadProd, admin.ScopeManageProd},
+	}
+
+	perms := scopedProjectPermissions(scope, "org", "p1")
+	require.True(t, perms.ReadProject)
+	require.True(t, perms.ReadProd)
+	require.True(t, perms.ManageProd)
+	require.False(t, perms.ReadProdStatus)
+	require.False(t, perms.ManageDev)
+	require.False(t, perms.ManageProject)
+	require.False(t, perms.ManageProjectMembers)
+
+	// Other projects and orgs
+	require.False(t, scopedProjectPermissions(scope, "org", "p2").ReadProject)
+	require.False(t, scopedProjectPermissions(scope, "other", "p1").ReadProject)
+
+	// No projects means all projects of the org
+	scope.ProjectIDs = nil
+	require.True(t, scopedProjectPermissions(scope, "org", "p2").ManageProd)
+	require.False(t, scopedProjectPermissions(scope, "other", "p2").ReadProject)
+
+	require.True(t, scopedOrgPermissions(scope, "org").ReadOrg)
+	require.False(t, scopedOrgPermissions(scope, "org").ManageOrg)
+	require.False(t, scopedOrgPermissions(scope, "other").ReadOrg)
+}
+
+func TestSCIMOrganizationID(t *testing.T) {
+	scope := &admin.AuthTokenScope{OrganizationID: "org", Scopes: []string{admin.ScopeReadProd}}
+	_, ok := scimOrganizationID(scope)
+	require.False(t, ok)
+
+	scope.Scopes = append(scope.Scopes, admin.ScopeSCIM)
+	orgID, ok := scimOrganizationID(scope)
+	require.True(t, ok)
+	require.Equal(t, "org", orgID)
+
+	// The scope doesn't grant other permissions
+	require.False(t, scopedProjectPermissions(scope, "org", "p1").ManageProd)
+	require.False(t, scopedOrgPermissions(scope, "org").ManageOrgMembers)
+
+	_, ok = scimOrganizationID(nil)
+	require.False(t, ok)
+	_, ok = SCIMOrganizationID(anonClaims{})
+	require.False(t, ok)
+}
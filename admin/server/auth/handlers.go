@@ -3,15 +3,20 @@ package auth
 import (
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/gorilla/sessions"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"go.uber.org/zap"
+	"golang.org/x/oauth2"
 )
 
 const (
@@ -19,6 +24,7 @@ const (
 	cookieFieldState       = "state"
 	cookieFieldRedirect    = "redirect"
 	cookieFieldAccessToken = "access_token"
+	cookieFieldSSOOrg      = "sso_org"
 )
 
 // RegisterEndpoints adds HTTP endpoints for auth.
@@ -29,6 +35,7 @@ func (a *Authenticator) RegisterEndpoints(mux *http.ServeMux) {
 	inner := http.NewServeMux()
 	inner.Handle("/auth/login", otelhttp.WithRouteTag("/auth/login", http.HandlerFunc(a.authLogin)))
 	inner.Handle("/auth/callback", otelhttp.WithRouteTag("/auth/callback", http.HandlerFunc(a.authLoginCallback)))
+	inner.Handle("/auth/sso/callback", otelhttp.WithRouteTag("/auth/sso/callback", http.HandlerFunc(a.ssoLoginCallback)))
 	inner.Handle("/auth/logout", otelhttp.WithRouteTag("/auth/logout", http.HandlerFunc(a.authLogout)))
 	inner.Handle("/auth/logout/callback", otelhttp.WithRouteTag("/auth/logout/callback", http.HandlerFunc(a.authLogoutCallback)))
 	inner.Handle("/auth/oauth/device_authorization", otelhttp.WithRouteTag("/auth/oauth/device_authorization", http.HandlerFunc(a.handleDeviceCodeRequest)))
@@ -40,6 +47,7 @@ func (a *Authenticator) RegisterEndpoints(mux *http.ServeMux) {
 // authLogin starts an OAuth and OIDC flow that redirects the user for authentication with the auth provider.
 // After auth, the user is redirected back to authLoginCallback, which in turn will redirect the user to "/".
 // You can override the redirect destination by passing a `?redirect=URI` query to this endpoint.
+// If an `?email=ADDRESS` query is passed and the address's domain is routed to an org's identity provider, the user authenticates with it instead (see ssoLoginCallback).
 func (a *Authenticator) authLogin(w http.ResponseWriter, r *http.Request) {
 	// Generate random state for CSRF
 	b := make([]byte, 32)
@@ -59,6 +67,7 @@ func (a *Authenticator) authLogin(w http.ResponseWriter, r *http.Request) {
 
 	// Set state in cookie
 	sess.Values[cookieFieldState] = state
+	delete(sess.Values, cookieFieldSSOOrg)
 
 	// Set redirect URL in cookie to enable custom redirects after auth has completed
 	redirect := r.URL.Query().Get("redirect")
@@ -66,6 +75,22 @@ func (a *Authenticator) authLogin(w http.ResponseWriter, r *http.Request) {
 		sess.Values[cookieFieldRedirect] = redirect
 	}
 
+	// Route the user to their org's identity provider if it has one
+	authCodeURL := a.oauth2.AuthCodeURL(state)
+	if email := r.URL.Query().Get("email"); email != "" {
+		sso, ssoConfig, err := a.ssoConfigForEmail(r.Context(), email)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to resolve identity provider: %s", err), http.StatusInternalServerError)
+			return
+		}
+		oauth2Config := &a.oauth2
+		if sso != nil {
+			sess.Values[cookieFieldSSOOrg] = sso.OrgID
+			oauth2Config = ssoConfig
+		}
+		authCodeURL = oauth2Config.AuthCodeURL(state, oauth2.SetAuthURLParam("login_hint", email))
+	}
+
 	// Save cookie
 	if err := sess.Save(r, w); err != nil {
 		http.Error(w, fmt.Sprintf("failed to save session: %s", err), http.StatusInternalServerError)
@@ -73,7 +98,7 @@ func (a *Authenticator) authLogin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Redirect to auth provider
-	http.Redirect(w, r, a.oauth2.AuthCodeURL(state), http.StatusTemporaryRedirect)
+	http.Redirect(w, r, authCodeURL, http.StatusTemporaryRedirect)
 }
 
 // authLoginCallback is called after the user has successfully authenticated with the auth provider.
@@ -141,6 +166,26 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	// Users whose domain is routed to an org's identity provider must authenticate with it
+	sso, err := a.admin.DB.FindOrganizationSSOByDomain(r.Context(), admin.EmailDomain(email))
+	if err == nil {
+		loginURL, err := urlutil.WithQuery(urlutil.MustJoinURL(a.opts.ExternalURL, "/auth/login"), map[string]string{"email": email})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to build login URL: %s", err), http.StatusInternalServerError)
+			return
+		}
+		a.logger.Info("routing user to the identity provider of their org", zap.String("org_id", sso.OrgID), observability.ZapCtx(r.Context()))
+		if err := sess.Save(r, w); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		http.Redirect(w, r, loginURL, http.StatusTemporaryRedirect)
+		return
+	} else if !errors.Is(err, database.ErrNotFound) {
+		http.Error(w, fmt.Sprintf("failed to resolve identity provider: %s", err), http.StatusInternalServerError)
+		return
+	}
+
 	// Create (or update) user in our DB
 	user, err := a.admin.CreateOrUpdateUser(r.Context(), email, name, photoURL)
 	if err != nil {
@@ -148,6 +193,12 @@ func (a *Authenticator) authLoginCallback(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	a.completeLogin(w, r, sess, user)
+}
+
+// completeLogin issues a new user auth token for an authenticated user and saves it in the auth cookie.
+// It then redirects the user to the location specified in the initial call to authLogin.
+func (a *Authenticator) completeLogin(w http.ResponseWriter, r *http.Request, sess *sessions.Session, user *database.User) {
 	// If there's already a token in the cookie, revoke it (since we're now issuing a new one)
 	oldAuthToken, ok := sess.Values[cookieFieldAccessToken].(string)
 	if ok && oldAuthToken != "" {
@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/pkg/urlutil"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+	"golang.org/x/oauth2"
+)
+
+// SSORedirectURL returns the URL that the identity providers of orgs redirect to after authentication.
+// Org admins register it with their identity provider. See ssoLoginCallback.
+func (a *Authenticator) SSORedirectURL() string {
+	return urlutil.MustJoinURL(a.opts.ExternalURL, "/auth/sso/callback")
+}
+
+// ssoConfigForEmail returns the identity provider that the domain of an email address is routed to.
+// It returns nil if the domain is not routed to an org's identity provider.
+func (a *Authenticator) ssoConfigForEmail(ctx context.Context, email string) (*database.OrganizationSSO, *oauth2.Config, error) {
+	sso, err := a.admin.DB.FindOrganizationSSOByDomain(ctx, admin.EmailDomain(email))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	_, oauth2Config, err := a.ssoProvider(ctx, sso)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sso, oauth2Config, nil
+}
+
+// ssoProvider discovers the OIDC provider of an org's identity provider and returns it with an OAuth2 config for it.
+func (a *Authenticator) ssoProvider(ctx context.Context, sso *database.OrganizationSSO) (*oidc.Provider, *oauth2.Config, error) {
+	provider, err := oidc.NewProvider(ctx, sso.IssuerURL)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to discover identity provider %q: %w", sso.IssuerURL, err)
+	}
+
+	// Some identity providers only add the groups claim if it's requested as a scope
+	scopes := []string{oidc.ScopeOpenID, "email", "profile"}
+	var metadata struct {
+		ScopesSupported []string `json:"scopes_supported"`
+	}
+	if err := provider.Claims(&metadata); err == nil && slices.Contains(metadata.ScopesSupported, sso.GroupsClaim) {
+		scopes = append(scopes, sso.GroupsClaim)
+	}
+
+	oauth2Config := &oauth2.Config{
+		ClientID:     sso.ClientID,
+		ClientSecret: sso.ClientSecret,
+		RedirectURL:  a.SSORedirectURL(),
+		Endpoint:     provider.Endpoint(),
+		Scopes:       scopes,
+	}
+	return provider, oauth2Config, nil
+}
+
+// ssoLoginCallback is called after the user has authenticated with their org's identity provider (see authLogin).
+// It's like authLoginCallback, but it also checks that the user's email address is in one of the org's domains,
+// and syncs the user's membership of the org's usergroups with the groups claim of the ID token.
+func (a *Authenticator) ssoLoginCallback(w http.ResponseWriter, r *http.Request) {
+	// Get auth cookie
+	sess, err := a.cookies.Get(r, cookieName)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to get session: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	// Check that random state matches (for CSRF protection)
+	if r.URL.Query().Get("state") != sess.Values[cookieFieldState] {
+		http.Error(w, "invalid state parameter", http.StatusBadRequest)
+		return
+	}
+	delete(sess.Values, cookieFieldState)
+
+	// Get the org whose identity provider the user was sent to
+	orgID, ok := sess.Values[cookieFieldSSOOrg].(string)
+	if !ok || orgID == "" {
+		http.Error(w, "no identity provider in session", http.StatusBadRequest)
+		return
+	}
+	delete(sess.Values, cookieFieldSSOOrg)
+
+	sso, err := a.admin.DB.FindOrganizationSSO(r.Context(), orgID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			http.Error(w, "the org no longer has an identity provider", http.StatusBadRequest)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to get identity provider: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	provider, oauth2Config, err := a.ssoProvider(r.Context(), sso)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Exchange authorization code for an oauth2 token
+	oauthToken, err := oauth2Config.Exchange(r.Context(), r.URL.Query().Get("code"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to convert authorization code into a token: %s", err), http.StatusUnauthorized)
+		return
+	}
+
+	// Extract and verify ID token (which contains the user's identity info)
+	rawIDToken, ok := oauthToken.Extra("id_token").(string)
+	if !ok {
+		http.Error(w, "no id_token field in oauth2 token", http.StatusUnauthorized)
+		return
+	}
+
+	idToken, err := provider.Verifier(&oidc.Config{ClientID: sso.ClientID}).Verify(r.Context(), rawIDToken)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to verify ID token: %s", err), http.StatusUnauthorized)
+		return
+	}
+
+	// Extract user profile information. Unlike the default auth provider, the name and picture are optional.
+	var profile map[string]interface{}
+	if err := idToken.Claims(&profile); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	email, ok := profile["email"].(string)
+	if !ok || email == "" {
+		http.Error(w, "claim 'email' not found", http.StatusUnauthorized)
+		return
+	}
+	if verified, ok := profile["email_verified"].(bool); ok && !verified {
+		http.Error(w, "email address is not verified", http.StatusUnauthorized)
+		return
+	}
+	name, _ := profile["name"].(string)
+	photoURL, _ := profile["picture"].(string)
+
+	// The org's identity provider can only authenticate users in the org's domains
+	if !slices.Contains(sso.Domains, admin.EmailDomain(email)) {
+		http.Error(w, fmt.Sprintf("the identity provider can't authenticate %q", email), http.StatusForbidden)
+		return
+	}
+
+	// Create (or update) user in our DB
+	user, err := a.admin.CreateOrUpdateUser(r.Context(), email, name, photoURL)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to update user: %s", err), http.StatusInternalServerError)
+		return
+	}
+
+	// Sync the user's usergroups with their groups in the identity provider
+	groups := admin.SSOGroups(profile, sso.GroupsClaim)
+	err = a.admin.SyncSSOGroups(r.Context(), sso.OrgID, user.ID, groups)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to sync groups: %s", err), http.StatusInternalServerError)
+		return
+	}
+	a.logger.Info("user signed in with the identity provider of their org", zap.String("org_id", sso.OrgID), zap.Int("groups", len(groups)), observability.ZapCtx(r.Context()))
+
+	a.completeLogin(w, r, sess, user)
+}
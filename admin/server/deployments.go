@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// listDeploymentRevisionsLimit is the number of revisions returned by ListDeploymentRevisions
+const listDeploymentRevisionsLimit = 50
+
+func (s *Server) ListDeploymentRevisions(ctx context.Context, req *adminv1.ListDeploymentRevisionsRequest) (*adminv1.ListDeploymentRevisionsResponse, error) {
+	depl, _, err := s.findDeploymentForStatus(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	revs, err := s.admin.DB.FindDeploymentRevisions(ctx, depl.ID, listDeploymentRevisionsLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.DeploymentRevision, len(revs))
+	for i, rev := range revs {
+		dtos[i] = deploymentRevisionToDTO(rev)
+		dtos[i].Results = nil
+		dtos[i].Logs = ""
+	}
+
+	return &adminv1.ListDeploymentRevisionsResponse{Revisions: dtos}, nil
+}
+
+func (s *Server) GetDeploymentRevision(ctx context.Context, req *adminv1.GetDeploymentRevisionRequest) (*adminv1.GetDeploymentRevisionResponse, error) {
+	depl, _, err := s.findDeploymentForStatus(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	rev, err := s.findDeploymentRevision(ctx, depl, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.GetDeploymentRevisionResponse{Revision: deploymentRevisionToDTO(rev)}, nil
+}
+
+func (s *Server) RollbackDeployment(ctx context.Context, req *adminv1.RollbackDeploymentRequest) (*adminv1.RollbackDeploymentResponse, error) {
+	depl, proj, err := s.findDeploymentForStatus(ctx, req.DeploymentId)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to roll back deployment")
+	}
+
+	rev, err := s.findDeploymentRevision(ctx, depl, req.RevisionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	env, err := s.admin.RollbackDeployment(ctx, proj, depl, rev)
+	if err != nil {
+		if errors.Is(err, admin.ErrRollbackPreview) || errors.Is(err, admin.ErrRollbackUnsuccessfulRevision) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.auditEnvironment(ctx, proj, env, admin.AuditActionProjectDeploymentRollback, nil, map[string]string{
+		"deployment_id": depl.ID,
+		"revision_id":   rev.ID,
+		"commit":        rev.Commit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.RollbackDeploymentResponse{Environment: environmentToDTO(env, nil)}, nil
+}
+
+// findDeploymentForStatus returns a deployment and its project if the caller can read the deployment's status, or a status error otherwise.
+// The status of preview deployments requires the dev permissions of the project, and the status of other deployments requires the prod permissions.
+func (s *Server) findDeploymentForStatus(ctx context.Context, id string) (*database.Deployment, *database.Project, error) {
+	depl, err := s.admin.DB.FindDeployment(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, nil, status.Error(codes.NotFound, "deployment not found")
+		}
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proj, err := s.admin.DB.FindProject(ctx, depl.ProjectID)
+	if err != nil {
+		return nil, nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if depl.PullRequestNumber != nil && !permissions.ReadDevStatus || depl.PullRequestNumber == nil && !permissions.ReadProdStatus {
+		return nil, nil, status.Error(codes.PermissionDenied, "does not have permission to read deployment status")
+	}
+
+	return depl, proj, nil
+}
+
+// findDeploymentRevision returns a revision of a deployment by ID, or a status error if it doesn't exist
+func (s *Server) findDeploymentRevision(ctx context.Context, depl *database.Deployment, id string) (*database.DeploymentRevision, error) {
+	rev, err := s.admin.DB.FindDeploymentRevision(ctx, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "revision not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if rev.DeploymentID != depl.ID {
+		return nil, status.Error(codes.NotFound, "revision not found")
+	}
+
+	return rev, nil
+}
+
+func deploymentRevisionToDTO(r *database.DeploymentRevision) *adminv1.DeploymentRevision {
+	results := make([]*adminv1.DeploymentRevisionResult, len(r.Results))
+	for i, res := range r.Results {
+		results[i] = &adminv1.DeploymentRevisionResult{
+			Path:   res.Path,
+			Errors: res.Errors,
+		}
+	}
+
+	dto := &adminv1.DeploymentRevision{
+		Id:           r.ID,
+		DeploymentId: r.DeploymentID,
+		Commit:       r.Commit,
+		TriggeredBy:  r.TriggeredBy,
+		Status:       deploymentStatusToDTO(r.Status),
+		Results:      results,
+		Logs:         r.Logs,
+		StartedOn:    timestamppb.New(r.StartedOn),
+	}
+	if r.FinishedOn != nil {
+		dto.FinishedOn = timestamppb.New(*r.FinishedOn)
+	}
+	return dto
+}
@@ -0,0 +1,332 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Environments are production deployments, so they're subject to the prod permissions of the project.
+
+func (s *Server) ListEnvironments(ctx context.Context, req *adminv1.ListEnvironmentsRequest) (*adminv1.ListEnvironmentsResponse, error) {
+	proj, err := s.findProject(ctx, req.OrganizationName, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	permissions := claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID)
+	if !permissions.ReadProd {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read environments")
+	}
+
+	envs, err := s.admin.DB.FindEnvironments(ctx, proj.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.Environment, len(envs))
+	for i, env := range envs {
+		var depl *database.Deployment
+		if env.DeploymentID != nil {
+			depl, err = s.admin.DB.FindDeployment(ctx, *env.DeploymentID)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if !permissions.ReadProdStatus {
+				depl.Logs = ""
+			}
+		}
+
+		dtos[i] = environmentToDTO(env, depl)
+		if !permissions.ManageProd {
+			// The DSN may contain credentials
+			dtos[i].OlapDsn = ""
+		}
+	}
+
+	return &adminv1.ListEnvironmentsResponse{Environments: dtos}, nil
+}
+
+func (s *Server) CreateEnvironment(ctx context.Context, req *adminv1.CreateEnvironmentRequest) (*adminv1.CreateEnvironmentResponse, error) {
+	proj, err := s.findProject(ctx, req.OrganizationName, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to create environments")
+	}
+
+	// The OLAP settings default to the settings of the prod environment
+	prod, err := s.findEnvironment(ctx, proj, database.EnvironmentNameProd)
+	if err != nil {
+		return nil, err
+	}
+	olapDriver, olapDSN := req.OlapDriver, req.OlapDsn
+	if olapDriver == "" {
+		olapDriver, olapDSN = prod.OLAPDriver, prod.OLAPDSN
+	}
+	slots := int(req.Slots)
+	if slots == 0 {
+		slots = prod.Slots
+	}
+
+	org, err := s.admin.DB.FindOrganization(ctx, proj.OrganizationID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if err := s.checkQuotas(ctx, org, 0, slots); err != nil {
+		return nil, err
+	}
+
+	// TODO: Validate that req.Branch is an actual branch.
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	env, err := s.admin.CreateEnvironment(ctx, proj, &database.InsertEnvironmentOptions{
+		Name:       req.Name,
+		Branch:     req.Branch,
+		Variables:  req.Variables,
+		OLAPDriver: olapDriver,
+		OLAPDSN:    olapDSN,
+		Slots:      slots,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Errorf(codes.AlreadyExists, "environment %q already exists", req.Name)
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, variables := admin.AuditVariablesDiff(nil, env.Variables)
+	after := admin.EnvironmentAuditFields(env)
+	for k, v := range variables {
+		after["variables."+k] = v
+	}
+	err = s.auditEnvironment(ctx, proj, env, admin.AuditActionProjectEnvironmentCreate, nil, after)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateEnvironmentResponse{Environment: environmentToDTO(env, nil)}, nil
+}
+
+func (s *Server) UpdateEnvironment(ctx context.Context, req *adminv1.UpdateEnvironmentRequest) (*adminv1.UpdateEnvironmentResponse, error) {
+	proj, err := s.findProject(ctx, req.OrganizationName, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to update environments")
+	}
+
+	env, err := s.findEnvironment(ctx, proj, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	before := admin.EnvironmentAuditFields(env)
+	env, err = s.admin.UpdateEnvironment(ctx, proj, env, &database.UpdateEnvironmentOptions{
+		Branch:    req.Branch,
+		Variables: env.Variables,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	before, after := admin.AuditDiff(before, admin.EnvironmentAuditFields(env))
+	err = s.auditEnvironment(ctx, proj, env, admin.AuditActionProjectEnvironmentUpdate, before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateEnvironmentResponse{Environment: environmentToDTO(env, nil)}, nil
+}
+
+func (s *Server) DeleteEnvironment(ctx context.Context, req *adminv1.DeleteEnvironmentRequest) (*adminv1.DeleteEnvironmentResponse, error) {
+	proj, err := s.findProject(ctx, req.OrganizationName, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to delete environments")
+	}
+
+	env, err := s.findEnvironment(ctx, proj, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DeleteEnvironment(ctx, env)
+	if err != nil {
+		if errors.Is(err, admin.ErrDeleteProdEnvironment) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.auditEnvironment(ctx, proj, env, admin.AuditActionProjectEnvironmentDelete, admin.EnvironmentAuditFields(env), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteEnvironmentResponse{}, nil
+}
+
+func (s *Server) PromoteEnvironment(ctx context.Context, req *adminv1.PromoteEnvironmentRequest) (*adminv1.PromoteEnvironmentResponse, error) {
+	proj, err := s.findProject(ctx, req.OrganizationName, req.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ManageProd {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to promote environments")
+	}
+
+	source, err := s.findEnvironment(ctx, proj, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.findEnvironment(ctx, proj, req.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	if source.ID == target.ID {
+		return nil, status.Error(codes.InvalidArgument, "cannot promote an environment to itself")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	before := admin.EnvironmentAuditFields(target)
+	target, err = s.admin.PromoteEnvironment(ctx, proj, source, target)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	before, after := admin.AuditDiff(before, admin.EnvironmentAuditFields(target))
+	after["source"] = source.Name
+	err = s.auditEnvironment(ctx, proj, target, admin.AuditActionProjectEnvironmentPromote, before, after)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.PromoteEnvironmentResponse{Environment: environmentToDTO(target, nil)}, nil
+}
+
+// auditEnvironment records an action on an environment in the audit log
+func (s *Server) auditEnvironment(ctx context.Context, proj *database.Project, env *database.Environment, action string, before, after map[string]string) error {
+	return s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+		Action:         action,
+		TargetType:     admin.AuditTargetEnvironment,
+		TargetID:       env.ID,
+		TargetName:     env.Name,
+		Before:         before,
+		After:          after,
+	})
+}
+
+// findProject returns a project by name, or a status error if it doesn't exist
+func (s *Server) findProject(ctx context.Context, orgName, name string) (*database.Project, error) {
+	proj, err := s.admin.DB.FindProjectByName(ctx, orgName, name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "proj not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return proj, nil
+}
+
+// findEnvironment returns an environment of a project by name, or a status error if it doesn't exist.
+// An empty name is the prod environment.
+func (s *Server) findEnvironment(ctx context.Context, proj *database.Project, name string) (*database.Environment, error) {
+	if name == "" {
+		name = database.EnvironmentNameProd
+	}
+
+	env, err := s.admin.DB.FindEnvironmentByName(ctx, proj.ID, name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "environment %q not found", name)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return env, nil
+}
+
+func environmentToDTO(e *database.Environment, d *database.Deployment) *adminv1.Environment {
+	dto := &adminv1.Environment{
+		Id:         e.ID,
+		ProjectId:  e.ProjectID,
+		Name:       e.Name,
+		Branch:     e.Branch,
+		Commit:     safeStr(e.Commit),
+		OlapDriver: e.OLAPDriver,
+		OlapDsn:    e.OLAPDSN,
+		Slots:      int64(e.Slots),
+		CreatedOn:  timestamppb.New(e.CreatedOn),
+		UpdatedOn:  timestamppb.New(e.UpdatedOn),
+	}
+	if d != nil {
+		dto.Deployment = deploymentToDTO(d)
+	}
+	return dto
+}
@@ -455,7 +455,7 @@ func (s *Server) githubWebhook(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = s.admin.ProcessGithubEvent(context.Background(), event)
+	err = s.admin.ProcessGithubEvent(context.Background(), event, github.DeliveryID(r))
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to process event: %s", err), http.StatusBadRequest)
 		return
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// listProjectJobsLimit is the number of jobs returned by ListProjectJobs
+const listProjectJobsLimit = 50
+
+func (s *Server) ListProjectJobs(ctx context.Context, req *adminv1.ListProjectJobsRequest) (*adminv1.ListProjectJobsResponse, error) {
+	proj, err := s.admin.DB.FindProjectByName(ctx, req.OrganizationName, req.Name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "proj not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.ProjectPermissions(ctx, proj.OrganizationID, proj.ID).ReadProdStatus {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project jobs")
+	}
+
+	jobs, err := s.admin.DB.FindJobsForProject(ctx, proj.ID, listProjectJobsLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.Job, len(jobs))
+	for i, job := range jobs {
+		dtos[i] = jobToDTO(job)
+	}
+
+	return &adminv1.ListProjectJobsResponse{Jobs: dtos}, nil
+}
+
+func (s *Server) GetJob(ctx context.Context, req *adminv1.GetJobRequest) (*adminv1.GetJobResponse, error) {
+	job, err := s.admin.DB.FindJob(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "job not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// Jobs are visible to the users who can read the status of their project.
+	// Jobs that don't belong to a project (e.g. processing of Github events) aren't visible.
+	claims := auth.GetClaims(ctx)
+	if job.OrganizationID == nil || job.ProjectID == nil || !claims.ProjectPermissions(ctx, *job.OrganizationID, *job.ProjectID).ReadProdStatus {
+		return nil, status.Error(codes.PermissionDenied, "does not have permission to read job")
+	}
+
+	return &adminv1.GetJobResponse{Job: jobToDTO(job)}, nil
+}
+
+func jobToDTO(j *database.Job) *adminv1.Job {
+	var s adminv1.JobStatus
+	switch j.Status {
+	case database.JobStatusUnspecified:
+		s = adminv1.JobStatus_JOB_STATUS_UNSPECIFIED
+	case database.JobStatusPending:
+		s = adminv1.JobStatus_JOB_STATUS_PENDING
+	case database.JobStatusRunning:
+		s = adminv1.JobStatus_JOB_STATUS_RUNNING
+	case database.JobStatusSucceeded:
+		s = adminv1.JobStatus_JOB_STATUS_SUCCEEDED
+	case database.JobStatusFailed:
+		s = adminv1.JobStatus_JOB_STATUS_FAILED
+	default:
+		panic(fmt.Errorf("unhandled job status %d", j.Status))
+	}
+
+	return &adminv1.Job{
+		Id:          j.ID,
+		Type:        j.Type,
+		ProjectId:   safeStr(j.ProjectID),
+		Status:      s,
+		Attempts:    int32(j.Attempts),
+		MaxAttempts: int32(j.MaxAttempts),
+		Error:       j.Error,
+		RunAfter:    timestamppb.New(j.RunAfter),
+		CreatedOn:   timestamppb.New(j.CreatedOn),
+		UpdatedOn:   timestamppb.New(j.UpdatedOn),
+	}
+}
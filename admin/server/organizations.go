@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/pkg/errors"
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -110,11 +113,33 @@ func (s *Server) DeleteOrganization(ctx context.Context, req *adminv1.DeleteOrga
 		return nil, status.Error(codes.PermissionDenied, "not allowed to delete org")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteOrganization(ctx, req.Name)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgDelete,
+		TargetType:     admin.AuditTargetOrganization,
+		TargetID:       org.ID,
+		TargetName:     org.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.DeleteOrganizationResponse{}, nil
 }
 
@@ -132,6 +157,13 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		return nil, status.Error(codes.PermissionDenied, "not allowed to update org")
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	old := org
 	org, err = s.admin.DB.UpdateOrganization(ctx, req.Id, &database.UpdateOrganizationOptions{
 		Name:        req.Name,
 		Description: req.Description,
@@ -140,6 +172,25 @@ func (s *Server) UpdateOrganization(ctx context.Context, req *adminv1.UpdateOrga
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	before, after := admin.AuditDiff(organizationAuditFields(old), organizationAuditFields(org))
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgUpdate,
+		TargetType:     admin.AuditTargetOrganization,
+		TargetID:       org.ID,
+		TargetName:     org.Name,
+		Before:         before,
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.UpdateOrganizationResponse{
 		Organization: organizationToDTO(org),
 	}, nil
@@ -218,10 +269,40 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		if claims.OwnerType() == auth.OwnerTypeUser {
 			invitedBy = claims.OwnerID()
 		}
-		err = s.admin.InviteUserToOrganization(ctx, req.Email, invitedBy, org.ID, role.ID, org.Name, role.Name)
+
+		ctx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = s.admin.InviteUserToOrganization(ctx, req.Email, invitedBy, org.ID, role.ID)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+			OrganizationID: &org.ID,
+			Action:         admin.AuditActionOrgMemberInvite,
+			TargetType:     admin.AuditTargetInvite,
+			TargetName:     req.Email,
+			After:          map[string]string{"role": role.Name},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.Commit()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// Send the email only once the invite is committed
+		err = s.admin.SendOrganizationInvite(req.Email, org.Name, role.Name)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		return &adminv1.AddOrganizationMemberResponse{
 			PendingSignup: true,
 		}, nil
@@ -248,6 +329,18 @@ func (s *Server) AddOrganizationMember(ctx context.Context, req *adminv1.AddOrga
 		// If the user is already in the all user group, we can ignore the error
 	}
 
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgMemberAdd,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+		After:          map[string]string{"role": role.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -283,10 +376,34 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 				}
 				return nil, status.Error(codes.Internal, err.Error())
 			}
+
+			ctx, tx, err := s.admin.DB.NewTx(ctx)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			defer func() { _ = tx.Rollback() }()
+
 			err = s.admin.DB.DeleteOrganizationInvite(ctx, invite.ID)
 			if err != nil {
 				return nil, status.Error(codes.Internal, err.Error())
 			}
+
+			err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+				OrganizationID: &org.ID,
+				Action:         admin.AuditActionOrgMemberRemove,
+				TargetType:     admin.AuditTargetInvite,
+				TargetID:       invite.ID,
+				TargetName:     req.Email,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			err = tx.Commit()
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
 			return &adminv1.RemoveOrganizationMemberResponse{}, nil
 		}
 		return nil, status.Error(codes.Internal, err.Error())
@@ -323,6 +440,18 @@ func (s *Server) RemoveOrganizationMember(ctx context.Context, req *adminv1.Remo
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgMemberRemove,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -378,14 +507,148 @@ func (s *Server) SetOrganizationMemberRole(ctx context.Context, req *adminv1.Set
 		}
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.UpdateOrganizationMemberUserRole(ctx, org.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgMemberSetRole,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+		After:          map[string]string{"role": role.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.SetOrganizationMemberRoleResponse{}, nil
 }
 
+func (s *Server) GetOrganizationMemberAttributes(ctx context.Context, req *adminv1.GetOrganizationMemberAttributesRequest) (*adminv1.GetOrganizationMemberAttributesResponse, error) {
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ReadOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to read org members")
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	attrs, err := s.admin.DB.FindOrganizationMemberUserAttributes(ctx, org.ID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pb, err := structpb.NewStruct(attrs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.GetOrganizationMemberAttributesResponse{Attributes: pb}, nil
+}
+
+func (s *Server) SetOrganizationMemberAttributes(ctx context.Context, req *adminv1.SetOrganizationMemberAttributesRequest) (*adminv1.SetOrganizationMemberAttributesResponse, error) {
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrgMembers {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to set org members attributes")
+	}
+
+	attrs := req.Attributes.AsMap()
+	err = admin.ValidateMemberAttributes(attrs)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	before, err := s.admin.DB.FindOrganizationMemberUserAttributes(ctx, org.ID, user.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.UpdateOrganizationMemberUserAttributes(ctx, org.ID, user.ID, attrs)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgMemberSetAttributes,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+		Before:         auditAttributes(before),
+		After:          auditAttributes(attrs),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.SetOrganizationMemberAttributesResponse{}, nil
+}
+
+// auditAttributes formats member attributes for the audit log, which only stores string values.
+func auditAttributes(attrs map[string]any) map[string]string {
+	res := make(map[string]string, len(attrs))
+	for k, v := range attrs {
+		// Values are decoded from JSON, so they can always be encoded again
+		b, _ := json.Marshal(v)
+		res[k] = string(b)
+	}
+	return res
+}
+
 func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrganizationRequest) (*adminv1.LeaveOrganizationResponse, error) {
 	// Check the request is made by an authenticated user
 	claims := auth.GetClaims(ctx)
@@ -437,6 +700,17 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgMemberRemove,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       claims.OwnerID(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -445,6 +719,14 @@ func (s *Server) LeaveOrganization(ctx context.Context, req *adminv1.LeaveOrgani
 	return &adminv1.LeaveOrganizationResponse{}, nil
 }
 
+// organizationAuditFields returns the fields of an org that are recorded in the audit log
+func organizationAuditFields(o *database.Organization) map[string]string {
+	return map[string]string{
+		"name":        o.Name,
+		"description": o.Description,
+	}
+}
+
 func organizationToDTO(o *database.Organization) *adminv1.Organization {
 	return &adminv1.Organization{
 		Id:          o.ID,
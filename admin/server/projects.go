@@ -66,6 +66,19 @@ func (s *Server) ListProjectsForOrganization(ctx context.Context, req *adminv1.L
 		}
 	}
 
+	// Get projects the service account's token is scoped to
+	if claims.OwnerType() == auth.OwnerTypeService {
+		projs, err := s.admin.DB.FindProjectsForOrganization(ctx, org.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		for _, p := range projs {
+			if claims.ProjectPermissions(ctx, org.ID, p.ID).ReadProject {
+				projsMap[p.Name] = p
+			}
+		}
+	}
+
 	// If no projects are public, and user is not an outside member of any projects, the projsMap is empty.
 	// If additionally, the user is not an org member, return permission denied (instead of an empty slice).
 	if len(projsMap) == 0 && !claims.OrganizationPermissions(ctx, org.ID).ReadProjects {
@@ -146,23 +159,67 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project")
 	}
 
-	if proj.ProdDeploymentID == nil || !permissions.ReadProd {
-		return &adminv1.GetProjectResponse{
-			Project:            projToDTO(proj, org.Name),
-			ProjectPermissions: permissions,
-		}, nil
-	}
+	var depl *database.Deployment
+	if req.PullRequest != 0 {
+		// Preview deployments of pull requests are dev deployments
+		if !permissions.ReadDev {
+			return nil, status.Error(codes.PermissionDenied, "does not have permission to read preview deployments")
+		}
 
-	depl, err := s.admin.DB.FindDeployment(ctx, *proj.ProdDeploymentID)
-	if err != nil {
-		if errors.Is(err, database.ErrNotFound) {
-			return nil, status.Error(codes.InvalidArgument, "project does not have a production deployment")
+		depl, err = s.admin.DB.FindDeploymentForPullRequest(ctx, proj.ID, int(req.PullRequest))
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Error(codes.NotFound, "pull request does not have a preview deployment")
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if !permissions.ReadDevStatus {
+			depl.Logs = ""
+		}
+	} else {
+		if !permissions.ReadProd {
+			return &adminv1.GetProjectResponse{
+				Project:            projToDTO(proj, org.Name),
+				ProjectPermissions: permissions,
+			}, nil
+		}
+
+		prod, err := s.admin.DB.FindEnvironmentByName(ctx, proj.ID, database.EnvironmentNameProd)
+		if err != nil && !errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if prod == nil || prod.DeploymentID == nil {
+			return &adminv1.GetProjectResponse{
+				Project:            projToDTO(proj, org.Name),
+				ProjectPermissions: permissions,
+			}, nil
+		}
+
+		depl, err = s.admin.DB.FindDeployment(ctx, *prod.DeploymentID)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Error(codes.InvalidArgument, "project does not have a production deployment")
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if !permissions.ReadProdStatus {
+			depl.Logs = ""
 		}
-		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if !permissions.ReadProdStatus {
-		depl.Logs = ""
+	// Attributes of the user are used to evaluate metrics view policies in the runtime
+	var attr map[string]any
+	if claims.OwnerType() == auth.OwnerTypeUser {
+		user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		attr, err = s.admin.UserAttributes(ctx, proj.OrganizationID, user, permissions.ManageProject)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	jwt, err := s.issuer.NewToken(runtimeauth.TokenOptions{
@@ -171,24 +228,30 @@ func (s *Server) GetProject(ctx context.Context, req *adminv1.GetProjectRequest)
 		TTL:         time.Hour,
 		InstancePermissions: map[string][]runtimeauth.Permission{
 			depl.RuntimeInstanceID: {
-				// TODO: Remove ReadProfiling and ReadRepo (may require frontend changes)
+				// ReadRepo is not granted since project files include the metrics view policies.
+				// ReadProfiling is needed by dashboards, and the runtime applies metrics view policies to profiling queries.
 				runtimeauth.ReadObjects,
 				runtimeauth.ReadMetrics,
 				runtimeauth.ReadProfiling,
-				runtimeauth.ReadRepo,
 			},
 		},
+		Attributes: attr,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "could not issue jwt: %s", err.Error())
 	}
 
-	return &adminv1.GetProjectResponse{
+	res := &adminv1.GetProjectResponse{
 		Project:            projToDTO(proj, org.Name),
-		ProdDeployment:     deploymentToDTO(depl),
 		Jwt:                jwt,
 		ProjectPermissions: permissions,
-	}, nil
+	}
+	if depl.PullRequestNumber != nil {
+		res.PreviewDeployment = deploymentToDTO(depl)
+	} else {
+		res.ProdDeployment = deploymentToDTO(depl)
+	}
+	return res, nil
 }
 
 func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRequest) (*adminv1.CreateProjectResponse, error) {
@@ -221,6 +284,10 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 
 	// TODO: Validate that req.ProdSlots is an allowed tier for the caller.
 
+	if err := s.checkQuotas(ctx, org, 1, int(req.ProdSlots)); err != nil {
+		return nil, err
+	}
+
 	// TODO: Validate that req.ProdOlapDriver and req.ProdOlapDsn are acceptable.
 
 	// Create the project
@@ -231,13 +298,14 @@ func (s *Server) CreateProject(ctx context.Context, req *adminv1.CreateProjectRe
 		Description:          req.Description,
 		Public:               req.Public,
 		Region:               req.Region,
-		ProdOLAPDriver:       req.ProdOlapDriver,
-		ProdOLAPDSN:          req.ProdOlapDsn,
-		ProdSlots:            int(req.ProdSlots),
-		ProdBranch:           req.ProdBranch,
 		GithubURL:            &req.GithubUrl,
 		GithubInstallationID: &installationID,
-		ProdVariables:        req.Variables,
+	}, &database.InsertEnvironmentOptions{
+		Branch:     req.ProdBranch,
+		Variables:  req.Variables,
+		OLAPDriver: req.ProdOlapDriver,
+		OLAPDSN:    req.ProdOlapDsn,
+		Slots:      int(req.ProdSlots),
 	})
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -268,12 +336,35 @@ func (s *Server) DeleteProject(ctx context.Context, req *adminv1.DeleteProjectRe
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to delete project")
 	}
 
-	err = s.admin.TeardownProject(ctx, proj)
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	job, err := s.admin.TeardownProject(ctx, proj)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return &adminv1.DeleteProjectResponse{}, nil
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+		Action:         admin.AuditActionProjectDelete,
+		TargetType:     admin.AuditTargetProject,
+		TargetID:       proj.ID,
+		TargetName:     proj.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteProjectResponse{Job: jobToDTO(job)}, nil
 }
 
 func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRequest) (*adminv1.UpdateProjectResponse, error) {
@@ -304,20 +395,71 @@ func (s *Server) UpdateProject(ctx context.Context, req *adminv1.UpdateProjectRe
 		githubURL = &req.GithubUrl
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	prod, err := s.findEnvironment(ctx, proj, database.EnvironmentNameProd)
+	if err != nil {
+		return nil, err
+	}
+	before := admin.ProjectAuditFields(proj, prod.Branch)
+
+	// Changing the prod branch re-ingests the project's sources, which isn't allowed when the org has used up its quotas
+	if req.ProdBranch != "" && prod.Branch != req.ProdBranch {
+		org, err := s.admin.DB.FindOrganization(ctx, proj.OrganizationID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if err := s.checkQuotas(ctx, org, 0, 0); err != nil {
+			return nil, err
+		}
+	}
+
 	proj, err = s.admin.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
 		Name:                 req.Name,
 		Description:          req.Description,
 		Public:               req.Public,
-		ProdBranch:           req.ProdBranch,
-		ProdVariables:        proj.ProdVariables,
 		GithubURL:            githubURL,
 		GithubInstallationID: proj.GithubInstallationID,
-		ProdDeploymentID:     proj.ProdDeploymentID,
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// Deploy the prod environment from the new branch
+	if req.ProdBranch != "" && prod.Branch != req.ProdBranch {
+		prod, err = s.admin.UpdateEnvironment(ctx, proj, prod, &database.UpdateEnvironmentOptions{
+			Branch:    req.ProdBranch,
+			Variables: prod.Variables,
+		})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	before, after := admin.AuditDiff(before, admin.ProjectAuditFields(proj, prod.Branch))
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+		Action:         admin.AuditActionProjectUpdate,
+		TargetType:     admin.AuditTargetProject,
+		TargetID:       proj.ID,
+		TargetName:     proj.Name,
+		Before:         before,
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.UpdateProjectResponse{
 		Project: projToDTO(proj, req.OrganizationName),
 	}, nil
@@ -396,15 +538,52 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		if claims.OwnerType() == auth.OwnerTypeUser {
 			invitedBy = claims.OwnerID()
 		}
-		err = s.admin.InviteUserToProject(ctx, req.Email, invitedBy, proj.ID, role.ID, proj.Name, role.Name)
+
+		ctx, tx, err := s.admin.DB.NewTx(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		defer func() { _ = tx.Rollback() }()
+
+		err = s.admin.InviteUserToProject(ctx, req.Email, invitedBy, proj.ID, role.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+			OrganizationID: &proj.OrganizationID,
+			ProjectID:      &proj.ID,
+			Action:         admin.AuditActionProjectMemberInvite,
+			TargetType:     admin.AuditTargetInvite,
+			TargetName:     req.Email,
+			After:          map[string]string{"role": role.Name},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = tx.Commit()
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+
+		// Send the email only once the invite is committed
+		err = s.admin.SendProjectInvite(req.Email, proj.Name, role.Name)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		return &adminv1.AddProjectMemberResponse{
 			PendingSignup: true,
 		}, nil
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.InsertProjectMemberUser(ctx, proj.ID, user.ID, role.ID)
 	if err != nil {
 		if errors.Is(err, database.ErrNotUnique) {
@@ -413,6 +592,24 @@ func (s *Server) AddProjectMember(ctx context.Context, req *adminv1.AddProjectMe
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+		Action:         admin.AuditActionProjectMemberAdd,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+		After:          map[string]string{"role": role.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.AddProjectMemberResponse{
 		PendingSignup: false,
 	}, nil
@@ -443,20 +640,68 @@ func (s *Server) RemoveProjectMember(ctx context.Context, req *adminv1.RemovePro
 				}
 				return nil, status.Error(codes.Internal, err.Error())
 			}
+
+			ctx, tx, err := s.admin.DB.NewTx(ctx)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			defer func() { _ = tx.Rollback() }()
+
 			err = s.admin.DB.DeleteProjectInvite(ctx, invite.ID)
 			if err != nil {
 				return nil, status.Error(codes.Internal, err.Error())
 			}
+
+			err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+				OrganizationID: &proj.OrganizationID,
+				ProjectID:      &proj.ID,
+				Action:         admin.AuditActionProjectMemberRemove,
+				TargetType:     admin.AuditTargetInvite,
+				TargetID:       invite.ID,
+				TargetName:     req.Email,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			err = tx.Commit()
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+
 			return &adminv1.RemoveProjectMemberResponse{}, nil
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.DeleteProjectMemberUser(ctx, proj.ID, user.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+		Action:         admin.AuditActionProjectMemberRemove,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.RemoveProjectMemberResponse{}, nil
 }
 
@@ -490,11 +735,35 @@ func (s *Server) SetProjectMemberRole(ctx context.Context, req *adminv1.SetProje
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	err = s.admin.DB.UpdateProjectMemberUserRole(ctx, proj.ID, user.ID, role.ID)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+		Action:         admin.AuditActionProjectMemberSetRole,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+		After:          map[string]string{"role": role.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.SetProjectMemberRoleResponse{}, nil
 }
 
@@ -512,7 +781,12 @@ func (s *Server) GetProjectVariables(ctx context.Context, req *adminv1.GetProjec
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to read project variables")
 	}
 
-	return &adminv1.GetProjectVariablesResponse{Variables: proj.ProdVariables}, nil
+	env, err := s.findEnvironment(ctx, proj, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.GetProjectVariablesResponse{Variables: env.Variables}, nil
 }
 
 func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.UpdateProjectVariablesRequest) (*adminv1.UpdateProjectVariablesResponse, error) {
@@ -529,21 +803,48 @@ func (s *Server) UpdateProjectVariables(ctx context.Context, req *adminv1.Update
 		return nil, status.Error(codes.PermissionDenied, "does not have permission to update project variables")
 	}
 
-	proj, err = s.admin.DB.UpdateProject(ctx, proj.ID, &database.UpdateProjectOptions{
-		Name:                 proj.Name,
-		Description:          proj.Description,
-		Public:               proj.Public,
-		ProdBranch:           proj.ProdBranch,
-		GithubURL:            proj.GithubURL,
-		GithubInstallationID: proj.GithubInstallationID,
-		ProdDeploymentID:     proj.ProdDeploymentID,
-		ProdVariables:        req.Variables,
+	env, err := s.findEnvironment(ctx, proj, req.Environment)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	oldVariables := env.Variables
+	env, err = s.admin.UpdateEnvironment(ctx, proj, env, &database.UpdateEnvironmentOptions{
+		Branch:    env.Branch,
+		Commit:    env.Commit,
+		Variables: req.Variables,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "variables updated failed with error %s", err.Error())
 	}
 
-	return &adminv1.UpdateProjectVariablesResponse{Variables: proj.ProdVariables}, nil
+	before, after := admin.AuditVariablesDiff(oldVariables, env.Variables)
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &proj.OrganizationID,
+		ProjectID:      &proj.ID,
+		Action:         admin.AuditActionProjectVariablesUpdate,
+		TargetType:     admin.AuditTargetEnvironment,
+		TargetID:       env.ID,
+		TargetName:     env.Name,
+		Before:         before,
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateProjectVariablesResponse{Variables: env.Variables}, nil
 }
 
 // fetchInstallationID returns a valid installation ID iff app is installed and user is a collaborator of the repo
@@ -582,53 +883,56 @@ func (s *Server) fetchInstallationID(ctx context.Context, githubURL, userID stri
 
 func projToDTO(p *database.Project, orgName string) *adminv1.Project {
 	return &adminv1.Project{
-		Id:               p.ID,
-		Name:             p.Name,
-		Description:      p.Description,
-		Public:           p.Public,
-		OrgId:            p.OrganizationID,
-		OrgName:          orgName,
-		Region:           p.Region,
-		ProdOlapDriver:   p.ProdOLAPDriver,
-		ProdOlapDsn:      p.ProdOLAPDSN,
-		ProdSlots:        int64(p.ProdSlots),
-		ProdBranch:       p.ProdBranch,
-		GithubUrl:        safeStr(p.GithubURL),
-		ProdDeploymentId: safeStr(p.ProdDeploymentID),
-		CreatedOn:        timestamppb.New(p.CreatedOn),
-		UpdatedOn:        timestamppb.New(p.UpdatedOn),
+		Id:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Public:      p.Public,
+		OrgId:       p.OrganizationID,
+		OrgName:     orgName,
+		Region:      p.Region,
+		GithubUrl:   safeStr(p.GithubURL),
+		CreatedOn:   timestamppb.New(p.CreatedOn),
+		UpdatedOn:   timestamppb.New(p.UpdatedOn),
 	}
 }
 
 func deploymentToDTO(d *database.Deployment) *adminv1.Deployment {
-	var s adminv1.DeploymentStatus
-	switch d.Status {
-	case database.DeploymentStatusUnspecified:
-		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_UNSPECIFIED
-	case database.DeploymentStatusPending:
-		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_PENDING
-	case database.DeploymentStatusOK:
-		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_OK
-	case database.DeploymentStatusReconciling:
-		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_RECONCILING
-	case database.DeploymentStatusError:
-		s = adminv1.DeploymentStatus_DEPLOYMENT_STATUS_ERROR
-	default:
-		panic(fmt.Errorf("unhandled deployment status %d", d.Status))
-	}
-
-	return &adminv1.Deployment{
+	dto := &adminv1.Deployment{
 		Id:                d.ID,
 		ProjectId:         d.ProjectID,
 		Slots:             int64(d.Slots),
 		Branch:            d.Branch,
 		RuntimeHost:       d.RuntimeHost,
 		RuntimeInstanceId: d.RuntimeInstanceID,
-		Status:            s,
+		Status:            deploymentStatusToDTO(d.Status),
 		Logs:              d.Logs,
 		CreatedOn:         timestamppb.New(d.CreatedOn),
 		UpdatedOn:         timestamppb.New(d.UpdatedOn),
 	}
+	if d.PullRequestNumber != nil {
+		dto.PullRequest = int64(*d.PullRequestNumber)
+	}
+	if d.ExpiresOn != nil {
+		dto.ExpiresOn = timestamppb.New(*d.ExpiresOn)
+	}
+	return dto
+}
+
+func deploymentStatusToDTO(s database.DeploymentStatus) adminv1.DeploymentStatus {
+	switch s {
+	case database.DeploymentStatusUnspecified:
+		return adminv1.DeploymentStatus_DEPLOYMENT_STATUS_UNSPECIFIED
+	case database.DeploymentStatusPending:
+		return adminv1.DeploymentStatus_DEPLOYMENT_STATUS_PENDING
+	case database.DeploymentStatusOK:
+		return adminv1.DeploymentStatus_DEPLOYMENT_STATUS_OK
+	case database.DeploymentStatusReconciling:
+		return adminv1.DeploymentStatus_DEPLOYMENT_STATUS_RECONCILING
+	case database.DeploymentStatusError:
+		return adminv1.DeploymentStatus_DEPLOYMENT_STATUS_ERROR
+	default:
+		panic(fmt.Errorf("unhandled deployment status %d", s))
+	}
 }
 
 func safeStr(s *string) string {
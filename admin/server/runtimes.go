@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) HeartbeatRuntime(ctx context.Context, req *adminv1.HeartbeatRuntimeRequest) (*adminv1.HeartbeatRuntimeResponse, error) {
+	// Runtimes can only register if a secret is configured
+	if s.opts.RuntimeSecret == "" {
+		return nil, status.Error(codes.FailedPrecondition, "runtime registration is not enabled")
+	}
+	if subtle.ConstantTimeCompare([]byte(req.Secret), []byte(s.opts.RuntimeSecret)) != 1 {
+		return nil, status.Error(codes.PermissionDenied, "invalid runtime secret")
+	}
+
+	// The audience defaults to the host, which is what runtimes use as their JWT audience by default
+	audience := req.AudienceUrl
+	if audience == "" {
+		audience = req.Host
+	}
+
+	rt, err := s.admin.HeartbeatRuntime(ctx, &database.UpsertRuntimeOptions{
+		Host:     req.Host,
+		Audience: audience,
+		Region:   req.Region,
+		Slots:    int(req.Slots),
+		DataDir:  req.DataDir,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.HeartbeatRuntimeResponse{Runtime: runtimeToDTO(rt, 0)}, nil
+}
+
+func (s *Server) ListRuntimes(ctx context.Context, req *adminv1.ListRuntimesRequest) (*adminv1.ListRuntimesResponse, error) {
+	if err := s.checkSuperuser(ctx); err != nil {
+		return nil, err
+	}
+
+	rts, err := s.admin.DB.FindRuntimes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stats, err := s.admin.DB.ResolveRuntimeSlotsUsed(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	used := make(map[string]int, len(stats))
+	for _, stat := range stats {
+		used[stat.RuntimeHost] = stat.SlotsUsed
+	}
+
+	dtos := make([]*adminv1.Runtime, len(rts))
+	for i, rt := range rts {
+		dtos[i] = runtimeToDTO(rt, used[rt.Host])
+	}
+
+	return &adminv1.ListRuntimesResponse{Runtimes: dtos}, nil
+}
+
+func (s *Server) DrainRuntime(ctx context.Context, req *adminv1.DrainRuntimeRequest) (*adminv1.DrainRuntimeResponse, error) {
+	if err := s.checkSuperuser(ctx); err != nil {
+		return nil, err
+	}
+
+	rt, err := s.admin.DrainRuntime(ctx, req.Host)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "runtime %q not found", req.Host)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DrainRuntimeResponse{Runtime: runtimeToDTO(rt, 0)}, nil
+}
+
+func (s *Server) UncordonRuntime(ctx context.Context, req *adminv1.UncordonRuntimeRequest) (*adminv1.UncordonRuntimeResponse, error) {
+	if err := s.checkSuperuser(ctx); err != nil {
+		return nil, err
+	}
+
+	rt, err := s.admin.UncordonRuntime(ctx, req.Host)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "runtime %q not found", req.Host)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UncordonRuntimeResponse{Runtime: runtimeToDTO(rt, 0)}, nil
+}
+
+// checkSuperuser returns a status error if the caller is not a user with one of the superuser emails configured for the server
+func (s *Server) checkSuperuser(ctx context.Context) error {
+	claims := auth.GetClaims(ctx)
+	if claims.OwnerType() != auth.OwnerTypeUser {
+		return status.Error(codes.Unauthenticated, "not authenticated as a user")
+	}
+
+	user, err := s.admin.DB.FindUser(ctx, claims.OwnerID())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for _, email := range s.opts.SuperuserEmails {
+		if strings.EqualFold(email, user.Email) {
+			return nil
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, "only superusers can manage runtimes")
+}
+
+func runtimeToDTO(r *database.Runtime, slotsUsed int) *adminv1.Runtime {
+	return &adminv1.Runtime{
+		Host:            r.Host,
+		AudienceUrl:     r.Audience,
+		Region:          r.Region,
+		Slots:           int64(r.Slots),
+		SlotsUsed:       int64(slotsUsed),
+		DataDir:         r.DataDir,
+		Status:          adminv1.RuntimeStatus(r.Status),
+		Draining:        r.Draining,
+		LastHeartbeatOn: timestamppb.New(r.LastHeartbeatOn),
+		CreatedOn:       timestamppb.New(r.CreatedOn),
+		UpdatedOn:       timestamppb.New(r.UpdatedOn),
+	}
+}
@@ -0,0 +1,1270 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc/status"
+)
+
+// Schemas of SCIM resources and messages (see RFC 7643 and RFC 7644)
+const (
+	scimSchemaUser         = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimSchemaGroup        = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimSchemaListResponse = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimSchemaError        = "urn:ietf:params:scim:api:messages:2.0:Error"
+)
+
+// scimContentType is the media type of SCIM responses
+const scimContentType = "application/scim+json"
+
+// scimMaxBodyBytes is the max size of a SCIM request body
+const scimMaxBodyBytes = 1 << 20
+
+// registerSCIMEndpoints registers the non-gRPC SCIM 2.0 endpoints that identity providers use to provision the users and groups of an org.
+// Requests must be authenticated with a service token that has the admin.ScopeSCIM scope. They provision the token's org.
+func (s *Server) registerSCIMEndpoints(mux *http.ServeMux) {
+	inner := http.NewServeMux()
+	inner.Handle("/scim/v2/Users", otelhttp.WithRouteTag("/scim/v2/Users", s.scimHandler(s.scimUsers)))
+	inner.Handle("/scim/v2/Users/", otelhttp.WithRouteTag("/scim/v2/Users/{id}", s.scimHandler(s.scimUser)))
+	inner.Handle("/scim/v2/Groups", otelhttp.WithRouteTag("/scim/v2/Groups", s.scimHandler(s.scimGroups)))
+	inner.Handle("/scim/v2/Groups/", otelhttp.WithRouteTag("/scim/v2/Groups/{id}", s.scimHandler(s.scimGroup)))
+	mux.Handle("/scim/", observability.Middleware("admin", s.logger, inner))
+}
+
+// scimHandlerFunc handles a SCIM request that provisions org
+type scimHandlerFunc func(w http.ResponseWriter, r *http.Request, org *database.Organization) error
+
+// scimHandler authenticates SCIM requests and writes the errors returned by fn in the SCIM format.
+func (s *Server) scimHandler(fn scimHandlerFunc) http.Handler {
+	return s.authenticator.HTTPMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		claims := auth.GetClaims(r.Context())
+		if claims.OwnerType() == auth.OwnerTypeAnon {
+			writeSCIMError(w, newSCIMError(http.StatusUnauthorized, "", "not authenticated"))
+			return
+		}
+
+		orgID, ok := auth.SCIMOrganizationID(claims)
+		if !ok {
+			writeSCIMError(w, newSCIMError(http.StatusForbidden, "", "a service token with the %q scope is required", admin.ScopeSCIM))
+			return
+		}
+
+		org, err := s.admin.DB.FindOrganization(r.Context(), orgID)
+		if err != nil {
+			writeSCIMError(w, err)
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, scimMaxBodyBytes)
+		err = fn(w, r, org)
+		if err != nil {
+			writeSCIMError(w, err)
+		}
+	}))
+}
+
+// scimUsers lists and provisions the users of an org
+func (s *Server) scimUsers(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	switch r.Method {
+	case http.MethodGet:
+		return s.scimListUsers(w, r, org)
+	case http.MethodPost:
+		return s.scimCreateUser(w, r, org)
+	default:
+		return newSCIMError(http.StatusMethodNotAllowed, "", "method %s not allowed", r.Method)
+	}
+}
+
+// scimUser gets, updates and deprovisions a user of an org
+func (s *Server) scimUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	id := strings.TrimPrefix(r.URL.Path, "/scim/v2/Users/")
+	if _, err := uuid.Parse(id); err != nil {
+		return newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+	}
+
+	su, err := s.admin.DB.FindOrganizationSCIMUser(r.Context(), org.ID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+		}
+		return err
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeSCIM(w, http.StatusOK, s.scimUserToDTO(su))
+		return nil
+	case http.MethodPut:
+		req := &scimUserResource{}
+		err := readSCIM(r, req)
+		if err != nil {
+			return err
+		}
+		su, err = s.scimUpdateUser(r.Context(), org, su, req)
+		if err != nil {
+			return err
+		}
+		writeSCIM(w, http.StatusOK, s.scimUserToDTO(su))
+		return nil
+	case http.MethodPatch:
+		req := &scimPatchRequest{}
+		err := readSCIM(r, req)
+		if err != nil {
+			return err
+		}
+		user := s.scimUserToDTO(su)
+		err = applySCIMUserPatch(user, req.Operations)
+		if err != nil {
+			return err
+		}
+		su, err = s.scimUpdateUser(r.Context(), org, su, user)
+		if err != nil {
+			return err
+		}
+		writeSCIM(w, http.StatusOK, s.scimUserToDTO(su))
+		return nil
+	case http.MethodDelete:
+		err := s.scimDeleteUser(r.Context(), org, su)
+		if err != nil {
+			return err
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	default:
+		return newSCIMError(http.StatusMethodNotAllowed, "", "method %s not allowed", r.Method)
+	}
+}
+
+func (s *Server) scimListUsers(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	filter, err := admin.ParseSCIMFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidFilter", err.Error())
+	}
+
+	users, err := s.admin.DB.FindOrganizationSCIMUsers(r.Context(), org.ID)
+	if err != nil {
+		return err
+	}
+
+	res := make([]interface{}, 0, len(users))
+	for _, u := range users {
+		if filter != nil {
+			ok, err := scimUserMatches(u, filter)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		}
+		res = append(res, s.scimUserToDTO(u))
+	}
+
+	writeSCIMList(w, r, res)
+	return nil
+}
+
+// scimCreateUser provisions a user in an org.
+// Users who have never signed in to Rill are created just in time, so they can be added to the org's usergroups before they sign in.
+func (s *Server) scimCreateUser(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	req := &scimUserResource{}
+	err := readSCIM(r, req)
+	if err != nil {
+		return err
+	}
+
+	email := req.email()
+	if _, err := mail.ParseAddress(email); err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "userName or emails must contain an email address")
+	}
+
+	// The user is created in the same transaction, so it's not left behind if provisioning fails
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	user, err := s.admin.DB.FindUserByEmail(ctx, email)
+	if err != nil {
+		if !errors.Is(err, database.ErrNotFound) {
+			return err
+		}
+		user, err = s.admin.CreateUser(ctx, email, req.displayName(), "")
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = s.admin.DB.FindOrganizationSCIMUser(ctx, org.ID, user.ID)
+	if err == nil {
+		return newSCIMError(http.StatusConflict, "uniqueness", "user %q already exists", email)
+	} else if !errors.Is(err, database.ErrNotFound) {
+		return err
+	}
+
+	active := req.Active == nil || *req.Active
+	su, err := s.admin.DB.InsertOrganizationSCIMUser(ctx, &database.InsertOrganizationSCIMUserOptions{
+		OrgID:      org.ID,
+		UserID:     user.ID,
+		ExternalID: req.ExternalID,
+		Active:     active,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return newSCIMError(http.StatusConflict, "uniqueness", "user %q already exists", email)
+		}
+		return err
+	}
+
+	if active {
+		err = s.addSCIMMember(ctx, org, user)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res := s.scimUserToDTO(su)
+	w.Header().Set("Location", res.Meta.Location)
+	writeSCIM(w, http.StatusCreated, res)
+	return nil
+}
+
+// scimUpdateUser updates a provisioned user to match req. It adds the user to the org or removes them from it if they were activated or deactivated.
+func (s *Server) scimUpdateUser(ctx context.Context, org *database.Organization, su *database.OrganizationSCIMUser, req *scimUserResource) (*database.OrganizationSCIMUser, error) {
+	if !strings.EqualFold(req.email(), su.Email) {
+		return nil, newSCIMError(http.StatusBadRequest, "mutability", "the email address of a user can't be changed")
+	}
+
+	user, err := s.admin.DB.FindUser(ctx, su.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users can belong to several orgs, so only the org that owns the user's email domain can change their name
+	updateName := false
+	if name := req.displayName(); name != "" && name != user.DisplayName {
+		updateName, err = s.orgHasEmailDomain(ctx, org.ID, user.Email)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if updateName {
+		user, err = s.admin.DB.UpdateUser(ctx, user.ID, &database.UpdateUserOptions{
+			DisplayName:    req.displayName(),
+			PhotoURL:       user.PhotoURL,
+			GithubUsername: user.GithubUsername,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	active := req.Active == nil || *req.Active
+	if active && !su.Active {
+		err = s.addSCIMMember(ctx, org, user)
+	} else if !active && su.Active {
+		err = s.removeSCIMMember(ctx, org, user)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	su, err = s.admin.DB.UpdateOrganizationSCIMUser(ctx, org.ID, user.ID, &database.UpdateOrganizationSCIMUserOptions{
+		ExternalID: req.ExternalID,
+		Active:     active,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return su, nil
+}
+
+// scimDeleteUser deprovisions a user from an org. The user is not deleted, since they may belong to other orgs.
+func (s *Server) scimDeleteUser(ctx context.Context, org *database.Organization, su *database.OrganizationSCIMUser) error {
+	user, err := s.admin.DB.FindUser(ctx, su.UserID)
+	if err != nil {
+		return err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if su.Active {
+		err = s.removeSCIMMember(ctx, org, user)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.admin.DB.DeleteOrganizationSCIMUser(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// addSCIMMember makes a provisioned user a member of the org with the viewer role, unless they're already a member.
+// It must be called in a transaction.
+func (s *Server) addSCIMMember(ctx context.Context, org *database.Organization, user *database.User) error {
+	member, err := s.isOrganizationMember(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+	if member {
+		return nil
+	}
+
+	role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameViewer)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.InsertOrganizationMemberUser(ctx, org.ID, user.ID, role.ID)
+	if err != nil {
+		return err
+	}
+
+	// Delete before inserting, since a failed insert would abort the transaction
+	err = s.admin.DB.DeleteUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	if err != nil {
+		return err
+	}
+	err = s.admin.DB.InsertUsergroupMember(ctx, *org.AllUsergroupID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgMemberAdd,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+		After:          map[string]string{"role": role.Name},
+	})
+}
+
+// removeSCIMMember removes a deprovisioned user from the org, its usergroups and its projects.
+// It must be called in a transaction.
+func (s *Server) removeSCIMMember(ctx context.Context, org *database.Organization, user *database.User) error {
+	member, err := s.isOrganizationMember(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	if member {
+		role, err := s.admin.DB.FindOrganizationRole(ctx, database.OrganizationRoleNameAdmin)
+		if err != nil {
+			return err
+		}
+		admins, err := s.admin.DB.FindOrganizationMemberUsersByRole(ctx, org.ID, role.ID)
+		if err != nil {
+			return err
+		}
+		if len(admins) == 1 && admins[0].ID == user.ID {
+			return newSCIMError(http.StatusBadRequest, "", "cannot remove the last owner of the org")
+		}
+
+		err = s.admin.DB.DeleteOrganizationMemberUser(ctx, org.ID, user.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.admin.DB.DeleteUsergroupMembersForOrganization(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.admin.DB.DeleteProjectMemberUsersForOrganization(ctx, org.ID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	return s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgMemberRemove,
+		TargetType:     admin.AuditTargetUser,
+		TargetID:       user.ID,
+		TargetName:     user.Email,
+	})
+}
+
+// isOrganizationMember returns true if the user has a role in the org (not counting the roles of their usergroups)
+func (s *Server) isOrganizationMember(ctx context.Context, orgID, userID string) (bool, error) {
+	members, err := s.admin.DB.FindOrganizationMemberUsers(ctx, orgID)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range members {
+		if m.ID == userID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// orgHasEmailDomain returns true if the domain of an email address is routed to the org's identity provider.
+func (s *Server) orgHasEmailDomain(ctx context.Context, orgID, email string) (bool, error) {
+	sso, err := s.admin.DB.FindOrganizationSSOByDomain(ctx, admin.EmailDomain(email))
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return sso.OrgID == orgID, nil
+}
+
+// scimGroups lists and provisions the groups of an org
+func (s *Server) scimGroups(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	switch r.Method {
+	case http.MethodGet:
+		return s.scimListGroups(w, r, org)
+	case http.MethodPost:
+		return s.scimCreateGroup(w, r, org)
+	default:
+		return newSCIMError(http.StatusMethodNotAllowed, "", "method %s not allowed", r.Method)
+	}
+}
+
+// scimGroup gets, updates and deletes a group of an org
+func (s *Server) scimGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	ctx := r.Context()
+
+	id := strings.TrimPrefix(r.URL.Path, "/scim/v2/Groups/")
+	if _, err := uuid.Parse(id); err != nil {
+		return newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+	}
+
+	g, err := s.admin.DB.FindOrganizationSCIMGroup(ctx, org.ID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+		}
+		return err
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		res, err := s.scimGroupToDTO(ctx, g, !scimExcludesMembers(r))
+		if err != nil {
+			return err
+		}
+		writeSCIM(w, http.StatusOK, res)
+		return nil
+	case http.MethodPut:
+		req := &scimGroupResource{}
+		err := readSCIM(r, req)
+		if err != nil {
+			return err
+		}
+		return s.scimUpdateGroup(w, r, org, g, req)
+	case http.MethodPatch:
+		req := &scimPatchRequest{}
+		err := readSCIM(r, req)
+		if err != nil {
+			return err
+		}
+		group, err := s.scimGroupToDTO(ctx, g, true)
+		if err != nil {
+			return err
+		}
+		err = applySCIMGroupPatch(group, req.Operations)
+		if err != nil {
+			return err
+		}
+		return s.scimUpdateGroup(w, r, org, g, group)
+	case http.MethodDelete:
+		err := s.scimDeleteGroup(ctx, org, g)
+		if err != nil {
+			return err
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return nil
+	default:
+		return newSCIMError(http.StatusMethodNotAllowed, "", "method %s not allowed", r.Method)
+	}
+}
+
+func (s *Server) scimListGroups(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	filter, err := admin.ParseSCIMFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidFilter", err.Error())
+	}
+
+	groups, err := s.admin.DB.FindOrganizationSCIMGroups(r.Context(), org.ID)
+	if err != nil {
+		return err
+	}
+
+	withMembers := !scimExcludesMembers(r)
+	res := make([]interface{}, 0, len(groups))
+	for _, g := range groups {
+		if filter != nil {
+			ok, err := scimGroupMatches(g, filter)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+		}
+		dto, err := s.scimGroupToDTO(r.Context(), g, withMembers)
+		if err != nil {
+			return err
+		}
+		res = append(res, dto)
+	}
+
+	writeSCIMList(w, r, res)
+	return nil
+}
+
+// scimCreateGroup provisions a group in an org.
+// A group whose display name is mapped to a usergroup for SSO (see AddOrganizationSSOGroup) is backed by that usergroup, so its members get the usergroup's roles.
+// Other groups are backed by a new usergroup without roles.
+func (s *Server) scimCreateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization) error {
+	req := &scimGroupResource{}
+	err := readSCIM(r, req)
+	if err != nil {
+		return err
+	}
+	if req.DisplayName == "" {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	usergroupID, err := s.scimUsergroupID(ctx, org, req.DisplayName)
+	if err != nil {
+		return err
+	}
+
+	g, err := s.admin.DB.InsertOrganizationSCIMGroup(ctx, &database.InsertOrganizationSCIMGroupOptions{
+		OrgID:       org.ID,
+		UsergroupID: usergroupID,
+		DisplayName: req.DisplayName,
+		ExternalID:  req.ExternalID,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return newSCIMError(http.StatusConflict, "uniqueness", "group %q already exists", req.DisplayName)
+		}
+		return err
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgSCIMGroupAdd,
+		TargetType:     admin.AuditTargetUsergroup,
+		TargetID:       g.UsergroupID,
+		TargetName:     g.UsergroupName,
+		After:          map[string]string{"display_name": g.DisplayName},
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.setSCIMGroupMembers(ctx, org, g, req.Members)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupToDTO(r.Context(), g, true)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Location", res.Meta.Location)
+	writeSCIM(w, http.StatusCreated, res)
+	return nil
+}
+
+// scimUpdateGroup updates a provisioned group and the members of its usergroup to match req.
+func (s *Server) scimUpdateGroup(w http.ResponseWriter, r *http.Request, org *database.Organization, g *database.OrganizationSCIMGroup, req *scimGroupResource) error {
+	if req.DisplayName == "" {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(r.Context())
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if req.DisplayName != g.DisplayName || req.ExternalID != g.ExternalID {
+		g, err = s.admin.DB.UpdateOrganizationSCIMGroup(ctx, g.UsergroupID, &database.UpdateOrganizationSCIMGroupOptions{
+			DisplayName: req.DisplayName,
+			ExternalID:  req.ExternalID,
+		})
+		if err != nil {
+			if errors.Is(err, database.ErrNotUnique) {
+				return newSCIMError(http.StatusConflict, "uniqueness", "group %q already exists", req.DisplayName)
+			}
+			return err
+		}
+	}
+
+	err = s.setSCIMGroupMembers(ctx, org, g, req.Members)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	res, err := s.scimGroupToDTO(r.Context(), g, true)
+	if err != nil {
+		return err
+	}
+	writeSCIM(w, http.StatusOK, res)
+	return nil
+}
+
+// scimDeleteGroup deletes a provisioned group and its usergroup.
+// Usergroups that are mapped for SSO are kept with their roles, but their members are removed.
+func (s *Server) scimDeleteGroup(ctx context.Context, org *database.Organization, g *database.OrganizationSCIMGroup) error {
+	mappings, err := s.admin.DB.FindOrganizationSSOGroups(ctx, org.ID)
+	if err != nil {
+		return err
+	}
+	mapped := false
+	for _, m := range mappings {
+		if m.UsergroupID == g.UsergroupID {
+			mapped = true
+			break
+		}
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if mapped {
+		err = s.setSCIMGroupMembers(ctx, org, g, nil)
+		if err != nil {
+			return err
+		}
+		err = s.admin.DB.DeleteOrganizationSCIMGroup(ctx, g.UsergroupID)
+	} else {
+		err = s.admin.DB.DeleteUsergroup(ctx, g.UsergroupID)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgSCIMGroupRemove,
+		TargetType:     admin.AuditTargetUsergroup,
+		TargetID:       g.UsergroupID,
+		TargetName:     g.UsergroupName,
+		Before:         map[string]string{"display_name": g.DisplayName},
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// scimUsergroupID returns the ID of the usergroup that backs a new group. It must be called in a transaction.
+func (s *Server) scimUsergroupID(ctx context.Context, org *database.Organization, displayName string) (string, error) {
+	mapping, err := s.admin.DB.FindOrganizationSSOGroup(ctx, org.ID, displayName)
+	if err == nil {
+		return mapping.UsergroupID, nil
+	} else if !errors.Is(err, database.ErrNotFound) {
+		return "", err
+	}
+
+	// Find a name that's not used by another usergroup, since a failed insert would abort the transaction
+	base := admin.SCIMUsergroupName(displayName)
+	name := base
+	for i := 2; ; i++ {
+		_, err := s.admin.DB.FindUsergroupByName(ctx, org.ID, name)
+		if errors.Is(err, database.ErrNotFound) {
+			break
+		} else if err != nil {
+			return "", err
+		}
+		name = admin.SCIMUsergroupNameWithSuffix(base, i)
+	}
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: name})
+	if err != nil {
+		return "", err
+	}
+	return group.ID, nil
+}
+
+// setSCIMGroupMembers makes the given users the members of a group's usergroup. It must be called in a transaction.
+// Members must be users provisioned in the org. Inactive users are not members of the org, so they're not added to its usergroups.
+func (s *Server) setSCIMGroupMembers(ctx context.Context, org *database.Organization, g *database.OrganizationSCIMGroup, members []*scimMember) error {
+	current, err := s.admin.DB.FindUsergroupMemberUsers(ctx, g.UsergroupID)
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]bool, len(members))
+	for _, m := range members {
+		want[m.Value] = true
+	}
+
+	has := make(map[string]bool, len(current))
+	for _, u := range current {
+		has[u.ID] = true
+		if want[u.ID] {
+			continue
+		}
+		err = s.admin.DB.DeleteUsergroupMember(ctx, g.UsergroupID, u.ID)
+		if err != nil {
+			return err
+		}
+		err = s.auditSCIMGroupMember(ctx, org, g, admin.AuditActionOrgUsergroupMemberRemove, u.Email)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, m := range members {
+		if has[m.Value] {
+			continue
+		}
+		has[m.Value] = true
+
+		su, err := s.findSCIMMember(ctx, org, m.Value)
+		if err != nil {
+			return err
+		}
+		if !su.Active {
+			continue
+		}
+
+		err = s.admin.DB.InsertUsergroupMember(ctx, g.UsergroupID, su.UserID)
+		if err != nil {
+			return err
+		}
+		err = s.auditSCIMGroupMember(ctx, org, g, admin.AuditActionOrgUsergroupMemberAdd, su.Email)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findSCIMMember finds the provisioned user referenced by a member of a group
+func (s *Server) findSCIMMember(ctx context.Context, org *database.Organization, id string) (*database.OrganizationSCIMUser, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "member %q not found", id)
+	}
+	su, err := s.admin.DB.FindOrganizationSCIMUser(ctx, org.ID, id)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "member %q not found", id)
+		}
+		return nil, err
+	}
+	return su, nil
+}
+
+func (s *Server) auditSCIMGroupMember(ctx context.Context, org *database.Organization, g *database.OrganizationSCIMGroup, action, email string) error {
+	opts := &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         action,
+		TargetType:     admin.AuditTargetUsergroup,
+		TargetID:       g.UsergroupID,
+		TargetName:     g.UsergroupName,
+	}
+	if action == admin.AuditActionOrgUsergroupMemberRemove {
+		opts.Before = map[string]string{"member": email}
+	} else {
+		opts.After = map[string]string{"member": email}
+	}
+	return s.audit(ctx, opts)
+}
+
+func (s *Server) scimUserToDTO(u *database.OrganizationSCIMUser) *scimUserResource {
+	active := u.Active
+	return &scimUserResource{
+		Schemas:     []string{scimSchemaUser},
+		ID:          u.UserID,
+		ExternalID:  u.ExternalID,
+		UserName:    u.Email,
+		Name:        &scimName{Formatted: u.DisplayName},
+		DisplayName: u.DisplayName,
+		Emails:      []*scimEmail{{Value: u.Email, Primary: true}},
+		Active:      &active,
+		Meta: &scimMeta{
+			ResourceType: "User",
+			Created:      u.CreatedOn,
+			LastModified: u.UpdatedOn,
+			Location:     s.urls.scim + "/Users/" + u.UserID,
+		},
+	}
+}
+
+func (s *Server) scimGroupToDTO(ctx context.Context, g *database.OrganizationSCIMGroup, withMembers bool) (*scimGroupResource, error) {
+	res := &scimGroupResource{
+		Schemas:     []string{scimSchemaGroup},
+		ID:          g.UsergroupID,
+		ExternalID:  g.ExternalID,
+		DisplayName: g.DisplayName,
+		Meta: &scimMeta{
+			ResourceType: "Group",
+			Created:      g.CreatedOn,
+			LastModified: g.UpdatedOn,
+			Location:     s.urls.scim + "/Groups/" + g.UsergroupID,
+		},
+	}
+
+	if withMembers {
+		users, err := s.admin.DB.FindUsergroupMemberUsers(ctx, g.UsergroupID)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			res.Members = append(res.Members, &scimMember{Value: u.ID, Display: u.Email})
+		}
+	}
+
+	return res, nil
+}
+
+// scimUserMatches returns true if a user matches a filter. Identity providers look up users by userName or externalId.
+func scimUserMatches(u *database.OrganizationSCIMUser, f *admin.SCIMFilter) (bool, error) {
+	switch strings.ToLower(f.Attribute) {
+	case "id":
+		return u.UserID == f.Value, nil
+	case "username", "emails.value":
+		return strings.EqualFold(u.Email, f.Value), nil
+	case "externalid":
+		return u.ExternalID == f.Value, nil
+	default:
+		return false, newSCIMError(http.StatusBadRequest, "invalidFilter", "filtering users by %q is not supported", f.Attribute)
+	}
+}
+
+// scimGroupMatches returns true if a group matches a filter. Identity providers look up groups by displayName or externalId.
+func scimGroupMatches(g *database.OrganizationSCIMGroup, f *admin.SCIMFilter) (bool, error) {
+	switch strings.ToLower(f.Attribute) {
+	case "id":
+		return g.UsergroupID == f.Value, nil
+	case "displayname":
+		return strings.EqualFold(g.DisplayName, f.Value), nil
+	case "externalid":
+		return g.ExternalID == f.Value, nil
+	default:
+		return false, newSCIMError(http.StatusBadRequest, "invalidFilter", "filtering groups by %q is not supported", f.Attribute)
+	}
+}
+
+// applySCIMUserPatch applies patch operations to a user. Attributes that are not stored (like phone numbers) are ignored.
+func applySCIMUserPatch(u *scimUserResource, ops []*scimPatchOp) error {
+	for _, op := range ops {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			if op.Path != "" {
+				err := setSCIMUserAttribute(u, op.Path, op.Value)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			var values map[string]json.RawMessage
+			err := json.Unmarshal(op.Value, &values)
+			if err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "the value of an operation without a path must be an object")
+			}
+			for path, v := range values {
+				err := setSCIMUserAttribute(u, path, v)
+				if err != nil {
+					return err
+				}
+			}
+		case "remove":
+			if strings.EqualFold(op.Path, "externalId") {
+				u.ExternalID = ""
+			}
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+func setSCIMUserAttribute(u *scimUserResource, path string, value json.RawMessage) error {
+	var err error
+	switch strings.ToLower(path) {
+	case "active":
+		var v interface{}
+		err = json.Unmarshal(value, &v)
+		if err == nil {
+			var active bool
+			active, err = admin.ParseSCIMBool(v)
+			u.Active = &active
+		}
+	case "externalid":
+		err = json.Unmarshal(value, &u.ExternalID)
+	case "username":
+		err = json.Unmarshal(value, &u.UserName)
+	case "displayname":
+		err = json.Unmarshal(value, &u.DisplayName)
+	case "name.formatted":
+		err = json.Unmarshal(value, &u.DisplayName)
+	case "name":
+		name := &scimName{}
+		err = json.Unmarshal(value, name)
+		if err == nil {
+			u.Name = name
+			u.DisplayName = ""
+		}
+	}
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid value for %q: %s", path, err)
+	}
+	return nil
+}
+
+// applySCIMGroupPatch applies patch operations to a group
+func applySCIMGroupPatch(g *scimGroupResource, ops []*scimPatchOp) error {
+	for _, op := range ops {
+		path, filter, err := admin.ParseSCIMValuePath(op.Path)
+		if err != nil {
+			return newSCIMError(http.StatusBadRequest, "invalidPath", err.Error())
+		}
+
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+			replace := strings.EqualFold(op.Op, "replace")
+			if path != "" {
+				err := setSCIMGroupAttribute(g, path, op.Value, replace)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			var values map[string]json.RawMessage
+			err := json.Unmarshal(op.Value, &values)
+			if err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "the value of an operation without a path must be an object")
+			}
+			for path, v := range values {
+				err := setSCIMGroupAttribute(g, path, v, replace)
+				if err != nil {
+					return err
+				}
+			}
+		case "remove":
+			switch strings.ToLower(path) {
+			case "members":
+				remove, err := scimMembersToRemove(filter, op.Value)
+				if err != nil {
+					return err
+				}
+				var members []*scimMember
+				for _, m := range g.Members {
+					if remove != nil && !remove[m.Value] {
+						members = append(members, m)
+					}
+				}
+				g.Members = members
+			case "externalid":
+				g.ExternalID = ""
+			default:
+				return newSCIMError(http.StatusBadRequest, "invalidPath", "can't remove %q", op.Path)
+			}
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported operation %q", op.Op)
+		}
+	}
+	return nil
+}
+
+func setSCIMGroupAttribute(g *scimGroupResource, path string, value json.RawMessage, replace bool) error {
+	var err error
+	switch strings.ToLower(path) {
+	case "displayname":
+		err = json.Unmarshal(value, &g.DisplayName)
+	case "externalid":
+		err = json.Unmarshal(value, &g.ExternalID)
+	case "members":
+		var members []*scimMember
+		err = json.Unmarshal(value, &members)
+		if err == nil {
+			if replace {
+				g.Members = members
+			} else {
+				g.Members = append(g.Members, members...)
+			}
+		}
+	default:
+		return newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported attribute %q", path)
+	}
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid value for %q: %s", path, err)
+	}
+	return nil
+}
+
+// scimMembersToRemove returns the IDs of the members removed by a remove operation, or nil if it removes all members.
+// The members are selected with a filter in the path (like `members[value eq "id"]`) or listed in the value.
+func scimMembersToRemove(filter *admin.SCIMFilter, value json.RawMessage) (map[string]bool, error) {
+	if filter != nil {
+		if !strings.EqualFold(filter.Attribute, "value") {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidFilter", "members can only be filtered by value")
+		}
+		return map[string]bool{filter.Value: true}, nil
+	}
+
+	if len(value) == 0 || string(value) == "null" {
+		return nil, nil
+	}
+
+	var members []*scimMember
+	err := json.Unmarshal(value, &members)
+	if err != nil {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "invalid value for \"members\": %s", err)
+	}
+	res := make(map[string]bool, len(members))
+	for _, m := range members {
+		res[m.Value] = true
+	}
+	return res, nil
+}
+
+// scimExcludesMembers returns true if the request asks to leave out the members of groups, which some identity providers do for large groups
+func scimExcludesMembers(r *http.Request) bool {
+	for _, attr := range strings.Split(r.URL.Query().Get("excludedAttributes"), ",") {
+		if strings.EqualFold(strings.TrimSpace(attr), "members") {
+			return true
+		}
+	}
+	return false
+}
+
+func readSCIM(r *http.Request, v interface{}) error {
+	err := json.NewDecoder(r.Body).Decode(v)
+	if err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidSyntax", "invalid request body: %s", err)
+	}
+	return nil
+}
+
+func writeSCIM(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeSCIMList writes a page of resources. Pages are selected with the 1-based startIndex and count query parameters.
+func writeSCIMList(w http.ResponseWriter, r *http.Request, resources []interface{}) {
+	start := 1
+	if n, err := strconv.Atoi(r.URL.Query().Get("startIndex")); err == nil && n > 1 {
+		start = n
+	}
+	count := len(resources)
+	if n, err := strconv.Atoi(r.URL.Query().Get("count")); err == nil && n >= 0 {
+		count = n
+	}
+
+	page := []interface{}{}
+	if start <= len(resources) {
+		page = resources[start-1:]
+		if count < len(page) {
+			page = page[:count]
+		}
+	}
+
+	writeSCIM(w, http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimSchemaListResponse},
+		TotalResults: len(resources),
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func writeSCIMError(w http.ResponseWriter, err error) {
+	var e *scimError
+	if !errors.As(err, &e) {
+		detail := err.Error()
+		if st, ok := status.FromError(err); ok {
+			detail = st.Message()
+		}
+		e = &scimError{status: http.StatusInternalServerError, detail: detail}
+	}
+
+	writeSCIM(w, e.status, &scimErrorResponse{
+		Schemas:  []string{scimSchemaError},
+		Status:   strconv.Itoa(e.status),
+		SCIMType: e.scimType,
+		Detail:   e.detail,
+	})
+}
+
+// scimError is an error that's written with its status and SCIM error type (see RFC 7644, section 3.12)
+type scimError struct {
+	status   int
+	scimType string
+	detail   string
+}
+
+func newSCIMError(code int, scimType, format string, args ...interface{}) *scimError {
+	return &scimError{status: code, scimType: scimType, detail: fmt.Sprintf(format, args...)}
+}
+
+func (e *scimError) Error() string {
+	return e.detail
+}
+
+type scimUserResource struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *scimName    `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Emails      []*scimEmail `json:"emails,omitempty"`
+	Active      *bool        `json:"active,omitempty"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+// email returns the email address of a user, which is their userName unless the identity provider uses another identifier
+func (u *scimUserResource) email() string {
+	if addr, err := mail.ParseAddress(u.UserName); err == nil && addr.Address == u.UserName {
+		return u.UserName
+	}
+	for _, e := range u.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(u.Emails) > 0 {
+		return u.Emails[0].Value
+	}
+	return u.UserName
+}
+
+func (u *scimUserResource) displayName() string {
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+	if u.Name == nil {
+		return ""
+	}
+	if u.Name.Formatted != "" {
+		return u.Name.Formatted
+	}
+	return strings.TrimSpace(u.Name.GivenName + " " + u.Name.FamilyName)
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimGroupResource struct {
+	Schemas     []string      `json:"schemas"`
+	ID          string        `json:"id,omitempty"`
+	ExternalID  string        `json:"externalId,omitempty"`
+	DisplayName string        `json:"displayName"`
+	Members     []*scimMember `json:"members,omitempty"`
+	Meta        *scimMeta     `json:"meta,omitempty"`
+}
+
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+	Location     string    `json:"location"`
+}
+
+type scimListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Schemas    []string       `json:"schemas"`
+	Operations []*scimPatchOp `json:"Operations"`
+}
+
+type scimPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+type scimErrorResponse struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+}
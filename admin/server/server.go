@@ -48,6 +48,8 @@ type Options struct {
 	GithubAppWebhookSecret string
 	GithubClientID         string
 	GithubClientSecret     string
+	RuntimeSecret          string
+	SuperuserEmails        []string
 }
 
 type Server struct {
@@ -170,6 +172,9 @@ func (s *Server) HTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Add Github-related endpoints (not gRPC handlers, just regular endpoints on /github/*)
 	s.registerGithubEndpoints(mux)
 
+	// Add SCIM endpoints for provisioning users and groups (not gRPC handlers, just regular endpoints on /scim/*)
+	s.registerSCIMEndpoints(mux)
+
 	// Build CORS options for admin server
 
 	// If the AllowedOrigins contains a "*" we want to return the requester's origin instead of "*" in the "Access-Control-Allow-Origin" header.
@@ -265,6 +270,7 @@ type externalURLs struct {
 	githubAuthCallback    string
 	githubAuthRetry       string
 	authLogin             string
+	scim                  string
 }
 
 func newURLRegistry(opts *Options) *externalURLs {
@@ -279,5 +285,6 @@ func newURLRegistry(opts *Options) *externalURLs {
 		githubAuthCallback:    urlutil.MustJoinURL(opts.ExternalURL, "/github/auth/callback"),
 		githubAuthRetry:       urlutil.MustJoinURL(opts.FrontendURL, "/-/github/connect/retry-auth"),
 		authLogin:             urlutil.MustJoinURL(opts.ExternalURL, "/auth/login"),
+		scim:                  urlutil.MustJoinURL(opts.ExternalURL, "/scim/v2"),
 	}
 }
@@ -0,0 +1,363 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Service accounts and their tokens can only be managed by org admins.
+
+func (s *Server) ListServiceAccounts(ctx context.Context, req *adminv1.ListServiceAccountsRequest) (*adminv1.ListServiceAccountsResponse, error) {
+	org, err := s.findOrganizationForServiceAccounts(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := s.admin.DB.FindServiceAccounts(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.ServiceAccount, len(accounts))
+	for i, a := range accounts {
+		dtos[i] = serviceAccountToDTO(a)
+	}
+
+	return &adminv1.ListServiceAccountsResponse{ServiceAccounts: dtos}, nil
+}
+
+func (s *Server) CreateServiceAccount(ctx context.Context, req *adminv1.CreateServiceAccountRequest) (*adminv1.CreateServiceAccountResponse, error) {
+	org, err := s.findOrganizationForServiceAccounts(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	account, err := s.admin.DB.InsertServiceAccount(ctx, &database.InsertServiceAccountOptions{
+		OrganizationID: org.ID,
+		Name:           req.Name,
+		Description:    req.Description,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Errorf(codes.AlreadyExists, "service account %q already exists", req.Name)
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgServiceAccountCreate,
+		TargetType:     admin.AuditTargetServiceAccount,
+		TargetID:       account.ID,
+		TargetName:     account.Name,
+		After:          map[string]string{"description": account.Description},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.CreateServiceAccountResponse{ServiceAccount: serviceAccountToDTO(account)}, nil
+}
+
+func (s *Server) DeleteServiceAccount(ctx context.Context, req *adminv1.DeleteServiceAccountRequest) (*adminv1.DeleteServiceAccountResponse, error) {
+	org, err := s.findOrganizationForServiceAccounts(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.findServiceAccount(ctx, org, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// The tokens of the service account are deleted with it
+	err = s.admin.DB.DeleteServiceAccount(ctx, account.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgServiceAccountDelete,
+		TargetType:     admin.AuditTargetServiceAccount,
+		TargetID:       account.ID,
+		TargetName:     account.Name,
+		Before:         map[string]string{"description": account.Description},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteServiceAccountResponse{}, nil
+}
+
+func (s *Server) ListServiceAuthTokens(ctx context.Context, req *adminv1.ListServiceAuthTokensRequest) (*adminv1.ListServiceAuthTokensResponse, error) {
+	org, err := s.findOrganizationForServiceAccounts(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.findServiceAccount(ctx, org, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.admin.DB.FindServiceAuthTokens(ctx, account.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dtos := make([]*adminv1.ServiceAuthToken, len(tokens))
+	for i, t := range tokens {
+		dtos[i], err = s.serviceAuthTokenToDTO(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &adminv1.ListServiceAuthTokensResponse{Tokens: dtos}, nil
+}
+
+func (s *Server) IssueServiceAuthToken(ctx context.Context, req *adminv1.IssueServiceAuthTokenRequest) (*adminv1.IssueServiceAuthTokenResponse, error) {
+	org, err := s.findOrganizationForServiceAccounts(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.findServiceAccount(ctx, org, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = admin.ValidateServiceTokenScopes(req.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	projectIDs := make([]string, len(req.Projects))
+	for i, name := range req.Projects {
+		proj, err := s.findProject(ctx, org.Name, name)
+		if err != nil {
+			return nil, err
+		}
+		projectIDs[i] = proj.ID
+	}
+
+	var expiresOn *time.Time
+	if req.ExpiresOn != nil {
+		t := req.ExpiresOn.AsTime()
+		if t.Before(time.Now()) {
+			return nil, status.Error(codes.InvalidArgument, "expiry must be in the future")
+		}
+		expiresOn = &t
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	tkn, model, err := s.admin.IssueServiceAuthToken(ctx, account, req.DisplayName, projectIDs, req.Scopes, expiresOn)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgServiceTokenIssue,
+		TargetType:     admin.AuditTargetAuthToken,
+		TargetID:       model.ID,
+		TargetName:     account.Name,
+		After:          serviceTokenAuditFields(model, req.Projects),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dto, err := s.serviceAuthTokenToDTO(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+
+	return &adminv1.IssueServiceAuthTokenResponse{
+		Token:       dto,
+		TokenString: tkn.Token().String(),
+	}, nil
+}
+
+func (s *Server) RevokeServiceAuthToken(ctx context.Context, req *adminv1.RevokeServiceAuthTokenRequest) (*adminv1.RevokeServiceAuthTokenResponse, error) {
+	org, err := s.findOrganizationForServiceAccounts(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.findServiceAccount(ctx, org, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	tkn, err := s.admin.DB.FindServiceAuthToken(ctx, req.TokenId)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "token not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if tkn.ServiceAccountID != account.ID {
+		return nil, status.Error(codes.NotFound, "token not found")
+	}
+
+	dto, err := s.serviceAuthTokenToDTO(ctx, tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteServiceAuthToken(ctx, tkn.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgServiceTokenRevoke,
+		TargetType:     admin.AuditTargetAuthToken,
+		TargetID:       tkn.ID,
+		TargetName:     account.Name,
+		Before:         serviceTokenAuditFields(tkn, dto.Projects),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.RevokeServiceAuthTokenResponse{}, nil
+}
+
+// findOrganizationForServiceAccounts returns an org by name if the caller can manage its service accounts, or a status error otherwise
+func (s *Server) findOrganizationForServiceAccounts(ctx context.Context, name string) (*database.Organization, error) {
+	org, err := s.admin.DB.FindOrganizationByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to manage service accounts")
+	}
+
+	return org, nil
+}
+
+// findServiceAccount returns a service account of an org by name, or a status error if it doesn't exist
+func (s *Server) findServiceAccount(ctx context.Context, org *database.Organization, name string) (*database.ServiceAccount, error) {
+	account, err := s.admin.DB.FindServiceAccountByName(ctx, org.ID, name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "service account %q not found", name)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return account, nil
+}
+
+// serviceAuthTokenToDTO converts a service account token to its DTO, which references projects by name.
+// Projects that were deleted after the token was issued are omitted.
+func (s *Server) serviceAuthTokenToDTO(ctx context.Context, t *database.ServiceAuthToken) (*adminv1.ServiceAuthToken, error) {
+	projects := make([]string, 0, len(t.ProjectIDs))
+	for _, id := range t.ProjectIDs {
+		proj, err := s.admin.DB.FindProject(ctx, id)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				continue
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		projects = append(projects, proj.Name)
+	}
+
+	dto := &adminv1.ServiceAuthToken{
+		Id:          t.ID,
+		DisplayName: t.DisplayName,
+		Projects:    projects,
+		Scopes:      t.Scopes,
+		CreatedOn:   timestamppb.New(t.CreatedOn),
+	}
+	if t.ExpiresOn != nil {
+		dto.ExpiresOn = timestamppb.New(*t.ExpiresOn)
+	}
+	return dto, nil
+}
+
+// serviceTokenAuditFields returns the fields of a service account token that are recorded in the audit log
+func serviceTokenAuditFields(t *database.ServiceAuthToken, projects []string) map[string]string {
+	fields := map[string]string{
+		"display_name": t.DisplayName,
+		"projects":     strings.Join(projects, ","),
+		"scopes":       strings.Join(t.Scopes, ","),
+	}
+	if t.ExpiresOn != nil {
+		fields["expires_on"] = t.ExpiresOn.Format(time.RFC3339)
+	}
+	return fields
+}
+
+func serviceAccountToDTO(a *database.ServiceAccount) *adminv1.ServiceAccount {
+	return &adminv1.ServiceAccount{
+		Id:          a.ID,
+		Name:        a.Name,
+		Description: a.Description,
+		CreatedOn:   timestamppb.New(a.CreatedOn),
+		UpdatedOn:   timestamppb.New(a.UpdatedOn),
+	}
+}
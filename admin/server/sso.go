@@ -0,0 +1,428 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The identity provider of an org and its group mappings can only be managed by org admins.
+
+func (s *Server) GetOrganizationSSO(ctx context.Context, req *adminv1.GetOrganizationSSORequest) (*adminv1.GetOrganizationSSOResponse, error) {
+	org, err := s.findOrganizationForSSO(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &adminv1.GetOrganizationSSOResponse{VerificationRecord: admin.SSOVerificationRecord(org.ID)}
+
+	sso, err := s.admin.DB.FindOrganizationSSO(ctx, org.ID)
+	if err == nil {
+		res.Sso = s.ssoToDTO(sso)
+	} else if !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	groups, err := s.admin.DB.FindOrganizationSSOGroups(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, g := range groups {
+		dto, err := s.ssoGroupToDTO(ctx, g)
+		if err != nil {
+			return nil, err
+		}
+		res.Groups = append(res.Groups, dto)
+	}
+
+	return res, nil
+}
+
+func (s *Server) SetOrganizationSSO(ctx context.Context, req *adminv1.SetOrganizationSSORequest) (*adminv1.SetOrganizationSSOResponse, error) {
+	org, err := s.findOrganizationForSSO(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	old, err := s.admin.DB.FindOrganizationSSO(ctx, org.ID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	clientSecret := req.ClientSecret
+	if clientSecret == "" {
+		if old == nil {
+			return nil, status.Error(codes.InvalidArgument, "client secret is required")
+		}
+		clientSecret = old.ClientSecret
+	}
+
+	groupsClaim := req.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+
+	domains := normalizeDomains(req.Domains)
+	for _, d := range domains {
+		err := admin.VerifySSODomain(ctx, org.ID, d)
+		if err != nil {
+			if errors.Is(err, admin.ErrSSODomainNotVerified) {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+			return nil, status.Errorf(codes.Unavailable, "failed to verify domain %q: %s", d, err.Error())
+		}
+	}
+
+	// Check that the issuer is an OIDC identity provider, so users are not locked out by a typo
+	_, err = oidc.NewProvider(ctx, req.IssuerUrl)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to discover identity provider: %s", err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	sso, err := s.admin.DB.UpsertOrganizationSSO(ctx, &database.UpsertOrganizationSSOOptions{
+		OrgID:        org.ID,
+		IssuerURL:    req.IssuerUrl,
+		ClientID:     req.ClientId,
+		ClientSecret: clientSecret,
+		GroupsClaim:  groupsClaim,
+		Domains:      domains,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Error(codes.AlreadyExists, "a domain is already routed to the identity provider of another org")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	before, after := admin.AuditDiff(ssoAuditFields(old), ssoAuditFields(sso))
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgSSOUpdate,
+		TargetType:     admin.AuditTargetOrganization,
+		TargetID:       org.ID,
+		TargetName:     org.Name,
+		Before:         before,
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.SetOrganizationSSOResponse{Sso: s.ssoToDTO(sso)}, nil
+}
+
+func (s *Server) DeleteOrganizationSSO(ctx context.Context, req *adminv1.DeleteOrganizationSSORequest) (*adminv1.DeleteOrganizationSSOResponse, error) {
+	org, err := s.findOrganizationForSSO(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	sso, err := s.admin.DB.FindOrganizationSSO(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "org doesn't have an identity provider")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	groups, err := s.admin.DB.FindOrganizationSSOGroups(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// The members of the mapped usergroups can no longer be synced, so the usergroups are deleted with the identity provider
+	for _, g := range groups {
+		err = s.admin.DB.DeleteUsergroup(ctx, g.UsergroupID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	err = s.admin.DB.DeleteOrganizationSSO(ctx, org.ID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgSSODelete,
+		TargetType:     admin.AuditTargetOrganization,
+		TargetID:       org.ID,
+		TargetName:     org.Name,
+		Before:         ssoAuditFields(sso),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.DeleteOrganizationSSOResponse{}, nil
+}
+
+func (s *Server) AddOrganizationSSOGroup(ctx context.Context, req *adminv1.AddOrganizationSSOGroupRequest) (*adminv1.AddOrganizationSSOGroupResponse, error) {
+	org, err := s.findOrganizationForSSO(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.admin.DB.FindOrganizationSSO(ctx, org.ID)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "org doesn't have an identity provider")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var orgRole *database.OrganizationRole
+	if req.OrgRole != "" {
+		orgRole, err = s.admin.DB.FindOrganizationRole(ctx, req.OrgRole)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Errorf(codes.InvalidArgument, "org role %q not found", req.OrgRole)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	projectRoles := make(map[string]*database.ProjectRole, len(req.ProjectRoles))
+	projects := make(map[string]*database.Project, len(req.ProjectRoles))
+	for name, roleName := range req.ProjectRoles {
+		proj, err := s.findProject(ctx, org.Name, name)
+		if err != nil {
+			return nil, err
+		}
+		role, err := s.admin.DB.FindProjectRole(ctx, roleName)
+		if err != nil {
+			if errors.Is(err, database.ErrNotFound) {
+				return nil, status.Errorf(codes.InvalidArgument, "project role %q not found", roleName)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		projects[name] = proj
+		projectRoles[name] = role
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	group, err := s.admin.DB.InsertUsergroup(ctx, &database.InsertUsergroupOptions{OrgID: org.ID, Name: req.Usergroup})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Errorf(codes.AlreadyExists, "usergroup %q already exists", req.Usergroup)
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if orgRole != nil {
+		err = s.admin.DB.InsertOrganizationMemberUsergroup(ctx, group.ID, org.ID, orgRole.ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	for name, proj := range projects {
+		err = s.admin.DB.InsertProjectMemberUsergroup(ctx, group.ID, proj.ID, projectRoles[name].ID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	mapping, err := s.admin.DB.InsertOrganizationSSOGroup(ctx, &database.InsertOrganizationSSOGroupOptions{
+		OrgID:       org.ID,
+		UsergroupID: group.ID,
+		IDPGroup:    req.IdpGroup,
+	})
+	if err != nil {
+		if errors.Is(err, database.ErrNotUnique) {
+			return nil, status.Errorf(codes.AlreadyExists, "group %q is already mapped to a usergroup", req.IdpGroup)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	after := map[string]string{"idp_group": req.IdpGroup, "org_role": req.OrgRole}
+	for name, role := range req.ProjectRoles {
+		after["project_role."+name] = role
+	}
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgSSOGroupAdd,
+		TargetType:     admin.AuditTargetUsergroup,
+		TargetID:       group.ID,
+		TargetName:     group.Name,
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dto, err := s.ssoGroupToDTO(ctx, mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.AddOrganizationSSOGroupResponse{Group: dto}, nil
+}
+
+func (s *Server) RemoveOrganizationSSOGroup(ctx context.Context, req *adminv1.RemoveOrganizationSSOGroupRequest) (*adminv1.RemoveOrganizationSSOGroupResponse, error) {
+	org, err := s.findOrganizationForSSO(ctx, req.Organization)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := s.admin.DB.FindOrganizationSSOGroup(ctx, org.ID, req.IdpGroup)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "group %q is not mapped to a usergroup", req.IdpGroup)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// The mapping and the usergroup's roles are deleted with the usergroup
+	err = s.admin.DB.DeleteUsergroup(ctx, mapping.UsergroupID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgSSOGroupRemove,
+		TargetType:     admin.AuditTargetUsergroup,
+		TargetID:       mapping.UsergroupID,
+		TargetName:     mapping.UsergroupName,
+		Before:         map[string]string{"idp_group": mapping.IDPGroup},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.RemoveOrganizationSSOGroupResponse{}, nil
+}
+
+// findOrganizationForSSO returns an org by name, or a status error if it doesn't exist or the caller can't manage it
+func (s *Server) findOrganizationForSSO(ctx context.Context, name string) (*database.Organization, error) {
+	org, err := s.admin.DB.FindOrganizationByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ManageOrg {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to manage the identity provider of the org")
+	}
+
+	return org, nil
+}
+
+// normalizeDomains returns the domains in lower case, sorted and without duplicates
+func normalizeDomains(domains []string) []string {
+	seen := make(map[string]bool, len(domains))
+	var res []string
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d == "" || seen[d] {
+			continue
+		}
+		seen[d] = true
+		res = append(res, d)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// ssoAuditFields returns the fields of an identity provider recorded in the audit log. The client secret is not recorded.
+func ssoAuditFields(sso *database.OrganizationSSO) map[string]string {
+	if sso == nil {
+		return map[string]string{}
+	}
+	return map[string]string{
+		"issuer_url":   sso.IssuerURL,
+		"client_id":    sso.ClientID,
+		"groups_claim": sso.GroupsClaim,
+		"domains":      strings.Join(sso.Domains, ","),
+	}
+}
+
+func (s *Server) ssoToDTO(sso *database.OrganizationSSO) *adminv1.OrganizationSSO {
+	return &adminv1.OrganizationSSO{
+		IssuerUrl:   sso.IssuerURL,
+		ClientId:    sso.ClientID,
+		GroupsClaim: sso.GroupsClaim,
+		Domains:     sso.Domains,
+		RedirectUrl: s.authenticator.SSORedirectURL(),
+		CreatedOn:   timestamppb.New(sso.CreatedOn),
+		UpdatedOn:   timestamppb.New(sso.UpdatedOn),
+	}
+}
+
+func (s *Server) ssoGroupToDTO(ctx context.Context, g *database.OrganizationSSOGroup) (*adminv1.SSOGroupMapping, error) {
+	roles, err := s.admin.DB.FindProjectRolesForUsergroup(ctx, g.UsergroupID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	dto := &adminv1.SSOGroupMapping{
+		IdpGroup:     g.IDPGroup,
+		Usergroup:    g.UsergroupName,
+		ProjectRoles: make(map[string]string, len(roles)),
+	}
+	if g.OrgRoleName != nil {
+		dto.OrgRole = *g.OrgRoleName
+	}
+	for _, r := range roles {
+		dto.ProjectRoles[r.ProjectName] = r.RoleName
+	}
+	return dto, nil
+}
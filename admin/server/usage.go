@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/server/auth"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetOrganizationUsage(ctx context.Context, req *adminv1.GetOrganizationUsageRequest) (*adminv1.GetOrganizationUsageResponse, error) {
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Superusers can read the usage of any org, since they manage its quotas
+	claims := auth.GetClaims(ctx)
+	if !claims.OrganizationPermissions(ctx, org.ID).ReadOrg {
+		if err := s.checkSuperuser(ctx); err != nil {
+			return nil, status.Error(codes.PermissionDenied, "not allowed to read org usage")
+		}
+	}
+
+	periodStart := admin.UsagePeriodStart(time.Now())
+	usage, err := s.admin.DB.ResolveOrganizationUsage(ctx, org.ID, periodStart)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.GetOrganizationUsageResponse{
+		Usage: &adminv1.OrganizationUsage{
+			Projects:      int32(usage.Projects),
+			SlotsTotal:    int32(usage.Slots),
+			IngestedBytes: usage.BytesIngested,
+			Queries:       usage.Queries,
+			PeriodStart:   timestamppb.New(periodStart),
+		},
+		Quotas: quotasToDTO(org.OrganizationQuotas),
+	}, nil
+}
+
+func (s *Server) UpdateOrganizationQuotas(ctx context.Context, req *adminv1.UpdateOrganizationQuotasRequest) (*adminv1.UpdateOrganizationQuotasResponse, error) {
+	if err := s.checkSuperuser(ctx); err != nil {
+		return nil, err
+	}
+
+	org, err := s.admin.DB.FindOrganizationByName(ctx, req.Organization)
+	if err != nil {
+		if errors.Is(err, database.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "org not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	old := org
+	org, err = s.admin.DB.UpdateOrganizationQuotas(ctx, org.ID, &database.UpdateOrganizationQuotasOptions{
+		Projects:        int(req.Quotas.Projects),
+		SlotsTotal:      int(req.Quotas.SlotsTotal),
+		IngestedBytes:   req.Quotas.IngestedBytes,
+		QueriesPerMonth: req.Quotas.QueriesPerMonth,
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	before, after := admin.AuditDiff(quotasAuditFields(old.OrganizationQuotas), quotasAuditFields(org.OrganizationQuotas))
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         admin.AuditActionOrgQuotasUpdate,
+		TargetType:     admin.AuditTargetOrganization,
+		TargetID:       org.ID,
+		TargetName:     org.Name,
+		Before:         before,
+		After:          after,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &adminv1.UpdateOrganizationQuotasResponse{Quotas: quotasToDTO(org.OrganizationQuotas)}, nil
+}
+
+// checkQuotas returns a ResourceExhausted error if adding the given number of projects and slots to an org would exceed its quotas
+func (s *Server) checkQuotas(ctx context.Context, org *database.Organization, projects, slots int) error {
+	err := s.admin.CheckQuotas(ctx, org, projects, slots)
+	if err != nil {
+		if errors.Is(err, admin.ErrQuotaExceeded) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+func quotasAuditFields(q database.OrganizationQuotas) map[string]string {
+	return map[string]string{
+		"projects":          strconv.Itoa(q.QuotaProjects),
+		"slots_total":       strconv.Itoa(q.QuotaSlotsTotal),
+		"ingested_bytes":    strconv.FormatInt(q.QuotaIngestedBytes, 10),
+		"queries_per_month": strconv.FormatInt(q.QuotaQueriesPerMonth, 10),
+	}
+}
+
+func quotasToDTO(q database.OrganizationQuotas) *adminv1.OrganizationQuotas {
+	return &adminv1.OrganizationQuotas{
+		Projects:        int32(q.QuotaProjects),
+		SlotsTotal:      int32(q.QuotaSlotsTotal),
+		IngestedBytes:   q.QuotaIngestedBytes,
+		QueriesPerMonth: q.QuotaQueriesPerMonth,
+	}
+}
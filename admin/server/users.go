@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/rilldata/rill/admin"
 	"github.com/rilldata/rill/admin/database"
 	"github.com/rilldata/rill/admin/server/auth"
 	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
@@ -48,11 +49,31 @@ func (s *Server) RevokeCurrentAuthToken(ctx context.Context, req *adminv1.Revoke
 	}
 	tokenID := claims.AuthTokenID()
 
-	err := s.admin.DB.DeleteUserAuthToken(ctx, tokenID)
+	ctx, tx, err := s.admin.DB.NewTx(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	err = s.admin.DB.DeleteUserAuthToken(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.audit(ctx, &database.InsertAuditLogEntryOptions{
+		Action:     admin.AuditActionUserAuthTokenRevoke,
+		TargetType: admin.AuditTargetAuthToken,
+		TargetID:   tokenID,
+	})
 	if err != nil {
 		return nil, err
 	}
 
+	err = tx.Commit()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &adminv1.RevokeCurrentAuthTokenResponse{
 		TokenId: tokenID,
 	}, nil
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"fmt"
+)
+
+// Scopes that can be granted to the auth tokens of service accounts.
+// Each project scope grants the project permission of the same name (see adminv1.ProjectPermissions).
+// Tokens can always read the projects they have access to, but can't manage the projects or their members.
+// ScopeSCIM lets an identity provider provision the users and groups of the token's org with SCIM.
+const (
+	ScopeReadProd       = "read_prod"
+	ScopeReadProdStatus = "read_prod_status"
+	ScopeManageProd     = "manage_prod"
+	ScopeReadDev        = "read_dev"
+	ScopeReadDevStatus  = "read_dev_status"
+	ScopeManageDev      = "manage_dev"
+	ScopeSCIM           = "scim"
+)
+
+// ServiceTokenScopes lists the valid scopes of service account tokens
+var ServiceTokenScopes = []string{
+	ScopeReadProd,
+	ScopeReadProdStatus,
+	ScopeManageProd,
+	ScopeReadDev,
+	ScopeReadDevStatus,
+	ScopeManageDev,
+	ScopeSCIM,
+}
+
+// ValidateServiceTokenScopes returns an error if scopes is empty or contains an unknown scope
+func ValidateServiceTokenScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("a service token must have at least one scope")
+	}
+
+	for _, scope := range scopes {
+		valid := false
+		for _, s := range ServiceTokenScopes {
+			if scope == s {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("unknown scope %q (valid scopes are %v)", scope, ServiceTokenScopes)
+		}
+	}
+
+	return nil
+}
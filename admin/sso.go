@@ -0,0 +1,114 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"strings"
+)
+
+// ssoVerificationPrefix is the prefix of the DNS TXT record that proves an org controls an email domain
+const ssoVerificationPrefix = "rill-sso-verification="
+
+// ErrSSODomainNotVerified is returned when an org configures SSO for a domain without a verification record
+var ErrSSODomainNotVerified = errors.New("domain not verified")
+
+// lookupTXT resolves DNS TXT records. It's replaced in tests.
+var lookupTXT = net.DefaultResolver.LookupTXT
+
+// SSOVerificationRecord returns the DNS TXT record that an org must add to a domain before routing its users to the org's identity provider.
+func SSOVerificationRecord(orgID string) string {
+	return ssoVerificationPrefix + orgID
+}
+
+// VerifySSODomain returns an error wrapping ErrSSODomainNotVerified if the domain doesn't have the org's verification record.
+// Without it, an org could route the users of any domain (and sign in as them) with its own identity provider.
+func VerifySSODomain(ctx context.Context, orgID, domain string) error {
+	records, err := lookupTXT(ctx, domain)
+	if err != nil {
+		var dnsErr *net.DNSError
+		if !errors.As(err, &dnsErr) || !dnsErr.IsNotFound {
+			return err
+		}
+	}
+
+	want := SSOVerificationRecord(orgID)
+	for _, r := range records {
+		if strings.TrimSpace(r) == want {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: add a TXT record with %q to %s", ErrSSODomainNotVerified, want, domain)
+}
+
+// EmailDomain returns the lower case domain of an email address, or an empty string if it's not a valid address.
+func EmailDomain(email string) string {
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return ""
+	}
+	i := strings.LastIndex(addr.Address, "@")
+	if i < 0 {
+		return ""
+	}
+	return strings.ToLower(addr.Address[i+1:])
+}
+
+// SSOGroups returns the groups in a claim of an ID token. Identity providers set it to a list of strings, or a single string if the user is in one group.
+func SSOGroups(claims map[string]interface{}, claim string) []string {
+	switch v := claims[claim].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var groups []string
+		for _, g := range v {
+			if s, ok := g.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+		return groups
+	default:
+		return nil
+	}
+}
+
+// SyncSSOGroups makes a user a member of the org's usergroups that are mapped to one of the given groups, and removes them from the other mapped usergroups.
+// It's called when a user signs in with the org's identity provider. Usergroups that are not mapped to a group are not changed.
+func (s *Service) SyncSSOGroups(ctx context.Context, orgID, userID string, groups []string) error {
+	mappings, err := s.DB.FindOrganizationSSOGroups(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	member := make(map[string]bool, len(groups))
+	for _, g := range groups {
+		member[g] = true
+	}
+
+	ctx, tx, err := s.DB.NewTx(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, m := range mappings {
+		err = s.DB.DeleteUsergroupMember(ctx, m.UsergroupID, userID)
+		if err != nil {
+			return err
+		}
+		if !member[m.IDPGroup] {
+			continue
+		}
+		err = s.DB.InsertUsergroupMember(ctx, m.UsergroupID, userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
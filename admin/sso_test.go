@@ -0,0 +1,46 @@
+package admin
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifySSODomain(t *testing.T) {
+	records := map[string][]string{
+		"acme.com":    {"v=spf1 -all", SSOVerificationRecord("org1")},
+		"example.com": {"v=spf1 -all"},
+	}
+	lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+		rs, ok := records[name]
+		if !ok {
+			return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+		}
+		return rs, nil
+	}
+	defer func() { lookupTXT = net.DefaultResolver.LookupTXT }()
+
+	ctx := context.Background()
+	require.NoError(t, VerifySSODomain(ctx, "org1", "acme.com"))
+	require.ErrorIs(t, VerifySSODomain(ctx, "org2", "acme.com"), ErrSSODomainNotVerified)
+	require.ErrorIs(t, VerifySSODomain(ctx, "org1", "example.com"), ErrSSODomainNotVerified)
+	require.ErrorIs(t, VerifySSODomain(ctx, "org1", "unknown.com"), ErrSSODomainNotVerified)
+}
+
+func TestEmailDomain(t *testing.T) {
+	require.Equal(t, "acme.com", EmailDomain("jane@acme.com"))
+	require.Equal(t, "acme.com", EmailDomain("Jane <jane@ACME.com>"))
+	require.Equal(t, "", EmailDomain("jane"))
+}
+
+func TestSSOGroups(t *testing.T) {
+	claims := map[string]interface{}{
+		"groups": []interface{}{"engineering", "sales", 1},
+		"team":   "data",
+	}
+	require.Equal(t, []string{"engineering", "sales"}, SSOGroups(claims, "groups"))
+	require.Equal(t, []string{"data"}, SSOGroups(claims, "team"))
+	require.Empty(t, SSOGroups(claims, "roles"))
+}
@@ -0,0 +1,273 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/rilldata/rill/admin/provisioner"
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/client"
+	"github.com/rilldata/rill/runtime/pkg/observability"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"go.uber.org/zap"
+)
+
+// usageCollectionInterval is how often the usage of the orgs' deployments is collected from the runtimes
+const usageCollectionInterval = 5 * time.Minute
+
+// ErrQuotaExceeded is returned when an action would exceed a quota of an org
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// UsagePeriodStart returns the start of the calendar month (in UTC) that queries served at t are counted for.
+func UsagePeriodStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// CheckQuotas returns an error wrapping ErrQuotaExceeded if adding the given number of projects and slots to an org would exceed its quotas,
+// or if the org has already used up its quota of ingested bytes or queries.
+func (s *Service) CheckQuotas(ctx context.Context, org *database.Organization, projects, slots int) error {
+	usage, err := s.DB.ResolveOrganizationUsage(ctx, org.ID, UsagePeriodStart(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	q := org.OrganizationQuotas
+	if projects > 0 && q.QuotaProjects >= 0 && usage.Projects+projects > q.QuotaProjects {
+		return fmt.Errorf("%w: the org is limited to %d projects", ErrQuotaExceeded, q.QuotaProjects)
+	}
+	if slots > 0 && q.QuotaSlotsTotal >= 0 && usage.Slots+slots > q.QuotaSlotsTotal {
+		return fmt.Errorf("%w: the org is limited to %d slots and uses %d", ErrQuotaExceeded, q.QuotaSlotsTotal, usage.Slots)
+	}
+	if q.QuotaIngestedBytes >= 0 && usage.BytesIngested >= q.QuotaIngestedBytes {
+		return fmt.Errorf("%w: the org has ingested %d of its %d bytes", ErrQuotaExceeded, usage.BytesIngested, q.QuotaIngestedBytes)
+	}
+	if q.QuotaQueriesPerMonth >= 0 && usage.Queries >= q.QuotaQueriesPerMonth {
+		return fmt.Errorf("%w: the org has used its %d queries for this month", ErrQuotaExceeded, q.QuotaQueriesPerMonth)
+	}
+	return nil
+}
+
+// startUsageCollection periodically enqueues a job that collects usage from the runtimes.
+// The job has an idempotency key, so admin servers don't enqueue it more than once.
+func (s *Service) startUsageCollection() {
+	s.jobsWG.Add(1)
+	go func() {
+		defer s.jobsWG.Done()
+		ticker := time.NewTicker(usageCollectionInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-s.closeCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			_, err := s.enqueueJob(s.closeCtx, &enqueueOptions{
+				Type:           JobTypeCollectUsage,
+				IdempotencyKey: JobTypeCollectUsage,
+			})
+			if err != nil && s.closeCtx.Err() == nil {
+				s.logger.Error("usage: could not enqueue collection", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// collectUsage runs a JobTypeCollectUsage job.
+// It records the usage reported by the runtime instance of every deployment, and then sets the ingestion limit of the instances
+// so the deployments of an org can't ingest more than the org's quota.
+// Runtimes that can't be reached are skipped, since their usage is collected by the next job.
+func (s *Service) collectUsage(ctx context.Context, job *database.Job) error {
+	orgs, err := s.DB.FindOrganizations(ctx)
+	if err != nil {
+		return err
+	}
+
+	periodStart := UsagePeriodStart(time.Now())
+	for _, org := range orgs {
+		ds, err := s.DB.FindDeploymentsForOrganization(ctx, org.ID)
+		if err != nil {
+			return err
+		}
+		if len(ds) == 0 {
+			continue
+		}
+
+		bytesIngested := make(map[string]int64, len(ds))
+		for _, d := range ds {
+			u, err := s.collectDeploymentUsage(ctx, org, d, periodStart)
+			if err != nil {
+				s.logger.Warn("usage: could not collect usage of deployment", zap.String("deployment_id", d.ID), zap.Error(err), observability.ZapCtx(ctx))
+				continue
+			}
+			bytesIngested[d.ID] = u.BytesIngested
+		}
+
+		usage, err := s.DB.ResolveOrganizationUsage(ctx, org.ID, periodStart)
+		if err != nil {
+			return err
+		}
+
+		for _, d := range ds {
+			own, ok := bytesIngested[d.ID]
+			if !ok {
+				continue
+			}
+			limit := deploymentIngestionLimit(org.QuotaIngestedBytes, usage.BytesIngested, own, provisioner.IngestionLimitBytes(d.OLAPDriver, d.Slots))
+			err := s.setIngestionLimit(ctx, d, limit)
+			if err != nil {
+				s.logger.Warn("usage: could not set ingestion limit of deployment", zap.String("deployment_id", d.ID), zap.Error(err), observability.ZapCtx(ctx))
+			}
+		}
+	}
+
+	return nil
+}
+
+// collectDeploymentUsage fetches the usage of a deployment's runtime instance and records it.
+// The queries served since the last report are added to the org's queries in the current period.
+func (s *Service) collectDeploymentUsage(ctx context.Context, org *database.Organization, d *database.Deployment, periodStart time.Time) (*database.DeploymentUsage, error) {
+	rt, err := s.openRuntimeClient(d)
+	if err != nil {
+		return nil, err
+	}
+	defer rt.Close()
+
+	res, err := rt.GetInstanceUsage(ctx, &runtimev1.GetInstanceUsageRequest{InstanceId: d.RuntimeInstanceID})
+	if err != nil {
+		return nil, err
+	}
+
+	prev, err := s.DB.FindDeploymentUsage(ctx, d.ID)
+	if err != nil && !errors.Is(err, database.ErrNotFound) {
+		return nil, err
+	}
+
+	txCtx, tx, err := s.DB.NewTx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// Older runtimes don't report when their counters started
+	var startedOn *time.Time
+	if res.CountersStartedOn != nil {
+		// Postgres stores timestamps with microsecond precision, so truncate to compare with the stored value
+		t := res.CountersStartedOn.AsTime().Truncate(time.Microsecond)
+		startedOn = &t
+	}
+
+	u, err := s.DB.UpsertDeploymentUsage(txCtx, &database.UpsertDeploymentUsageOptions{
+		DeploymentID:            d.ID,
+		RuntimeInstanceID:       d.RuntimeInstanceID,
+		BytesIngested:           res.BytesIngested,
+		QueriesCounter:          res.Queries,
+		QueriesCounterStartedOn: startedOn,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesSince(prev, d.RuntimeInstanceID, res.Queries, startedOn)
+	if queries > 0 {
+		err = s.DB.IncrementOrganizationQueries(txCtx, org.ID, periodStart, queries)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// setIngestionLimit sets the ingestion limit of a deployment's runtime instance, if it's not already set to limit.
+func (s *Service) setIngestionLimit(ctx context.Context, d *database.Deployment, limit int64) error {
+	rt, err := s.openRuntimeClient(d)
+	if err != nil {
+		return err
+	}
+	defer rt.Close()
+
+	resp, err := rt.GetInstance(ctx, &runtimev1.GetInstanceRequest{InstanceId: d.RuntimeInstanceID})
+	if err != nil {
+		return err
+	}
+
+	inst := resp.Instance
+	if inst.IngestionLimitBytes == limit {
+		return nil
+	}
+
+	_, err = rt.EditInstance(ctx, &runtimev1.EditInstanceRequest{
+		InstanceId:           d.RuntimeInstanceID,
+		OlapDriver:           inst.OlapDriver,
+		OlapDsn:              inst.OlapDsn,
+		RepoDriver:           inst.RepoDriver,
+		RepoDsn:              inst.RepoDsn,
+		EmbedCatalog:         inst.EmbedCatalog,
+		Variables:            inst.Variables,
+		IngestionLimitBytes:  limit,
+		ReconcileConcurrency: inst.ReconcileConcurrency,
+		Profile:              inst.Profile,
+	})
+	return err
+}
+
+// openRuntimeClient opens a client for the runtime of a deployment, with permissions to manage its instance
+func (s *Service) openRuntimeClient(d *database.Deployment) (*client.Client, error) {
+	jwt, err := s.issuer.NewToken(auth.TokenOptions{
+		AudienceURL:       d.RuntimeAudience,
+		TTL:               time.Hour,
+		SystemPermissions: []auth.Permission{auth.ManageInstances, auth.ReadInstance},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(d.RuntimeHost, jwt)
+}
+
+// queriesSince returns the queries served by a deployment's instance since its previous report.
+// The instance's counter restarts when the runtime restarts or the deployment is moved to a new instance.
+// A restart is detected by a change of the counter's start time. If either report lacks it, a counter lower than the previous one is taken as a restart.
+func queriesSince(prev *database.DeploymentUsage, instanceID string, counter int64, startedOn *time.Time) int64 {
+	if prev == nil || prev.RuntimeInstanceID != instanceID || counter < prev.QueriesCounter {
+		return counter
+	}
+	if startedOn != nil && prev.QueriesCounterStartedOn != nil && !startedOn.Equal(*prev.QueriesCounterStartedOn) {
+		return counter
+	}
+	return counter - prev.QueriesCounter
+}
+
+// deploymentIngestionLimit returns the ingestion limit of a deployment's instance.
+// The instance can ingest what's left of the org's quota on top of what it has already ingested, up to its default limit.
+// A negative quota means the org is not limited, and a default limit of 0 means the instance is not limited.
+func deploymentIngestionLimit(quota, orgBytes, deploymentBytes, defaultLimit int64) int64 {
+	if quota < 0 {
+		return defaultLimit
+	}
+
+	remaining := quota - orgBytes
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	limit := deploymentBytes + remaining
+	if defaultLimit != 0 && defaultLimit < limit {
+		limit = defaultLimit
+	}
+
+	// An ingestion limit of 0 means there's no limit
+	if limit == 0 {
+		limit = 1
+	}
+	return limit
+}
@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/admin/database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsagePeriodStart(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	require.Equal(t, time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC), UsagePeriodStart(time.Date(2023, time.May, 17, 12, 0, 0, 0, time.UTC)))
+	require.Equal(t, time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC), UsagePeriodStart(time.Date(2023, time.May, 1, 1, 0, 0, 0, loc)))
+}
+
+func TestQueriesSince(t *testing.T) {
+	prev := &database.DeploymentUsage{RuntimeInstanceID: "a", QueriesCounter: 10}
+	require.Equal(t, int64(10), queriesSince(nil, "a", 10, nil))
+	require.Equal(t, int64(5), queriesSince(prev, "a", 15, nil))
+	require.Equal(t, int64(0), queriesSince(prev, "a", 10, nil))
+	// The runtime restarted
+	require.Equal(t, int64(3), queriesSince(prev, "a", 3, nil))
+	// The deployment moved to another instance
+	require.Equal(t, int64(12), queriesSince(prev, "b", 12, nil))
+
+	started := time.Date(2023, time.May, 1, 0, 0, 0, 0, time.UTC)
+	restarted := started.Add(time.Hour)
+	prev = &database.DeploymentUsage{RuntimeInstanceID: "a", QueriesCounter: 10, QueriesCounterStartedOn: &started}
+	require.Equal(t, int64(5), queriesSince(prev, "a", 15, &started))
+	// The runtime restarted and has already served more queries than at the previous report
+	require.Equal(t, int64(15), queriesSince(prev, "a", 15, &restarted))
+	// The previous report didn't include the start time
+	prev.QueriesCounterStartedOn = nil
+	require.Equal(t, int64(5), queriesSince(prev, "a", 15, &restarted))
+}
+
+func TestDeploymentIngestionLimit(t *testing.T) {
+	// Not limited by the org
+	require.Equal(t, int64(500), deploymentIngestionLimit(-1, 100, 50, 500))
+	require.Equal(t, int64(0), deploymentIngestionLimit(-1, 100, 50, 0))
+	// Limited by what's left of the org's quota
+	require.Equal(t, int64(250), deploymentIngestionLimit(300, 100, 50, 500))
+	require.Equal(t, int64(250), deploymentIngestionLimit(300, 100, 50, 0))
+	// Limited by the default limit
+	require.Equal(t, int64(200), deploymentIngestionLimit(1000, 100, 50, 200))
+	// The org has used up its quota
+	require.Equal(t, int64(50), deploymentIngestionLimit(100, 150, 50, 500))
+	require.Equal(t, int64(1), deploymentIngestionLimit(100, 150, 0, 500))
+}
@@ -28,24 +28,40 @@ func (s *Service) CreateOrUpdateUser(ctx context.Context, email, name, photoURL
 		return nil, err
 	}
 
-	// Get user invites if exists
-	orgInvites, err := s.DB.FindOrganizationInvitesByEmail(ctx, email)
+	ctx, tx, err := s.DB.NewTx(ctx)
 	if err != nil {
 		return nil, err
 	}
-	projectInvites, err := s.DB.FindProjectInvitesByEmail(ctx, email)
+	defer func() { _ = tx.Rollback() }()
+
+	user, err = s.CreateUser(ctx, email, name, photoURL)
 	if err != nil {
 		return nil, err
 	}
 
-	ctx, tx, err := s.DB.NewTx(ctx)
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// CreateUser creates a new user and accepts the org and project invites sent to their email.
+// It doesn't open a transaction, so callers should call it in one.
+func (s *Service) CreateUser(ctx context.Context, email, name, photoURL string) (*database.User, error) {
+	// Get user invites if exists
+	orgInvites, err := s.DB.FindOrganizationInvitesByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	projectInvites, err := s.DB.FindProjectInvitesByEmail(ctx, email)
 	if err != nil {
 		return nil, err
 	}
-	defer func() { _ = tx.Rollback() }()
 
 	// User does not exist. Creating a new user.
-	user, err = s.DB.InsertUser(ctx, &database.InsertUserOptions{
+	user, err := s.DB.InsertUser(ctx, &database.InsertUserOptions{
 		Email:       email,
 		DisplayName: name,
 		PhotoURL:    photoURL,
@@ -78,11 +94,6 @@ func (s *Service) CreateOrUpdateUser(ctx context.Context, email, name, photoURL
 		}
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
 	return user, nil
 }
 
@@ -106,6 +117,18 @@ func (s *Service) CreateOrganizationForUser(ctx context.Context, userID, orgName
 		return nil, err
 	}
 
+	err = s.auditUserAction(ctx, userID, &database.InsertAuditLogEntryOptions{
+		OrganizationID: &org.ID,
+		Action:         AuditActionOrgCreate,
+		TargetType:     AuditTargetOrganization,
+		TargetID:       org.ID,
+		TargetName:     org.Name,
+		After:          map[string]string{"name": org.Name, "description": org.Description},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -113,7 +136,9 @@ func (s *Service) CreateOrganizationForUser(ctx context.Context, userID, orgName
 	return org, nil
 }
 
-func (s *Service) InviteUserToOrganization(ctx context.Context, email, inviterID, orgID, roleID, orgName, roleName string) error {
+// InviteUserToOrganization creates an invite for a user who hasn't signed up yet.
+// It doesn't send the invitation email, so the caller can send it with SendOrganizationInvite after committing its transaction.
+func (s *Service) InviteUserToOrganization(ctx context.Context, email, inviterID, orgID, roleID string) error {
 	// Validate email address
 	_, err := mail.ParseAddress(email)
 	if err != nil {
@@ -121,21 +146,17 @@ func (s *Service) InviteUserToOrganization(ctx context.Context, email, inviterID
 	}
 
 	// Create invite
-	err = s.DB.InsertOrganizationInvite(ctx, email, orgID, roleID, inviterID)
-	if err != nil {
-		return err
-	}
-
-	// Send invitation email
-	err = s.email.SendOrganizationInvite(email, "", orgName, roleName)
-	if err != nil {
-		return err
-	}
+	return s.DB.InsertOrganizationInvite(ctx, email, orgID, roleID, inviterID)
+}
 
-	return nil
+// SendOrganizationInvite sends the invitation email for an invite created with InviteUserToOrganization.
+func (s *Service) SendOrganizationInvite(email, orgName, roleName string) error {
+	return s.email.SendOrganizationInvite(email, "", orgName, roleName)
 }
 
-func (s *Service) InviteUserToProject(ctx context.Context, email, inviterID, projectID, roleID, projectName, roleName string) error {
+// InviteUserToProject creates an invite for a user who hasn't signed up yet.
+// It doesn't send the invitation email, so the caller can send it with SendProjectInvite after committing its transaction.
+func (s *Service) InviteUserToProject(ctx context.Context, email, inviterID, projectID, roleID string) error {
 	// Validate email address
 	_, err := mail.ParseAddress(email)
 	if err != nil {
@@ -143,18 +164,12 @@ func (s *Service) InviteUserToProject(ctx context.Context, email, inviterID, pro
 	}
 
 	// Create invite
-	err = s.DB.InsertProjectInvite(ctx, email, projectID, roleID, inviterID)
-	if err != nil {
-		return err
-	}
-
-	// Send invitation email
-	err = s.email.SendProjectInvite(email, "", projectName, roleName)
-	if err != nil {
-		return err
-	}
+	return s.DB.InsertProjectInvite(ctx, email, projectID, roleID, inviterID)
+}
 
-	return nil
+// SendProjectInvite sends the invitation email for an invite created with InviteUserToProject.
+func (s *Service) SendProjectInvite(email, projectName, roleName string) error {
+	return s.email.SendProjectInvite(email, "", projectName, roleName)
 }
 
 func (s *Service) prepareOrganization(ctx context.Context, orgID, userID string) (*database.Organization, error) {
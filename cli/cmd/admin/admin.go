@@ -14,5 +14,7 @@ func AdminCmd(cfg *config.Config) *cobra.Command {
 	}
 	adminCmd.AddCommand(StartCmd(cfg))
 	adminCmd.AddCommand(PingCmd(cfg))
+	adminCmd.AddCommand(RuntimesCmd(cfg))
+	adminCmd.AddCommand(QuotasCmd(cfg))
 	return adminCmd
 }
@@ -0,0 +1,99 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/c2h5oh/datasize"
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// QuotasCmd manages the quotas of orgs on an admin server
+func QuotasCmd(cfg *config.Config) *cobra.Command {
+	quotasCmd := &cobra.Command{
+		Use:   "quotas",
+		Short: "Manage the quotas of organizations",
+	}
+	quotasCmd.AddCommand(SetQuotasCmd(cfg))
+	return quotasCmd
+}
+
+func SetQuotasCmd(cfg *config.Config) *cobra.Command {
+	var projects, slots int32
+	var queries int64
+	var ingested string
+
+	setCmd := &cobra.Command{
+		Use:   "set <org>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Set the quotas of an organization",
+		Long:  "Set the quotas of an organization. Quotas that are not passed are left unchanged, and a negative quota removes the limit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.GetOrganizationUsage(cmd.Context(), &adminv1.GetOrganizationUsageRequest{Organization: args[0]})
+			if err != nil {
+				return err
+			}
+
+			quotas := res.Quotas
+			if cmd.Flags().Changed("projects") {
+				quotas.Projects = projects
+			}
+			if cmd.Flags().Changed("slots") {
+				quotas.SlotsTotal = slots
+			}
+			if cmd.Flags().Changed("ingested-bytes") {
+				quotas.IngestedBytes, err = parseBytesQuota(ingested)
+				if err != nil {
+					return fmt.Errorf("invalid --ingested-bytes: %w", err)
+				}
+			}
+			if cmd.Flags().Changed("queries-per-month") {
+				quotas.QueriesPerMonth = queries
+			}
+
+			_, err = client.UpdateOrganizationQuotas(cmd.Context(), &adminv1.UpdateOrganizationQuotasRequest{
+				Organization: args[0],
+				Quotas:       quotas,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Updated quotas of org %q", args[0]))
+			return nil
+		},
+	}
+	setCmd.Flags().SortFlags = false
+	setCmd.Flags().Int32Var(&projects, "projects", -1, "Max number of projects")
+	setCmd.Flags().Int32Var(&slots, "slots", -1, "Max total slots of deployments, including preview deployments")
+	setCmd.Flags().StringVar(&ingested, "ingested-bytes", "-1", "Max data ingested across all deployments (e.g. 100GB)")
+	setCmd.Flags().Int64Var(&queries, "queries-per-month", -1, "Max number of queries per calendar month")
+
+	return setCmd
+}
+
+// parseBytesQuota parses a number of bytes or a size like 10GB. A negative number means no limit.
+func parseBytesQuota(s string) (int64, error) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		if n < 0 {
+			return -1, nil
+		}
+		return n, nil
+	}
+
+	var size datasize.ByteSize
+	err := size.UnmarshalText([]byte(s))
+	if err != nil {
+		return 0, err
+	}
+	return int64(size.Bytes()), nil
+}
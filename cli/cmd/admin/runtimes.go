@@ -0,0 +1,128 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// RuntimesCmd manages the runtimes registered with the dynamic provisioner of an admin server
+func RuntimesCmd(cfg *config.Config) *cobra.Command {
+	runtimesCmd := &cobra.Command{
+		Use:   "runtimes",
+		Short: "Manage runtimes registered with the admin server",
+	}
+	runtimesCmd.AddCommand(ListRuntimesCmd(cfg))
+	runtimesCmd.AddCommand(DrainRuntimeCmd(cfg))
+	runtimesCmd.AddCommand(UncordonRuntimeCmd(cfg))
+	return runtimesCmd
+}
+
+func ListRuntimesCmd(cfg *config.Config) *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Args:  cobra.NoArgs,
+		Short: "List runtimes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListRuntimes(cmd.Context(), &adminv1.ListRuntimesRequest{})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Runtimes) == 0 {
+				cmdutil.WarnPrinter("No runtimes found")
+				return nil
+			}
+
+			cmdutil.SuccessPrinter("Runtimes list \n")
+			cmdutil.TablePrinter(toRuntimeTable(res.Runtimes))
+			return nil
+		},
+	}
+
+	return listCmd
+}
+
+func DrainRuntimeCmd(cfg *config.Config) *cobra.Command {
+	drainCmd := &cobra.Command{
+		Use:   "drain <host>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Stop provisioning on a runtime and migrate its deployments to other runtimes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.DrainRuntime(cmd.Context(), &adminv1.DrainRuntimeRequest{Host: args[0]})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Draining runtime %q, its deployments are migrated in the background", args[0]))
+			return nil
+		},
+	}
+
+	return drainCmd
+}
+
+func UncordonRuntimeCmd(cfg *config.Config) *cobra.Command {
+	uncordonCmd := &cobra.Command{
+		Use:   "uncordon <host>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Allow provisioning on a drained runtime again",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.UncordonRuntime(cmd.Context(), &adminv1.UncordonRuntimeRequest{Host: args[0]})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Uncordoned runtime %q", args[0]))
+			return nil
+		},
+	}
+
+	return uncordonCmd
+}
+
+func toRuntimeTable(rts []*adminv1.Runtime) []*runtime {
+	rows := make([]*runtime, 0, len(rts))
+	for _, rt := range rts {
+		rows = append(rows, &runtime{
+			Host:          rt.Host,
+			Region:        rt.Region,
+			Slots:         fmt.Sprintf("%d/%d", rt.SlotsUsed, rt.Slots),
+			Status:        strings.TrimPrefix(rt.Status.String(), "RUNTIME_STATUS_"),
+			Draining:      rt.Draining,
+			LastHeartbeat: rt.LastHeartbeatOn.AsTime().Local().Format("2006-01-02 15:04:05"),
+		})
+	}
+	return rows
+}
+
+type runtime struct {
+	Host          string `header:"host" json:"host"`
+	Region        string `header:"region" json:"region"`
+	Slots         string `header:"slots" json:"slots"`
+	Status        string `header:"status" json:"status"`
+	Draining      bool   `header:"draining" json:"draining"`
+	LastHeartbeat string `header:"last heartbeat" json:"last_heartbeat"`
+}
@@ -50,6 +50,10 @@ type Config struct {
 	GithubClientID         string                 `split_words:"true"`
 	GithubClientSecret     string                 `split_words:"true"`
 	ProvisionerSpec        string                 `split_words:"true"`
+	RuntimeSecret          string                 `split_words:"true"`
+	Superusers             []string               `split_words:"true"`
+	JobWorkers             int                    `default:"4" split_words:"true"`
+	PreviewTTL             time.Duration          `default:"168h" split_words:"true"`
 	SigningJWKS            string                 `split_words:"true"`
 	SigningKeyID           string                 `split_words:"true"`
 	EmailSMTPHost          string                 `split_words:"true"`
@@ -140,6 +144,9 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 				GithubAppID:         conf.GithubAppID,
 				GithubAppPrivateKey: conf.GithubAppPrivateKey,
 				ProvisionerSpec:     conf.ProvisionerSpec,
+				JobWorkers:          conf.JobWorkers,
+				FrontendURL:         conf.FrontendURL,
+				PreviewTTL:          conf.PreviewTTL,
 			}
 			adm, err := admin.New(cmd.Context(), admOpts, logger, issuer, emailClient)
 			if err != nil {
@@ -173,6 +180,8 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 				GithubAppWebhookSecret: conf.GithubAppWebhookSecret,
 				GithubClientID:         conf.GithubClientID,
 				GithubClientSecret:     conf.GithubClientSecret,
+				RuntimeSecret:          conf.RuntimeSecret,
+				SuperuserEmails:        conf.Superusers,
 			}
 			srv, err := server.New(srvOpts, logger, adm, issuer)
 			if err != nil {
@@ -191,6 +191,31 @@ func OrgExists(ctx context.Context, c *client.Client, name string) (bool, error)
 	return true, nil
 }
 
+// WaitForJob polls a background job of the admin service until it has succeeded or failed.
+// It returns an error if the job failed.
+func WaitForJob(ctx context.Context, c *client.Client, job *adminv1.Job) error {
+	for {
+		switch job.Status {
+		case adminv1.JobStatus_JOB_STATUS_SUCCEEDED:
+			return nil
+		case adminv1.JobStatus_JOB_STATUS_FAILED:
+			return fmt.Errorf("job failed: %s", job.Error)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+
+		res, err := c.GetJob(ctx, &adminv1.GetJobRequest{Id: job.Id})
+		if err != nil {
+			return err
+		}
+		job = res.Job
+	}
+}
+
 func WarnPrinter(str string) {
 	boldYellow := color.New(color.FgYellow).Add(color.Bold)
 	boldYellow.Fprintln(color.Output, str)
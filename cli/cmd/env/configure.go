@@ -18,7 +18,7 @@ import (
 )
 
 func ConfigureCmd(cfg *config.Config) *cobra.Command {
-	var projectPath, projectName string
+	var projectPath, projectName, environment string
 
 	configureCommand := &cobra.Command{
 		Use:   "configure",
@@ -83,6 +83,7 @@ func ConfigureCmd(cfg *config.Config) *cobra.Command {
 			varResp, err := client.GetProjectVariables(ctx, &adminv1.GetProjectVariablesRequest{
 				OrganizationName: cfg.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to list existing variables %w", err)
@@ -100,6 +101,7 @@ func ConfigureCmd(cfg *config.Config) *cobra.Command {
 			_, err = client.UpdateProjectVariables(ctx, &adminv1.UpdateProjectVariablesRequest{
 				OrganizationName: cfg.Org,
 				Name:             projectName,
+				Environment:      environment,
 				Variables:        varResp.Variables,
 			})
 			if err != nil {
@@ -114,6 +116,7 @@ func ConfigureCmd(cfg *config.Config) *cobra.Command {
 	configureCommand.Flags().SortFlags = false
 	configureCommand.Flags().StringVar(&projectPath, "path", ".", "Project directory")
 	configureCommand.Flags().StringVar(&projectName, "project", "", "")
+	configureCommand.Flags().StringVar(&environment, "environment", "prod", "Environment")
 
 	return configureCommand
 }
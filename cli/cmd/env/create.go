@@ -0,0 +1,55 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// CreateCmd is sub command for env. Creates an environment of a project and deploys it
+func CreateCmd(cfg *config.Config) *cobra.Command {
+	var projectName, branch, dbDriver, dbDSN string
+	var slots int
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			resp, err := client.CreateEnvironment(cmd.Context(), &adminv1.CreateEnvironmentRequest{
+				OrganizationName: cfg.Org,
+				ProjectName:      projectName,
+				Name:             args[0],
+				Branch:           branch,
+				OlapDriver:       dbDriver,
+				OlapDsn:          dbDSN,
+				Slots:            int64(slots),
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Created environment %q\n", resp.Environment.Name))
+			fmt.Printf("Set its variables with \"rill env configure --project %s --environment %s\"\n", projectName, resp.Environment.Name)
+			return nil
+		},
+	}
+
+	createCmd.Flags().SortFlags = false
+	createCmd.Flags().StringVar(&projectName, "project", "", "")
+	createCmd.Flags().StringVar(&branch, "branch", "", "Git branch to deploy from")
+	createCmd.Flags().IntVar(&slots, "slots", 0, "Slots to allocate (default: the slots of prod)")
+	createCmd.Flags().StringVar(&dbDriver, "db-driver", "", "Database driver (default: the database driver of prod)")
+	createCmd.Flags().StringVar(&dbDSN, "db-dsn", "", "Database driver configuration")
+	_ = createCmd.MarkFlagRequired("project")
+	_ = createCmd.MarkFlagRequired("branch")
+	return createCmd
+}
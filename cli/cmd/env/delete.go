@@ -0,0 +1,54 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCmd is sub command for env. Deletes an environment of a project
+func DeleteCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+	var force bool
+	deleteCmd := &cobra.Command{
+		Use:   "delete <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if !force {
+				msg := fmt.Sprintf("Do you want to delete environment %q and tear down its deployment?", name)
+				if !cmdutil.ConfirmPrompt(msg, "", false) {
+					return nil
+				}
+			}
+
+			_, err = client.DeleteEnvironment(cmd.Context(), &adminv1.DeleteEnvironmentRequest{
+				OrganizationName: cfg.Org,
+				ProjectName:      projectName,
+				Name:             name,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Deleted environment %q\n", name))
+			return nil
+		},
+	}
+
+	deleteCmd.Flags().SortFlags = false
+	deleteCmd.Flags().StringVar(&projectName, "project", "", "")
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Delete forcefully, skips the confirmation")
+	_ = deleteCmd.MarkFlagRequired("project")
+	return deleteCmd
+}
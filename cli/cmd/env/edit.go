@@ -0,0 +1,47 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// EditCmd is sub command for env. Changes the branch an environment is deployed from
+func EditCmd(cfg *config.Config) *cobra.Command {
+	var projectName, branch string
+	editCmd := &cobra.Command{
+		Use:   "edit <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Change the branch of an environment",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			resp, err := client.UpdateEnvironment(cmd.Context(), &adminv1.UpdateEnvironmentRequest{
+				OrganizationName: cfg.Org,
+				ProjectName:      projectName,
+				Name:             args[0],
+				Branch:           branch,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Deploying environment %q from branch %q\n", resp.Environment.Name, resp.Environment.Branch))
+			return nil
+		},
+	}
+
+	editCmd.Flags().SortFlags = false
+	editCmd.Flags().StringVar(&projectName, "project", "", "")
+	editCmd.Flags().StringVar(&branch, "branch", "", "Git branch to deploy from")
+	_ = editCmd.MarkFlagRequired("project")
+	_ = editCmd.MarkFlagRequired("branch")
+	return editCmd
+}
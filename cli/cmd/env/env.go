@@ -9,7 +9,7 @@ import (
 func EnvCmd(cfg *config.Config) *cobra.Command {
 	envCmd := &cobra.Command{
 		Use:               "env",
-		Short:             "Manage variables for a project",
+		Short:             "Manage environments and their variables for a project",
 		Hidden:            !cfg.IsDev(),
 		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
 	}
@@ -17,5 +17,10 @@ func EnvCmd(cfg *config.Config) *cobra.Command {
 	envCmd.AddCommand(SetCmd(cfg))
 	envCmd.AddCommand(RmCmd(cfg))
 	envCmd.AddCommand(ShowEnvCmd(cfg))
+	envCmd.AddCommand(ListCmd(cfg))
+	envCmd.AddCommand(CreateCmd(cfg))
+	envCmd.AddCommand(EditCmd(cfg))
+	envCmd.AddCommand(DeleteCmd(cfg))
+	envCmd.AddCommand(PromoteCmd(cfg))
 	return envCmd
 }
@@ -0,0 +1,65 @@
+package env
+
+import (
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd is sub command for env. Lists the environments of a project
+func ListCmd(cfg *config.Config) *cobra.Command {
+	var projectName string
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List environments",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			resp, err := client.ListEnvironments(cmd.Context(), &adminv1.ListEnvironmentsRequest{
+				OrganizationName: cfg.Org,
+				ProjectName:      projectName,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter("Environments list \n")
+			cmdutil.TablePrinter(toTable(resp.Environments))
+			return nil
+		},
+	}
+	listCmd.Flags().StringVar(&projectName, "project", "", "")
+	_ = listCmd.MarkFlagRequired("project")
+	return listCmd
+}
+
+func toTable(envs []*adminv1.Environment) []*environment {
+	rows := make([]*environment, 0, len(envs))
+	for _, env := range envs {
+		rows = append(rows, toRow(env))
+	}
+	return rows
+}
+
+func toRow(e *adminv1.Environment) *environment {
+	return &environment{
+		Name:   e.Name,
+		Branch: e.Branch,
+		Commit: e.Commit,
+		Status: e.Deployment.GetStatus().String(),
+		Slots:  e.Slots,
+	}
+}
+
+type environment struct {
+	Name   string `header:"name" json:"name"`
+	Branch string `header:"branch" json:"branch"`
+	Commit string `header:"commit" json:"commit"`
+	Status string `header:"status" json:"status"`
+	Slots  int64  `header:"slots" json:"slots"`
+}
@@ -0,0 +1,48 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// PromoteCmd is sub command for env. Deploys the commit of an environment to another environment
+func PromoteCmd(cfg *config.Config) *cobra.Command {
+	var projectName, target string
+	promoteCmd := &cobra.Command{
+		Use:   "promote <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Deploy the commit of an environment to another environment",
+		Long: "Deploys the commit of an environment to another environment, which stays on that commit until it's promoted again.\n" +
+			"Use \"rill env edit\" to deploy the environment from the head of a branch again.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			resp, err := client.PromoteEnvironment(cmd.Context(), &adminv1.PromoteEnvironmentRequest{
+				OrganizationName: cfg.Org,
+				ProjectName:      projectName,
+				Name:             args[0],
+				Target:           target,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Deploying commit %s to environment %q\n", resp.Environment.Commit, resp.Environment.Name))
+			return nil
+		},
+	}
+
+	promoteCmd.Flags().SortFlags = false
+	promoteCmd.Flags().StringVar(&projectName, "project", "", "")
+	promoteCmd.Flags().StringVar(&target, "to", "prod", "Environment to deploy to")
+	_ = promoteCmd.MarkFlagRequired("project")
+	return promoteCmd
+}
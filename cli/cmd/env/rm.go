@@ -9,7 +9,7 @@ import (
 
 // RmCmd is sub command for env. Removes the variable for a project
 func RmCmd(cfg *config.Config) *cobra.Command {
-	var projectName string
+	var projectName, environment string
 	rmCmd := &cobra.Command{
 		Use:   "rm <key>",
 		Args:  cobra.ExactArgs(1),
@@ -26,6 +26,7 @@ func RmCmd(cfg *config.Config) *cobra.Command {
 			resp, err := client.GetProjectVariables(ctx, &adminv1.GetProjectVariablesRequest{
 				OrganizationName: cfg.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -39,6 +40,7 @@ func RmCmd(cfg *config.Config) *cobra.Command {
 			_, err = client.UpdateProjectVariables(ctx, &adminv1.UpdateProjectVariablesRequest{
 				OrganizationName: cfg.Org,
 				Name:             projectName,
+				Environment:      environment,
 				Variables:        resp.Variables,
 			})
 			if err != nil {
@@ -50,6 +52,7 @@ func RmCmd(cfg *config.Config) *cobra.Command {
 		},
 	}
 	rmCmd.Flags().StringVar(&projectName, "project", "", "")
+	rmCmd.Flags().StringVar(&environment, "environment", "prod", "Environment")
 	_ = rmCmd.MarkFlagRequired("project")
 	return rmCmd
 }
@@ -9,7 +9,7 @@ import (
 
 // SetCmd is sub command for env. Sets the variable for a project
 func SetCmd(cfg *config.Config) *cobra.Command {
-	var projectName string
+	var projectName, environment string
 	setCmd := &cobra.Command{
 		Use:   "set <key> <value>",
 		Args:  cobra.ExactArgs(2),
@@ -27,6 +27,7 @@ func SetCmd(cfg *config.Config) *cobra.Command {
 			resp, err := client.GetProjectVariables(ctx, &adminv1.GetProjectVariablesRequest{
 				OrganizationName: cfg.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -43,6 +44,7 @@ func SetCmd(cfg *config.Config) *cobra.Command {
 			_, err = client.UpdateProjectVariables(ctx, &adminv1.UpdateProjectVariablesRequest{
 				OrganizationName: cfg.Org,
 				Name:             projectName,
+				Environment:      environment,
 				Variables:        resp.Variables,
 			})
 			if err != nil {
@@ -55,6 +57,7 @@ func SetCmd(cfg *config.Config) *cobra.Command {
 	}
 
 	setCmd.Flags().StringVar(&projectName, "project", "", "")
+	setCmd.Flags().StringVar(&environment, "environment", "prod", "Environment")
 	_ = setCmd.MarkFlagRequired("project")
 	return setCmd
 }
@@ -12,7 +12,7 @@ import (
 )
 
 func ShowEnvCmd(cfg *config.Config) *cobra.Command {
-	var projectName string
+	var projectName, environment string
 	showCmd := &cobra.Command{
 		Use:   "show",
 		Short: "Show credentials and other variables",
@@ -26,6 +26,7 @@ func ShowEnvCmd(cfg *config.Config) *cobra.Command {
 			resp, err := client.GetProjectVariables(cmd.Context(), &adminv1.GetProjectVariablesRequest{
 				OrganizationName: cfg.Org,
 				Name:             projectName,
+				Environment:      environment,
 			})
 			if err != nil {
 				return err
@@ -36,6 +37,7 @@ func ShowEnvCmd(cfg *config.Config) *cobra.Command {
 		},
 	}
 	showCmd.Flags().StringVar(&projectName, "project", "", "")
+	showCmd.Flags().StringVar(&environment, "environment", "prod", "Environment")
 	_ = showCmd.MarkFlagRequired("project")
 	return showCmd
 }
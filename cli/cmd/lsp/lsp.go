@@ -0,0 +1,56 @@
+package lsp
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rilldata/rill/cli/pkg/config"
+	"github.com/rilldata/rill/cli/pkg/local"
+	"github.com/rilldata/rill/cli/pkg/lsp"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/spf13/cobra"
+)
+
+func LSPCmd(cfg *config.Config) *cobra.Command {
+	var projectPath string
+	var olapDriver string
+	var olapDSN string
+	var verbose bool
+	var variables []string
+	var profile string
+
+	lspCmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Start a language server for the project files on stdin and stdout",
+		Long: `Start a language server for the project files, which editors talk to over stdin and stdout using the Language Server Protocol.
+It builds the project and rebuilds it when files are saved, so it can't share a database with "rill start".`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if profile != drivers.ProfileDev && profile != drivers.ProfileProd {
+				return fmt.Errorf("invalid profile %q", profile)
+			}
+
+			// stdout is used for the protocol, so the logs are written as JSON to stderr
+			app, err := local.NewApp(cmd.Context(), cfg.Version, verbose, olapDriver, olapDSN, projectPath, local.LogFormatJSON, variables, profile)
+			if err != nil {
+				return err
+			}
+			defer app.Close()
+
+			if !app.IsProjectInit() {
+				return fmt.Errorf("not a valid Rill project")
+			}
+
+			server := lsp.NewServer(app.Runtime, app.Instance.ID, app.ProjectPath, cfg.Version.String(), app.BaseLogger)
+			return server.Serve(cmd.Context(), os.Stdin, os.Stdout)
+		},
+	}
+	lspCmd.Flags().SortFlags = false
+	lspCmd.Flags().StringVar(&projectPath, "project", ".", "Project directory")
+	lspCmd.Flags().StringVar(&olapDSN, "db", local.DefaultOLAPDSN, "Database DSN")
+	lspCmd.Flags().StringVar(&olapDriver, "db-driver", local.DefaultOLAPDriver, "Database driver")
+	lspCmd.Flags().BoolVar(&verbose, "verbose", false, "Sets the log level to debug")
+	lspCmd.Flags().StringSliceVarP(&variables, "env", "e", []string{}, "Set project variables")
+	lspCmd.Flags().StringVar(&profile, "profile", local.DefaultProfile, "Profile for model settings in rill.yaml and model files (options: \"dev\", \"prod\")")
+
+	return lspCmd
+}
@@ -0,0 +1,146 @@
+package org
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditCmd lists the audit log of administrative actions in an org
+func AuditCmd(cfg *config.Config) *cobra.Command {
+	var orgName, project, actor, since, until string
+	var limit uint32
+
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Args:  cobra.NoArgs,
+		Short: "Show the audit log",
+		Long:  "Show the audit log of administrative actions in an org, newest first. Requires admin permissions on the org.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			req := &adminv1.ListAuditLogEntriesRequest{
+				Organization: orgName,
+				Project:      project,
+				Actor:        actor,
+				PageSize:     limit,
+			}
+			if since != "" {
+				req.After, err = parseAuditTime(since)
+				if err != nil {
+					return fmt.Errorf("invalid --since: %w", err)
+				}
+			}
+			if until != "" {
+				req.Before, err = parseAuditTime(until)
+				if err != nil {
+					return fmt.Errorf("invalid --until: %w", err)
+				}
+			}
+
+			res, err := client.ListAuditLogEntries(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			if len(res.Entries) == 0 {
+				cmdutil.WarnPrinter("No audit log entries found")
+				return nil
+			}
+
+			cmdutil.SuccessPrinter("Audit log \n")
+			cmdutil.TablePrinter(toAuditTable(res.Entries))
+			return nil
+		},
+	}
+	auditCmd.Flags().SortFlags = false
+	auditCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization name")
+	auditCmd.Flags().StringVar(&project, "project", "", "Only show actions in this project")
+	auditCmd.Flags().StringVar(&actor, "actor", "", "Only show actions by this user email or service account name")
+	auditCmd.Flags().StringVar(&since, "since", "", "Only show actions after this time (RFC3339 timestamp or duration ago, e.g. 24h)")
+	auditCmd.Flags().StringVar(&until, "until", "", "Only show actions before this time (RFC3339 timestamp or duration ago, e.g. 1h)")
+	auditCmd.Flags().Uint32Var(&limit, "limit", 100, "Maximum number of entries to show")
+
+	return auditCmd
+}
+
+// parseAuditTime parses a RFC3339 timestamp, or a duration that's subtracted from the current time
+func parseAuditTime(s string) (*timestamppb.Timestamp, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return timestamppb.New(time.Now().Add(-d)), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, fmt.Errorf("expected a RFC3339 timestamp or a duration")
+	}
+	return timestamppb.New(t), nil
+}
+
+func toAuditTable(entries []*adminv1.AuditLogEntry) []*auditLogEntry {
+	rows := make([]*auditLogEntry, 0, len(entries))
+	for _, e := range entries {
+		actor := e.ActorName
+		if actor == "" {
+			actor = e.ActorType
+		}
+
+		target := e.TargetName
+		if target == "" {
+			target = e.TargetId
+		}
+
+		rows = append(rows, &auditLogEntry{
+			Time:    e.CreatedOn.AsTime().Local().Format(time.RFC1123),
+			Actor:   actor,
+			Action:  e.Action,
+			Target:  fmt.Sprintf("%s %s", e.TargetType, target),
+			Project: e.Project,
+			Changes: auditChanges(e.Before, e.After),
+		})
+	}
+	return rows
+}
+
+// auditChanges formats the fields changed by an action, e.g. `role: viewer -> admin`
+func auditChanges(before, after map[string]string) string {
+	keys := make([]string, 0, len(after))
+	for k := range after {
+		keys = append(keys, k)
+	}
+	for k := range before {
+		if _, ok := after[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	changes := make([]string, len(keys))
+	for i, k := range keys {
+		if b, ok := before[k]; ok {
+			changes[i] = fmt.Sprintf("%s: %q -> %q", k, b, after[k])
+		} else {
+			changes[i] = fmt.Sprintf("%s: %q", k, after[k])
+		}
+	}
+	return strings.Join(changes, ", ")
+}
+
+type auditLogEntry struct {
+	Time    string `header:"time" json:"time"`
+	Actor   string `header:"actor" json:"actor"`
+	Action  string `header:"action" json:"action"`
+	Target  string `header:"target" json:"target"`
+	Project string `header:"project" json:"project"`
+	Changes string `header:"changes" json:"changes"`
+}
@@ -57,7 +57,13 @@ func DeleteCmd(cfg *config.Config) *cobra.Command {
 			}
 
 			for _, proj := range projects {
-				_, err := client.DeleteProject(context.Background(), &adminv1.DeleteProjectRequest{OrganizationName: args[0], Name: proj})
+				res, err := client.DeleteProject(context.Background(), &adminv1.DeleteProjectRequest{OrganizationName: args[0], Name: proj})
+				if err != nil {
+					return err
+				}
+
+				// The org can only be deleted after the project is torn down
+				err = cmdutil.WaitForJob(cmd.Context(), client, res.Job)
 				if err != nil {
 					return err
 				}
@@ -22,6 +22,9 @@ func OrgCmd(cfg *config.Config) *cobra.Command {
 	orgCmd.AddCommand(ListCmd(cfg))
 	orgCmd.AddCommand(DeleteCmd(cfg))
 	orgCmd.AddCommand(RenameCmd(cfg))
+	orgCmd.AddCommand(AuditCmd(cfg))
+	orgCmd.AddCommand(UsageCmd(cfg))
+	orgCmd.AddCommand(SSOCmd(cfg))
 
 	return orgCmd
 }
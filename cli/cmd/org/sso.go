@@ -0,0 +1,265 @@
+package org
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// SSOCmd manages the OIDC identity provider of an org and the mappings of its groups to usergroups
+func SSOCmd(cfg *config.Config) *cobra.Command {
+	ssoCmd := &cobra.Command{
+		Use:   "sso",
+		Short: "Manage single sign-on",
+	}
+	ssoCmd.AddCommand(SSOShowCmd(cfg))
+	ssoCmd.AddCommand(SSOSetCmd(cfg))
+	ssoCmd.AddCommand(SSODeleteCmd(cfg))
+	ssoCmd.AddCommand(SSOAddGroupCmd(cfg))
+	ssoCmd.AddCommand(SSORemoveGroupCmd(cfg))
+	return ssoCmd
+}
+
+func SSOShowCmd(cfg *config.Config) *cobra.Command {
+	var orgName string
+
+	showCmd := &cobra.Command{
+		Use:   "show",
+		Args:  cobra.NoArgs,
+		Short: "Show the identity provider and group mappings",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.GetOrganizationSSO(cmd.Context(), &adminv1.GetOrganizationSSORequest{Organization: orgName})
+			if err != nil {
+				return err
+			}
+
+			if res.Sso == nil {
+				cmdutil.WarnPrinter(fmt.Sprintf("Org %q doesn't have an identity provider", orgName))
+				fmt.Printf("Before setting one, add a DNS TXT record with %q to each of your email domains\n", res.VerificationRecord)
+				return nil
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Identity provider of org %q \n", orgName))
+			fmt.Printf("Issuer URL:    %s\n", res.Sso.IssuerUrl)
+			fmt.Printf("Client ID:     %s\n", res.Sso.ClientId)
+			fmt.Printf("Groups claim:  %s\n", res.Sso.GroupsClaim)
+			fmt.Printf("Domains:       %s\n", strings.Join(res.Sso.Domains, ", "))
+			fmt.Printf("Redirect URL:  %s\n", res.Sso.RedirectUrl)
+			fmt.Printf("DNS record:    %s\n", res.VerificationRecord)
+
+			if len(res.Groups) == 0 {
+				cmdutil.WarnPrinter("No groups are mapped to usergroups")
+				return nil
+			}
+
+			fmt.Println()
+			cmdutil.TablePrinter(toSSOGroupTable(res.Groups))
+			return nil
+		},
+	}
+	showCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization name")
+
+	return showCmd
+}
+
+func SSOSetCmd(cfg *config.Config) *cobra.Command {
+	var orgName, issuerURL, clientID, clientSecret, groupsClaim string
+	var domains []string
+
+	setCmd := &cobra.Command{
+		Use:   "set",
+		Args:  cobra.NoArgs,
+		Short: "Set the identity provider",
+		Long: "Set the OIDC identity provider that users with an email address in one of the domains sign in with.\n" +
+			"Each domain must have the DNS TXT record shown by \"rill org sso show\", and the redirect URL must be registered with the identity provider.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.SetOrganizationSSO(cmd.Context(), &adminv1.SetOrganizationSSORequest{
+				Organization: orgName,
+				IssuerUrl:    issuerURL,
+				ClientId:     clientID,
+				ClientSecret: clientSecret,
+				GroupsClaim:  groupsClaim,
+				Domains:      domains,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Users in %s now sign in with %s", strings.Join(res.Sso.Domains, ", "), res.Sso.IssuerUrl))
+			fmt.Printf("Register this redirect URL with the identity provider: %s\n", res.Sso.RedirectUrl)
+			return nil
+		},
+	}
+	setCmd.Flags().SortFlags = false
+	setCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization name")
+	setCmd.Flags().StringVar(&issuerURL, "issuer-url", "", "OIDC issuer URL")
+	setCmd.Flags().StringVar(&clientID, "client-id", "", "OAuth client ID")
+	setCmd.Flags().StringVar(&clientSecret, "client-secret", "", "OAuth client secret (keeps the current secret if not passed)")
+	setCmd.Flags().StringVar(&groupsClaim, "groups-claim", "groups", "ID token claim with the user's groups")
+	setCmd.Flags().StringSliceVar(&domains, "domain", nil, "Email domain to route to the identity provider (can be repeated)")
+	_ = setCmd.MarkFlagRequired("issuer-url")
+	_ = setCmd.MarkFlagRequired("client-id")
+	_ = setCmd.MarkFlagRequired("domain")
+
+	return setCmd
+}
+
+func SSODeleteCmd(cfg *config.Config) *cobra.Command {
+	var orgName string
+	var force bool
+
+	deleteCmd := &cobra.Command{
+		Use:   "delete",
+		Args:  cobra.NoArgs,
+		Short: "Remove the identity provider",
+		Long:  "Remove the identity provider. Users sign in with the default identity provider again, and the usergroups mapped to groups are deleted.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if !force {
+				msg := fmt.Sprintf("Enter %q to confirm removing the identity provider", orgName)
+				name, err := cmdutil.InputPrompt(msg, "")
+				if err != nil {
+					return err
+				}
+				if name != orgName {
+					return fmt.Errorf("Entered incorrect name : %s", name)
+				}
+			}
+
+			_, err = client.DeleteOrganizationSSO(cmd.Context(), &adminv1.DeleteOrganizationSSORequest{Organization: orgName})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Removed the identity provider of org %q", orgName))
+			return nil
+		},
+	}
+	deleteCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization name")
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Delete forcefully, skips the confirmation")
+
+	return deleteCmd
+}
+
+func SSOAddGroupCmd(cfg *config.Config) *cobra.Command {
+	var orgName, usergroup, role string
+	var projectRoles map[string]string
+
+	addCmd := &cobra.Command{
+		Use:   "add-group <idp-group>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Map a group of the identity provider to a usergroup",
+		Long:  "Create a usergroup with an org role and project roles. Users in the group of the identity provider are added to the usergroup when they sign in.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if usergroup == "" {
+				usergroup = args[0]
+			}
+
+			res, err := client.AddOrganizationSSOGroup(cmd.Context(), &adminv1.AddOrganizationSSOGroupRequest{
+				Organization: orgName,
+				IdpGroup:     args[0],
+				Usergroup:    usergroup,
+				OrgRole:      role,
+				ProjectRoles: projectRoles,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Mapped group %q to usergroup %q", res.Group.IdpGroup, res.Group.Usergroup))
+			return nil
+		},
+	}
+	addCmd.Flags().SortFlags = false
+	addCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization name")
+	addCmd.Flags().StringVar(&usergroup, "usergroup", "", "Name of the usergroup (defaults to the name of the group)")
+	addCmd.Flags().StringVar(&role, "role", "", "Org role of the usergroup")
+	addCmd.Flags().StringToStringVar(&projectRoles, "project-role", nil, "Project role of the usergroup as <project>=<role> (can be repeated)")
+
+	return addCmd
+}
+
+func SSORemoveGroupCmd(cfg *config.Config) *cobra.Command {
+	var orgName string
+
+	removeCmd := &cobra.Command{
+		Use:   "remove-group <idp-group>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the usergroup mapped to a group of the identity provider",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.RemoveOrganizationSSOGroup(cmd.Context(), &adminv1.RemoveOrganizationSSOGroupRequest{
+				Organization: orgName,
+				IdpGroup:     args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Removed the usergroup mapped to group %q", args[0]))
+			return nil
+		},
+	}
+	removeCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization name")
+
+	return removeCmd
+}
+
+func toSSOGroupTable(groups []*adminv1.SSOGroupMapping) []*ssoGroup {
+	rows := make([]*ssoGroup, 0, len(groups))
+	for _, g := range groups {
+		projects := make([]string, 0, len(g.ProjectRoles))
+		for name, role := range g.ProjectRoles {
+			projects = append(projects, fmt.Sprintf("%s=%s", name, role))
+		}
+		sort.Strings(projects)
+
+		rows = append(rows, &ssoGroup{
+			IDPGroup:     g.IdpGroup,
+			Usergroup:    g.Usergroup,
+			OrgRole:      g.OrgRole,
+			ProjectRoles: strings.Join(projects, ", "),
+		})
+	}
+	return rows
+}
+
+type ssoGroup struct {
+	IDPGroup     string `header:"group" json:"idp_group"`
+	Usergroup    string `header:"usergroup" json:"usergroup"`
+	OrgRole      string `header:"org role" json:"org_role"`
+	ProjectRoles string `header:"project roles" json:"project_roles"`
+}
@@ -0,0 +1,75 @@
+package org
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/c2h5oh/datasize"
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// UsageCmd shows the usage and quotas of an org
+func UsageCmd(cfg *config.Config) *cobra.Command {
+	var orgName string
+
+	usageCmd := &cobra.Command{
+		Use:   "usage",
+		Args:  cobra.NoArgs,
+		Short: "Show usage and quotas",
+		Long:  "Show the resources used by an org and its quotas. Bytes ingested are collected from the runtimes every few minutes.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.GetOrganizationUsage(cmd.Context(), &adminv1.GetOrganizationUsageRequest{Organization: orgName})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Usage of org %q \n", orgName))
+			cmdutil.TablePrinter(toUsageTable(res.Usage, res.Quotas))
+			return nil
+		},
+	}
+	usageCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization name")
+
+	return usageCmd
+}
+
+func toUsageTable(u *adminv1.OrganizationUsage, q *adminv1.OrganizationQuotas) []*usage {
+	month := u.PeriodStart.AsTime().Format("January 2006")
+	return []*usage{
+		{Resource: "projects", Used: formatCount(int64(u.Projects)), Quota: formatQuota(int64(q.Projects), formatCount)},
+		{Resource: "slots", Used: formatCount(int64(u.SlotsTotal)), Quota: formatQuota(int64(q.SlotsTotal), formatCount)},
+		{Resource: "ingested data", Used: formatBytes(u.IngestedBytes), Quota: formatQuota(q.IngestedBytes, formatBytes)},
+		{Resource: "queries in " + month, Used: formatCount(u.Queries), Quota: formatQuota(q.QueriesPerMonth, formatCount)},
+	}
+}
+
+// formatQuota formats a quota with format, or as "unlimited" if it's negative
+func formatQuota(n int64, format func(int64) string) string {
+	if n < 0 {
+		return "unlimited"
+	}
+	return format(n)
+}
+
+func formatCount(n int64) string {
+	return strconv.FormatInt(n, 10)
+}
+
+func formatBytes(n int64) string {
+	return datasize.ByteSize(n).HumanReadable()
+}
+
+type usage struct {
+	Resource string `header:"resource" json:"resource"`
+	Used     string `header:"used" json:"used"`
+	Quota    string `header:"quota" json:"quota"`
+}
@@ -0,0 +1,107 @@
+package plan
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/c2h5oh/datasize"
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	"github.com/rilldata/rill/cli/pkg/local"
+	"github.com/rilldata/rill/runtime/services/catalog"
+	"github.com/spf13/cobra"
+)
+
+func PlanCmd(cfg *config.Config) *cobra.Command {
+	var projectPath string
+	var olapDriver string
+	var olapDSN string
+	var verbose bool
+	var variables []string
+	var all bool
+
+	planCmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Show the changes a build would make without applying them",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app, err := local.NewApp(cmd.Context(), cfg.Version, verbose, olapDriver, olapDSN, projectPath, local.LogFormatConsole, variables, local.DefaultProfile)
+			if err != nil {
+				return err
+			}
+			defer app.Close()
+
+			if !app.IsProjectInit() {
+				return fmt.Errorf("not a valid Rill project")
+			}
+
+			plan, err := app.Runtime.PlanReconcile(cmd.Context(), app.Instance.ID, nil, nil)
+			if err != nil {
+				return fmt.Errorf("plan project: %w", err)
+			}
+
+			rows := make([]*planRow, 0, len(plan.Items))
+			for _, item := range plan.Items {
+				if item.Action == catalog.PlanActionNoOp && !all {
+					continue
+				}
+				rows = append(rows, toRow(item))
+			}
+
+			if len(rows) == 0 {
+				fmt.Println("No changes. The project is up to date.")
+			} else {
+				cmdutil.TablePrinter(rows)
+			}
+
+			for _, e := range plan.Errors {
+				app.Logger.Errorf("%s: %s", e.FilePath, e.Message)
+			}
+
+			return nil
+		},
+	}
+	planCmd.Flags().SortFlags = false
+	planCmd.Flags().StringVar(&projectPath, "project", ".", "Project directory")
+	planCmd.Flags().StringVar(&olapDSN, "db", local.DefaultOLAPDSN, "Database DSN")
+	planCmd.Flags().StringVar(&olapDriver, "db-driver", local.DefaultOLAPDriver, "Database driver")
+	planCmd.Flags().BoolVar(&verbose, "verbose", false, "Sets the log level to debug")
+	planCmd.Flags().StringSliceVarP(&variables, "env", "e", []string{}, "Set project variables")
+	planCmd.Flags().BoolVar(&all, "all", false, "Also list objects that are unchanged")
+
+	return planCmd
+}
+
+type planRow struct {
+	Action     string `header:"action"`
+	Name       string `header:"name"`
+	Path       string `header:"path"`
+	Reasons    string `header:"reasons"`
+	Ingest     string `header:"estimated ingest"`
+	Downstream string `header:"downstream"`
+}
+
+func toRow(item *catalog.PlanItem) *planRow {
+	name := item.Name
+	if item.FromName != "" && item.Action == catalog.PlanActionRename {
+		name = fmt.Sprintf("%s -> %s", item.FromName, item.Name)
+	}
+
+	reasons := make([]string, len(item.Reasons))
+	for i, reason := range item.Reasons {
+		reasons[i] = reason.String()
+	}
+
+	ingest := ""
+	if item.EstimatedBytes > 0 {
+		ingest = datasize.ByteSize(item.EstimatedBytes).HumanReadable()
+	}
+
+	return &planRow{
+		Action:     item.Action.String(),
+		Name:       name,
+		Path:       item.Path,
+		Reasons:    strings.Join(reasons, ", "),
+		Ingest:     ingest,
+		Downstream: strings.Join(item.Downstream, ", "),
+	}
+}
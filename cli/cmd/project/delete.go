@@ -46,7 +46,7 @@ func DeleteCmd(cfg *config.Config) *cobra.Command {
 				}
 			}
 
-			_, err = client.DeleteProject(context.Background(), &adminv1.DeleteProjectRequest{
+			res, err := client.DeleteProject(context.Background(), &adminv1.DeleteProjectRequest{
 				OrganizationName: cfg.Org,
 				Name:             name,
 			})
@@ -54,6 +54,15 @@ func DeleteCmd(cfg *config.Config) *cobra.Command {
 				return err
 			}
 
+			// The project is deleted in the background after its deployments are torn down
+			sp := cmdutil.Spinner("Deleting project")
+			sp.Start()
+			err = cmdutil.WaitForJob(cmd.Context(), client, res.Job)
+			sp.Stop()
+			if err != nil {
+				return err
+			}
+
 			cmdutil.SuccessPrinter(fmt.Sprintf("Deleted project: %v\n", name))
 			return nil
 		},
@@ -50,7 +50,7 @@ func EditCmd(cfg *config.Config) *cobra.Command {
 			}
 
 			if !cmd.Flags().Changed("prod-branch") {
-				prodBranch, err = cmdutil.InputPrompt("Enter the production branch", proj.ProdBranch)
+				prodBranch, err = cmdutil.InputPrompt("Enter the production branch", resp.ProdDeployment.GetBranch())
 				if err != nil {
 					return err
 				}
@@ -0,0 +1,184 @@
+package project
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	adminClient "github.com/rilldata/rill/admin/client"
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func LogsCmd(cfg *config.Config) *cobra.Command {
+	var name, path, environment, revisionID string
+	var pullRequest int64
+
+	logsCmd := &cobra.Command{
+		Use:   "logs",
+		Args:  cobra.NoArgs,
+		Short: "Show the deployment history of a project",
+		Long: "Lists the most recent reconciles of the deployment of an environment, or of the preview deployment of a pull request.\n" +
+			"Pass --revision to show the results of a reconcile for each file.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if !cmd.Flags().Changed("project") {
+				name, err = inferProjectName(cmd.Context(), client, cfg.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			depl, err := findDeployment(cmd.Context(), client, cfg.Org, name, environment, pullRequest)
+			if err != nil {
+				return err
+			}
+
+			if revisionID != "" {
+				res, err := client.GetDeploymentRevision(cmd.Context(), &adminv1.GetDeploymentRevisionRequest{
+					DeploymentId: depl.Id,
+					Id:           revisionID,
+				})
+				if err != nil {
+					return err
+				}
+
+				printRevision(res.Revision)
+				return nil
+			}
+
+			res, err := client.ListDeploymentRevisions(cmd.Context(), &adminv1.ListDeploymentRevisionsRequest{
+				DeploymentId: depl.Id,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Revisions) == 0 {
+				cmdutil.WarnPrinter("No revisions found")
+				return nil
+			}
+
+			cmdutil.SuccessPrinter("Revisions list \n")
+			cmdutil.TablePrinter(toRevisionTable(res.Revisions))
+			return nil
+		},
+	}
+
+	logsCmd.Flags().SortFlags = false
+	logsCmd.Flags().StringVar(&name, "project", "", "Name")
+	logsCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	logsCmd.Flags().StringVar(&environment, "environment", "prod", "Environment")
+	logsCmd.Flags().Int64Var(&pullRequest, "pull-request", 0, "Pull request of a preview deployment")
+	logsCmd.Flags().StringVar(&revisionID, "revision", "", "Revision ID")
+
+	return logsCmd
+}
+
+// findDeployment returns the deployment of an environment of a project, or the preview deployment of a pull request if pullRequest is not 0
+func findDeployment(ctx context.Context, client *adminClient.Client, org, project, environment string, pullRequest int64) (*adminv1.Deployment, error) {
+	if pullRequest != 0 {
+		res, err := client.GetProject(ctx, &adminv1.GetProjectRequest{
+			OrganizationName: org,
+			Name:             project,
+			PullRequest:      pullRequest,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return res.PreviewDeployment, nil
+	}
+
+	res, err := client.ListEnvironments(ctx, &adminv1.ListEnvironmentsRequest{
+		OrganizationName: org,
+		ProjectName:      project,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, env := range res.Environments {
+		if strings.EqualFold(env.Name, environment) {
+			if env.Deployment == nil {
+				return nil, fmt.Errorf("environment %q is not deployed", env.Name)
+			}
+			return env.Deployment, nil
+		}
+	}
+	return nil, fmt.Errorf("environment %q not found", environment)
+}
+
+func printRevision(rev *adminv1.DeploymentRevision) {
+	cmdutil.SuccessPrinter("Revision info\n")
+	fmt.Printf("  ID: %s\n", rev.Id)
+	fmt.Printf("  Commit: %s\n", rev.Commit)
+	fmt.Printf("  Triggered by: %s\n", rev.TriggeredBy)
+	fmt.Printf("  Status: %s\n", rev.Status.String())
+	fmt.Printf("  Started: %s\n", rev.StartedOn.AsTime().Local().Format(time.RFC1123))
+	if rev.FinishedOn != nil {
+		fmt.Printf("  Finished: %s\n", rev.FinishedOn.AsTime().Local().Format(time.RFC1123))
+	}
+
+	if len(rev.Results) == 0 {
+		// The reconcile didn't complete, so the logs contain the error calling the runtime
+		if rev.Logs != "" {
+			fmt.Printf("  Logs: %s\n", rev.Logs)
+		}
+		return
+	}
+
+	fmt.Println("  Results:")
+	for _, res := range rev.Results {
+		path := res.Path
+		if path == "" {
+			path = "(project)"
+		}
+		if len(res.Errors) == 0 {
+			fmt.Printf("\t%s: ok\n", path)
+			continue
+		}
+		fmt.Printf("\t%s: %s\n", path, strings.Join(res.Errors, "; "))
+	}
+}
+
+func toRevisionTable(revs []*adminv1.DeploymentRevision) []*revision {
+	rows := make([]*revision, 0, len(revs))
+	for _, rev := range revs {
+		row := &revision{
+			ID:          rev.Id,
+			Commit:      shortCommit(rev.Commit),
+			TriggeredBy: rev.TriggeredBy,
+			Status:      strings.TrimPrefix(rev.Status.String(), "DEPLOYMENT_STATUS_"),
+			Started:     rev.StartedOn.AsTime().Local().Format("2006-01-02 15:04:05"),
+		}
+		if rev.FinishedOn != nil {
+			row.Duration = rev.FinishedOn.AsTime().Sub(rev.StartedOn.AsTime()).Round(time.Second).String()
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func shortCommit(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+type revision struct {
+	ID          string `header:"id" json:"id"`
+	Commit      string `header:"commit" json:"commit"`
+	TriggeredBy string `header:"triggered by" json:"triggered_by"`
+	Status      string `header:"status" json:"status"`
+	Started     string `header:"started" json:"started"`
+	Duration    string `header:"duration" json:"duration"`
+}
@@ -26,6 +26,8 @@ func ProjectCmd(cfg *config.Config) *cobra.Command {
 	projectCmd.AddCommand(DeleteCmd(cfg))
 	projectCmd.AddCommand(ListCmd(cfg))
 	projectCmd.AddCommand(RenameCmd(cfg))
+	projectCmd.AddCommand(LogsCmd(cfg))
+	projectCmd.AddCommand(RollbackCmd(cfg))
 	return projectCmd
 }
 
@@ -72,7 +72,6 @@ func RenameCmd(cfg *config.Config) *cobra.Command {
 				Name:             newName,
 				Description:      proj.Description,
 				Public:           proj.Public,
-				ProdBranch:       proj.ProdBranch,
 				GithubUrl:        proj.GithubUrl,
 			})
 			if err != nil {
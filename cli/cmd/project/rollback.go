@@ -0,0 +1,92 @@
+package project
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+func RollbackCmd(cfg *config.Config) *cobra.Command {
+	var name, path, environment, revisionID string
+
+	rollbackCmd := &cobra.Command{
+		Use:   "rollback",
+		Args:  cobra.NoArgs,
+		Short: "Roll back an environment to a previous commit",
+		Long: "Redeploys the commit of a previous successful revision of an environment's deployment.\n" +
+			"By default, it rolls back to the last successful commit before the current one. Use \"rill project logs\" to find revisions.\n" +
+			"The environment stays on that commit until its branch is updated with \"rill env edit\".",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if !cmd.Flags().Changed("project") {
+				name, err = inferProjectName(cmd.Context(), client, cfg.Org, path)
+				if err != nil {
+					return err
+				}
+			}
+
+			depl, err := findDeployment(cmd.Context(), client, cfg.Org, name, environment, 0)
+			if err != nil {
+				return err
+			}
+
+			if revisionID == "" {
+				res, err := client.ListDeploymentRevisions(cmd.Context(), &adminv1.ListDeploymentRevisionsRequest{
+					DeploymentId: depl.Id,
+				})
+				if err != nil {
+					return err
+				}
+
+				rev := previousRevision(res.Revisions)
+				if rev == nil {
+					return fmt.Errorf("no successful revision with a previous commit found")
+				}
+				revisionID = rev.Id
+			}
+
+			res, err := client.RollbackDeployment(cmd.Context(), &adminv1.RollbackDeploymentRequest{
+				DeploymentId: depl.Id,
+				RevisionId:   revisionID,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Rolling back environment %q to commit %s\n", res.Environment.Name, res.Environment.Commit))
+			return nil
+		},
+	}
+
+	rollbackCmd.Flags().SortFlags = false
+	rollbackCmd.Flags().StringVar(&name, "project", "", "Name")
+	rollbackCmd.Flags().StringVar(&path, "path", ".", "Project directory")
+	rollbackCmd.Flags().StringVar(&environment, "environment", "prod", "Environment")
+	rollbackCmd.Flags().StringVar(&revisionID, "revision", "", "Revision ID (defaults to the last successful commit before the current one)")
+
+	return rollbackCmd
+}
+
+// previousRevision returns the most recent successful revision of a commit other than the commit of the latest revision.
+// The revisions must be ordered from newest to oldest.
+func previousRevision(revs []*adminv1.DeploymentRevision) *adminv1.DeploymentRevision {
+	if len(revs) == 0 {
+		return nil
+	}
+
+	current := revs[0].Commit
+	for _, rev := range revs[1:] {
+		if rev.Status == adminv1.DeploymentStatus_DEPLOYMENT_STATUS_OK && rev.Commit != "" && rev.Commit != current {
+			return rev
+		}
+	}
+	return nil
+}
@@ -12,9 +12,12 @@ import (
 	"github.com/rilldata/rill/cli/cmd/docs"
 	"github.com/rilldata/rill/cli/cmd/env"
 	"github.com/rilldata/rill/cli/cmd/initialize"
+	"github.com/rilldata/rill/cli/cmd/lsp"
 	"github.com/rilldata/rill/cli/cmd/org"
+	"github.com/rilldata/rill/cli/cmd/plan"
 	"github.com/rilldata/rill/cli/cmd/project"
 	"github.com/rilldata/rill/cli/cmd/runtime"
+	"github.com/rilldata/rill/cli/cmd/service"
 	"github.com/rilldata/rill/cli/cmd/source"
 	"github.com/rilldata/rill/cli/cmd/start"
 	"github.com/rilldata/rill/cli/cmd/user"
@@ -93,6 +96,8 @@ func runCmd(ctx context.Context, ver config.Version) error {
 	rootCmd.AddCommand(initialize.InitCmd(cfg))
 	rootCmd.AddCommand(start.StartCmd(cfg))
 	rootCmd.AddCommand(build.BuildCmd(cfg))
+	rootCmd.AddCommand(plan.PlanCmd(cfg))
+	rootCmd.AddCommand(lsp.LSPCmd(cfg))
 	rootCmd.AddCommand(source.SourceCmd(cfg))
 	rootCmd.AddCommand(admin.AdminCmd(cfg))
 	rootCmd.AddCommand(runtime.RuntimeCmd(cfg))
@@ -111,6 +116,7 @@ func runCmd(ctx context.Context, ver config.Version) error {
 		deploy.DeployCmd(cfg),
 		user.UserCmd(cfg),
 		env.EnvCmd(cfg),
+		service.ServiceCmd(cfg),
 		auth.LoginCmd(cfg),
 		auth.LogoutCmd(cfg),
 	}
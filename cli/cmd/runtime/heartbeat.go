@@ -0,0 +1,62 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	adminclient "github.com/rilldata/rill/admin/client"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"go.uber.org/zap"
+)
+
+// sendHeartbeats registers the runtime with the admin server and keeps sending heartbeats until ctx is cancelled.
+// Failed heartbeats are logged and retried on the next interval, since the admin server marks the runtime unhealthy if they keep failing.
+func sendHeartbeats(ctx context.Context, conf *Config, version string, logger *zap.Logger) error {
+	if conf.AdvertiseURL == "" {
+		return fmt.Errorf("RILL_RUNTIME_ADVERTISE_URL must be set to register with the admin server")
+	}
+
+	client, err := adminclient.New(conf.AdminURL, "", fmt.Sprintf("rill-runtime/%s", version))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	audience := conf.AuthAudienceURL
+	if audience == "" {
+		audience = conf.AdvertiseURL
+	}
+
+	req := &adminv1.HeartbeatRuntimeRequest{
+		Secret:      conf.AdminRuntimeSecret,
+		Host:        conf.AdvertiseURL,
+		AudienceUrl: audience,
+		Region:      conf.Region,
+		Slots:       int64(conf.Slots),
+		DataDir:     conf.DataDir,
+	}
+
+	ticker := time.NewTicker(conf.HeartbeatInterval)
+	defer ticker.Stop()
+
+	registered := false
+	for {
+		_, err := client.HeartbeatRuntime(ctx, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			logger.Warn("heartbeat to admin server failed", zap.Error(err))
+		} else if !registered {
+			logger.Info("registered with admin server", zap.String("admin_url", conf.AdminURL), zap.String("host", conf.AdvertiseURL))
+			registered = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
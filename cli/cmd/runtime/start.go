@@ -48,9 +48,31 @@ type Config struct {
 	SafeSourceRefresh   bool                   `default:"false" split_words:"true"`
 	ConnectionCacheSize int                    `default:"100" split_words:"true"`
 	QueryCacheSize      int                    `default:"10000" split_words:"true"`
+	// QueryCacheSizeBytes and QueryCacheInstanceQuotaBytes bound the estimated memory used by cached query results.
+	// QueryCacheDiskPath enables persisting cached query results to disk (bounded by QueryCacheDiskSizeBytes).
+	QueryCacheSizeBytes          int64  `default:"1073741824" split_words:"true"`
+	QueryCacheInstanceQuotaBytes int64  `default:"0" split_words:"true"`
+	QueryCacheDiskPath           string `split_words:"true"`
+	QueryCacheDiskSizeBytes      int64  `default:"10737418240" split_words:"true"`
+	// Statement timeouts and concurrency limits for OLAP queries (zero values disable them)
+	QueryDefaultTimeout      time.Duration `default:"0s" split_words:"true"`
+	QueryMaxTimeout          time.Duration `default:"0s" split_words:"true"`
+	QueryInstanceConcurrency int           `default:"0" split_words:"true"`
+	QuerySubjectConcurrency  int           `default:"0" split_words:"true"`
 	// AllowHostAccess controls whether instance can use host credentials and
 	// local_file sources can access directory outside repo
 	AllowHostAccess bool `default:"false" split_words:"true"`
+	// TransactionalReconcile reverts all the migrations of a reconcile if any of them fails
+	TransactionalReconcile bool `default:"false" split_words:"true"`
+	// AdminURL enables registering the runtime with the dynamic provisioner of an admin server by sending heartbeats.
+	// The runtime is registered as AdvertiseURL, and AdminRuntimeSecret must match the admin server's runtime secret.
+	AdminURL           string        `split_words:"true"`
+	AdminRuntimeSecret string        `split_words:"true"`
+	AdvertiseURL       string        `split_words:"true"`
+	Region             string        `split_words:"true"`
+	Slots              int           `default:"0" split_words:"true"`
+	DataDir            string        `split_words:"true"`
+	HeartbeatInterval  time.Duration `default:"15s" split_words:"true"`
 }
 
 // StartCmd starts a stand-alone runtime server. It only allows configuration using environment variables.
@@ -101,12 +123,21 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 
 			// Init runtime
 			opts := &runtime.Options{
-				ConnectionCacheSize: conf.ConnectionCacheSize,
-				MetastoreDriver:     conf.MetastoreDriver,
-				MetastoreDSN:        conf.MetastoreURL,
-				QueryCacheSize:      conf.QueryCacheSize,
-				AllowHostAccess:     conf.AllowHostAccess,
-				SafeSourceRefresh:   conf.SafeSourceRefresh,
+				ConnectionCacheSize:          conf.ConnectionCacheSize,
+				MetastoreDriver:              conf.MetastoreDriver,
+				MetastoreDSN:                 conf.MetastoreURL,
+				QueryCacheSize:               conf.QueryCacheSize,
+				QueryCacheSizeBytes:          conf.QueryCacheSizeBytes,
+				QueryCacheInstanceQuotaBytes: conf.QueryCacheInstanceQuotaBytes,
+				QueryCacheDiskPath:           conf.QueryCacheDiskPath,
+				QueryCacheDiskSizeBytes:      conf.QueryCacheDiskSizeBytes,
+				QueryDefaultTimeout:          conf.QueryDefaultTimeout,
+				QueryMaxTimeout:              conf.QueryMaxTimeout,
+				QueryInstanceConcurrency:     conf.QueryInstanceConcurrency,
+				QuerySubjectConcurrency:      conf.QuerySubjectConcurrency,
+				AllowHostAccess:              conf.AllowHostAccess,
+				SafeSourceRefresh:            conf.SafeSourceRefresh,
+				TransactionalReconcile:       conf.TransactionalReconcile,
 			}
 			rt, err := runtime.New(opts, logger)
 			if err != nil {
@@ -134,6 +165,9 @@ func StartCmd(cliCfg *config.Config) *cobra.Command {
 			group, cctx := errgroup.WithContext(ctx)
 			group.Go(func() error { return s.ServeGRPC(cctx) })
 			group.Go(func() error { return s.ServeHTTP(cctx, nil) })
+			if conf.AdminURL != "" {
+				group.Go(func() error { return sendHeartbeats(cctx, &conf, cliCfg.Version.String(), logger) })
+			}
 			err = group.Wait()
 			if err != nil {
 				logger.Fatal("server crashed", zap.Error(err))
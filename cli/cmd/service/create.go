@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// CreateCmd is sub command for service. Creates a service account in the current org
+func CreateCmd(cfg *config.Config) *cobra.Command {
+	var description string
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create service account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.CreateServiceAccount(cmd.Context(), &adminv1.CreateServiceAccountRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+				Description:  description,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Created service account %q\n", res.ServiceAccount.Name))
+			fmt.Printf("Issue a token for it with: rill service token issue %s --scope <scope>\n", res.ServiceAccount.Name)
+			return nil
+		},
+	}
+	createCmd.Flags().StringVar(&description, "description", "", "Description")
+	return createCmd
+}
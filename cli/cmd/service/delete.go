@@ -0,0 +1,48 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// DeleteCmd is sub command for service. Deletes a service account and revokes its tokens
+func DeleteCmd(cfg *config.Config) *cobra.Command {
+	var force bool
+	deleteCmd := &cobra.Command{
+		Use:   "delete <name>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delete service account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			if !force {
+				msg := fmt.Sprintf("Do you want to delete service account %q and revoke its tokens?", name)
+				if !cmdutil.ConfirmPrompt(msg, "", false) {
+					return nil
+				}
+			}
+
+			_, err = client.DeleteServiceAccount(cmd.Context(), &adminv1.DeleteServiceAccountRequest{
+				Organization: cfg.Org,
+				Name:         name,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Deleted service account %q\n", name))
+			return nil
+		},
+	}
+	deleteCmd.Flags().BoolVar(&force, "force", false, "Delete forcefully, skips the confirmation")
+	return deleteCmd
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// ListCmd is sub command for service. Lists the service accounts of the current org
+func ListCmd(cfg *config.Config) *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Args:  cobra.NoArgs,
+		Short: "List service accounts",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListServiceAccounts(cmd.Context(), &adminv1.ListServiceAccountsRequest{
+				Organization: cfg.Org,
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.ServiceAccounts) == 0 {
+				cmdutil.WarnPrinter("No service accounts found")
+				return nil
+			}
+
+			cmdutil.SuccessPrinter("Service accounts list \n")
+			cmdutil.TablePrinter(toTable(res.ServiceAccounts))
+			return nil
+		},
+	}
+	return listCmd
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+)
+
+// ServiceCmd manages the service accounts of an organization, which authenticate with scoped tokens (e.g. in CI pipelines)
+func ServiceCmd(cfg *config.Config) *cobra.Command {
+	serviceCmd := &cobra.Command{
+		Use:               "service",
+		Short:             "Manage service accounts and their tokens",
+		Hidden:            !cfg.IsDev(),
+		PersistentPreRunE: cmdutil.CheckChain(cmdutil.CheckAuth(cfg), cmdutil.CheckOrganization(cfg)),
+	}
+	serviceCmd.AddCommand(ListCmd(cfg))
+	serviceCmd.AddCommand(CreateCmd(cfg))
+	serviceCmd.AddCommand(DeleteCmd(cfg))
+	serviceCmd.AddCommand(TokenCmd(cfg))
+	return serviceCmd
+}
+
+func toTable(accounts []*adminv1.ServiceAccount) []*serviceAccount {
+	rows := make([]*serviceAccount, 0, len(accounts))
+	for _, a := range accounts {
+		rows = append(rows, &serviceAccount{
+			Name:        a.Name,
+			Description: a.Description,
+			CreatedOn:   a.CreatedOn.AsTime().Local().Format(time.RFC1123),
+		})
+	}
+	return rows
+}
+
+func toTokenTable(tokens []*adminv1.ServiceAuthToken) []*serviceToken {
+	rows := make([]*serviceToken, 0, len(tokens))
+	for _, t := range tokens {
+		row := &serviceToken{
+			ID:          t.Id,
+			DisplayName: t.DisplayName,
+			Projects:    strings.Join(t.Projects, ", "),
+			Scopes:      strings.Join(t.Scopes, ", "),
+			ExpiresOn:   "never",
+		}
+		if len(t.Projects) == 0 {
+			row.Projects = "all"
+		}
+		if t.ExpiresOn != nil {
+			row.ExpiresOn = t.ExpiresOn.AsTime().Local().Format(time.RFC1123)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+type serviceAccount struct {
+	Name        string `header:"name" json:"name"`
+	Description string `header:"description" json:"description"`
+	CreatedOn   string `header:"created on" json:"created_on"`
+}
+
+type serviceToken struct {
+	ID          string `header:"id" json:"id"`
+	DisplayName string `header:"name" json:"display_name"`
+	Projects    string `header:"projects" json:"projects"`
+	Scopes      string `header:"scopes" json:"scopes"`
+	ExpiresOn   string `header:"expires on" json:"expires_on"`
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/admin"
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TokenCmd is sub command for service. Manages the auth tokens of a service account
+func TokenCmd(cfg *config.Config) *cobra.Command {
+	tokenCmd := &cobra.Command{
+		Use:   "token",
+		Short: "Manage auth tokens of a service account",
+	}
+	tokenCmd.AddCommand(TokenListCmd(cfg))
+	tokenCmd.AddCommand(TokenIssueCmd(cfg))
+	tokenCmd.AddCommand(TokenRevokeCmd(cfg))
+	return tokenCmd
+}
+
+func TokenListCmd(cfg *config.Config) *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list <service>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List tokens of a service account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			res, err := client.ListServiceAuthTokens(cmd.Context(), &adminv1.ListServiceAuthTokensRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			if len(res.Tokens) == 0 {
+				cmdutil.WarnPrinter("No tokens found")
+				return nil
+			}
+
+			cmdutil.SuccessPrinter("Tokens list \n")
+			cmdutil.TablePrinter(toTokenTable(res.Tokens))
+			return nil
+		},
+	}
+	return listCmd
+}
+
+func TokenIssueCmd(cfg *config.Config) *cobra.Command {
+	var displayName string
+	var projects, scopes []string
+	var ttl time.Duration
+
+	issueCmd := &cobra.Command{
+		Use:   "issue <service>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Issue a token for a service account",
+		Long: "Issues a token that can only access the given projects (or all projects of the org) with the given scopes.\n" +
+			"Valid scopes are: " + strings.Join(admin.ServiceTokenScopes, ", ") + "\n" +
+			"A token with the scim scope lets your identity provider provision the org's users and groups on the admin server's /scim/v2 endpoint.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			req := &adminv1.IssueServiceAuthTokenRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+				DisplayName:  displayName,
+				Projects:     projects,
+				Scopes:       scopes,
+			}
+			if ttl > 0 {
+				req.ExpiresOn = timestamppb.New(time.Now().Add(ttl))
+			}
+
+			res, err := client.IssueServiceAuthToken(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Issued token %s for service account %q\n", res.Token.Id, args[0]))
+			fmt.Printf("Token: %s\n\n", res.TokenString)
+			cmdutil.WarnPrinter("Store the token securely, it can't be displayed again. Pass it with --api-token or configure it as a secret of your CI pipeline.")
+			return nil
+		},
+	}
+	issueCmd.Flags().SortFlags = false
+	issueCmd.Flags().StringSliceVar(&scopes, "scope", nil, "Permission scopes of the token (repeatable)")
+	issueCmd.Flags().StringSliceVar(&projects, "project", nil, "Projects the token can access (repeatable, defaults to all projects)")
+	issueCmd.Flags().DurationVar(&ttl, "ttl", 0, "Duration until the token expires (defaults to no expiry)")
+	issueCmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the token")
+	_ = issueCmd.MarkFlagRequired("scope")
+	return issueCmd
+}
+
+func TokenRevokeCmd(cfg *config.Config) *cobra.Command {
+	revokeCmd := &cobra.Command{
+		Use:   "revoke <service> <token-id>",
+		Args:  cobra.ExactArgs(2),
+		Short: "Revoke a token of a service account",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.RevokeServiceAuthToken(cmd.Context(), &adminv1.RevokeServiceAuthTokenRequest{
+				Organization: cfg.Org,
+				Name:         args[0],
+				TokenId:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Revoked token %s\n", args[1]))
+			return nil
+		},
+	}
+	return revokeCmd
+}
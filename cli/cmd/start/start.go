@@ -1,14 +1,17 @@
 package start
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
 	"github.com/rilldata/rill/cli/pkg/config"
 	"github.com/rilldata/rill/cli/pkg/gitutil"
 	"github.com/rilldata/rill/cli/pkg/local"
 	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
+	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/spf13/cobra"
 )
 
@@ -26,6 +29,7 @@ func StartCmd(cfg *config.Config) *cobra.Command {
 	var logFormat string
 	var variables []string
 	var exampleName string
+	var profile string
 
 	startCmd := &cobra.Command{
 		Use:   "start [<path>]",
@@ -80,7 +84,34 @@ func StartCmd(cfg *config.Config) *cobra.Command {
 				return fmt.Errorf("invalid log format %q", logFormat)
 			}
 
-			app, err := local.NewApp(cmd.Context(), cfg.Version, verbose, olapDriver, olapDSN, projectPath, parsedLogFormat, variables)
+			if profile != drivers.ProfileDev && profile != drivers.ProfileProd {
+				return fmt.Errorf("invalid profile %q", profile)
+			}
+
+			// use the default OLAP connector in rill.yaml if no driver was passed
+			if !cmd.Flags().Changed("db-driver") && rillv1beta.HasRillProject(projectPath) {
+				// an invalid rill.yaml is reported when the project is reconciled, so it doesn't prevent starting
+				conf, err := rillv1beta.ReadProjectConfig(projectPath)
+				if err != nil {
+					var configErr *rillv1beta.ConfigError
+					if !errors.As(err, &configErr) {
+						return fmt.Errorf("could not read rill.yaml: %w", err)
+					}
+					cmdutil.WarnPrinter(fmt.Sprintf("Invalid rill.yaml: %s", err))
+					conf = &rillv1beta.ProjectConfig{}
+				}
+				for _, w := range conf.Warnings {
+					cmdutil.WarnPrinter(fmt.Sprintf("Ignoring rill.yaml: %s", w))
+				}
+				if conf.OLAPConnector != "" && conf.OLAPConnector != olapDriver {
+					if !cmd.Flags().Changed("db") {
+						return fmt.Errorf("olap_connector %q in rill.yaml requires passing --db", conf.OLAPConnector)
+					}
+					olapDriver = conf.OLAPConnector
+				}
+			}
+
+			app, err := local.NewApp(cmd.Context(), cfg.Version, verbose, olapDriver, olapDSN, projectPath, parsedLogFormat, variables, profile)
 			if err != nil {
 				return err
 			}
@@ -133,6 +164,7 @@ func StartCmd(cfg *config.Config) *cobra.Command {
 	startCmd.Flags().StringVar(&logFormat, "log-format", "console", "Log format (options: \"console\", \"json\")")
 	startCmd.Flags().StringSliceVarP(&variables, "env", "e", []string{}, "Set project variables")
 	startCmd.Flags().StringVar(&exampleName, "example", "default", "Name of example project")
+	startCmd.Flags().StringVar(&profile, "profile", local.DefaultProfile, "Profile for model settings in rill.yaml and model files (options: \"dev\", \"prod\")")
 
 	return startCmd
 }
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rilldata/rill/cli/cmd/cmdutil"
+	"github.com/rilldata/rill/cli/pkg/config"
+	adminv1 "github.com/rilldata/rill/proto/gen/rill/admin/v1"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func SetAttributesCmd(cfg *config.Config) *cobra.Command {
+	var orgName string
+	var email string
+	var attributes string
+
+	setAttributesCmd := &cobra.Command{
+		Use:   "set-attributes",
+		Short: "Set custom attributes used by metrics view policies",
+		Long:  "Set custom attributes of an organization member, which are available to metrics view policies as .user.<name>.\nPass an empty JSON object to clear them.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdutil.StringPromptIfEmpty(&email, "Enter email")
+
+			attrs := map[string]any{}
+			if attributes != "" {
+				err := json.Unmarshal([]byte(attributes), &attrs)
+				if err != nil {
+					return fmt.Errorf("invalid attributes: %w", err)
+				}
+			}
+			attrsPB, err := structpb.NewStruct(attrs)
+			if err != nil {
+				return err
+			}
+
+			client, err := cmdutil.Client(cfg)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			_, err = client.SetOrganizationMemberAttributes(cmd.Context(), &adminv1.SetOrganizationMemberAttributesRequest{
+				Organization: orgName,
+				Email:        email,
+				Attributes:   attrsPB,
+			})
+			if err != nil {
+				return err
+			}
+
+			cmdutil.SuccessPrinter(fmt.Sprintf("Updated attributes of user %q in the organization %q", email, orgName))
+			return nil
+		},
+	}
+
+	setAttributesCmd.Flags().StringVar(&orgName, "org", cfg.Org, "Organization")
+	setAttributesCmd.Flags().StringVar(&email, "email", "", "Email of the user")
+	setAttributesCmd.Flags().StringVar(&attributes, "attributes", "", `Attributes as a JSON object, for example '{"region": "emea"}'`)
+
+	return setAttributesCmd
+}
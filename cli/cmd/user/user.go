@@ -16,6 +16,7 @@ func UserCmd(cfg *config.Config) *cobra.Command {
 	userCmd.AddCommand(AddCmd(cfg))
 	userCmd.AddCommand(RemoveCmd(cfg))
 	userCmd.AddCommand(SetRoleCmd(cfg))
+	userCmd.AddCommand(SetAttributesCmd(cfg))
 
 	return userCmd
 }
@@ -42,6 +42,7 @@ const (
 	DefaultInstanceID = "default"
 	DefaultOLAPDriver = "duckdb"
 	DefaultOLAPDSN    = "stage.db"
+	DefaultProfile    = drivers.ProfileDev
 )
 
 // App encapsulates the logic associated with configuring and running the UI and the runtime in a local environment.
@@ -59,7 +60,7 @@ type App struct {
 	observabilityShutdown observability.ShutdownFunc
 }
 
-func NewApp(ctx context.Context, ver config.Version, verbose bool, olapDriver, olapDSN, projectPath string, logFormat LogFormat, variables []string) (*App, error) {
+func NewApp(ctx context.Context, ver config.Version, verbose bool, olapDriver, olapDSN, projectPath string, logFormat LogFormat, variables []string, profile string) (*App, error) {
 	// Setup a friendly-looking colored/json logger
 	var logger *zap.Logger
 	var err error
@@ -143,6 +144,7 @@ func NewApp(ctx context.Context, ver config.Version, verbose bool, olapDriver, o
 		RepoDSN:      projectPath,
 		EmbedCatalog: olapDriver == "duckdb",
 		Variables:    parsedVariables,
+		Profile:      profile,
 	}
 	err = rt.CreateInstance(ctx, inst)
 	if err != nil {
@@ -298,6 +300,19 @@ func (a *App) ReconcileSource(sourcePath string) error {
 	return nil
 }
 
+func (a *App) logReconcileEvent(e *runtime.ReconcileEvent) {
+	if e.Err != nil {
+		a.Logger.Errorf("Reconcile of changed files failed: %v", e.Err)
+		return
+	}
+	for _, path := range e.Result.AffectedPaths {
+		a.Logger.Infof("Reconciled: %s", path)
+	}
+	for _, merr := range e.Result.Errors {
+		a.Logger.Errorf("%s: %s", merr.FilePath, merr.Message)
+	}
+}
+
 func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser, readonly bool) error {
 	// Get analytics info
 	installID, enabled, err := dotrill.AnalyticsInfo()
@@ -361,6 +376,17 @@ func (a *App) Serve(httpPort, grpcPort int, enableUI, openBrowser, readonly bool
 		})
 	})
 
+	// Reconcile files changed outside of the UI (e.g. in an editor or by git)
+	if !readonly {
+		group.Go(func() error {
+			err := a.Runtime.SubscribeReconcile(ctx, a.Instance.ID, a.logReconcileEvent)
+			if err != nil {
+				a.Logger.Warnf("Not watching project for changes: %v", err)
+			}
+			return nil
+		})
+	}
+
 	// Open the browser when health check succeeds
 	go a.pollServer(ctx, httpPort, enableUI && openBrowser)
 
@@ -0,0 +1,335 @@
+package lsp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	artifactsyaml "github.com/rilldata/rill/runtime/services/catalog/artifacts/yaml"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator/metricsviews"
+)
+
+var (
+	dashboardKeys = yamlKeys(artifactsyaml.MetricsView{}, "display_name")
+	measureKeys   = yamlKeys(artifactsyaml.Measure{})
+	dimensionKeys = yamlKeys(artifactsyaml.Dimension{})
+	rollupKeys    = yamlKeys(artifactsyaml.Rollup{})
+)
+
+// wordRegex matches identifiers in SQL
+var wordRegex = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+func (s *Server) completion(ctx context.Context, params *TextDocumentPositionParams) ([]CompletionItem, error) {
+	path, text, err := s.document(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kindOf(path) {
+	case fileSQL:
+		items := s.objectItems(ctx, drivers.ObjectTypeTable, drivers.ObjectTypeSource, drivers.ObjectTypeModel)
+		for _, name := range s.referencedObjects(ctx, text) {
+			items = append(items, s.columnItems(ctx, name)...)
+		}
+		return items, nil
+	case fileDashboard:
+		return s.dashboardCompletion(ctx, text, params.Position), nil
+	default:
+		return []CompletionItem{}, nil
+	}
+}
+
+func (s *Server) dashboardCompletion(ctx context.Context, text string, pos Position) []CompletionItem {
+	c := cursorAt(text, pos)
+
+	switch {
+	case c.key == "model":
+		return s.objectItems(ctx, drivers.ObjectTypeTable, drivers.ObjectTypeSource, drivers.ObjectTypeModel)
+	case c.key == "timeseries" || c.key == "property" || c.key == "expression" || c.item && c.section == "time_dimensions":
+		return s.columnItems(ctx, dashboardModel(text))
+	case c.key == "format_preset":
+		presets := make([]string, 0, len(metricsviews.FormatPresets))
+		for preset := range metricsviews.FormatPresets {
+			presets = append(presets, preset)
+		}
+		sort.Strings(presets)
+		return valueItems(presets)
+	case c.key == "smallest_time_grain" || c.key == "time_grain":
+		return valueItems(timeGrains())
+	case c.key != "":
+		return []CompletionItem{}
+	}
+
+	switch c.section {
+	case "":
+		return keyItems(dashboardKeys)
+	case "measures":
+		return keyItems(measureKeys)
+	case "dimensions":
+		return keyItems(dimensionKeys)
+	case "rollups":
+		return keyItems(rollupKeys)
+	default:
+		return []CompletionItem{}
+	}
+}
+
+// objectItems returns completion items for the names of the catalog objects of the given types
+func (s *Server) objectItems(ctx context.Context, types ...drivers.ObjectType) []CompletionItem {
+	items := []CompletionItem{}
+	for _, entry := range s.entries(ctx) {
+		for _, typ := range types {
+			if entry.Type == typ {
+				items = append(items, CompletionItem{
+					Label:  entry.Name,
+					Kind:   CompletionItemKindClass,
+					Detail: objectTypeName(entry.Type),
+				})
+				break
+			}
+		}
+	}
+	return items
+}
+
+// columnItems returns completion items for the columns of the table with the given name
+func (s *Server) columnItems(ctx context.Context, name string) []CompletionItem {
+	items := []CompletionItem{}
+	for _, field := range s.columns(ctx, name) {
+		items = append(items, CompletionItem{
+			Label:  field.Name,
+			Kind:   CompletionItemKindField,
+			Detail: fmt.Sprintf("%s (%s)", typeName(field.Type), name),
+		})
+	}
+	return items
+}
+
+func keyItems(keys []string) []CompletionItem {
+	items := make([]CompletionItem, len(keys))
+	for i, key := range keys {
+		items[i] = CompletionItem{Label: key, Kind: CompletionItemKindProperty}
+	}
+	return items
+}
+
+func valueItems(values []string) []CompletionItem {
+	items := make([]CompletionItem, len(values))
+	for i, val := range values {
+		items[i] = CompletionItem{Label: val, Kind: CompletionItemKindValue}
+	}
+	return items
+}
+
+// timeGrains returns the values of smallest_time_grain and time_grain in dashboards
+func timeGrains() []string {
+	var grains []string
+	for i := int32(1); ; i++ {
+		name, ok := runtimev1.TimeGrain_name[i]
+		if !ok {
+			return grains
+		}
+		grains = append(grains, strings.ToLower(strings.TrimPrefix(name, "TIME_GRAIN_")))
+	}
+}
+
+func (s *Server) hover(ctx context.Context, params *TextDocumentPositionParams) (*Hover, error) {
+	path, text, err := s.document(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	word, rng := wordAt(text, params.Position)
+	if word == "" {
+		return nil, nil
+	}
+
+	var contents string
+	if entry := s.entry(ctx, word); entry != nil {
+		contents = s.describeObject(ctx, entry)
+	} else {
+		switch kindOf(path) {
+		case fileSQL:
+			for _, name := range s.referencedObjects(ctx, text) {
+				if field := s.column(ctx, name, word); field != nil {
+					contents = fmt.Sprintf("`%s` %s\n\nColumn of `%s`", field.Name, typeName(field.Type), name)
+					break
+				}
+			}
+		case fileDashboard:
+			contents = s.describeDashboardField(ctx, path, text, word)
+		}
+	}
+
+	if contents == "" {
+		return nil, nil
+	}
+	return &Hover{Contents: MarkupContent{Kind: "markdown", Value: contents}, Range: &rng}, nil
+}
+
+// describeObject returns markdown for a catalog object, which lists the columns of tables, sources and models
+func (s *Server) describeObject(ctx context.Context, entry *drivers.CatalogEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "**%s** (%s)\n", entry.Name, objectTypeName(entry.Type))
+
+	if entry.Type == drivers.ObjectTypeMetricsView {
+		mv := entry.GetMetricsView()
+		if mv.Description != "" {
+			fmt.Fprintf(&b, "\n%s\n", mv.Description)
+		}
+		fmt.Fprintf(&b, "\nModel: `%s`\n", mv.Model)
+		return b.String()
+	}
+
+	fields := s.columns(ctx, entry.Name)
+	if len(fields) == 0 {
+		return b.String()
+	}
+	b.WriteString("\n| Column | Type |\n| --- | --- |\n")
+	for _, field := range fields {
+		fmt.Fprintf(&b, "| %s | %s |\n", field.Name, typeName(field.Type))
+	}
+	return b.String()
+}
+
+// describeDashboardField returns markdown for a column of the dashboard's model, or a dimension or measure of the dashboard
+func (s *Server) describeDashboardField(ctx context.Context, path, text, word string) string {
+	var b strings.Builder
+	model := dashboardModel(text)
+	if field := s.column(ctx, model, word); field != nil {
+		fmt.Fprintf(&b, "`%s` %s\n\nColumn of `%s`\n", field.Name, typeName(field.Type), model)
+	}
+
+	// the descriptions are from the last valid version of the dashboard
+	var mv *runtimev1.MetricsView
+	for _, entry := range s.entries(ctx) {
+		if entry.Type == drivers.ObjectTypeMetricsView && entry.Path == path {
+			mv = entry.GetMetricsView()
+		}
+	}
+	if mv == nil {
+		return b.String()
+	}
+	for _, dim := range mv.Dimensions {
+		if strings.EqualFold(dim.Name, word) {
+			fmt.Fprintf(&b, "\nDimension **%s**", dim.Label)
+			if dim.Description != "" {
+				fmt.Fprintf(&b, ": %s", dim.Description)
+			}
+			b.WriteString("\n")
+		}
+	}
+	for _, measure := range mv.Measures {
+		if strings.EqualFold(measure.Name, word) {
+			fmt.Fprintf(&b, "\nMeasure **%s**: `%s`", measure.Label, measure.Expression)
+			if measure.Description != "" {
+				fmt.Fprintf(&b, "\n\n%s", measure.Description)
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func (s *Server) definition(ctx context.Context, params *TextDocumentPositionParams) ([]Location, error) {
+	path, text, err := s.document(ctx, params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+	word, _ := wordAt(text, params.Position)
+	if word == "" {
+		return []Location{}, nil
+	}
+
+	entry := s.entry(ctx, word)
+	if entry == nil && kindOf(path) == fileDashboard && s.column(ctx, dashboardModel(text), word) != nil {
+		// columns of a dashboard go to its model
+		entry = s.entry(ctx, dashboardModel(text))
+	}
+	if entry == nil || entry.Path == "" || entry.Embedded {
+		return []Location{}, nil
+	}
+	return []Location{{URI: s.uri(entry.Path)}}, nil
+}
+
+// entries returns the objects in the catalog, which are the objects of the last reconcile
+func (s *Server) entries(ctx context.Context) []*drivers.CatalogEntry {
+	entries, err := s.rt.ListCatalogEntries(ctx, s.instanceID, drivers.ObjectTypeUnspecified)
+	if err != nil {
+		return nil
+	}
+	return entries
+}
+
+// entry returns the catalog object with the given name, or nil if there isn't one
+func (s *Server) entry(ctx context.Context, name string) *drivers.CatalogEntry {
+	for _, entry := range s.entries(ctx) {
+		if strings.EqualFold(entry.Name, name) {
+			return entry
+		}
+	}
+	return nil
+}
+
+// referencedObjects returns the names of the tables, sources and models that appear in a query
+func (s *Server) referencedObjects(ctx context.Context, text string) []string {
+	words := make(map[string]bool)
+	for _, word := range wordRegex.FindAllString(text, -1) {
+		words[strings.ToLower(word)] = true
+	}
+
+	var names []string
+	for _, entry := range s.entries(ctx) {
+		if entry.Type != drivers.ObjectTypeMetricsView && words[strings.ToLower(entry.Name)] {
+			names = append(names, entry.Name)
+		}
+	}
+	return names
+}
+
+// columns returns the columns of the table with the given name in the OLAP database
+func (s *Server) columns(ctx context.Context, name string) []*runtimev1.StructType_Field {
+	if name == "" {
+		return nil
+	}
+	olap, err := s.rt.OLAP(ctx, s.instanceID)
+	if err != nil {
+		return nil
+	}
+	table, err := olap.InformationSchema().Lookup(ctx, name)
+	if err != nil {
+		return nil
+	}
+	return table.Schema.Fields
+}
+
+func (s *Server) column(ctx context.Context, table, name string) *runtimev1.StructType_Field {
+	for _, field := range s.columns(ctx, table) {
+		if strings.EqualFold(field.Name, name) {
+			return field
+		}
+	}
+	return nil
+}
+
+func typeName(t *runtimev1.Type) string {
+	return strings.TrimPrefix(t.GetCode().String(), "CODE_")
+}
+
+func objectTypeName(t drivers.ObjectType) string {
+	switch t {
+	case drivers.ObjectTypeTable:
+		return "table"
+	case drivers.ObjectTypeSource:
+		return "source"
+	case drivers.ObjectTypeModel:
+		return "model"
+	case drivers.ObjectTypeMetricsView:
+		return "dashboard"
+	default:
+		return "object"
+	}
+}
@@ -0,0 +1,157 @@
+package lsp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func frame(body string) string {
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+func TestServeLifecycle(t *testing.T) {
+	in := strings.NewReader(
+		frame(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`) +
+			frame(`{"jsonrpc":"2.0","id":2,"method":"workspace/symbol","params":{}}`) +
+			frame(`{"jsonrpc":"2.0","method":"$/cancelRequest","params":{"id":1}}`) +
+			frame(`{"jsonrpc":"2.0","id":3,"method":"shutdown"}`) +
+			frame(`{"jsonrpc":"2.0","method":"exit"}`),
+	)
+	out := &bytes.Buffer{}
+
+	s := NewServer(nil, "default", "/project", "0.1.0", zap.NewNop())
+	require.NoError(t, s.Serve(context.Background(), in, out))
+
+	var responses []map[string]any
+	for _, part := range strings.Split(out.String(), "Content-Length: ")[1:] {
+		_, body, _ := strings.Cut(part, "\r\n\r\n")
+		res := make(map[string]any)
+		require.NoError(t, json.Unmarshal([]byte(body), &res))
+		responses = append(responses, res)
+	}
+
+	require.Len(t, responses, 3)
+	require.EqualValues(t, 1, responses[0]["id"])
+	caps := responses[0]["result"].(map[string]any)["capabilities"].(map[string]any)
+	require.Equal(t, true, caps["hoverProvider"])
+	require.Equal(t, true, caps["definitionProvider"])
+
+	require.EqualValues(t, 2, responses[1]["id"])
+	require.EqualValues(t, codeMethodNotFound, responses[1]["error"].(map[string]any)["code"])
+
+	require.EqualValues(t, 3, responses[2]["id"])
+	require.Contains(t, responses[2], "result")
+}
+
+func TestExitWithoutShutdown(t *testing.T) {
+	in := strings.NewReader(frame(`{"jsonrpc":"2.0","method":"exit"}`))
+	s := NewServer(nil, "default", "/project", "0.1.0", zap.NewNop())
+	require.Error(t, s.Serve(context.Background(), in, &bytes.Buffer{}))
+}
+
+func TestRepoPath(t *testing.T) {
+	s := NewServer(nil, "default", "/home/me/project", "", zap.NewNop())
+
+	path, ok := s.repoPath("file:///home/me/project/models/a%20b.sql")
+	require.True(t, ok)
+	require.Equal(t, "/models/a b.sql", path)
+	require.Equal(t, "file:///home/me/project/models/a%20b.sql", s.uri(path))
+
+	_, ok = s.repoPath("file:///home/me/other/models/a.sql")
+	require.False(t, ok)
+	_, ok = s.repoPath("untitled:Untitled-1")
+	require.False(t, ok)
+}
+
+func TestToDiagnostic(t *testing.T) {
+	d := toDiagnostic(&runtimev1.ReconcileError{
+		Code:          runtimev1.ReconcileError_CODE_VALIDATION,
+		Message:       "dimension not found: domain",
+		StartLocation: &runtimev1.ReconcileError_CharLocation{Line: 4, Column: 3},
+		EndLocation:   &runtimev1.ReconcileError_CharLocation{Line: 4, Column: 19},
+	})
+	require.Equal(t, Range{Start: Position{Line: 3, Character: 2}, End: Position{Line: 3, Character: 18}}, d.Range)
+	require.Equal(t, "validation", d.Code)
+
+	// only the line is known
+	d = toDiagnostic(&runtimev1.ReconcileError{StartLocation: &runtimev1.ReconcileError_CharLocation{Line: 2}})
+	require.Equal(t, Range{Start: Position{Line: 1}, End: Position{Line: 2}}, d.Range)
+
+	// no position
+	d = toDiagnostic(&runtimev1.ReconcileError{Message: "failed"})
+	require.Equal(t, Range{}, d.Range)
+}
+
+func TestWordAt(t *testing.T) {
+	text := "select count(*) from AdBids_model\nwhere publisher = 'x'"
+
+	word, rng := wordAt(text, Position{Line: 0, Character: 25})
+	require.Equal(t, "AdBids_model", word)
+	require.Equal(t, Range{Start: Position{Line: 0, Character: 21}, End: Position{Line: 0, Character: 33}}, rng)
+
+	word, _ = wordAt(text, Position{Line: 1, Character: 6})
+	require.Equal(t, "publisher", word)
+
+	word, _ = wordAt(text, Position{Line: 0, Character: 15})
+	require.Equal(t, "", word)
+
+	word, _ = wordAt(text, Position{Line: 5, Character: 0})
+	require.Equal(t, "", word)
+}
+
+func TestCursorAt(t *testing.T) {
+	text := `model: AdBids_model
+timeseries: timestamp
+time_dimensions:
+-
+dimensions:
+- property: publisher
+
+measures:
+- expression: count(*)
+  format_preset:
+policy:
+  exclude:
+  - if: "true"
+
+`
+	tests := []struct {
+		name string
+		pos  Position
+		want yamlCursor
+	}{
+		{"model value", Position{Line: 0, Character: 7}, yamlCursor{key: "model"}},
+		{"top level key", Position{Line: 1, Character: 2}, yamlCursor{}},
+		{"time dimension item", Position{Line: 3, Character: 2}, yamlCursor{section: "time_dimensions", item: true}},
+		{"dimension value", Position{Line: 5, Character: 12}, yamlCursor{section: "dimensions", key: "property"}},
+		{"unindented line after a list", Position{Line: 6, Character: 0}, yamlCursor{}},
+		{"measure format preset", Position{Line: 9, Character: 17}, yamlCursor{section: "measures", key: "format_preset"}},
+		{"nested section", Position{Line: 12, Character: 4}, yamlCursor{section: "exclude", item: true}},
+		{"after the last line", Position{Line: 20, Character: 0}, yamlCursor{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, cursorAt(text, tt.pos))
+		})
+	}
+}
+
+func TestDashboardModel(t *testing.T) {
+	require.Equal(t, "AdBids_model", dashboardModel("title: Ad Bids\nmodel: \"AdBids_model\"\n"))
+	require.Equal(t, "", dashboardModel("title: Ad Bids\n"))
+}
+
+func TestYAMLKeys(t *testing.T) {
+	require.Contains(t, measureKeys, "format_preset")
+	require.Contains(t, dimensionKeys, "property")
+	require.Contains(t, dashboardKeys, "timeseries")
+	require.NotContains(t, dashboardKeys, "display_name")
+}
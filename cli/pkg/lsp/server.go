@@ -0,0 +1,330 @@
+// Package lsp implements a Language Server Protocol server for the files of a Rill project.
+// It runs a local runtime instance for the project, reconciles it when files are saved and publishes reconcile errors as diagnostics.
+// Completion, hover and go-to-definition use the catalog and the information schema of the instance.
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/services/catalog"
+	"go.uber.org/zap"
+)
+
+// diagnosticSource is the source of the diagnostics published by the server
+const diagnosticSource = "rill"
+
+// Server is a language server for the files of the project in an instance's repo.
+type Server struct {
+	rt          *runtime.Runtime
+	instanceID  string
+	projectPath string
+	version     string
+	logger      *zap.Logger
+	conn        *conn
+
+	// docs contains the text of the documents open in the client, which can differ from the files until they're saved
+	docs     map[string]string
+	docsLock sync.Mutex
+
+	// diagnostics contains the reconcile errors of each file path that has errors
+	diagnostics     map[string][]*runtimev1.ReconcileError
+	diagnosticsLock sync.Mutex
+
+	shutdown bool
+}
+
+// NewServer creates a server for the project at projectPath, which must be the repo of the instance.
+func NewServer(rt *runtime.Runtime, instanceID, projectPath, version string, logger *zap.Logger) *Server {
+	return &Server{
+		rt:          rt,
+		instanceID:  instanceID,
+		projectPath: projectPath,
+		version:     version,
+		logger:      logger,
+		docs:        make(map[string]string),
+		diagnostics: make(map[string][]*runtimev1.ReconcileError),
+	}
+}
+
+// Serve handles the messages from the client on r and writes responses to w until the client exits or r is closed.
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	s.conn = newConn(r, w)
+	for {
+		req, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			var rpcErr *rpcError
+			if errors.As(err, &rpcErr) {
+				// the message is invalid, but the stream is still usable
+				_ = s.conn.reply(nil, nil, rpcErr)
+				continue
+			}
+			return err
+		}
+
+		if req.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("exit without shutdown")
+			}
+			return nil
+		}
+
+		result, err := s.handle(ctx, req)
+		if req.ID == nil {
+			// notifications don't have responses
+			if err != nil {
+				s.logger.Warn("lsp: notification failed", zap.String("method", req.Method), zap.Error(err))
+			}
+			continue
+		}
+		if err := s.conn.reply(req.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, req *request) (any, error) {
+	switch req.Method {
+	case "initialize":
+		return &InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:   TextDocumentSyncOptions{OpenClose: true, Change: TextDocumentSyncKindFull},
+				CompletionProvider: CompletionOptions{TriggerCharacters: []string{" ", ":", "."}},
+				HoverProvider:      true,
+				DefinitionProvider: true,
+			},
+			ServerInfo: ServerInfo{Name: "rill", Version: s.version},
+		}, nil
+	case "initialized":
+		go s.watch(ctx)
+		return nil, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		params := &DidOpenTextDocumentParams{}
+		if err := unmarshalParams(req, params); err != nil {
+			return nil, err
+		}
+		s.setDoc(params.TextDocument.URI, params.TextDocument.Text)
+		return nil, nil
+	case "textDocument/didChange":
+		params := &DidChangeTextDocumentParams{}
+		if err := unmarshalParams(req, params); err != nil {
+			return nil, err
+		}
+		// with full sync, the last change contains the full text
+		if n := len(params.ContentChanges); n > 0 {
+			s.setDoc(params.TextDocument.URI, params.ContentChanges[n-1].Text)
+		}
+		return nil, nil
+	case "textDocument/didClose":
+		params := &DidCloseTextDocumentParams{}
+		if err := unmarshalParams(req, params); err != nil {
+			return nil, err
+		}
+		s.docsLock.Lock()
+		delete(s.docs, params.TextDocument.URI)
+		s.docsLock.Unlock()
+		return nil, nil
+	case "textDocument/completion":
+		params := &TextDocumentPositionParams{}
+		if err := unmarshalParams(req, params); err != nil {
+			return nil, err
+		}
+		return s.completion(ctx, params)
+	case "textDocument/hover":
+		params := &TextDocumentPositionParams{}
+		if err := unmarshalParams(req, params); err != nil {
+			return nil, err
+		}
+		return s.hover(ctx, params)
+	case "textDocument/definition":
+		params := &TextDocumentPositionParams{}
+		if err := unmarshalParams(req, params); err != nil {
+			return nil, err
+		}
+		return s.definition(ctx, params)
+	}
+
+	if req.ID == nil || strings.HasPrefix(req.Method, "$/") {
+		// unknown notifications and optional requests can be ignored
+		return nil, nil
+	}
+	return nil, &rpcError{Code: codeMethodNotFound, Message: fmt.Sprintf("method not supported: %s", req.Method)}
+}
+
+func unmarshalParams(req *request, params any) error {
+	if err := json.Unmarshal(req.Params, params); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func (s *Server) setDoc(uri, text string) {
+	s.docsLock.Lock()
+	defer s.docsLock.Unlock()
+	s.docs[uri] = text
+}
+
+// document returns the repo path and text of the document at uri.
+// The text of open documents is the text in the client, and otherwise the file in the repo.
+func (s *Server) document(ctx context.Context, uri string) (string, string, error) {
+	path, ok := s.repoPath(uri)
+	if !ok {
+		return "", "", fmt.Errorf("file is not in the project: %s", uri)
+	}
+
+	s.docsLock.Lock()
+	text, ok := s.docs[uri]
+	s.docsLock.Unlock()
+	if ok {
+		return path, text, nil
+	}
+
+	text, _, err := s.rt.GetFile(ctx, s.instanceID, path)
+	if err != nil {
+		return "", "", err
+	}
+	return path, text, nil
+}
+
+// watch reconciles the project and then publishes the diagnostics of the reconciles done when files change
+func (s *Server) watch(ctx context.Context) {
+	go func() {
+		err := s.rt.SubscribeReconcile(ctx, s.instanceID, func(e *runtime.ReconcileEvent) {
+			if e.Err != nil {
+				s.logger.Warn("lsp: reconcile failed", zap.Error(e.Err))
+				return
+			}
+			s.publishDiagnostics(e.ChangedPaths, e.Result)
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			s.logger.Warn("lsp: watching the project failed, diagnostics will not update", zap.Error(err))
+		}
+	}()
+
+	res, err := s.rt.Reconcile(ctx, s.instanceID, nil, nil, false, false)
+	if err != nil {
+		s.logger.Warn("lsp: reconcile failed", zap.Error(err))
+		return
+	}
+	s.publishDiagnostics(nil, res)
+}
+
+// publishDiagnostics updates the diagnostics of the files that were reconciled.
+// changedPaths is nil if the whole project was reconciled.
+func (s *Server) publishDiagnostics(changedPaths []string, res *catalog.ReconcileResult) {
+	s.diagnosticsLock.Lock()
+	defer s.diagnosticsLock.Unlock()
+
+	// the files that had errors before are cleared if they're reconciled without errors
+	paths := make(map[string]bool)
+	if changedPaths == nil {
+		for path := range s.diagnostics {
+			paths[path] = true
+		}
+	}
+	for _, path := range changedPaths {
+		paths[path] = true
+	}
+	for _, path := range res.AffectedPaths {
+		paths[path] = true
+	}
+
+	errs := make(map[string][]*runtimev1.ReconcileError)
+	for _, e := range res.Errors {
+		if e.FilePath == "" {
+			s.logger.Warn("lsp: reconcile error", zap.String("message", e.Message))
+			continue
+		}
+		errs[e.FilePath] = append(errs[e.FilePath], e)
+		paths[e.FilePath] = true
+	}
+
+	for path := range paths {
+		if len(errs[path]) == 0 {
+			delete(s.diagnostics, path)
+		} else {
+			s.diagnostics[path] = errs[path]
+		}
+
+		diagnostics := make([]Diagnostic, 0, len(errs[path]))
+		for _, e := range errs[path] {
+			diagnostics = append(diagnostics, toDiagnostic(e))
+		}
+		err := s.conn.notify("textDocument/publishDiagnostics", &PublishDiagnosticsParams{
+			URI:         s.uri(path),
+			Diagnostics: diagnostics,
+		})
+		if err != nil {
+			s.logger.Warn("lsp: publishing diagnostics failed", zap.Error(err))
+		}
+	}
+}
+
+// toDiagnostic converts a reconcile error to a diagnostic.
+// Reconcile errors have 1-based positions, and errors without a position are shown at the start of the file.
+func toDiagnostic(e *runtimev1.ReconcileError) Diagnostic {
+	var rng Range
+	if e.StartLocation != nil && e.StartLocation.Line > 0 {
+		rng.Start = toPosition(e.StartLocation)
+		rng.End = rng.Start
+		if e.EndLocation != nil && e.EndLocation.Line > 0 {
+			rng.End = toPosition(e.EndLocation)
+		} else {
+			// only the start is known, so mark the rest of the line
+			rng.End = Position{Line: rng.Start.Line + 1}
+		}
+	}
+
+	return Diagnostic{
+		Range:    rng,
+		Severity: DiagnosticSeverityError,
+		Code:     strings.ToLower(strings.TrimPrefix(e.Code.String(), "CODE_")),
+		Source:   diagnosticSource,
+		Message:  e.Message,
+	}
+}
+
+func toPosition(loc *runtimev1.ReconcileError_CharLocation) Position {
+	pos := Position{Line: int(loc.Line) - 1}
+	if loc.Column > 0 {
+		pos.Character = int(loc.Column) - 1
+	}
+	return pos
+}
+
+// repoPath returns the path in the repo of the file at uri, e.g. "/models/a.sql"
+func (s *Server) repoPath(uri string) (string, bool) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return "", false
+	}
+	rel, err := filepath.Rel(s.projectPath, filepath.FromSlash(u.Path))
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return "/" + filepath.ToSlash(rel), true
+}
+
+// uri returns the URI of the file at path in the repo
+func (s *Server) uri(path string) string {
+	u := &url.URL{Scheme: "file", Path: filepath.ToSlash(filepath.Join(s.projectPath, filepath.FromSlash(path)))}
+	return u.String()
+}
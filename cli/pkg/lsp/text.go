@@ -0,0 +1,179 @@
+package lsp
+
+import (
+	"path"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// fileKind is the kind of artifact a file in the project is
+type fileKind int
+
+const (
+	fileOther fileKind = iota
+	fileSQL
+	fileSource
+	fileDashboard
+)
+
+func kindOf(repoPath string) fileKind {
+	switch {
+	case path.Ext(repoPath) == ".sql":
+		return fileSQL
+	case path.Ext(repoPath) == ".yaml" && path.Base(path.Dir(repoPath)) == "sources":
+		return fileSource
+	case path.Ext(repoPath) == ".yaml" && path.Base(path.Dir(repoPath)) == "dashboards":
+		return fileDashboard
+	default:
+		return fileOther
+	}
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+// wordAt returns the identifier at pos in text and its range, or an empty string if pos isn't in an identifier
+func wordAt(text string, pos Position) (string, Range) {
+	line, ok := lineAt(text, pos.Line)
+	if !ok {
+		return "", Range{}
+	}
+	start := min(pos.Character, len(line))
+	for start > 0 && isWordChar(line[start-1]) {
+		start--
+	}
+	end := min(pos.Character, len(line))
+	for end < len(line) && isWordChar(line[end]) {
+		end++
+	}
+	return line[start:end], Range{
+		Start: Position{Line: pos.Line, Character: start},
+		End:   Position{Line: pos.Line, Character: end},
+	}
+}
+
+func lineAt(text string, n int) (string, bool) {
+	lines := strings.Split(text, "\n")
+	if n < 0 || n >= len(lines) {
+		return "", false
+	}
+	return strings.TrimSuffix(lines[n], "\r"), true
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+var (
+	// yamlValueRegex matches a line up to the cursor that is in the value of a key, e.g. "  - expression: avg("
+	yamlValueRegex = regexp.MustCompile(`^\s*(?:-\s+)?([\w.]+):\s*`)
+	// yamlItemRegex matches a line up to the cursor that is in an item of a list of values, e.g. "  - "
+	yamlItemRegex = regexp.MustCompile(`^\s*-(?:\s+[^:]*)?$`)
+	// yamlBlockKeyRegex matches a line that starts a nested mapping or list, e.g. "measures:"
+	yamlBlockKeyRegex = regexp.MustCompile(`^([\w.]+):\s*(?:#.*)?$`)
+	// dashboardModelRegex matches the model of a dashboard
+	dashboardModelRegex = regexp.MustCompile(`(?m)^model:\s*["']?(\w+)`)
+)
+
+// yamlCursor describes where the cursor is in a YAML document
+type yamlCursor struct {
+	// section is the key of the mapping or list the cursor is nested in, or empty at the top level
+	section string
+	// key is the key whose value the cursor is in, or empty if the cursor is at a key
+	key string
+	// item is true if the cursor is in an item of a list before its first key, e.g. in "time_dimensions:\n- "
+	item bool
+}
+
+// cursorAt finds where pos is in text.
+// It uses the indentation of lines instead of parsing the document, since documents being edited are usually invalid.
+func cursorAt(text string, pos Position) yamlCursor {
+	lines := strings.Split(text, "\n")
+	if pos.Line >= len(lines) {
+		return yamlCursor{}
+	}
+	cur := strings.TrimSuffix(lines[pos.Line], "\r")
+	cur = cur[:min(pos.Character, len(cur))]
+
+	var c yamlCursor
+	if match := yamlValueRegex.FindStringSubmatch(cur); match != nil {
+		c.key = match[1]
+	} else if yamlItemRegex.MatchString(cur) {
+		c.item = true
+	}
+
+	// find the nearest line above with a key that the line is nested in
+	trimmed := strings.TrimLeft(cur, " ")
+	indent := len(cur) - len(trimmed)
+	inItem := strings.HasPrefix(trimmed, "-")
+	for i := pos.Line - 1; i >= 0; i-- {
+		line := strings.TrimSuffix(lines[i], "\r")
+		trimmed := strings.TrimLeft(line, " ")
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+		lineIndent := len(line) - len(trimmed)
+
+		if strings.HasPrefix(trimmed, "-") && lineIndent < indent && !inItem {
+			// the line continues the item
+			indent = lineIndent
+			inItem = true
+			continue
+		}
+		if lineIndent < indent || inItem && lineIndent == indent {
+			if match := yamlBlockKeyRegex.FindStringSubmatch(trimmed); match != nil {
+				c.section = match[1]
+				return c
+			}
+			if lineIndent < indent {
+				// the line is a nested key of an item or mapping
+				indent = lineIndent
+				inItem = strings.HasPrefix(trimmed, "-")
+			}
+		}
+	}
+	return c
+}
+
+// dashboardModel returns the model of the dashboard in text
+func dashboardModel(text string) string {
+	match := dashboardModelRegex.FindStringSubmatch(text)
+	if match == nil {
+		return ""
+	}
+	return match[1]
+}
+
+// yamlKeys returns the keys of a YAML artifact type, which is a struct with yaml tags.
+// Keys that are only kept for backwards compatibility are excluded.
+func yamlKeys(v any, exclude ...string) []string {
+	typ := reflect.TypeOf(v)
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	var keys []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		excluded := false
+		for _, e := range exclude {
+			excluded = excluded || e == name
+		}
+		if !excluded {
+			keys = append(keys, name)
+		}
+	}
+	return keys
+}
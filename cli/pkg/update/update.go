@@ -41,7 +41,8 @@ func CheckVersion(ctx context.Context, currentVersion string) error {
 	}
 
 	if v1.LessThan(v2) {
-		fmt.Printf("\n%s %s → %s\n\n",
+		// printed to stderr to not interfere with commands that use stdout for a protocol, like "rill lsp"
+		fmt.Fprintf(os.Stderr, "\n%s %s → %s\n\n",
 			color.YellowString("A new version of rill is available:"),
 			color.CyanString(currentVersion),
 			color.CyanString(latestVersion))
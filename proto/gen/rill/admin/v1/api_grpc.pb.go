@@ -25,6 +25,13 @@ const (
 	AdminService_CreateOrganization_FullMethodName                      = "/rill.admin.v1.AdminService/CreateOrganization"
 	AdminService_DeleteOrganization_FullMethodName                      = "/rill.admin.v1.AdminService/DeleteOrganization"
 	AdminService_UpdateOrganization_FullMethodName                      = "/rill.admin.v1.AdminService/UpdateOrganization"
+	AdminService_GetOrganizationUsage_FullMethodName                    = "/rill.admin.v1.AdminService/GetOrganizationUsage"
+	AdminService_UpdateOrganizationQuotas_FullMethodName                = "/rill.admin.v1.AdminService/UpdateOrganizationQuotas"
+	AdminService_GetOrganizationSSO_FullMethodName                      = "/rill.admin.v1.AdminService/GetOrganizationSSO"
+	AdminService_SetOrganizationSSO_FullMethodName                      = "/rill.admin.v1.AdminService/SetOrganizationSSO"
+	AdminService_DeleteOrganizationSSO_FullMethodName                   = "/rill.admin.v1.AdminService/DeleteOrganizationSSO"
+	AdminService_AddOrganizationSSOGroup_FullMethodName                 = "/rill.admin.v1.AdminService/AddOrganizationSSOGroup"
+	AdminService_RemoveOrganizationSSOGroup_FullMethodName              = "/rill.admin.v1.AdminService/RemoveOrganizationSSOGroup"
 	AdminService_ListProjectsForOrganization_FullMethodName             = "/rill.admin.v1.AdminService/ListProjectsForOrganization"
 	AdminService_ListProjectsForOrganizationAndGithubURL_FullMethodName = "/rill.admin.v1.AdminService/ListProjectsForOrganizationAndGithubURL"
 	AdminService_GetProject_FullMethodName                              = "/rill.admin.v1.AdminService/GetProject"
@@ -33,15 +40,38 @@ const (
 	AdminService_DeleteProject_FullMethodName                           = "/rill.admin.v1.AdminService/DeleteProject"
 	AdminService_UpdateProject_FullMethodName                           = "/rill.admin.v1.AdminService/UpdateProject"
 	AdminService_UpdateProjectVariables_FullMethodName                  = "/rill.admin.v1.AdminService/UpdateProjectVariables"
+	AdminService_ListEnvironments_FullMethodName                        = "/rill.admin.v1.AdminService/ListEnvironments"
+	AdminService_CreateEnvironment_FullMethodName                       = "/rill.admin.v1.AdminService/CreateEnvironment"
+	AdminService_UpdateEnvironment_FullMethodName                       = "/rill.admin.v1.AdminService/UpdateEnvironment"
+	AdminService_DeleteEnvironment_FullMethodName                       = "/rill.admin.v1.AdminService/DeleteEnvironment"
+	AdminService_PromoteEnvironment_FullMethodName                      = "/rill.admin.v1.AdminService/PromoteEnvironment"
+	AdminService_ListDeploymentRevisions_FullMethodName                 = "/rill.admin.v1.AdminService/ListDeploymentRevisions"
+	AdminService_GetDeploymentRevision_FullMethodName                   = "/rill.admin.v1.AdminService/GetDeploymentRevision"
+	AdminService_RollbackDeployment_FullMethodName                      = "/rill.admin.v1.AdminService/RollbackDeployment"
+	AdminService_ListProjectJobs_FullMethodName                         = "/rill.admin.v1.AdminService/ListProjectJobs"
+	AdminService_GetJob_FullMethodName                                  = "/rill.admin.v1.AdminService/GetJob"
+	AdminService_HeartbeatRuntime_FullMethodName                        = "/rill.admin.v1.AdminService/HeartbeatRuntime"
+	AdminService_ListRuntimes_FullMethodName                            = "/rill.admin.v1.AdminService/ListRuntimes"
+	AdminService_DrainRuntime_FullMethodName                            = "/rill.admin.v1.AdminService/DrainRuntime"
+	AdminService_UncordonRuntime_FullMethodName                         = "/rill.admin.v1.AdminService/UncordonRuntime"
 	AdminService_ListOrganizationMembers_FullMethodName                 = "/rill.admin.v1.AdminService/ListOrganizationMembers"
 	AdminService_AddOrganizationMember_FullMethodName                   = "/rill.admin.v1.AdminService/AddOrganizationMember"
 	AdminService_RemoveOrganizationMember_FullMethodName                = "/rill.admin.v1.AdminService/RemoveOrganizationMember"
 	AdminService_LeaveOrganization_FullMethodName                       = "/rill.admin.v1.AdminService/LeaveOrganization"
 	AdminService_SetOrganizationMemberRole_FullMethodName               = "/rill.admin.v1.AdminService/SetOrganizationMemberRole"
+	AdminService_GetOrganizationMemberAttributes_FullMethodName         = "/rill.admin.v1.AdminService/GetOrganizationMemberAttributes"
+	AdminService_SetOrganizationMemberAttributes_FullMethodName         = "/rill.admin.v1.AdminService/SetOrganizationMemberAttributes"
 	AdminService_ListProjectMembers_FullMethodName                      = "/rill.admin.v1.AdminService/ListProjectMembers"
 	AdminService_AddProjectMember_FullMethodName                        = "/rill.admin.v1.AdminService/AddProjectMember"
 	AdminService_RemoveProjectMember_FullMethodName                     = "/rill.admin.v1.AdminService/RemoveProjectMember"
 	AdminService_SetProjectMemberRole_FullMethodName                    = "/rill.admin.v1.AdminService/SetProjectMemberRole"
+	AdminService_ListServiceAccounts_FullMethodName                     = "/rill.admin.v1.AdminService/ListServiceAccounts"
+	AdminService_CreateServiceAccount_FullMethodName                    = "/rill.admin.v1.AdminService/CreateServiceAccount"
+	AdminService_DeleteServiceAccount_FullMethodName                    = "/rill.admin.v1.AdminService/DeleteServiceAccount"
+	AdminService_ListServiceAuthTokens_FullMethodName                   = "/rill.admin.v1.AdminService/ListServiceAuthTokens"
+	AdminService_IssueServiceAuthToken_FullMethodName                   = "/rill.admin.v1.AdminService/IssueServiceAuthToken"
+	AdminService_RevokeServiceAuthToken_FullMethodName                  = "/rill.admin.v1.AdminService/RevokeServiceAuthToken"
+	AdminService_ListAuditLogEntries_FullMethodName                     = "/rill.admin.v1.AdminService/ListAuditLogEntries"
 	AdminService_GetCurrentUser_FullMethodName                          = "/rill.admin.v1.AdminService/GetCurrentUser"
 	AdminService_RevokeCurrentAuthToken_FullMethodName                  = "/rill.admin.v1.AdminService/RevokeCurrentAuthToken"
 	AdminService_GetGithubRepoStatus_FullMethodName                     = "/rill.admin.v1.AdminService/GetGithubRepoStatus"
@@ -63,6 +93,21 @@ type AdminServiceClient interface {
 	DeleteOrganization(ctx context.Context, in *DeleteOrganizationRequest, opts ...grpc.CallOption) (*DeleteOrganizationResponse, error)
 	// UpdateOrganization deletes an organizations
 	UpdateOrganization(ctx context.Context, in *UpdateOrganizationRequest, opts ...grpc.CallOption) (*UpdateOrganizationResponse, error)
+	// GetOrganizationUsage returns the current usage and the quotas of an organization
+	GetOrganizationUsage(ctx context.Context, in *GetOrganizationUsageRequest, opts ...grpc.CallOption) (*GetOrganizationUsageResponse, error)
+	// UpdateOrganizationQuotas sets the quotas of an organization (superusers only)
+	UpdateOrganizationQuotas(ctx context.Context, in *UpdateOrganizationQuotasRequest, opts ...grpc.CallOption) (*UpdateOrganizationQuotasResponse, error)
+	// GetOrganizationSSO returns the identity provider of an organization and the mappings of its groups to usergroups
+	GetOrganizationSSO(ctx context.Context, in *GetOrganizationSSORequest, opts ...grpc.CallOption) (*GetOrganizationSSOResponse, error)
+	// SetOrganizationSSO configures the OIDC identity provider that users with an email address in one of the domains sign in with.
+	// Each domain must have a DNS TXT record with the verification record returned by GetOrganizationSSO.
+	SetOrganizationSSO(ctx context.Context, in *SetOrganizationSSORequest, opts ...grpc.CallOption) (*SetOrganizationSSOResponse, error)
+	// DeleteOrganizationSSO removes the identity provider of an organization and the usergroups mapped to its groups
+	DeleteOrganizationSSO(ctx context.Context, in *DeleteOrganizationSSORequest, opts ...grpc.CallOption) (*DeleteOrganizationSSOResponse, error)
+	// AddOrganizationSSOGroup creates a usergroup with organization and project roles, whose members are the users in a group of the identity provider
+	AddOrganizationSSOGroup(ctx context.Context, in *AddOrganizationSSOGroupRequest, opts ...grpc.CallOption) (*AddOrganizationSSOGroupResponse, error)
+	// RemoveOrganizationSSOGroup deletes the usergroup mapped to a group of the identity provider
+	RemoveOrganizationSSOGroup(ctx context.Context, in *RemoveOrganizationSSOGroupRequest, opts ...grpc.CallOption) (*RemoveOrganizationSSOGroupResponse, error)
 	// ListProjectsForOrganization lists all the projects currently available for given organizations
 	ListProjectsForOrganization(ctx context.Context, in *ListProjectsForOrganizationRequest, opts ...grpc.CallOption) (*ListProjectsForOrganizationResponse, error)
 	// ListProjectsForOrganizationAndGithubURL lists all the project for org deployed from githubURL
@@ -79,6 +124,36 @@ type AdminServiceClient interface {
 	UpdateProject(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
 	// UpdateProjectVariables updates variables for a project. NOTE: Update project API doesn't update variables.
 	UpdateProjectVariables(ctx context.Context, in *UpdateProjectVariablesRequest, opts ...grpc.CallOption) (*UpdateProjectVariablesResponse, error)
+	// ListEnvironments lists the environments of a project, such as prod and staging, with their deployments
+	ListEnvironments(ctx context.Context, in *ListEnvironmentsRequest, opts ...grpc.CallOption) (*ListEnvironmentsResponse, error)
+	// CreateEnvironment creates a named environment for a project and deploys it
+	CreateEnvironment(ctx context.Context, in *CreateEnvironmentRequest, opts ...grpc.CallOption) (*CreateEnvironmentResponse, error)
+	// UpdateEnvironment changes the branch an environment is deployed from. It unpins the environment from a promoted commit.
+	UpdateEnvironment(ctx context.Context, in *UpdateEnvironmentRequest, opts ...grpc.CallOption) (*UpdateEnvironmentResponse, error)
+	// DeleteEnvironment tears down the deployment of an environment and deletes it. The prod environment can't be deleted.
+	DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentRequest, opts ...grpc.CallOption) (*DeleteEnvironmentResponse, error)
+	// PromoteEnvironment deploys the commit of an environment to another environment, e.g. from staging to prod
+	PromoteEnvironment(ctx context.Context, in *PromoteEnvironmentRequest, opts ...grpc.CallOption) (*PromoteEnvironmentResponse, error)
+	// ListDeploymentRevisions lists the most recent reconciles of a deployment, with the commit they deployed and their outcome
+	ListDeploymentRevisions(ctx context.Context, in *ListDeploymentRevisionsRequest, opts ...grpc.CallOption) (*ListDeploymentRevisionsResponse, error)
+	// GetDeploymentRevision returns a reconcile of a deployment, including the results for each file and its logs
+	GetDeploymentRevision(ctx context.Context, in *GetDeploymentRevisionRequest, opts ...grpc.CallOption) (*GetDeploymentRevisionResponse, error)
+	// RollbackDeployment redeploys the commit of a previous successful revision of a deployment.
+	// It pins the deployment's environment to the commit until the environment's branch is updated.
+	RollbackDeployment(ctx context.Context, in *RollbackDeploymentRequest, opts ...grpc.CallOption) (*RollbackDeploymentResponse, error)
+	// ListProjectJobs lists the most recent background jobs of a project, such as reconciles of its deployments
+	ListProjectJobs(ctx context.Context, in *ListProjectJobsRequest, opts ...grpc.CallOption) (*ListProjectJobsResponse, error)
+	// GetJob returns the status of a background job
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error)
+	// HeartbeatRuntime registers a runtime server with the dynamic provisioner, or records that a registered runtime is alive.
+	// It's called periodically by runtime servers, which authenticate with the shared runtime secret.
+	HeartbeatRuntime(ctx context.Context, in *HeartbeatRuntimeRequest, opts ...grpc.CallOption) (*HeartbeatRuntimeResponse, error)
+	// ListRuntimes lists the runtime servers that registered with the dynamic provisioner (superusers only)
+	ListRuntimes(ctx context.Context, in *ListRuntimesRequest, opts ...grpc.CallOption) (*ListRuntimesResponse, error)
+	// DrainRuntime stops new deployments from being provisioned on a runtime and migrates its deployments to other runtimes (superusers only)
+	DrainRuntime(ctx context.Context, in *DrainRuntimeRequest, opts ...grpc.CallOption) (*DrainRuntimeResponse, error)
+	// UncordonRuntime allows new deployments to be provisioned on a drained runtime again (superusers only)
+	UncordonRuntime(ctx context.Context, in *UncordonRuntimeRequest, opts ...grpc.CallOption) (*UncordonRuntimeResponse, error)
 	// ListOrganizationMembers lists all the org members
 	ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error)
 	// AddOrganizationMember lists all the org members
@@ -89,6 +164,10 @@ type AdminServiceClient interface {
 	LeaveOrganization(ctx context.Context, in *LeaveOrganizationRequest, opts ...grpc.CallOption) (*LeaveOrganizationResponse, error)
 	// SetOrganizationMemberRole sets the role for the member
 	SetOrganizationMemberRole(ctx context.Context, in *SetOrganizationMemberRoleRequest, opts ...grpc.CallOption) (*SetOrganizationMemberRoleResponse, error)
+	// GetOrganizationMemberAttributes returns the custom attributes of a member, which are available to metrics view policies
+	GetOrganizationMemberAttributes(ctx context.Context, in *GetOrganizationMemberAttributesRequest, opts ...grpc.CallOption) (*GetOrganizationMemberAttributesResponse, error)
+	// SetOrganizationMemberAttributes replaces the custom attributes of a member
+	SetOrganizationMemberAttributes(ctx context.Context, in *SetOrganizationMemberAttributesRequest, opts ...grpc.CallOption) (*SetOrganizationMemberAttributesResponse, error)
 	// ListProjectMembers lists all the project members
 	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
 	// AddProjectMember adds a member to the project
@@ -97,6 +176,21 @@ type AdminServiceClient interface {
 	RemoveProjectMember(ctx context.Context, in *RemoveProjectMemberRequest, opts ...grpc.CallOption) (*RemoveProjectMemberResponse, error)
 	// SetProjectMemberRole sets the role for the member
 	SetProjectMemberRole(ctx context.Context, in *SetProjectMemberRoleRequest, opts ...grpc.CallOption) (*SetProjectMemberRoleResponse, error)
+	// ListServiceAccounts lists the service accounts of an organization
+	ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error)
+	// CreateServiceAccount creates a service account for an organization, e.g. for a CI pipeline
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error)
+	// DeleteServiceAccount deletes a service account and revokes its tokens
+	DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error)
+	// ListServiceAuthTokens lists the auth tokens of a service account
+	ListServiceAuthTokens(ctx context.Context, in *ListServiceAuthTokensRequest, opts ...grpc.CallOption) (*ListServiceAuthTokensResponse, error)
+	// IssueServiceAuthToken issues an auth token for a service account.
+	// The token is restricted to the given projects (or all projects of the org if none are given) and permission scopes.
+	IssueServiceAuthToken(ctx context.Context, in *IssueServiceAuthTokenRequest, opts ...grpc.CallOption) (*IssueServiceAuthTokenResponse, error)
+	// RevokeServiceAuthToken revokes an auth token of a service account
+	RevokeServiceAuthToken(ctx context.Context, in *RevokeServiceAuthTokenRequest, opts ...grpc.CallOption) (*RevokeServiceAuthTokenResponse, error)
+	// ListAuditLogEntries lists the audit log of administrative actions in an organization, newest first
+	ListAuditLogEntries(ctx context.Context, in *ListAuditLogEntriesRequest, opts ...grpc.CallOption) (*ListAuditLogEntriesResponse, error)
 	// GetCurrentUser returns the currently authenticated user (if any)
 	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
 	// RevokeCurrentAuthToken revoke the current auth token
@@ -168,6 +262,69 @@ func (c *adminServiceClient) UpdateOrganization(ctx context.Context, in *UpdateO
 	return out, nil
 }
 
+func (c *adminServiceClient) GetOrganizationUsage(ctx context.Context, in *GetOrganizationUsageRequest, opts ...grpc.CallOption) (*GetOrganizationUsageResponse, error) {
+	out := new(GetOrganizationUsageResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetOrganizationUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateOrganizationQuotas(ctx context.Context, in *UpdateOrganizationQuotasRequest, opts ...grpc.CallOption) (*UpdateOrganizationQuotasResponse, error) {
+	out := new(UpdateOrganizationQuotasResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateOrganizationQuotas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetOrganizationSSO(ctx context.Context, in *GetOrganizationSSORequest, opts ...grpc.CallOption) (*GetOrganizationSSOResponse, error) {
+	out := new(GetOrganizationSSOResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetOrganizationSSO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetOrganizationSSO(ctx context.Context, in *SetOrganizationSSORequest, opts ...grpc.CallOption) (*SetOrganizationSSOResponse, error) {
+	out := new(SetOrganizationSSOResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetOrganizationSSO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteOrganizationSSO(ctx context.Context, in *DeleteOrganizationSSORequest, opts ...grpc.CallOption) (*DeleteOrganizationSSOResponse, error) {
+	out := new(DeleteOrganizationSSOResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteOrganizationSSO_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AddOrganizationSSOGroup(ctx context.Context, in *AddOrganizationSSOGroupRequest, opts ...grpc.CallOption) (*AddOrganizationSSOGroupResponse, error) {
+	out := new(AddOrganizationSSOGroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_AddOrganizationSSOGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RemoveOrganizationSSOGroup(ctx context.Context, in *RemoveOrganizationSSOGroupRequest, opts ...grpc.CallOption) (*RemoveOrganizationSSOGroupResponse, error) {
+	out := new(RemoveOrganizationSSOGroupResponse)
+	err := c.cc.Invoke(ctx, AdminService_RemoveOrganizationSSOGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListProjectsForOrganization(ctx context.Context, in *ListProjectsForOrganizationRequest, opts ...grpc.CallOption) (*ListProjectsForOrganizationResponse, error) {
 	out := new(ListProjectsForOrganizationResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListProjectsForOrganization_FullMethodName, in, out, opts...)
@@ -240,6 +397,132 @@ func (c *adminServiceClient) UpdateProjectVariables(ctx context.Context, in *Upd
 	return out, nil
 }
 
+func (c *adminServiceClient) ListEnvironments(ctx context.Context, in *ListEnvironmentsRequest, opts ...grpc.CallOption) (*ListEnvironmentsResponse, error) {
+	out := new(ListEnvironmentsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListEnvironments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateEnvironment(ctx context.Context, in *CreateEnvironmentRequest, opts ...grpc.CallOption) (*CreateEnvironmentResponse, error) {
+	out := new(CreateEnvironmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateEnvironment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateEnvironment(ctx context.Context, in *UpdateEnvironmentRequest, opts ...grpc.CallOption) (*UpdateEnvironmentResponse, error) {
+	out := new(UpdateEnvironmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateEnvironment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteEnvironment(ctx context.Context, in *DeleteEnvironmentRequest, opts ...grpc.CallOption) (*DeleteEnvironmentResponse, error) {
+	out := new(DeleteEnvironmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteEnvironment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) PromoteEnvironment(ctx context.Context, in *PromoteEnvironmentRequest, opts ...grpc.CallOption) (*PromoteEnvironmentResponse, error) {
+	out := new(PromoteEnvironmentResponse)
+	err := c.cc.Invoke(ctx, AdminService_PromoteEnvironment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDeploymentRevisions(ctx context.Context, in *ListDeploymentRevisionsRequest, opts ...grpc.CallOption) (*ListDeploymentRevisionsResponse, error) {
+	out := new(ListDeploymentRevisionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDeploymentRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDeploymentRevision(ctx context.Context, in *GetDeploymentRevisionRequest, opts ...grpc.CallOption) (*GetDeploymentRevisionResponse, error) {
+	out := new(GetDeploymentRevisionResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDeploymentRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RollbackDeployment(ctx context.Context, in *RollbackDeploymentRequest, opts ...grpc.CallOption) (*RollbackDeploymentResponse, error) {
+	out := new(RollbackDeploymentResponse)
+	err := c.cc.Invoke(ctx, AdminService_RollbackDeployment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListProjectJobs(ctx context.Context, in *ListProjectJobsRequest, opts ...grpc.CallOption) (*ListProjectJobsResponse, error) {
+	out := new(ListProjectJobsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListProjectJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobResponse, error) {
+	out := new(GetJobResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) HeartbeatRuntime(ctx context.Context, in *HeartbeatRuntimeRequest, opts ...grpc.CallOption) (*HeartbeatRuntimeResponse, error) {
+	out := new(HeartbeatRuntimeResponse)
+	err := c.cc.Invoke(ctx, AdminService_HeartbeatRuntime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListRuntimes(ctx context.Context, in *ListRuntimesRequest, opts ...grpc.CallOption) (*ListRuntimesResponse, error) {
+	out := new(ListRuntimesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListRuntimes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DrainRuntime(ctx context.Context, in *DrainRuntimeRequest, opts ...grpc.CallOption) (*DrainRuntimeResponse, error) {
+	out := new(DrainRuntimeResponse)
+	err := c.cc.Invoke(ctx, AdminService_DrainRuntime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UncordonRuntime(ctx context.Context, in *UncordonRuntimeRequest, opts ...grpc.CallOption) (*UncordonRuntimeResponse, error) {
+	out := new(UncordonRuntimeResponse)
+	err := c.cc.Invoke(ctx, AdminService_UncordonRuntime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListOrganizationMembers(ctx context.Context, in *ListOrganizationMembersRequest, opts ...grpc.CallOption) (*ListOrganizationMembersResponse, error) {
 	out := new(ListOrganizationMembersResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListOrganizationMembers_FullMethodName, in, out, opts...)
@@ -285,6 +568,24 @@ func (c *adminServiceClient) SetOrganizationMemberRole(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *adminServiceClient) GetOrganizationMemberAttributes(ctx context.Context, in *GetOrganizationMemberAttributesRequest, opts ...grpc.CallOption) (*GetOrganizationMemberAttributesResponse, error) {
+	out := new(GetOrganizationMemberAttributesResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetOrganizationMemberAttributes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetOrganizationMemberAttributes(ctx context.Context, in *SetOrganizationMemberAttributesRequest, opts ...grpc.CallOption) (*SetOrganizationMemberAttributesResponse, error) {
+	out := new(SetOrganizationMemberAttributesResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetOrganizationMemberAttributes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
 	out := new(ListProjectMembersResponse)
 	err := c.cc.Invoke(ctx, AdminService_ListProjectMembers_FullMethodName, in, out, opts...)
@@ -321,6 +622,69 @@ func (c *adminServiceClient) SetProjectMemberRole(ctx context.Context, in *SetPr
 	return out, nil
 }
 
+func (c *adminServiceClient) ListServiceAccounts(ctx context.Context, in *ListServiceAccountsRequest, opts ...grpc.CallOption) (*ListServiceAccountsResponse, error) {
+	out := new(ListServiceAccountsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListServiceAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*CreateServiceAccountResponse, error) {
+	out := new(CreateServiceAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteServiceAccount(ctx context.Context, in *DeleteServiceAccountRequest, opts ...grpc.CallOption) (*DeleteServiceAccountResponse, error) {
+	out := new(DeleteServiceAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListServiceAuthTokens(ctx context.Context, in *ListServiceAuthTokensRequest, opts ...grpc.CallOption) (*ListServiceAuthTokensResponse, error) {
+	out := new(ListServiceAuthTokensResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListServiceAuthTokens_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) IssueServiceAuthToken(ctx context.Context, in *IssueServiceAuthTokenRequest, opts ...grpc.CallOption) (*IssueServiceAuthTokenResponse, error) {
+	out := new(IssueServiceAuthTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_IssueServiceAuthToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RevokeServiceAuthToken(ctx context.Context, in *RevokeServiceAuthTokenRequest, opts ...grpc.CallOption) (*RevokeServiceAuthTokenResponse, error) {
+	out := new(RevokeServiceAuthTokenResponse)
+	err := c.cc.Invoke(ctx, AdminService_RevokeServiceAuthToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAuditLogEntries(ctx context.Context, in *ListAuditLogEntriesRequest, opts ...grpc.CallOption) (*ListAuditLogEntriesResponse, error) {
+	out := new(ListAuditLogEntriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAuditLogEntries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error) {
 	out := new(GetCurrentUserResponse)
 	err := c.cc.Invoke(ctx, AdminService_GetCurrentUser_FullMethodName, in, out, opts...)
@@ -364,6 +728,21 @@ type AdminServiceServer interface {
 	DeleteOrganization(context.Context, *DeleteOrganizationRequest) (*DeleteOrganizationResponse, error)
 	// UpdateOrganization deletes an organizations
 	UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error)
+	// GetOrganizationUsage returns the current usage and the quotas of an organization
+	GetOrganizationUsage(context.Context, *GetOrganizationUsageRequest) (*GetOrganizationUsageResponse, error)
+	// UpdateOrganizationQuotas sets the quotas of an organization (superusers only)
+	UpdateOrganizationQuotas(context.Context, *UpdateOrganizationQuotasRequest) (*UpdateOrganizationQuotasResponse, error)
+	// GetOrganizationSSO returns the identity provider of an organization and the mappings of its groups to usergroups
+	GetOrganizationSSO(context.Context, *GetOrganizationSSORequest) (*GetOrganizationSSOResponse, error)
+	// SetOrganizationSSO configures the OIDC identity provider that users with an email address in one of the domains sign in with.
+	// Each domain must have a DNS TXT record with the verification record returned by GetOrganizationSSO.
+	SetOrganizationSSO(context.Context, *SetOrganizationSSORequest) (*SetOrganizationSSOResponse, error)
+	// DeleteOrganizationSSO removes the identity provider of an organization and the usergroups mapped to its groups
+	DeleteOrganizationSSO(context.Context, *DeleteOrganizationSSORequest) (*DeleteOrganizationSSOResponse, error)
+	// AddOrganizationSSOGroup creates a usergroup with organization and project roles, whose members are the users in a group of the identity provider
+	AddOrganizationSSOGroup(context.Context, *AddOrganizationSSOGroupRequest) (*AddOrganizationSSOGroupResponse, error)
+	// RemoveOrganizationSSOGroup deletes the usergroup mapped to a group of the identity provider
+	RemoveOrganizationSSOGroup(context.Context, *RemoveOrganizationSSOGroupRequest) (*RemoveOrganizationSSOGroupResponse, error)
 	// ListProjectsForOrganization lists all the projects currently available for given organizations
 	ListProjectsForOrganization(context.Context, *ListProjectsForOrganizationRequest) (*ListProjectsForOrganizationResponse, error)
 	// ListProjectsForOrganizationAndGithubURL lists all the project for org deployed from githubURL
@@ -380,6 +759,36 @@ type AdminServiceServer interface {
 	UpdateProject(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
 	// UpdateProjectVariables updates variables for a project. NOTE: Update project API doesn't update variables.
 	UpdateProjectVariables(context.Context, *UpdateProjectVariablesRequest) (*UpdateProjectVariablesResponse, error)
+	// ListEnvironments lists the environments of a project, such as prod and staging, with their deployments
+	ListEnvironments(context.Context, *ListEnvironmentsRequest) (*ListEnvironmentsResponse, error)
+	// CreateEnvironment creates a named environment for a project and deploys it
+	CreateEnvironment(context.Context, *CreateEnvironmentRequest) (*CreateEnvironmentResponse, error)
+	// UpdateEnvironment changes the branch an environment is deployed from. It unpins the environment from a promoted commit.
+	UpdateEnvironment(context.Context, *UpdateEnvironmentRequest) (*UpdateEnvironmentResponse, error)
+	// DeleteEnvironment tears down the deployment of an environment and deletes it. The prod environment can't be deleted.
+	DeleteEnvironment(context.Context, *DeleteEnvironmentRequest) (*DeleteEnvironmentResponse, error)
+	// PromoteEnvironment deploys the commit of an environment to another environment, e.g. from staging to prod
+	PromoteEnvironment(context.Context, *PromoteEnvironmentRequest) (*PromoteEnvironmentResponse, error)
+	// ListDeploymentRevisions lists the most recent reconciles of a deployment, with the commit they deployed and their outcome
+	ListDeploymentRevisions(context.Context, *ListDeploymentRevisionsRequest) (*ListDeploymentRevisionsResponse, error)
+	// GetDeploymentRevision returns a reconcile of a deployment, including the results for each file and its logs
+	GetDeploymentRevision(context.Context, *GetDeploymentRevisionRequest) (*GetDeploymentRevisionResponse, error)
+	// RollbackDeployment redeploys the commit of a previous successful revision of a deployment.
+	// It pins the deployment's environment to the commit until the environment's branch is updated.
+	RollbackDeployment(context.Context, *RollbackDeploymentRequest) (*RollbackDeploymentResponse, error)
+	// ListProjectJobs lists the most recent background jobs of a project, such as reconciles of its deployments
+	ListProjectJobs(context.Context, *ListProjectJobsRequest) (*ListProjectJobsResponse, error)
+	// GetJob returns the status of a background job
+	GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error)
+	// HeartbeatRuntime registers a runtime server with the dynamic provisioner, or records that a registered runtime is alive.
+	// It's called periodically by runtime servers, which authenticate with the shared runtime secret.
+	HeartbeatRuntime(context.Context, *HeartbeatRuntimeRequest) (*HeartbeatRuntimeResponse, error)
+	// ListRuntimes lists the runtime servers that registered with the dynamic provisioner (superusers only)
+	ListRuntimes(context.Context, *ListRuntimesRequest) (*ListRuntimesResponse, error)
+	// DrainRuntime stops new deployments from being provisioned on a runtime and migrates its deployments to other runtimes (superusers only)
+	DrainRuntime(context.Context, *DrainRuntimeRequest) (*DrainRuntimeResponse, error)
+	// UncordonRuntime allows new deployments to be provisioned on a drained runtime again (superusers only)
+	UncordonRuntime(context.Context, *UncordonRuntimeRequest) (*UncordonRuntimeResponse, error)
 	// ListOrganizationMembers lists all the org members
 	ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error)
 	// AddOrganizationMember lists all the org members
@@ -390,6 +799,10 @@ type AdminServiceServer interface {
 	LeaveOrganization(context.Context, *LeaveOrganizationRequest) (*LeaveOrganizationResponse, error)
 	// SetOrganizationMemberRole sets the role for the member
 	SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error)
+	// GetOrganizationMemberAttributes returns the custom attributes of a member, which are available to metrics view policies
+	GetOrganizationMemberAttributes(context.Context, *GetOrganizationMemberAttributesRequest) (*GetOrganizationMemberAttributesResponse, error)
+	// SetOrganizationMemberAttributes replaces the custom attributes of a member
+	SetOrganizationMemberAttributes(context.Context, *SetOrganizationMemberAttributesRequest) (*SetOrganizationMemberAttributesResponse, error)
 	// ListProjectMembers lists all the project members
 	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
 	// AddProjectMember adds a member to the project
@@ -398,6 +811,21 @@ type AdminServiceServer interface {
 	RemoveProjectMember(context.Context, *RemoveProjectMemberRequest) (*RemoveProjectMemberResponse, error)
 	// SetProjectMemberRole sets the role for the member
 	SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error)
+	// ListServiceAccounts lists the service accounts of an organization
+	ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error)
+	// CreateServiceAccount creates a service account for an organization, e.g. for a CI pipeline
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error)
+	// DeleteServiceAccount deletes a service account and revokes its tokens
+	DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error)
+	// ListServiceAuthTokens lists the auth tokens of a service account
+	ListServiceAuthTokens(context.Context, *ListServiceAuthTokensRequest) (*ListServiceAuthTokensResponse, error)
+	// IssueServiceAuthToken issues an auth token for a service account.
+	// The token is restricted to the given projects (or all projects of the org if none are given) and permission scopes.
+	IssueServiceAuthToken(context.Context, *IssueServiceAuthTokenRequest) (*IssueServiceAuthTokenResponse, error)
+	// RevokeServiceAuthToken revokes an auth token of a service account
+	RevokeServiceAuthToken(context.Context, *RevokeServiceAuthTokenRequest) (*RevokeServiceAuthTokenResponse, error)
+	// ListAuditLogEntries lists the audit log of administrative actions in an organization, newest first
+	ListAuditLogEntries(context.Context, *ListAuditLogEntriesRequest) (*ListAuditLogEntriesResponse, error)
 	// GetCurrentUser returns the currently authenticated user (if any)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
 	// RevokeCurrentAuthToken revoke the current auth token
@@ -430,6 +858,27 @@ func (UnimplementedAdminServiceServer) DeleteOrganization(context.Context, *Dele
 func (UnimplementedAdminServiceServer) UpdateOrganization(context.Context, *UpdateOrganizationRequest) (*UpdateOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganization not implemented")
 }
+func (UnimplementedAdminServiceServer) GetOrganizationUsage(context.Context, *GetOrganizationUsageRequest) (*GetOrganizationUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationUsage not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateOrganizationQuotas(context.Context, *UpdateOrganizationQuotasRequest) (*UpdateOrganizationQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganizationQuotas not implemented")
+}
+func (UnimplementedAdminServiceServer) GetOrganizationSSO(context.Context, *GetOrganizationSSORequest) (*GetOrganizationSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationSSO not implemented")
+}
+func (UnimplementedAdminServiceServer) SetOrganizationSSO(context.Context, *SetOrganizationSSORequest) (*SetOrganizationSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationSSO not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteOrganizationSSO(context.Context, *DeleteOrganizationSSORequest) (*DeleteOrganizationSSOResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOrganizationSSO not implemented")
+}
+func (UnimplementedAdminServiceServer) AddOrganizationSSOGroup(context.Context, *AddOrganizationSSOGroupRequest) (*AddOrganizationSSOGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrganizationSSOGroup not implemented")
+}
+func (UnimplementedAdminServiceServer) RemoveOrganizationSSOGroup(context.Context, *RemoveOrganizationSSOGroupRequest) (*RemoveOrganizationSSOGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrganizationSSOGroup not implemented")
+}
 func (UnimplementedAdminServiceServer) ListProjectsForOrganization(context.Context, *ListProjectsForOrganizationRequest) (*ListProjectsForOrganizationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectsForOrganization not implemented")
 }
@@ -454,6 +903,48 @@ func (UnimplementedAdminServiceServer) UpdateProject(context.Context, *UpdatePro
 func (UnimplementedAdminServiceServer) UpdateProjectVariables(context.Context, *UpdateProjectVariablesRequest) (*UpdateProjectVariablesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectVariables not implemented")
 }
+func (UnimplementedAdminServiceServer) ListEnvironments(context.Context, *ListEnvironmentsRequest) (*ListEnvironmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnvironments not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateEnvironment(context.Context, *CreateEnvironmentRequest) (*CreateEnvironmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEnvironment not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateEnvironment(context.Context, *UpdateEnvironmentRequest) (*UpdateEnvironmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEnvironment not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteEnvironment(context.Context, *DeleteEnvironmentRequest) (*DeleteEnvironmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnvironment not implemented")
+}
+func (UnimplementedAdminServiceServer) PromoteEnvironment(context.Context, *PromoteEnvironmentRequest) (*PromoteEnvironmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteEnvironment not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDeploymentRevisions(context.Context, *ListDeploymentRevisionsRequest) (*ListDeploymentRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeploymentRevisions not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDeploymentRevision(context.Context, *GetDeploymentRevisionRequest) (*GetDeploymentRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentRevision not implemented")
+}
+func (UnimplementedAdminServiceServer) RollbackDeployment(context.Context, *RollbackDeploymentRequest) (*RollbackDeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackDeployment not implemented")
+}
+func (UnimplementedAdminServiceServer) ListProjectJobs(context.Context, *ListProjectJobsRequest) (*ListProjectJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectJobs not implemented")
+}
+func (UnimplementedAdminServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedAdminServiceServer) HeartbeatRuntime(context.Context, *HeartbeatRuntimeRequest) (*HeartbeatRuntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeartbeatRuntime not implemented")
+}
+func (UnimplementedAdminServiceServer) ListRuntimes(context.Context, *ListRuntimesRequest) (*ListRuntimesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuntimes not implemented")
+}
+func (UnimplementedAdminServiceServer) DrainRuntime(context.Context, *DrainRuntimeRequest) (*DrainRuntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainRuntime not implemented")
+}
+func (UnimplementedAdminServiceServer) UncordonRuntime(context.Context, *UncordonRuntimeRequest) (*UncordonRuntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonRuntime not implemented")
+}
 func (UnimplementedAdminServiceServer) ListOrganizationMembers(context.Context, *ListOrganizationMembersRequest) (*ListOrganizationMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListOrganizationMembers not implemented")
 }
@@ -469,6 +960,12 @@ func (UnimplementedAdminServiceServer) LeaveOrganization(context.Context, *Leave
 func (UnimplementedAdminServiceServer) SetOrganizationMemberRole(context.Context, *SetOrganizationMemberRoleRequest) (*SetOrganizationMemberRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMemberRole not implemented")
 }
+func (UnimplementedAdminServiceServer) GetOrganizationMemberAttributes(context.Context, *GetOrganizationMemberAttributesRequest) (*GetOrganizationMemberAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrganizationMemberAttributes not implemented")
+}
+func (UnimplementedAdminServiceServer) SetOrganizationMemberAttributes(context.Context, *SetOrganizationMemberAttributesRequest) (*SetOrganizationMemberAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganizationMemberAttributes not implemented")
+}
 func (UnimplementedAdminServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
 }
@@ -481,6 +978,27 @@ func (UnimplementedAdminServiceServer) RemoveProjectMember(context.Context, *Rem
 func (UnimplementedAdminServiceServer) SetProjectMemberRole(context.Context, *SetProjectMemberRoleRequest) (*SetProjectMemberRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMemberRole not implemented")
 }
+func (UnimplementedAdminServiceServer) ListServiceAccounts(context.Context, *ListServiceAccountsRequest) (*ListServiceAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAccounts not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*CreateServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteServiceAccount(context.Context, *DeleteServiceAccountRequest) (*DeleteServiceAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) ListServiceAuthTokens(context.Context, *ListServiceAuthTokensRequest) (*ListServiceAuthTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListServiceAuthTokens not implemented")
+}
+func (UnimplementedAdminServiceServer) IssueServiceAuthToken(context.Context, *IssueServiceAuthTokenRequest) (*IssueServiceAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueServiceAuthToken not implemented")
+}
+func (UnimplementedAdminServiceServer) RevokeServiceAuthToken(context.Context, *RevokeServiceAuthTokenRequest) (*RevokeServiceAuthTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeServiceAuthToken not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAuditLogEntries(context.Context, *ListAuditLogEntriesRequest) (*ListAuditLogEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogEntries not implemented")
+}
 func (UnimplementedAdminServiceServer) GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentUser not implemented")
 }
@@ -611,151 +1129,529 @@ func _AdminService_UpdateOrganization_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ListProjectsForOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectsForOrganizationRequest)
+func _AdminService_GetOrganizationUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationUsageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ListProjectsForOrganization(ctx, in)
+		return srv.(AdminServiceServer).GetOrganizationUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_ListProjectsForOrganization_FullMethodName,
+		FullMethod: AdminService_GetOrganizationUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListProjectsForOrganization(ctx, req.(*ListProjectsForOrganizationRequest))
+		return srv.(AdminServiceServer).GetOrganizationUsage(ctx, req.(*GetOrganizationUsageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ListProjectsForOrganizationAndGithubURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProjectsForOrganizationAndGithubURLRequest)
+func _AdminService_UpdateOrganizationQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOrganizationQuotasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).ListProjectsForOrganizationAndGithubURL(ctx, in)
+		return srv.(AdminServiceServer).UpdateOrganizationQuotas(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_ListProjectsForOrganizationAndGithubURL_FullMethodName,
+		FullMethod: AdminService_UpdateOrganizationQuotas_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).ListProjectsForOrganizationAndGithubURL(ctx, req.(*ListProjectsForOrganizationAndGithubURLRequest))
+		return srv.(AdminServiceServer).UpdateOrganizationQuotas(ctx, req.(*UpdateOrganizationQuotasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRequest)
+func _AdminService_GetOrganizationSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationSSORequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetProject(ctx, in)
+		return srv.(AdminServiceServer).GetOrganizationSSO(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_GetProject_FullMethodName,
+		FullMethod: AdminService_GetOrganizationSSO_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+		return srv.(AdminServiceServer).GetOrganizationSSO(ctx, req.(*GetOrganizationSSORequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_GetProjectVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectVariablesRequest)
+func _AdminService_SetOrganizationSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationSSORequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).GetProjectVariables(ctx, in)
+		return srv.(AdminServiceServer).SetOrganizationSSO(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_GetProjectVariables_FullMethodName,
+		FullMethod: AdminService_SetOrganizationSSO_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).GetProjectVariables(ctx, req.(*GetProjectVariablesRequest))
+		return srv.(AdminServiceServer).SetOrganizationSSO(ctx, req.(*SetOrganizationSSORequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _AdminService_DeleteOrganizationSSO_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOrganizationSSORequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).CreateProject(ctx, in)
+		return srv.(AdminServiceServer).DeleteOrganizationSSO(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_CreateProject_FullMethodName,
+		FullMethod: AdminService_DeleteOrganizationSSO_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(AdminServiceServer).DeleteOrganizationSSO(ctx, req.(*DeleteOrganizationSSORequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _AdminService_AddOrganizationSSOGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrganizationSSOGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).DeleteProject(ctx, in)
+		return srv.(AdminServiceServer).AddOrganizationSSOGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_DeleteProject_FullMethodName,
+		FullMethod: AdminService_AddOrganizationSSOGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+		return srv.(AdminServiceServer).AddOrganizationSSOGroup(ctx, req.(*AddOrganizationSSOGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _AdminService_RemoveOrganizationSSOGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrganizationSSOGroupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateProject(ctx, in)
+		return srv.(AdminServiceServer).RemoveOrganizationSSOGroup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_UpdateProject_FullMethodName,
+		FullMethod: AdminService_RemoveOrganizationSSOGroup_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+		return srv.(AdminServiceServer).RemoveOrganizationSSOGroup(ctx, req.(*RemoveOrganizationSSOGroupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_UpdateProjectVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectVariablesRequest)
+func _AdminService_ListProjectsForOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsForOrganizationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(AdminServiceServer).UpdateProjectVariables(ctx, in)
+		return srv.(AdminServiceServer).ListProjectsForOrganization(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: AdminService_UpdateProjectVariables_FullMethodName,
+		FullMethod: AdminService_ListProjectsForOrganization_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(AdminServiceServer).UpdateProjectVariables(ctx, req.(*UpdateProjectVariablesRequest))
+		return srv.(AdminServiceServer).ListProjectsForOrganization(ctx, req.(*ListProjectsForOrganizationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _AdminService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _AdminService_ListProjectsForOrganizationAndGithubURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsForOrganizationAndGithubURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectsForOrganizationAndGithubURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectsForOrganizationAndGithubURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectsForOrganizationAndGithubURL(ctx, req.(*ListProjectsForOrganizationAndGithubURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProject(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetProjectVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetProjectVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetProjectVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetProjectVariables(ctx, req.(*GetProjectVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateProject_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateProjectVariables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectVariablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateProjectVariables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateProjectVariables_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateProjectVariables(ctx, req.(*UpdateProjectVariablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListEnvironments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEnvironmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListEnvironments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListEnvironments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListEnvironments(ctx, req.(*ListEnvironmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateEnvironment(ctx, req.(*CreateEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateEnvironment(ctx, req.(*UpdateEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteEnvironment(ctx, req.(*DeleteEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_PromoteEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).PromoteEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_PromoteEnvironment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).PromoteEnvironment(ctx, req.(*PromoteEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDeploymentRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeploymentRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDeploymentRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDeploymentRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDeploymentRevisions(ctx, req.(*ListDeploymentRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDeploymentRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDeploymentRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDeploymentRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDeploymentRevision(ctx, req.(*GetDeploymentRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RollbackDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RollbackDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RollbackDeployment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RollbackDeployment(ctx, req.(*RollbackDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListProjectJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListProjectJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListProjectJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListProjectJobs(ctx, req.(*ListProjectJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_HeartbeatRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeartbeatRuntimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).HeartbeatRuntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_HeartbeatRuntime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).HeartbeatRuntime(ctx, req.(*HeartbeatRuntimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListRuntimes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuntimesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListRuntimes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListRuntimes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListRuntimes(ctx, req.(*ListRuntimesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DrainRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRuntimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DrainRuntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DrainRuntime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DrainRuntime(ctx, req.(*DrainRuntimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UncordonRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonRuntimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UncordonRuntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UncordonRuntime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UncordonRuntime(ctx, req.(*UncordonRuntimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListOrganizationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListOrganizationMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -845,6 +1741,42 @@ func _AdminService_SetOrganizationMemberRole_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetOrganizationMemberAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrganizationMemberAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetOrganizationMemberAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetOrganizationMemberAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetOrganizationMemberAttributes(ctx, req.(*GetOrganizationMemberAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetOrganizationMemberAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationMemberAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetOrganizationMemberAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetOrganizationMemberAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetOrganizationMemberAttributes(ctx, req.(*SetOrganizationMemberAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectMembersRequest)
 	if err := dec(in); err != nil {
@@ -917,6 +1849,132 @@ func _AdminService_SetProjectMemberRole_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ListServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListServiceAccounts(ctx, req.(*ListServiceAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteServiceAccount(ctx, req.(*DeleteServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListServiceAuthTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListServiceAuthTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListServiceAuthTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListServiceAuthTokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListServiceAuthTokens(ctx, req.(*ListServiceAuthTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_IssueServiceAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueServiceAuthTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).IssueServiceAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_IssueServiceAuthToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).IssueServiceAuthToken(ctx, req.(*IssueServiceAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RevokeServiceAuthToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeServiceAuthTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RevokeServiceAuthToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RevokeServiceAuthToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RevokeServiceAuthToken(ctx, req.(*RevokeServiceAuthTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAuditLogEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAuditLogEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAuditLogEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAuditLogEntries(ctx, req.(*ListAuditLogEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCurrentUserRequest)
 	if err := dec(in); err != nil {
@@ -1002,6 +2060,34 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrganization",
 			Handler:    _AdminService_UpdateOrganization_Handler,
 		},
+		{
+			MethodName: "GetOrganizationUsage",
+			Handler:    _AdminService_GetOrganizationUsage_Handler,
+		},
+		{
+			MethodName: "UpdateOrganizationQuotas",
+			Handler:    _AdminService_UpdateOrganizationQuotas_Handler,
+		},
+		{
+			MethodName: "GetOrganizationSSO",
+			Handler:    _AdminService_GetOrganizationSSO_Handler,
+		},
+		{
+			MethodName: "SetOrganizationSSO",
+			Handler:    _AdminService_SetOrganizationSSO_Handler,
+		},
+		{
+			MethodName: "DeleteOrganizationSSO",
+			Handler:    _AdminService_DeleteOrganizationSSO_Handler,
+		},
+		{
+			MethodName: "AddOrganizationSSOGroup",
+			Handler:    _AdminService_AddOrganizationSSOGroup_Handler,
+		},
+		{
+			MethodName: "RemoveOrganizationSSOGroup",
+			Handler:    _AdminService_RemoveOrganizationSSOGroup_Handler,
+		},
 		{
 			MethodName: "ListProjectsForOrganization",
 			Handler:    _AdminService_ListProjectsForOrganization_Handler,
@@ -1034,6 +2120,62 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProjectVariables",
 			Handler:    _AdminService_UpdateProjectVariables_Handler,
 		},
+		{
+			MethodName: "ListEnvironments",
+			Handler:    _AdminService_ListEnvironments_Handler,
+		},
+		{
+			MethodName: "CreateEnvironment",
+			Handler:    _AdminService_CreateEnvironment_Handler,
+		},
+		{
+			MethodName: "UpdateEnvironment",
+			Handler:    _AdminService_UpdateEnvironment_Handler,
+		},
+		{
+			MethodName: "DeleteEnvironment",
+			Handler:    _AdminService_DeleteEnvironment_Handler,
+		},
+		{
+			MethodName: "PromoteEnvironment",
+			Handler:    _AdminService_PromoteEnvironment_Handler,
+		},
+		{
+			MethodName: "ListDeploymentRevisions",
+			Handler:    _AdminService_ListDeploymentRevisions_Handler,
+		},
+		{
+			MethodName: "GetDeploymentRevision",
+			Handler:    _AdminService_GetDeploymentRevision_Handler,
+		},
+		{
+			MethodName: "RollbackDeployment",
+			Handler:    _AdminService_RollbackDeployment_Handler,
+		},
+		{
+			MethodName: "ListProjectJobs",
+			Handler:    _AdminService_ListProjectJobs_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _AdminService_GetJob_Handler,
+		},
+		{
+			MethodName: "HeartbeatRuntime",
+			Handler:    _AdminService_HeartbeatRuntime_Handler,
+		},
+		{
+			MethodName: "ListRuntimes",
+			Handler:    _AdminService_ListRuntimes_Handler,
+		},
+		{
+			MethodName: "DrainRuntime",
+			Handler:    _AdminService_DrainRuntime_Handler,
+		},
+		{
+			MethodName: "UncordonRuntime",
+			Handler:    _AdminService_UncordonRuntime_Handler,
+		},
 		{
 			MethodName: "ListOrganizationMembers",
 			Handler:    _AdminService_ListOrganizationMembers_Handler,
@@ -1054,6 +2196,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetOrganizationMemberRole",
 			Handler:    _AdminService_SetOrganizationMemberRole_Handler,
 		},
+		{
+			MethodName: "GetOrganizationMemberAttributes",
+			Handler:    _AdminService_GetOrganizationMemberAttributes_Handler,
+		},
+		{
+			MethodName: "SetOrganizationMemberAttributes",
+			Handler:    _AdminService_SetOrganizationMemberAttributes_Handler,
+		},
 		{
 			MethodName: "ListProjectMembers",
 			Handler:    _AdminService_ListProjectMembers_Handler,
@@ -1070,6 +2220,34 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProjectMemberRole",
 			Handler:    _AdminService_SetProjectMemberRole_Handler,
 		},
+		{
+			MethodName: "ListServiceAccounts",
+			Handler:    _AdminService_ListServiceAccounts_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _AdminService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _AdminService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "ListServiceAuthTokens",
+			Handler:    _AdminService_ListServiceAuthTokens_Handler,
+		},
+		{
+			MethodName: "IssueServiceAuthToken",
+			Handler:    _AdminService_IssueServiceAuthToken_Handler,
+		},
+		{
+			MethodName: "RevokeServiceAuthToken",
+			Handler:    _AdminService_RevokeServiceAuthToken_Handler,
+		},
+		{
+			MethodName: "ListAuditLogEntries",
+			Handler:    _AdminService_ListAuditLogEntries_Handler,
+		},
 		{
 			MethodName: "GetCurrentUser",
 			Handler:    _AdminService_GetCurrentUser_Handler,
@@ -331,6 +331,10 @@ func (m *Instance) validate(all bool) error {
 
 	// no validation rules for IngestionLimitBytes
 
+	// no validation rules for ReconcileConcurrency
+
+	// no validation rules for Profile
+
 	if len(errors) > 0 {
 		return InstanceMultiError(errors)
 	}
@@ -980,6 +984,28 @@ func (m *CreateInstanceRequest) validate(all bool) error {
 
 	// no validation rules for IngestionLimitBytes
 
+	if m.GetReconcileConcurrency() < 0 {
+		err := CreateInstanceRequestValidationError{
+			field:  "ReconcileConcurrency",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateInstanceRequest_Profile_InLookup[m.GetProfile()]; !ok {
+		err := CreateInstanceRequestValidationError{
+			field:  "Profile",
+			reason: "value must be in list [ dev prod]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateInstanceRequestMultiError(errors)
 	}
@@ -1073,6 +1099,12 @@ var _CreateInstanceRequest_RepoDriver_InLookup = map[string]struct{}{
 	"github":    {},
 }
 
+var _CreateInstanceRequest_Profile_InLookup = map[string]struct{}{
+	"":     {},
+	"dev":  {},
+	"prod": {},
+}
+
 // Validate checks the field values on CreateInstanceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1488,6 +1520,28 @@ func (m *EditInstanceRequest) validate(all bool) error {
 
 	// no validation rules for IngestionLimitBytes
 
+	if m.GetReconcileConcurrency() < 0 {
+		err := EditInstanceRequestValidationError{
+			field:  "ReconcileConcurrency",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _EditInstanceRequest_Profile_InLookup[m.GetProfile()]; !ok {
+		err := EditInstanceRequestValidationError{
+			field:  "Profile",
+			reason: "value must be in list [ dev prod]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return EditInstanceRequestMultiError(errors)
 	}
@@ -1581,6 +1635,12 @@ var _EditInstanceRequest_RepoDriver_InLookup = map[string]struct{}{
 	"github":    {},
 }
 
+var _EditInstanceRequest_Profile_InLookup = map[string]struct{}{
+	"":     {},
+	"dev":  {},
+	"prod": {},
+}
+
 // Validate checks the field values on EditInstanceResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1712,30 +1772,30 @@ var _ interface {
 	ErrorName() string
 } = EditInstanceResponseValidationError{}
 
-// Validate checks the field values on ListFilesRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListFilesRequest) Validate() error {
+// Validate checks the field values on GetInstanceUsageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInstanceUsageRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFilesRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetInstanceUsageRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFilesRequestMultiError, or nil if none found.
-func (m *ListFilesRequest) ValidateAll() error {
+// GetInstanceUsageRequestMultiError, or nil if none found.
+func (m *GetInstanceUsageRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFilesRequest) validate(all bool) error {
+func (m *GetInstanceUsageRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ListFilesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := ListFilesRequestValidationError{
+	if !_GetInstanceUsageRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GetInstanceUsageRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -1745,22 +1805,20 @@ func (m *ListFilesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Glob
-
 	if len(errors) > 0 {
-		return ListFilesRequestMultiError(errors)
+		return GetInstanceUsageRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFilesRequestMultiError is an error wrapping multiple validation errors
-// returned by ListFilesRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListFilesRequestMultiError []error
+// GetInstanceUsageRequestMultiError is an error wrapping multiple validation
+// errors returned by GetInstanceUsageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetInstanceUsageRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFilesRequestMultiError) Error() string {
+func (m GetInstanceUsageRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1769,11 +1827,11 @@ func (m ListFilesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFilesRequestMultiError) AllErrors() []error { return m }
+func (m GetInstanceUsageRequestMultiError) AllErrors() []error { return m }
 
-// ListFilesRequestValidationError is the validation error returned by
-// ListFilesRequest.Validate if the designated constraints aren't met.
-type ListFilesRequestValidationError struct {
+// GetInstanceUsageRequestValidationError is the validation error returned by
+// GetInstanceUsageRequest.Validate if the designated constraints aren't met.
+type GetInstanceUsageRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1781,22 +1839,24 @@ type ListFilesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFilesRequestValidationError) Field() string { return e.field }
+func (e GetInstanceUsageRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFilesRequestValidationError) Reason() string { return e.reason }
+func (e GetInstanceUsageRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFilesRequestValidationError) Cause() error { return e.cause }
+func (e GetInstanceUsageRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFilesRequestValidationError) Key() bool { return e.key }
+func (e GetInstanceUsageRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFilesRequestValidationError) ErrorName() string { return "ListFilesRequestValidationError" }
+func (e GetInstanceUsageRequestValidationError) ErrorName() string {
+	return "GetInstanceUsageRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListFilesRequestValidationError) Error() string {
+func (e GetInstanceUsageRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1808,14 +1868,14 @@ func (e ListFilesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFilesRequest.%s: %s%s",
+		"invalid %sGetInstanceUsageRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFilesRequestValidationError{}
+var _ error = GetInstanceUsageRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1823,46 +1883,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFilesRequestValidationError{}
+} = GetInstanceUsageRequestValidationError{}
 
-var _ListFilesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _GetInstanceUsageRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on ListFilesResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListFilesResponse) Validate() error {
+// Validate checks the field values on GetInstanceUsageResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInstanceUsageResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListFilesResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetInstanceUsageResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListFilesResponseMultiError, or nil if none found.
-func (m *ListFilesResponse) ValidateAll() error {
+// GetInstanceUsageResponseMultiError, or nil if none found.
+func (m *GetInstanceUsageResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListFilesResponse) validate(all bool) error {
+func (m *GetInstanceUsageResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for BytesIngested
+
+	// no validation rules for Queries
+
+	// no validation rules for CachedQueries
+
+	if all {
+		switch v := interface{}(m.GetCountersStartedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInstanceUsageResponseValidationError{
+					field:  "CountersStartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInstanceUsageResponseValidationError{
+					field:  "CountersStartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCountersStartedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInstanceUsageResponseValidationError{
+				field:  "CountersStartedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListFilesResponseMultiError(errors)
+		return GetInstanceUsageResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListFilesResponseMultiError is an error wrapping multiple validation errors
-// returned by ListFilesResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListFilesResponseMultiError []error
+// GetInstanceUsageResponseMultiError is an error wrapping multiple validation
+// errors returned by GetInstanceUsageResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetInstanceUsageResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListFilesResponseMultiError) Error() string {
+func (m GetInstanceUsageResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1871,11 +1966,11 @@ func (m ListFilesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListFilesResponseMultiError) AllErrors() []error { return m }
+func (m GetInstanceUsageResponseMultiError) AllErrors() []error { return m }
 
-// ListFilesResponseValidationError is the validation error returned by
-// ListFilesResponse.Validate if the designated constraints aren't met.
-type ListFilesResponseValidationError struct {
+// GetInstanceUsageResponseValidationError is the validation error returned by
+// GetInstanceUsageResponse.Validate if the designated constraints aren't met.
+type GetInstanceUsageResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1883,24 +1978,24 @@ type ListFilesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListFilesResponseValidationError) Field() string { return e.field }
+func (e GetInstanceUsageResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListFilesResponseValidationError) Reason() string { return e.reason }
+func (e GetInstanceUsageResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListFilesResponseValidationError) Cause() error { return e.cause }
+func (e GetInstanceUsageResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListFilesResponseValidationError) Key() bool { return e.key }
+func (e GetInstanceUsageResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListFilesResponseValidationError) ErrorName() string {
-	return "ListFilesResponseValidationError"
+func (e GetInstanceUsageResponseValidationError) ErrorName() string {
+	return "GetInstanceUsageResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListFilesResponseValidationError) Error() string {
+func (e GetInstanceUsageResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1912,14 +2007,14 @@ func (e ListFilesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListFilesResponse.%s: %s%s",
+		"invalid %sGetInstanceUsageResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListFilesResponseValidationError{}
+var _ error = GetInstanceUsageResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1927,66 +2022,86 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListFilesResponseValidationError{}
+} = GetInstanceUsageResponseValidationError{}
 
-// Validate checks the field values on GetFileRequest with the rules defined in
+// Validate checks the field values on RunningQuery with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *GetFileRequest) Validate() error {
+func (m *RunningQuery) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFileRequest with the rules defined
+// ValidateAll checks the field values on RunningQuery with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in GetFileRequestMultiError,
-// or nil if none found.
-func (m *GetFileRequest) ValidateAll() error {
+// result is a list of violation errors wrapped in RunningQueryMultiError, or
+// nil if none found.
+func (m *RunningQuery) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFileRequest) validate(all bool) error {
+func (m *RunningQuery) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := GetFileRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Id
 
-	if utf8.RuneCountInString(m.GetPath()) < 1 {
-		err := GetFileRequestValidationError{
-			field:  "Path",
-			reason: "value length must be at least 1 runes",
+	// no validation rules for InstanceId
+
+	// no validation rules for Sql
+
+	// no validation rules for Priority
+
+	// no validation rules for Subject
+
+	if all {
+		switch v := interface{}(m.GetStartedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RunningQueryValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RunningQueryValidationError{
+					field:  "StartedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
 		}
-		if !all {
-			return err
+	} else if v, ok := interface{}(m.GetStartedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RunningQueryValidationError{
+				field:  "StartedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
 		}
-		errors = append(errors, err)
 	}
 
+	// no validation rules for ElapsedMs
+
+	// no validation rules for Queued
+
 	if len(errors) > 0 {
-		return GetFileRequestMultiError(errors)
+		return RunningQueryMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFileRequestMultiError is an error wrapping multiple validation errors
-// returned by GetFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetFileRequestMultiError []error
+// RunningQueryMultiError is an error wrapping multiple validation errors
+// returned by RunningQuery.ValidateAll() if the designated constraints aren't met.
+type RunningQueryMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFileRequestMultiError) Error() string {
+func (m RunningQueryMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1995,11 +2110,11 @@ func (m GetFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFileRequestMultiError) AllErrors() []error { return m }
+func (m RunningQueryMultiError) AllErrors() []error { return m }
 
-// GetFileRequestValidationError is the validation error returned by
-// GetFileRequest.Validate if the designated constraints aren't met.
-type GetFileRequestValidationError struct {
+// RunningQueryValidationError is the validation error returned by
+// RunningQuery.Validate if the designated constraints aren't met.
+type RunningQueryValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2007,22 +2122,22 @@ type GetFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFileRequestValidationError) Field() string { return e.field }
+func (e RunningQueryValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFileRequestValidationError) Reason() string { return e.reason }
+func (e RunningQueryValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFileRequestValidationError) Cause() error { return e.cause }
+func (e RunningQueryValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFileRequestValidationError) Key() bool { return e.key }
+func (e RunningQueryValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
+func (e RunningQueryValidationError) ErrorName() string { return "RunningQueryValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetFileRequestValidationError) Error() string {
+func (e RunningQueryValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2034,14 +2149,14 @@ func (e GetFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFileRequest.%s: %s%s",
+		"invalid %sRunningQuery.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFileRequestValidationError{}
+var _ error = RunningQueryValidationError{}
 
 var _ interface {
 	Field() string
@@ -2049,77 +2164,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFileRequestValidationError{}
+} = RunningQueryValidationError{}
 
-var _GetFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
-
-// Validate checks the field values on GetFileResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetFileResponse) Validate() error {
+// Validate checks the field values on ListRunningQueriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRunningQueriesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ListRunningQueriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetFileResponseMultiError, or nil if none found.
-func (m *GetFileResponse) ValidateAll() error {
+// ListRunningQueriesRequestMultiError, or nil if none found.
+func (m *ListRunningQueriesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetFileResponse) validate(all bool) error {
+func (m *ListRunningQueriesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Blob
-
-	if all {
-		switch v := interface{}(m.GetUpdatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetFileResponseValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetFileResponseValidationError{
-					field:  "UpdatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if !_ListRunningQueriesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ListRunningQueriesRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
-	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetFileResponseValidationError{
-				field:  "UpdatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return GetFileResponseMultiError(errors)
+		return ListRunningQueriesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetFileResponseMultiError is an error wrapping multiple validation errors
-// returned by GetFileResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetFileResponseMultiError []error
+// ListRunningQueriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListRunningQueriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListRunningQueriesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetFileResponseMultiError) Error() string {
+func (m ListRunningQueriesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2128,11 +2221,11 @@ func (m GetFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetFileResponseMultiError) AllErrors() []error { return m }
+func (m ListRunningQueriesRequestMultiError) AllErrors() []error { return m }
 
-// GetFileResponseValidationError is the validation error returned by
-// GetFileResponse.Validate if the designated constraints aren't met.
-type GetFileResponseValidationError struct {
+// ListRunningQueriesRequestValidationError is the validation error returned by
+// ListRunningQueriesRequest.Validate if the designated constraints aren't met.
+type ListRunningQueriesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2140,22 +2233,24 @@ type GetFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetFileResponseValidationError) Field() string { return e.field }
+func (e ListRunningQueriesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetFileResponseValidationError) Reason() string { return e.reason }
+func (e ListRunningQueriesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetFileResponseValidationError) Cause() error { return e.cause }
+func (e ListRunningQueriesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetFileResponseValidationError) Key() bool { return e.key }
+func (e ListRunningQueriesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetFileResponseValidationError) ErrorName() string { return "GetFileResponseValidationError" }
+func (e ListRunningQueriesRequestValidationError) ErrorName() string {
+	return "ListRunningQueriesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetFileResponseValidationError) Error() string {
+func (e ListRunningQueriesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2167,14 +2262,14 @@ func (e GetFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetFileResponse.%s: %s%s",
+		"invalid %sListRunningQueriesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetFileResponseValidationError{}
+var _ error = ListRunningQueriesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2182,72 +2277,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetFileResponseValidationError{}
+} = ListRunningQueriesRequestValidationError{}
 
-// Validate checks the field values on PutFileRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PutFileRequest) Validate() error {
+var _ListRunningQueriesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on ListRunningQueriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListRunningQueriesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PutFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in PutFileRequestMultiError,
-// or nil if none found.
-func (m *PutFileRequest) ValidateAll() error {
+// ValidateAll checks the field values on ListRunningQueriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListRunningQueriesResponseMultiError, or nil if none found.
+func (m *ListRunningQueriesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PutFileRequest) validate(all bool) error {
+func (m *ListRunningQueriesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_PutFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := PutFileRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
 
-	if utf8.RuneCountInString(m.GetPath()) < 1 {
-		err := PutFileRequestValidationError{
-			field:  "Path",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListRunningQueriesResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListRunningQueriesResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListRunningQueriesResponseValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Blob
-
-	// no validation rules for Create
 
-	// no validation rules for CreateOnly
+	}
 
 	if len(errors) > 0 {
-		return PutFileRequestMultiError(errors)
+		return ListRunningQueriesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PutFileRequestMultiError is an error wrapping multiple validation errors
-// returned by PutFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type PutFileRequestMultiError []error
+// ListRunningQueriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListRunningQueriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListRunningQueriesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PutFileRequestMultiError) Error() string {
+func (m ListRunningQueriesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2256,11 +2359,11 @@ func (m PutFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PutFileRequestMultiError) AllErrors() []error { return m }
+func (m ListRunningQueriesResponseMultiError) AllErrors() []error { return m }
 
-// PutFileRequestValidationError is the validation error returned by
-// PutFileRequest.Validate if the designated constraints aren't met.
-type PutFileRequestValidationError struct {
+// ListRunningQueriesResponseValidationError is the validation error returned
+// by ListRunningQueriesResponse.Validate if the designated constraints aren't met.
+type ListRunningQueriesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2268,22 +2371,24 @@ type PutFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PutFileRequestValidationError) Field() string { return e.field }
+func (e ListRunningQueriesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PutFileRequestValidationError) Reason() string { return e.reason }
+func (e ListRunningQueriesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PutFileRequestValidationError) Cause() error { return e.cause }
+func (e ListRunningQueriesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PutFileRequestValidationError) Key() bool { return e.key }
+func (e ListRunningQueriesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PutFileRequestValidationError) ErrorName() string { return "PutFileRequestValidationError" }
+func (e ListRunningQueriesResponseValidationError) ErrorName() string {
+	return "ListRunningQueriesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PutFileRequestValidationError) Error() string {
+func (e ListRunningQueriesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2295,14 +2400,14 @@ func (e PutFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPutFileRequest.%s: %s%s",
+		"invalid %sListRunningQueriesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PutFileRequestValidationError{}
+var _ error = ListRunningQueriesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2310,48 +2415,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PutFileRequestValidationError{}
-
-var _PutFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = ListRunningQueriesResponseValidationError{}
 
-// Validate checks the field values on PutFileResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *PutFileResponse) Validate() error {
+// Validate checks the field values on CancelRunningQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelRunningQueryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PutFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CancelRunningQueryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PutFileResponseMultiError, or nil if none found.
-func (m *PutFileResponse) ValidateAll() error {
+// CancelRunningQueryRequestMultiError, or nil if none found.
+func (m *CancelRunningQueryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PutFileResponse) validate(all bool) error {
+func (m *CancelRunningQueryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for FilePath
+	if !_CancelRunningQueryRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := CancelRunningQueryRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQueryId()) < 1 {
+		err := CancelRunningQueryRequestValidationError{
+			field:  "QueryId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return PutFileResponseMultiError(errors)
+		return CancelRunningQueryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PutFileResponseMultiError is an error wrapping multiple validation errors
-// returned by PutFileResponse.ValidateAll() if the designated constraints
-// aren't met.
-type PutFileResponseMultiError []error
+// CancelRunningQueryRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelRunningQueryRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CancelRunningQueryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PutFileResponseMultiError) Error() string {
+func (m CancelRunningQueryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2360,11 +2483,11 @@ func (m PutFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PutFileResponseMultiError) AllErrors() []error { return m }
+func (m CancelRunningQueryRequestMultiError) AllErrors() []error { return m }
 
-// PutFileResponseValidationError is the validation error returned by
-// PutFileResponse.Validate if the designated constraints aren't met.
-type PutFileResponseValidationError struct {
+// CancelRunningQueryRequestValidationError is the validation error returned by
+// CancelRunningQueryRequest.Validate if the designated constraints aren't met.
+type CancelRunningQueryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2372,22 +2495,24 @@ type PutFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PutFileResponseValidationError) Field() string { return e.field }
+func (e CancelRunningQueryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PutFileResponseValidationError) Reason() string { return e.reason }
+func (e CancelRunningQueryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PutFileResponseValidationError) Cause() error { return e.cause }
+func (e CancelRunningQueryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PutFileResponseValidationError) Key() bool { return e.key }
+func (e CancelRunningQueryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PutFileResponseValidationError) ErrorName() string { return "PutFileResponseValidationError" }
+func (e CancelRunningQueryRequestValidationError) ErrorName() string {
+	return "CancelRunningQueryRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PutFileResponseValidationError) Error() string {
+func (e CancelRunningQueryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2399,14 +2524,14 @@ func (e PutFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPutFileResponse.%s: %s%s",
+		"invalid %sCancelRunningQueryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PutFileResponseValidationError{}
+var _ error = CancelRunningQueryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2414,66 +2539,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PutFileResponseValidationError{}
+} = CancelRunningQueryRequestValidationError{}
 
-// Validate checks the field values on DeleteFileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *DeleteFileRequest) Validate() error {
+var _CancelRunningQueryRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on CancelRunningQueryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelRunningQueryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFileRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on CancelRunningQueryResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteFileRequestMultiError, or nil if none found.
-func (m *DeleteFileRequest) ValidateAll() error {
+// CancelRunningQueryResponseMultiError, or nil if none found.
+func (m *CancelRunningQueryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFileRequest) validate(all bool) error {
+func (m *CancelRunningQueryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_DeleteFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := DeleteFileRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetPath()) < 1 {
-		err := DeleteFileRequestValidationError{
-			field:  "Path",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return DeleteFileRequestMultiError(errors)
+		return CancelRunningQueryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFileRequestMultiError is an error wrapping multiple validation errors
-// returned by DeleteFileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type DeleteFileRequestMultiError []error
+// CancelRunningQueryResponseMultiError is an error wrapping multiple
+// validation errors returned by CancelRunningQueryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CancelRunningQueryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFileRequestMultiError) Error() string {
+func (m CancelRunningQueryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2482,11 +2587,11 @@ func (m DeleteFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFileRequestMultiError) AllErrors() []error { return m }
+func (m CancelRunningQueryResponseMultiError) AllErrors() []error { return m }
 
-// DeleteFileRequestValidationError is the validation error returned by
-// DeleteFileRequest.Validate if the designated constraints aren't met.
-type DeleteFileRequestValidationError struct {
+// CancelRunningQueryResponseValidationError is the validation error returned
+// by CancelRunningQueryResponse.Validate if the designated constraints aren't met.
+type CancelRunningQueryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2494,24 +2599,24 @@ type DeleteFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFileRequestValidationError) Field() string { return e.field }
+func (e CancelRunningQueryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFileRequestValidationError) Reason() string { return e.reason }
+func (e CancelRunningQueryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFileRequestValidationError) Cause() error { return e.cause }
+func (e CancelRunningQueryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFileRequestValidationError) Key() bool { return e.key }
+func (e CancelRunningQueryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFileRequestValidationError) ErrorName() string {
-	return "DeleteFileRequestValidationError"
+func (e CancelRunningQueryResponseValidationError) ErrorName() string {
+	return "CancelRunningQueryResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e DeleteFileRequestValidationError) Error() string {
+func (e CancelRunningQueryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2523,14 +2628,14 @@ func (e DeleteFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFileRequest.%s: %s%s",
+		"invalid %sCancelRunningQueryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFileRequestValidationError{}
+var _ error = CancelRunningQueryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2538,46 +2643,57 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFileRequestValidationError{}
-
-var _DeleteFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = CancelRunningQueryResponseValidationError{}
 
-// Validate checks the field values on DeleteFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeleteFileResponse) Validate() error {
+// Validate checks the field values on ListFilesRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListFilesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeleteFileResponse with the rules
+// ValidateAll checks the field values on ListFilesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// DeleteFileResponseMultiError, or nil if none found.
-func (m *DeleteFileResponse) ValidateAll() error {
+// ListFilesRequestMultiError, or nil if none found.
+func (m *ListFilesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeleteFileResponse) validate(all bool) error {
+func (m *ListFilesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_ListFilesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ListFilesRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Glob
+
 	if len(errors) > 0 {
-		return DeleteFileResponseMultiError(errors)
+		return ListFilesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeleteFileResponseMultiError is an error wrapping multiple validation errors
-// returned by DeleteFileResponse.ValidateAll() if the designated constraints
+// ListFilesRequestMultiError is an error wrapping multiple validation errors
+// returned by ListFilesRequest.ValidateAll() if the designated constraints
 // aren't met.
-type DeleteFileResponseMultiError []error
+type ListFilesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeleteFileResponseMultiError) Error() string {
+func (m ListFilesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2586,11 +2702,11 @@ func (m DeleteFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeleteFileResponseMultiError) AllErrors() []error { return m }
+func (m ListFilesRequestMultiError) AllErrors() []error { return m }
 
-// DeleteFileResponseValidationError is the validation error returned by
-// DeleteFileResponse.Validate if the designated constraints aren't met.
-type DeleteFileResponseValidationError struct {
+// ListFilesRequestValidationError is the validation error returned by
+// ListFilesRequest.Validate if the designated constraints aren't met.
+type ListFilesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2598,24 +2714,22 @@ type DeleteFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeleteFileResponseValidationError) Field() string { return e.field }
+func (e ListFilesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeleteFileResponseValidationError) Reason() string { return e.reason }
+func (e ListFilesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeleteFileResponseValidationError) Cause() error { return e.cause }
+func (e ListFilesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeleteFileResponseValidationError) Key() bool { return e.key }
+func (e ListFilesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeleteFileResponseValidationError) ErrorName() string {
-	return "DeleteFileResponseValidationError"
-}
+func (e ListFilesRequestValidationError) ErrorName() string { return "ListFilesRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeleteFileResponseValidationError) Error() string {
+func (e ListFilesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2627,14 +2741,14 @@ func (e DeleteFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeleteFileResponse.%s: %s%s",
+		"invalid %sListFilesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeleteFileResponseValidationError{}
+var _ error = ListFilesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2642,77 +2756,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeleteFileResponseValidationError{}
+} = ListFilesRequestValidationError{}
 
-// Validate checks the field values on RenameFileRequest with the rules defined
+var _ListFilesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on ListFilesResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *RenameFileRequest) Validate() error {
+func (m *ListFilesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenameFileRequest with the rules
+// ValidateAll checks the field values on ListFilesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// RenameFileRequestMultiError, or nil if none found.
-func (m *RenameFileRequest) ValidateAll() error {
+// ListFilesResponseMultiError, or nil if none found.
+func (m *ListFilesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenameFileRequest) validate(all bool) error {
+func (m *ListFilesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_RenameFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := RenameFileRequestValidationError{
-			field:  "InstanceId",
-			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetFromPath()) < 1 {
-		err := RenameFileRequestValidationError{
-			field:  "FromPath",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetToPath()) < 1 {
-		err := RenameFileRequestValidationError{
-			field:  "ToPath",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if len(errors) > 0 {
-		return RenameFileRequestMultiError(errors)
+		return ListFilesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// RenameFileRequestMultiError is an error wrapping multiple validation errors
-// returned by RenameFileRequest.ValidateAll() if the designated constraints
+// ListFilesResponseMultiError is an error wrapping multiple validation errors
+// returned by ListFilesResponse.ValidateAll() if the designated constraints
 // aren't met.
-type RenameFileRequestMultiError []error
+type ListFilesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenameFileRequestMultiError) Error() string {
+func (m ListFilesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2721,11 +2804,11 @@ func (m RenameFileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenameFileRequestMultiError) AllErrors() []error { return m }
+func (m ListFilesResponseMultiError) AllErrors() []error { return m }
 
-// RenameFileRequestValidationError is the validation error returned by
-// RenameFileRequest.Validate if the designated constraints aren't met.
-type RenameFileRequestValidationError struct {
+// ListFilesResponseValidationError is the validation error returned by
+// ListFilesResponse.Validate if the designated constraints aren't met.
+type ListFilesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2733,24 +2816,24 @@ type RenameFileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameFileRequestValidationError) Field() string { return e.field }
+func (e ListFilesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameFileRequestValidationError) Reason() string { return e.reason }
+func (e ListFilesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameFileRequestValidationError) Cause() error { return e.cause }
+func (e ListFilesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameFileRequestValidationError) Key() bool { return e.key }
+func (e ListFilesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameFileRequestValidationError) ErrorName() string {
-	return "RenameFileRequestValidationError"
+func (e ListFilesResponseValidationError) ErrorName() string {
+	return "ListFilesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e RenameFileRequestValidationError) Error() string {
+func (e ListFilesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2762,14 +2845,14 @@ func (e RenameFileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameFileRequest.%s: %s%s",
+		"invalid %sListFilesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameFileRequestValidationError{}
+var _ error = ListFilesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2777,46 +2860,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenameFileRequestValidationError{}
-
-var _RenameFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+} = ListFilesResponseValidationError{}
 
-// Validate checks the field values on RenameFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *RenameFileResponse) Validate() error {
+// Validate checks the field values on GetFileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RenameFileResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// RenameFileResponseMultiError, or nil if none found.
-func (m *RenameFileResponse) ValidateAll() error {
+// ValidateAll checks the field values on GetFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetFileRequestMultiError,
+// or nil if none found.
+func (m *GetFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RenameFileResponse) validate(all bool) error {
+func (m *GetFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if !_GetFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GetFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := GetFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return RenameFileResponseMultiError(errors)
+		return GetFileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// RenameFileResponseMultiError is an error wrapping multiple validation errors
-// returned by RenameFileResponse.ValidateAll() if the designated constraints
+// GetFileRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFileRequest.ValidateAll() if the designated constraints
 // aren't met.
-type RenameFileResponseMultiError []error
+type GetFileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RenameFileResponseMultiError) Error() string {
+func (m GetFileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2825,11 +2928,11 @@ func (m RenameFileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RenameFileResponseMultiError) AllErrors() []error { return m }
+func (m GetFileRequestMultiError) AllErrors() []error { return m }
 
-// RenameFileResponseValidationError is the validation error returned by
-// RenameFileResponse.Validate if the designated constraints aren't met.
-type RenameFileResponseValidationError struct {
+// GetFileRequestValidationError is the validation error returned by
+// GetFileRequest.Validate if the designated constraints aren't met.
+type GetFileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2837,24 +2940,22 @@ type RenameFileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e RenameFileResponseValidationError) Field() string { return e.field }
+func (e GetFileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RenameFileResponseValidationError) Reason() string { return e.reason }
+func (e GetFileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RenameFileResponseValidationError) Cause() error { return e.cause }
+func (e GetFileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RenameFileResponseValidationError) Key() bool { return e.key }
+func (e GetFileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RenameFileResponseValidationError) ErrorName() string {
-	return "RenameFileResponseValidationError"
-}
+func (e GetFileRequestValidationError) ErrorName() string { return "GetFileRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e RenameFileResponseValidationError) Error() string {
+func (e GetFileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2866,14 +2967,14 @@ func (e RenameFileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRenameFileResponse.%s: %s%s",
+		"invalid %sGetFileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = RenameFileResponseValidationError{}
+var _ error = GetFileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2881,70 +2982,39 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RenameFileResponseValidationError{}
+} = GetFileRequestValidationError{}
 
-// Validate checks the field values on CatalogEntry with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *CatalogEntry) Validate() error {
+var _GetFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on GetFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CatalogEntry with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CatalogEntryMultiError, or
-// nil if none found.
-func (m *CatalogEntry) ValidateAll() error {
+// ValidateAll checks the field values on GetFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFileResponseMultiError, or nil if none found.
+func (m *GetFileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CatalogEntry) validate(all bool) error {
+func (m *GetFileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Name
-
-	// no validation rules for Path
-
-	// no validation rules for Embedded
-
-	if all {
-		switch v := interface{}(m.GetCreatedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CatalogEntryValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CatalogEntryValidationError{
-					field:  "CreatedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CatalogEntryValidationError{
-				field:  "CreatedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Blob
 
 	if all {
 		switch v := interface{}(m.GetUpdatedOn()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CatalogEntryValidationError{
+				errors = append(errors, GetFileResponseValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2952,7 +3022,7 @@ func (m *CatalogEntry) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CatalogEntryValidationError{
+				errors = append(errors, GetFileResponseValidationError{
 					field:  "UpdatedOn",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -2961,7 +3031,7 @@ func (m *CatalogEntry) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CatalogEntryValidationError{
+			return GetFileResponseValidationError{
 				field:  "UpdatedOn",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -2969,217 +3039,1588 @@ func (m *CatalogEntry) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetRefreshedOn()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CatalogEntryValidationError{
-					field:  "RefreshedOn",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
+	if len(errors) > 0 {
+		return GetFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFileResponseMultiError is an error wrapping multiple validation errors
+// returned by GetFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFileResponseMultiError) AllErrors() []error { return m }
+
+// GetFileResponseValidationError is the validation error returned by
+// GetFileResponse.Validate if the designated constraints aren't met.
+type GetFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFileResponseValidationError) ErrorName() string { return "GetFileResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFileResponseValidationError{}
+
+// Validate checks the field values on PutFileRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PutFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PutFileRequestMultiError,
+// or nil if none found.
+func (m *PutFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_PutFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := PutFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := PutFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Blob
+
+	// no validation rules for Create
+
+	// no validation rules for CreateOnly
+
+	if len(errors) > 0 {
+		return PutFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutFileRequestMultiError is an error wrapping multiple validation errors
+// returned by PutFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PutFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutFileRequestMultiError) AllErrors() []error { return m }
+
+// PutFileRequestValidationError is the validation error returned by
+// PutFileRequest.Validate if the designated constraints aren't met.
+type PutFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutFileRequestValidationError) ErrorName() string { return "PutFileRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PutFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutFileRequestValidationError{}
+
+var _PutFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on PutFileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PutFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PutFileResponseMultiError, or nil if none found.
+func (m *PutFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FilePath
+
+	if len(errors) > 0 {
+		return PutFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutFileResponseMultiError is an error wrapping multiple validation errors
+// returned by PutFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PutFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutFileResponseMultiError) AllErrors() []error { return m }
+
+// PutFileResponseValidationError is the validation error returned by
+// PutFileResponse.Validate if the designated constraints aren't met.
+type PutFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutFileResponseValidationError) ErrorName() string { return "PutFileResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PutFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutFileResponseValidationError{}
+
+// Validate checks the field values on DeleteFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFileRequestMultiError, or nil if none found.
+func (m *DeleteFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_DeleteFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := DeleteFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := DeleteFileRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFileRequestMultiError is an error wrapping multiple validation errors
+// returned by DeleteFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFileRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFileRequestValidationError is the validation error returned by
+// DeleteFileRequest.Validate if the designated constraints aren't met.
+type DeleteFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFileRequestValidationError) ErrorName() string {
+	return "DeleteFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFileRequestValidationError{}
+
+var _DeleteFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on DeleteFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteFileResponseMultiError, or nil if none found.
+func (m *DeleteFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFileResponseMultiError is an error wrapping multiple validation errors
+// returned by DeleteFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type DeleteFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFileResponseMultiError) AllErrors() []error { return m }
+
+// DeleteFileResponseValidationError is the validation error returned by
+// DeleteFileResponse.Validate if the designated constraints aren't met.
+type DeleteFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFileResponseValidationError) ErrorName() string {
+	return "DeleteFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFileResponseValidationError{}
+
+// Validate checks the field values on RenameFileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RenameFileRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameFileRequestMultiError, or nil if none found.
+func (m *RenameFileRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameFileRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_RenameFileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := RenameFileRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetFromPath()) < 1 {
+		err := RenameFileRequestValidationError{
+			field:  "FromPath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetToPath()) < 1 {
+		err := RenameFileRequestValidationError{
+			field:  "ToPath",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RenameFileRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameFileRequestMultiError is an error wrapping multiple validation errors
+// returned by RenameFileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RenameFileRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameFileRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameFileRequestMultiError) AllErrors() []error { return m }
+
+// RenameFileRequestValidationError is the validation error returned by
+// RenameFileRequest.Validate if the designated constraints aren't met.
+type RenameFileRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameFileRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameFileRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameFileRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameFileRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameFileRequestValidationError) ErrorName() string {
+	return "RenameFileRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameFileRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameFileRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameFileRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameFileRequestValidationError{}
+
+var _RenameFileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on RenameFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenameFileResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenameFileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenameFileResponseMultiError, or nil if none found.
+func (m *RenameFileResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenameFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RenameFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenameFileResponseMultiError is an error wrapping multiple validation errors
+// returned by RenameFileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RenameFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenameFileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenameFileResponseMultiError) AllErrors() []error { return m }
+
+// RenameFileResponseValidationError is the validation error returned by
+// RenameFileResponse.Validate if the designated constraints aren't met.
+type RenameFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenameFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenameFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenameFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenameFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenameFileResponseValidationError) ErrorName() string {
+	return "RenameFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenameFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenameFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenameFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenameFileResponseValidationError{}
+
+// Validate checks the field values on CatalogEntry with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CatalogEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CatalogEntry with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CatalogEntryMultiError, or
+// nil if none found.
+func (m *CatalogEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CatalogEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Path
+
+	// no validation rules for Embedded
+
+	if all {
+		switch v := interface{}(m.GetCreatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CatalogEntryValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CatalogEntryValidationError{
+					field:  "CreatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CatalogEntryValidationError{
+				field:  "CreatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CatalogEntryValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CatalogEntryValidationError{
+					field:  "UpdatedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CatalogEntryValidationError{
+				field:  "UpdatedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRefreshedOn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CatalogEntryValidationError{
+					field:  "RefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CatalogEntryValidationError{
+					field:  "RefreshedOn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRefreshedOn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CatalogEntryValidationError{
+				field:  "RefreshedOn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Object.(type) {
+	case *CatalogEntry_Table:
+		if v == nil {
+			err := CatalogEntryValidationError{
+				field:  "Object",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetTable()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "Table",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "Table",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogEntryValidationError{
+					field:  "Table",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CatalogEntry_Source:
+		if v == nil {
+			err := CatalogEntryValidationError{
+				field:  "Object",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetSource()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "Source",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "Source",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogEntryValidationError{
+					field:  "Source",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CatalogEntry_Model:
+		if v == nil {
+			err := CatalogEntryValidationError{
+				field:  "Object",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetModel()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "Model",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "Model",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetModel()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogEntryValidationError{
+					field:  "Model",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *CatalogEntry_MetricsView:
+		if v == nil {
+			err := CatalogEntryValidationError{
+				field:  "Object",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetricsView()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "MetricsView",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CatalogEntryValidationError{
+						field:  "MetricsView",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetricsView()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CatalogEntryValidationError{
+					field:  "MetricsView",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return CatalogEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CatalogEntryMultiError is an error wrapping multiple validation errors
+// returned by CatalogEntry.ValidateAll() if the designated constraints aren't met.
+type CatalogEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CatalogEntryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CatalogEntryMultiError) AllErrors() []error { return m }
+
+// CatalogEntryValidationError is the validation error returned by
+// CatalogEntry.Validate if the designated constraints aren't met.
+type CatalogEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CatalogEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CatalogEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CatalogEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CatalogEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CatalogEntryValidationError) ErrorName() string { return "CatalogEntryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CatalogEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCatalogEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CatalogEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CatalogEntryValidationError{}
+
+// Validate checks the field values on ListCatalogEntriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCatalogEntriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCatalogEntriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCatalogEntriesRequestMultiError, or nil if none found.
+func (m *ListCatalogEntriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCatalogEntriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_ListCatalogEntriesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ListCatalogEntriesRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ObjectType_name[int32(m.GetType())]; !ok {
+		err := ListCatalogEntriesRequestValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCatalogEntriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCatalogEntriesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListCatalogEntriesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListCatalogEntriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCatalogEntriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCatalogEntriesRequestMultiError) AllErrors() []error { return m }
+
+// ListCatalogEntriesRequestValidationError is the validation error returned by
+// ListCatalogEntriesRequest.Validate if the designated constraints aren't met.
+type ListCatalogEntriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCatalogEntriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCatalogEntriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCatalogEntriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCatalogEntriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCatalogEntriesRequestValidationError) ErrorName() string {
+	return "ListCatalogEntriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCatalogEntriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCatalogEntriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCatalogEntriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCatalogEntriesRequestValidationError{}
+
+var _ListCatalogEntriesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+
+// Validate checks the field values on ListCatalogEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListCatalogEntriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCatalogEntriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCatalogEntriesResponseMultiError, or nil if none found.
+func (m *ListCatalogEntriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCatalogEntriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEntries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCatalogEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListCatalogEntriesResponseValidationError{
+						field:  fmt.Sprintf("Entries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CatalogEntryValidationError{
-					field:  "RefreshedOn",
+				return ListCatalogEntriesResponseValidationError{
+					field:  fmt.Sprintf("Entries[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
+				}
 			}
 		}
-	} else if v, ok := interface{}(m.GetRefreshedOn()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CatalogEntryValidationError{
-				field:  "RefreshedOn",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+
+	}
+
+	if len(errors) > 0 {
+		return ListCatalogEntriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCatalogEntriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListCatalogEntriesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListCatalogEntriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCatalogEntriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCatalogEntriesResponseMultiError) AllErrors() []error { return m }
+
+// ListCatalogEntriesResponseValidationError is the validation error returned
+// by ListCatalogEntriesResponse.Validate if the designated constraints aren't met.
+type ListCatalogEntriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCatalogEntriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCatalogEntriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCatalogEntriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCatalogEntriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCatalogEntriesResponseValidationError) ErrorName() string {
+	return "ListCatalogEntriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListCatalogEntriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCatalogEntriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCatalogEntriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCatalogEntriesResponseValidationError{}
+
+// Validate checks the field values on GetCatalogEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCatalogEntryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCatalogEntryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCatalogEntryRequestMultiError, or nil if none found.
+func (m *GetCatalogEntryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCatalogEntryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetCatalogEntryRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := GetCatalogEntryRequestValidationError{
+			field:  "InstanceId",
+			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return GetCatalogEntryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCatalogEntryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCatalogEntryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCatalogEntryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCatalogEntryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCatalogEntryRequestMultiError) AllErrors() []error { return m }
+
+// GetCatalogEntryRequestValidationError is the validation error returned by
+// GetCatalogEntryRequest.Validate if the designated constraints aren't met.
+type GetCatalogEntryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCatalogEntryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCatalogEntryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCatalogEntryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCatalogEntryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCatalogEntryRequestValidationError) ErrorName() string {
+	return "GetCatalogEntryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCatalogEntryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	switch v := m.Object.(type) {
-	case *CatalogEntry_Table:
-		if v == nil {
-			err := CatalogEntryValidationError{
-				field:  "Object",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-		if all {
-			switch v := interface{}(m.GetTable()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "Table",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "Table",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetTable()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CatalogEntryValidationError{
-					field:  "Table",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return fmt.Sprintf(
+		"invalid %sGetCatalogEntryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	case *CatalogEntry_Source:
-		if v == nil {
-			err := CatalogEntryValidationError{
-				field:  "Object",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+var _ error = GetCatalogEntryRequestValidationError{}
 
-		if all {
-			switch v := interface{}(m.GetSource()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "Source",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "Source",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetSource()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CatalogEntryValidationError{
-					field:  "Source",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCatalogEntryRequestValidationError{}
 
-	case *CatalogEntry_Model:
-		if v == nil {
-			err := CatalogEntryValidationError{
-				field:  "Object",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+var _GetCatalogEntryRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-		if all {
-			switch v := interface{}(m.GetModel()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "Model",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "Model",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetModel()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return CatalogEntryValidationError{
-					field:  "Model",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Validate checks the field values on GetCatalogEntryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCatalogEntryResponse) Validate() error {
+	return m.validate(false)
+}
 
-	case *CatalogEntry_MetricsView:
-		if v == nil {
-			err := CatalogEntryValidationError{
-				field:  "Object",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// ValidateAll checks the field values on GetCatalogEntryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCatalogEntryResponseMultiError, or nil if none found.
+func (m *GetCatalogEntryResponse) ValidateAll() error {
+	return m.validate(true)
+}
 
-		if all {
-			switch v := interface{}(m.GetMetricsView()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "MetricsView",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CatalogEntryValidationError{
-						field:  "MetricsView",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+func (m *GetCatalogEntryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetEntry()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCatalogEntryResponseValidationError{
+					field:  "Entry",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetMetricsView()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CatalogEntryValidationError{
-					field:  "MetricsView",
+				errors = append(errors, GetCatalogEntryResponseValidationError{
+					field:  "Entry",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEntry()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCatalogEntryResponseValidationError{
+				field:  "Entry",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return CatalogEntryMultiError(errors)
+		return GetCatalogEntryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CatalogEntryMultiError is an error wrapping multiple validation errors
-// returned by CatalogEntry.ValidateAll() if the designated constraints aren't met.
-type CatalogEntryMultiError []error
+// GetCatalogEntryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCatalogEntryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCatalogEntryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CatalogEntryMultiError) Error() string {
+func (m GetCatalogEntryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3188,11 +4629,11 @@ func (m CatalogEntryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CatalogEntryMultiError) AllErrors() []error { return m }
+func (m GetCatalogEntryResponseMultiError) AllErrors() []error { return m }
 
-// CatalogEntryValidationError is the validation error returned by
-// CatalogEntry.Validate if the designated constraints aren't met.
-type CatalogEntryValidationError struct {
+// GetCatalogEntryResponseValidationError is the validation error returned by
+// GetCatalogEntryResponse.Validate if the designated constraints aren't met.
+type GetCatalogEntryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3200,22 +4641,24 @@ type CatalogEntryValidationError struct {
 }
 
 // Field function returns field value.
-func (e CatalogEntryValidationError) Field() string { return e.field }
+func (e GetCatalogEntryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CatalogEntryValidationError) Reason() string { return e.reason }
+func (e GetCatalogEntryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CatalogEntryValidationError) Cause() error { return e.cause }
+func (e GetCatalogEntryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CatalogEntryValidationError) Key() bool { return e.key }
+func (e GetCatalogEntryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CatalogEntryValidationError) ErrorName() string { return "CatalogEntryValidationError" }
+func (e GetCatalogEntryResponseValidationError) ErrorName() string {
+	return "GetCatalogEntryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CatalogEntryValidationError) Error() string {
+func (e GetCatalogEntryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3227,14 +4670,14 @@ func (e CatalogEntryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCatalogEntry.%s: %s%s",
+		"invalid %sGetCatalogEntryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CatalogEntryValidationError{}
+var _ error = GetCatalogEntryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3242,32 +4685,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CatalogEntryValidationError{}
+} = GetCatalogEntryResponseValidationError{}
 
-// Validate checks the field values on ListCatalogEntriesRequest with the rules
+// Validate checks the field values on TriggerRefreshRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCatalogEntriesRequest) Validate() error {
+func (m *TriggerRefreshRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCatalogEntriesRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TriggerRefreshRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCatalogEntriesRequestMultiError, or nil if none found.
-func (m *ListCatalogEntriesRequest) ValidateAll() error {
+// TriggerRefreshRequestMultiError, or nil if none found.
+func (m *TriggerRefreshRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCatalogEntriesRequest) validate(all bool) error {
+func (m *TriggerRefreshRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ListCatalogEntriesRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := ListCatalogEntriesRequestValidationError{
+	if !_TriggerRefreshRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := TriggerRefreshRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -3277,31 +4720,22 @@ func (m *ListCatalogEntriesRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := ObjectType_name[int32(m.GetType())]; !ok {
-		err := ListCatalogEntriesRequestValidationError{
-			field:  "Type",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Name
 
 	if len(errors) > 0 {
-		return ListCatalogEntriesRequestMultiError(errors)
+		return TriggerRefreshRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCatalogEntriesRequestMultiError is an error wrapping multiple validation
-// errors returned by ListCatalogEntriesRequest.ValidateAll() if the
-// designated constraints aren't met.
-type ListCatalogEntriesRequestMultiError []error
+// TriggerRefreshRequestMultiError is an error wrapping multiple validation
+// errors returned by TriggerRefreshRequest.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerRefreshRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCatalogEntriesRequestMultiError) Error() string {
+func (m TriggerRefreshRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3310,11 +4744,11 @@ func (m ListCatalogEntriesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCatalogEntriesRequestMultiError) AllErrors() []error { return m }
+func (m TriggerRefreshRequestMultiError) AllErrors() []error { return m }
 
-// ListCatalogEntriesRequestValidationError is the validation error returned by
-// ListCatalogEntriesRequest.Validate if the designated constraints aren't met.
-type ListCatalogEntriesRequestValidationError struct {
+// TriggerRefreshRequestValidationError is the validation error returned by
+// TriggerRefreshRequest.Validate if the designated constraints aren't met.
+type TriggerRefreshRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3322,24 +4756,24 @@ type ListCatalogEntriesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCatalogEntriesRequestValidationError) Field() string { return e.field }
+func (e TriggerRefreshRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCatalogEntriesRequestValidationError) Reason() string { return e.reason }
+func (e TriggerRefreshRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCatalogEntriesRequestValidationError) Cause() error { return e.cause }
+func (e TriggerRefreshRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCatalogEntriesRequestValidationError) Key() bool { return e.key }
+func (e TriggerRefreshRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCatalogEntriesRequestValidationError) ErrorName() string {
-	return "ListCatalogEntriesRequestValidationError"
+func (e TriggerRefreshRequestValidationError) ErrorName() string {
+	return "TriggerRefreshRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCatalogEntriesRequestValidationError) Error() string {
+func (e TriggerRefreshRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3351,14 +4785,14 @@ func (e ListCatalogEntriesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCatalogEntriesRequest.%s: %s%s",
+		"invalid %sTriggerRefreshRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCatalogEntriesRequestValidationError{}
+var _ error = TriggerRefreshRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3366,80 +4800,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCatalogEntriesRequestValidationError{}
+} = TriggerRefreshRequestValidationError{}
 
-var _ListCatalogEntriesRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _TriggerRefreshRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on ListCatalogEntriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on TriggerRefreshResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListCatalogEntriesResponse) Validate() error {
+func (m *TriggerRefreshResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCatalogEntriesResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TriggerRefreshResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCatalogEntriesResponseMultiError, or nil if none found.
-func (m *ListCatalogEntriesResponse) ValidateAll() error {
+// TriggerRefreshResponseMultiError, or nil if none found.
+func (m *TriggerRefreshResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCatalogEntriesResponse) validate(all bool) error {
+func (m *TriggerRefreshResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetEntries() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListCatalogEntriesResponseValidationError{
-						field:  fmt.Sprintf("Entries[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCatalogEntriesResponseValidationError{
-						field:  fmt.Sprintf("Entries[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListCatalogEntriesResponseValidationError{
-					field:  fmt.Sprintf("Entries[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
-
 	if len(errors) > 0 {
-		return ListCatalogEntriesResponseMultiError(errors)
+		return TriggerRefreshResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCatalogEntriesResponseMultiError is an error wrapping multiple
-// validation errors returned by ListCatalogEntriesResponse.ValidateAll() if
-// the designated constraints aren't met.
-type ListCatalogEntriesResponseMultiError []error
+// TriggerRefreshResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerRefreshResponse.ValidateAll() if the designated
+// constraints aren't met.
+type TriggerRefreshResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCatalogEntriesResponseMultiError) Error() string {
+func (m TriggerRefreshResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3448,11 +4848,11 @@ func (m ListCatalogEntriesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCatalogEntriesResponseMultiError) AllErrors() []error { return m }
+func (m TriggerRefreshResponseMultiError) AllErrors() []error { return m }
 
-// ListCatalogEntriesResponseValidationError is the validation error returned
-// by ListCatalogEntriesResponse.Validate if the designated constraints aren't met.
-type ListCatalogEntriesResponseValidationError struct {
+// TriggerRefreshResponseValidationError is the validation error returned by
+// TriggerRefreshResponse.Validate if the designated constraints aren't met.
+type TriggerRefreshResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3460,24 +4860,24 @@ type ListCatalogEntriesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCatalogEntriesResponseValidationError) Field() string { return e.field }
+func (e TriggerRefreshResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCatalogEntriesResponseValidationError) Reason() string { return e.reason }
+func (e TriggerRefreshResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCatalogEntriesResponseValidationError) Cause() error { return e.cause }
+func (e TriggerRefreshResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCatalogEntriesResponseValidationError) Key() bool { return e.key }
+func (e TriggerRefreshResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCatalogEntriesResponseValidationError) ErrorName() string {
-	return "ListCatalogEntriesResponseValidationError"
+func (e TriggerRefreshResponseValidationError) ErrorName() string {
+	return "TriggerRefreshResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListCatalogEntriesResponseValidationError) Error() string {
+func (e TriggerRefreshResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3489,14 +4889,14 @@ func (e ListCatalogEntriesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCatalogEntriesResponse.%s: %s%s",
+		"invalid %sTriggerRefreshResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCatalogEntriesResponseValidationError{}
+var _ error = TriggerRefreshResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3504,32 +4904,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCatalogEntriesResponseValidationError{}
+} = TriggerRefreshResponseValidationError{}
 
-// Validate checks the field values on GetCatalogEntryRequest with the rules
+// Validate checks the field values on TriggerSyncRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCatalogEntryRequest) Validate() error {
+func (m *TriggerSyncRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCatalogEntryRequest with the rules
+// ValidateAll checks the field values on TriggerSyncRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCatalogEntryRequestMultiError, or nil if none found.
-func (m *GetCatalogEntryRequest) ValidateAll() error {
+// TriggerSyncRequestMultiError, or nil if none found.
+func (m *TriggerSyncRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCatalogEntryRequest) validate(all bool) error {
+func (m *TriggerSyncRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_GetCatalogEntryRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := GetCatalogEntryRequestValidationError{
+	if !_TriggerSyncRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := TriggerSyncRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -3539,22 +4939,20 @@ func (m *GetCatalogEntryRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Name
-
 	if len(errors) > 0 {
-		return GetCatalogEntryRequestMultiError(errors)
+		return TriggerSyncRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCatalogEntryRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCatalogEntryRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCatalogEntryRequestMultiError []error
+// TriggerSyncRequestMultiError is an error wrapping multiple validation errors
+// returned by TriggerSyncRequest.ValidateAll() if the designated constraints
+// aren't met.
+type TriggerSyncRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCatalogEntryRequestMultiError) Error() string {
+func (m TriggerSyncRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3563,11 +4961,11 @@ func (m GetCatalogEntryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCatalogEntryRequestMultiError) AllErrors() []error { return m }
+func (m TriggerSyncRequestMultiError) AllErrors() []error { return m }
 
-// GetCatalogEntryRequestValidationError is the validation error returned by
-// GetCatalogEntryRequest.Validate if the designated constraints aren't met.
-type GetCatalogEntryRequestValidationError struct {
+// TriggerSyncRequestValidationError is the validation error returned by
+// TriggerSyncRequest.Validate if the designated constraints aren't met.
+type TriggerSyncRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3575,24 +4973,24 @@ type GetCatalogEntryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCatalogEntryRequestValidationError) Field() string { return e.field }
+func (e TriggerSyncRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCatalogEntryRequestValidationError) Reason() string { return e.reason }
+func (e TriggerSyncRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCatalogEntryRequestValidationError) Cause() error { return e.cause }
+func (e TriggerSyncRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCatalogEntryRequestValidationError) Key() bool { return e.key }
+func (e TriggerSyncRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCatalogEntryRequestValidationError) ErrorName() string {
-	return "GetCatalogEntryRequestValidationError"
+func (e TriggerSyncRequestValidationError) ErrorName() string {
+	return "TriggerSyncRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCatalogEntryRequestValidationError) Error() string {
+func (e TriggerSyncRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3604,14 +5002,14 @@ func (e GetCatalogEntryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCatalogEntryRequest.%s: %s%s",
+		"invalid %sTriggerSyncRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCatalogEntryRequestValidationError{}
+var _ error = TriggerSyncRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3619,75 +5017,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCatalogEntryRequestValidationError{}
+} = TriggerSyncRequestValidationError{}
 
-var _GetCatalogEntryRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _TriggerSyncRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on GetCatalogEntryResponse with the rules
+// Validate checks the field values on TriggerSyncResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCatalogEntryResponse) Validate() error {
+func (m *TriggerSyncResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCatalogEntryResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on TriggerSyncResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCatalogEntryResponseMultiError, or nil if none found.
-func (m *GetCatalogEntryResponse) ValidateAll() error {
+// TriggerSyncResponseMultiError, or nil if none found.
+func (m *TriggerSyncResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCatalogEntryResponse) validate(all bool) error {
+func (m *TriggerSyncResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEntry()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCatalogEntryResponseValidationError{
-					field:  "Entry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCatalogEntryResponseValidationError{
-					field:  "Entry",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEntry()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCatalogEntryResponseValidationError{
-				field:  "Entry",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for ObjectsCount
+
+	// no validation rules for ObjectsAddedCount
+
+	// no validation rules for ObjectsUpdatedCount
+
+	// no validation rules for ObjectsRemovedCount
 
 	if len(errors) > 0 {
-		return GetCatalogEntryResponseMultiError(errors)
+		return TriggerSyncResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCatalogEntryResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCatalogEntryResponse.ValidateAll() if the designated
+// TriggerSyncResponseMultiError is an error wrapping multiple validation
+// errors returned by TriggerSyncResponse.ValidateAll() if the designated
 // constraints aren't met.
-type GetCatalogEntryResponseMultiError []error
+type TriggerSyncResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCatalogEntryResponseMultiError) Error() string {
+func (m TriggerSyncResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3696,11 +5073,11 @@ func (m GetCatalogEntryResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCatalogEntryResponseMultiError) AllErrors() []error { return m }
+func (m TriggerSyncResponseMultiError) AllErrors() []error { return m }
 
-// GetCatalogEntryResponseValidationError is the validation error returned by
-// GetCatalogEntryResponse.Validate if the designated constraints aren't met.
-type GetCatalogEntryResponseValidationError struct {
+// TriggerSyncResponseValidationError is the validation error returned by
+// TriggerSyncResponse.Validate if the designated constraints aren't met.
+type TriggerSyncResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3708,24 +5085,24 @@ type GetCatalogEntryResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCatalogEntryResponseValidationError) Field() string { return e.field }
+func (e TriggerSyncResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCatalogEntryResponseValidationError) Reason() string { return e.reason }
+func (e TriggerSyncResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCatalogEntryResponseValidationError) Cause() error { return e.cause }
+func (e TriggerSyncResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCatalogEntryResponseValidationError) Key() bool { return e.key }
+func (e TriggerSyncResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCatalogEntryResponseValidationError) ErrorName() string {
-	return "GetCatalogEntryResponseValidationError"
+func (e TriggerSyncResponseValidationError) ErrorName() string {
+	return "TriggerSyncResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCatalogEntryResponseValidationError) Error() string {
+func (e TriggerSyncResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3737,14 +5114,14 @@ func (e GetCatalogEntryResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCatalogEntryResponse.%s: %s%s",
+		"invalid %sTriggerSyncResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCatalogEntryResponseValidationError{}
+var _ error = TriggerSyncResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3752,32 +5129,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCatalogEntryResponseValidationError{}
+} = TriggerSyncResponseValidationError{}
 
-// Validate checks the field values on TriggerRefreshRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerRefreshRequest) Validate() error {
+// Validate checks the field values on ReconcileRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReconcileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRefreshRequest with the rules
+// ValidateAll checks the field values on ReconcileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerRefreshRequestMultiError, or nil if none found.
-func (m *TriggerRefreshRequest) ValidateAll() error {
+// ReconcileRequestMultiError, or nil if none found.
+func (m *ReconcileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRefreshRequest) validate(all bool) error {
+func (m *ReconcileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_TriggerRefreshRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := TriggerRefreshRequestValidationError{
+	if !_ReconcileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := ReconcileRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -3787,22 +5164,24 @@ func (m *TriggerRefreshRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Name
+	// no validation rules for Dry
+
+	// no validation rules for Strict
 
 	if len(errors) > 0 {
-		return TriggerRefreshRequestMultiError(errors)
+		return ReconcileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerRefreshRequestMultiError is an error wrapping multiple validation
-// errors returned by TriggerRefreshRequest.ValidateAll() if the designated
-// constraints aren't met.
-type TriggerRefreshRequestMultiError []error
+// ReconcileRequestMultiError is an error wrapping multiple validation errors
+// returned by ReconcileRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ReconcileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRefreshRequestMultiError) Error() string {
+func (m ReconcileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3811,11 +5190,11 @@ func (m TriggerRefreshRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRefreshRequestMultiError) AllErrors() []error { return m }
+func (m ReconcileRequestMultiError) AllErrors() []error { return m }
 
-// TriggerRefreshRequestValidationError is the validation error returned by
-// TriggerRefreshRequest.Validate if the designated constraints aren't met.
-type TriggerRefreshRequestValidationError struct {
+// ReconcileRequestValidationError is the validation error returned by
+// ReconcileRequest.Validate if the designated constraints aren't met.
+type ReconcileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3823,24 +5202,22 @@ type TriggerRefreshRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRefreshRequestValidationError) Field() string { return e.field }
+func (e ReconcileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRefreshRequestValidationError) Reason() string { return e.reason }
+func (e ReconcileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRefreshRequestValidationError) Cause() error { return e.cause }
+func (e ReconcileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRefreshRequestValidationError) Key() bool { return e.key }
+func (e ReconcileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRefreshRequestValidationError) ErrorName() string {
-	return "TriggerRefreshRequestValidationError"
-}
+func (e ReconcileRequestValidationError) ErrorName() string { return "ReconcileRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TriggerRefreshRequestValidationError) Error() string {
+func (e ReconcileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3852,14 +5229,14 @@ func (e TriggerRefreshRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRefreshRequest.%s: %s%s",
+		"invalid %sReconcileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRefreshRequestValidationError{}
+var _ error = ReconcileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3867,46 +5244,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRefreshRequestValidationError{}
+} = ReconcileRequestValidationError{}
 
-var _TriggerRefreshRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _ReconcileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on TriggerRefreshResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerRefreshResponse) Validate() error {
+// Validate checks the field values on ReconcileResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReconcileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerRefreshResponse with the rules
+// ValidateAll checks the field values on ReconcileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerRefreshResponseMultiError, or nil if none found.
-func (m *TriggerRefreshResponse) ValidateAll() error {
+// ReconcileResponseMultiError, or nil if none found.
+func (m *ReconcileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerRefreshResponse) validate(all bool) error {
+func (m *ReconcileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReconcileResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReconcileResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReconcileResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Commit
+
 	if len(errors) > 0 {
-		return TriggerRefreshResponseMultiError(errors)
+		return ReconcileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerRefreshResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerRefreshResponse.ValidateAll() if the designated
-// constraints aren't met.
-type TriggerRefreshResponseMultiError []error
+// ReconcileResponseMultiError is an error wrapping multiple validation errors
+// returned by ReconcileResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ReconcileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerRefreshResponseMultiError) Error() string {
+func (m ReconcileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3915,11 +5328,11 @@ func (m TriggerRefreshResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerRefreshResponseMultiError) AllErrors() []error { return m }
+func (m ReconcileResponseMultiError) AllErrors() []error { return m }
 
-// TriggerRefreshResponseValidationError is the validation error returned by
-// TriggerRefreshResponse.Validate if the designated constraints aren't met.
-type TriggerRefreshResponseValidationError struct {
+// ReconcileResponseValidationError is the validation error returned by
+// ReconcileResponse.Validate if the designated constraints aren't met.
+type ReconcileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3927,24 +5340,24 @@ type TriggerRefreshResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerRefreshResponseValidationError) Field() string { return e.field }
+func (e ReconcileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerRefreshResponseValidationError) Reason() string { return e.reason }
+func (e ReconcileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerRefreshResponseValidationError) Cause() error { return e.cause }
+func (e ReconcileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerRefreshResponseValidationError) Key() bool { return e.key }
+func (e ReconcileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerRefreshResponseValidationError) ErrorName() string {
-	return "TriggerRefreshResponseValidationError"
+func (e ReconcileResponseValidationError) ErrorName() string {
+	return "ReconcileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerRefreshResponseValidationError) Error() string {
+func (e ReconcileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3956,14 +5369,14 @@ func (e TriggerRefreshResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerRefreshResponse.%s: %s%s",
+		"invalid %sReconcileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerRefreshResponseValidationError{}
+var _ error = ReconcileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3971,32 +5384,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerRefreshResponseValidationError{}
+} = ReconcileResponseValidationError{}
 
-// Validate checks the field values on TriggerSyncRequest with the rules
+// Validate checks the field values on WatchReconcileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerSyncRequest) Validate() error {
+func (m *WatchReconcileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerSyncRequest with the rules
+// ValidateAll checks the field values on WatchReconcileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerSyncRequestMultiError, or nil if none found.
-func (m *TriggerSyncRequest) ValidateAll() error {
+// WatchReconcileRequestMultiError, or nil if none found.
+func (m *WatchReconcileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerSyncRequest) validate(all bool) error {
+func (m *WatchReconcileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_TriggerSyncRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := TriggerSyncRequestValidationError{
+	if !_WatchReconcileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := WatchReconcileRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -4007,19 +5420,19 @@ func (m *TriggerSyncRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return TriggerSyncRequestMultiError(errors)
+		return WatchReconcileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerSyncRequestMultiError is an error wrapping multiple validation errors
-// returned by TriggerSyncRequest.ValidateAll() if the designated constraints
-// aren't met.
-type TriggerSyncRequestMultiError []error
+// WatchReconcileRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchReconcileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchReconcileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerSyncRequestMultiError) Error() string {
+func (m WatchReconcileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4028,11 +5441,11 @@ func (m TriggerSyncRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerSyncRequestMultiError) AllErrors() []error { return m }
+func (m WatchReconcileRequestMultiError) AllErrors() []error { return m }
 
-// TriggerSyncRequestValidationError is the validation error returned by
-// TriggerSyncRequest.Validate if the designated constraints aren't met.
-type TriggerSyncRequestValidationError struct {
+// WatchReconcileRequestValidationError is the validation error returned by
+// WatchReconcileRequest.Validate if the designated constraints aren't met.
+type WatchReconcileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4040,24 +5453,24 @@ type TriggerSyncRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerSyncRequestValidationError) Field() string { return e.field }
+func (e WatchReconcileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerSyncRequestValidationError) Reason() string { return e.reason }
+func (e WatchReconcileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerSyncRequestValidationError) Cause() error { return e.cause }
+func (e WatchReconcileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerSyncRequestValidationError) Key() bool { return e.key }
+func (e WatchReconcileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerSyncRequestValidationError) ErrorName() string {
-	return "TriggerSyncRequestValidationError"
+func (e WatchReconcileRequestValidationError) ErrorName() string {
+	return "WatchReconcileRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerSyncRequestValidationError) Error() string {
+func (e WatchReconcileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4069,14 +5482,14 @@ func (e TriggerSyncRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerSyncRequest.%s: %s%s",
+		"invalid %sWatchReconcileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerSyncRequestValidationError{}
+var _ error = WatchReconcileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4084,54 +5497,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerSyncRequestValidationError{}
+} = WatchReconcileRequestValidationError{}
 
-var _TriggerSyncRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _WatchReconcileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on TriggerSyncResponse with the rules
+// Validate checks the field values on WatchReconcileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *TriggerSyncResponse) Validate() error {
+func (m *WatchReconcileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TriggerSyncResponse with the rules
+// ValidateAll checks the field values on WatchReconcileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// TriggerSyncResponseMultiError, or nil if none found.
-func (m *TriggerSyncResponse) ValidateAll() error {
+// WatchReconcileResponseMultiError, or nil if none found.
+func (m *WatchReconcileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TriggerSyncResponse) validate(all bool) error {
+func (m *WatchReconcileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ObjectsCount
-
-	// no validation rules for ObjectsAddedCount
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
 
-	// no validation rules for ObjectsUpdatedCount
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchReconcileResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchReconcileResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchReconcileResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for ObjectsRemovedCount
+	}
 
 	if len(errors) > 0 {
-		return TriggerSyncResponseMultiError(errors)
+		return WatchReconcileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TriggerSyncResponseMultiError is an error wrapping multiple validation
-// errors returned by TriggerSyncResponse.ValidateAll() if the designated
+// WatchReconcileResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchReconcileResponse.ValidateAll() if the designated
 // constraints aren't met.
-type TriggerSyncResponseMultiError []error
+type WatchReconcileResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TriggerSyncResponseMultiError) Error() string {
+func (m WatchReconcileResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4140,11 +5579,11 @@ func (m TriggerSyncResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TriggerSyncResponseMultiError) AllErrors() []error { return m }
+func (m WatchReconcileResponseMultiError) AllErrors() []error { return m }
 
-// TriggerSyncResponseValidationError is the validation error returned by
-// TriggerSyncResponse.Validate if the designated constraints aren't met.
-type TriggerSyncResponseValidationError struct {
+// WatchReconcileResponseValidationError is the validation error returned by
+// WatchReconcileResponse.Validate if the designated constraints aren't met.
+type WatchReconcileResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4152,24 +5591,24 @@ type TriggerSyncResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e TriggerSyncResponseValidationError) Field() string { return e.field }
+func (e WatchReconcileResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TriggerSyncResponseValidationError) Reason() string { return e.reason }
+func (e WatchReconcileResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TriggerSyncResponseValidationError) Cause() error { return e.cause }
+func (e WatchReconcileResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TriggerSyncResponseValidationError) Key() bool { return e.key }
+func (e WatchReconcileResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TriggerSyncResponseValidationError) ErrorName() string {
-	return "TriggerSyncResponseValidationError"
+func (e WatchReconcileResponseValidationError) ErrorName() string {
+	return "WatchReconcileResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e TriggerSyncResponseValidationError) Error() string {
+func (e WatchReconcileResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4181,14 +5620,14 @@ func (e TriggerSyncResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTriggerSyncResponse.%s: %s%s",
+		"invalid %sWatchReconcileResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TriggerSyncResponseValidationError{}
+var _ error = WatchReconcileResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4196,32 +5635,32 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TriggerSyncResponseValidationError{}
+} = WatchReconcileResponseValidationError{}
 
-// Validate checks the field values on ReconcileRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ReconcileRequest) Validate() error {
+// Validate checks the field values on PlanReconcileRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanReconcileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReconcileRequest with the rules
+// ValidateAll checks the field values on PlanReconcileRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReconcileRequestMultiError, or nil if none found.
-func (m *ReconcileRequest) ValidateAll() error {
+// PlanReconcileRequestMultiError, or nil if none found.
+func (m *PlanReconcileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReconcileRequest) validate(all bool) error {
+func (m *PlanReconcileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if !_ReconcileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
-		err := ReconcileRequestValidationError{
+	if !_PlanReconcileRequest_InstanceId_Pattern.MatchString(m.GetInstanceId()) {
+		err := PlanReconcileRequestValidationError{
 			field:  "InstanceId",
 			reason: "value does not match regex pattern \"^[_\\\\-a-zA-Z0-9]+$\"",
 		}
@@ -4231,24 +5670,20 @@ func (m *ReconcileRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Dry
-
-	// no validation rules for Strict
-
 	if len(errors) > 0 {
-		return ReconcileRequestMultiError(errors)
+		return PlanReconcileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReconcileRequestMultiError is an error wrapping multiple validation errors
-// returned by ReconcileRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ReconcileRequestMultiError []error
+// PlanReconcileRequestMultiError is an error wrapping multiple validation
+// errors returned by PlanReconcileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PlanReconcileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReconcileRequestMultiError) Error() string {
+func (m PlanReconcileRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4257,11 +5692,11 @@ func (m ReconcileRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReconcileRequestMultiError) AllErrors() []error { return m }
+func (m PlanReconcileRequestMultiError) AllErrors() []error { return m }
 
-// ReconcileRequestValidationError is the validation error returned by
-// ReconcileRequest.Validate if the designated constraints aren't met.
-type ReconcileRequestValidationError struct {
+// PlanReconcileRequestValidationError is the validation error returned by
+// PlanReconcileRequest.Validate if the designated constraints aren't met.
+type PlanReconcileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4269,22 +5704,24 @@ type ReconcileRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReconcileRequestValidationError) Field() string { return e.field }
+func (e PlanReconcileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReconcileRequestValidationError) Reason() string { return e.reason }
+func (e PlanReconcileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReconcileRequestValidationError) Cause() error { return e.cause }
+func (e PlanReconcileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReconcileRequestValidationError) Key() bool { return e.key }
+func (e PlanReconcileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReconcileRequestValidationError) ErrorName() string { return "ReconcileRequestValidationError" }
+func (e PlanReconcileRequestValidationError) ErrorName() string {
+	return "PlanReconcileRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ReconcileRequestValidationError) Error() string {
+func (e PlanReconcileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4296,14 +5733,14 @@ func (e ReconcileRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReconcileRequest.%s: %s%s",
+		"invalid %sPlanReconcileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReconcileRequestValidationError{}
+var _ error = PlanReconcileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4311,32 +5748,66 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReconcileRequestValidationError{}
+} = PlanReconcileRequestValidationError{}
 
-var _ReconcileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
+var _PlanReconcileRequest_InstanceId_Pattern = regexp.MustCompile("^[_\\-a-zA-Z0-9]+$")
 
-// Validate checks the field values on ReconcileResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ReconcileResponse) Validate() error {
+// Validate checks the field values on PlanReconcileResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PlanReconcileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReconcileResponse with the rules
+// ValidateAll checks the field values on PlanReconcileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReconcileResponseMultiError, or nil if none found.
-func (m *ReconcileResponse) ValidateAll() error {
+// PlanReconcileResponseMultiError, or nil if none found.
+func (m *PlanReconcileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReconcileResponse) validate(all bool) error {
+func (m *PlanReconcileResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PlanReconcileResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PlanReconcileResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PlanReconcileResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	for idx, item := range m.GetErrors() {
 		_, _ = idx, item
 
@@ -4344,7 +5815,7 @@ func (m *ReconcileResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ReconcileResponseValidationError{
+					errors = append(errors, PlanReconcileResponseValidationError{
 						field:  fmt.Sprintf("Errors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -4352,7 +5823,7 @@ func (m *ReconcileResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ReconcileResponseValidationError{
+					errors = append(errors, PlanReconcileResponseValidationError{
 						field:  fmt.Sprintf("Errors[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -4361,7 +5832,7 @@ func (m *ReconcileResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ReconcileResponseValidationError{
+				return PlanReconcileResponseValidationError{
 					field:  fmt.Sprintf("Errors[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -4372,19 +5843,133 @@ func (m *ReconcileResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ReconcileResponseMultiError(errors)
+		return PlanReconcileResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReconcileResponseMultiError is an error wrapping multiple validation errors
-// returned by ReconcileResponse.ValidateAll() if the designated constraints
+// PlanReconcileResponseMultiError is an error wrapping multiple validation
+// errors returned by PlanReconcileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PlanReconcileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PlanReconcileResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PlanReconcileResponseMultiError) AllErrors() []error { return m }
+
+// PlanReconcileResponseValidationError is the validation error returned by
+// PlanReconcileResponse.Validate if the designated constraints aren't met.
+type PlanReconcileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PlanReconcileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PlanReconcileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PlanReconcileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PlanReconcileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PlanReconcileResponseValidationError) ErrorName() string {
+	return "PlanReconcileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PlanReconcileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPlanReconcileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PlanReconcileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PlanReconcileResponseValidationError{}
+
+// Validate checks the field values on ReconcilePlanItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReconcilePlanItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReconcilePlanItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReconcilePlanItemMultiError, or nil if none found.
+func (m *ReconcilePlanItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReconcilePlanItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Path
+
+	// no validation rules for Type
+
+	// no validation rules for Action
+
+	// no validation rules for FromName
+
+	// no validation rules for EstimatedBytes
+
+	if len(errors) > 0 {
+		return ReconcilePlanItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReconcilePlanItemMultiError is an error wrapping multiple validation errors
+// returned by ReconcilePlanItem.ValidateAll() if the designated constraints
 // aren't met.
-type ReconcileResponseMultiError []error
+type ReconcilePlanItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReconcileResponseMultiError) Error() string {
+func (m ReconcilePlanItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4393,11 +5978,11 @@ func (m ReconcileResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReconcileResponseMultiError) AllErrors() []error { return m }
+func (m ReconcilePlanItemMultiError) AllErrors() []error { return m }
 
-// ReconcileResponseValidationError is the validation error returned by
-// ReconcileResponse.Validate if the designated constraints aren't met.
-type ReconcileResponseValidationError struct {
+// ReconcilePlanItemValidationError is the validation error returned by
+// ReconcilePlanItem.Validate if the designated constraints aren't met.
+type ReconcilePlanItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4405,24 +5990,24 @@ type ReconcileResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReconcileResponseValidationError) Field() string { return e.field }
+func (e ReconcilePlanItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReconcileResponseValidationError) Reason() string { return e.reason }
+func (e ReconcilePlanItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReconcileResponseValidationError) Cause() error { return e.cause }
+func (e ReconcilePlanItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReconcileResponseValidationError) Key() bool { return e.key }
+func (e ReconcilePlanItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReconcileResponseValidationError) ErrorName() string {
-	return "ReconcileResponseValidationError"
+func (e ReconcilePlanItemValidationError) ErrorName() string {
+	return "ReconcilePlanItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ReconcileResponseValidationError) Error() string {
+func (e ReconcilePlanItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4434,14 +6019,14 @@ func (e ReconcileResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReconcileResponse.%s: %s%s",
+		"invalid %sReconcilePlanItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReconcileResponseValidationError{}
+var _ error = ReconcilePlanItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -4449,7 +6034,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReconcileResponseValidationError{}
+} = ReconcilePlanItemValidationError{}
 
 // Validate checks the field values on ReconcileError with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
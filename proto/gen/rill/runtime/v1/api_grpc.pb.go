@@ -25,6 +25,9 @@ const (
 	RuntimeService_CreateInstance_FullMethodName         = "/rill.runtime.v1.RuntimeService/CreateInstance"
 	RuntimeService_EditInstance_FullMethodName           = "/rill.runtime.v1.RuntimeService/EditInstance"
 	RuntimeService_DeleteInstance_FullMethodName         = "/rill.runtime.v1.RuntimeService/DeleteInstance"
+	RuntimeService_GetInstanceUsage_FullMethodName       = "/rill.runtime.v1.RuntimeService/GetInstanceUsage"
+	RuntimeService_ListRunningQueries_FullMethodName     = "/rill.runtime.v1.RuntimeService/ListRunningQueries"
+	RuntimeService_CancelRunningQuery_FullMethodName     = "/rill.runtime.v1.RuntimeService/CancelRunningQuery"
 	RuntimeService_ListFiles_FullMethodName              = "/rill.runtime.v1.RuntimeService/ListFiles"
 	RuntimeService_GetFile_FullMethodName                = "/rill.runtime.v1.RuntimeService/GetFile"
 	RuntimeService_PutFile_FullMethodName                = "/rill.runtime.v1.RuntimeService/PutFile"
@@ -35,6 +38,8 @@ const (
 	RuntimeService_TriggerRefresh_FullMethodName         = "/rill.runtime.v1.RuntimeService/TriggerRefresh"
 	RuntimeService_TriggerSync_FullMethodName            = "/rill.runtime.v1.RuntimeService/TriggerSync"
 	RuntimeService_Reconcile_FullMethodName              = "/rill.runtime.v1.RuntimeService/Reconcile"
+	RuntimeService_PlanReconcile_FullMethodName          = "/rill.runtime.v1.RuntimeService/PlanReconcile"
+	RuntimeService_WatchReconcile_FullMethodName         = "/rill.runtime.v1.RuntimeService/WatchReconcile"
 	RuntimeService_PutFileAndReconcile_FullMethodName    = "/rill.runtime.v1.RuntimeService/PutFileAndReconcile"
 	RuntimeService_DeleteFileAndReconcile_FullMethodName = "/rill.runtime.v1.RuntimeService/DeleteFileAndReconcile"
 	RuntimeService_RenameFileAndReconcile_FullMethodName = "/rill.runtime.v1.RuntimeService/RenameFileAndReconcile"
@@ -58,6 +63,12 @@ type RuntimeServiceClient interface {
 	EditInstance(ctx context.Context, in *EditInstanceRequest, opts ...grpc.CallOption) (*EditInstanceResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(ctx context.Context, in *DeleteInstanceRequest, opts ...grpc.CallOption) (*DeleteInstanceResponse, error)
+	// GetInstanceUsage returns the resources used by an instance
+	GetInstanceUsage(ctx context.Context, in *GetInstanceUsageRequest, opts ...grpc.CallOption) (*GetInstanceUsageResponse, error)
+	// ListRunningQueries lists the OLAP queries currently queued or executing for an instance
+	ListRunningQueries(ctx context.Context, in *ListRunningQueriesRequest, opts ...grpc.CallOption) (*ListRunningQueriesResponse, error)
+	// CancelRunningQuery cancels a queued or executing OLAP query
+	CancelRunningQuery(ctx context.Context, in *CancelRunningQueryRequest, opts ...grpc.CallOption) (*CancelRunningQueryResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
 	// The files are sorted by their full path.
 	ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error)
@@ -85,6 +96,13 @@ type RuntimeServiceClient interface {
 	// the desired state expressed in the artifacts. Any existing objects not described in the submitted
 	// artifacts will be deleted.
 	Reconcile(ctx context.Context, in *ReconcileRequest, opts ...grpc.CallOption) (*ReconcileResponse, error)
+	// PlanReconcile previews the migrations Reconcile would apply without executing them.
+	// For each object, it returns the action, the reasons for it, the estimated size of the data a source would ingest,
+	// and the downstream objects that would be migrated as a consequence.
+	PlanReconcile(ctx context.Context, in *PlanReconcileRequest, opts ...grpc.CallOption) (*PlanReconcileResponse, error)
+	// WatchReconcile watches the instance's repo for changes made outside of the runtime (e.g. in an editor),
+	// reconciles the changed files and streams the results. Only repos that can detect changes (i.e. "file") are supported.
+	WatchReconcile(ctx context.Context, in *WatchReconcileRequest, opts ...grpc.CallOption) (RuntimeService_WatchReconcileClient, error)
 	// PutFileAndReconcile combines PutFile and Reconcile in a single endpoint to reduce latency.
 	// It is equivalent to calling the two RPCs sequentially.
 	PutFileAndReconcile(ctx context.Context, in *PutFileAndReconcileRequest, opts ...grpc.CallOption) (*PutFileAndReconcileResponse, error)
@@ -160,6 +178,33 @@ func (c *runtimeServiceClient) DeleteInstance(ctx context.Context, in *DeleteIns
 	return out, nil
 }
 
+func (c *runtimeServiceClient) GetInstanceUsage(ctx context.Context, in *GetInstanceUsageRequest, opts ...grpc.CallOption) (*GetInstanceUsageResponse, error) {
+	out := new(GetInstanceUsageResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_GetInstanceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) ListRunningQueries(ctx context.Context, in *ListRunningQueriesRequest, opts ...grpc.CallOption) (*ListRunningQueriesResponse, error) {
+	out := new(ListRunningQueriesResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_ListRunningQueries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) CancelRunningQuery(ctx context.Context, in *CancelRunningQueryRequest, opts ...grpc.CallOption) (*CancelRunningQueryResponse, error) {
+	out := new(CancelRunningQueryResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_CancelRunningQuery_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *runtimeServiceClient) ListFiles(ctx context.Context, in *ListFilesRequest, opts ...grpc.CallOption) (*ListFilesResponse, error) {
 	out := new(ListFilesResponse)
 	err := c.cc.Invoke(ctx, RuntimeService_ListFiles_FullMethodName, in, out, opts...)
@@ -250,6 +295,47 @@ func (c *runtimeServiceClient) Reconcile(ctx context.Context, in *ReconcileReque
 	return out, nil
 }
 
+func (c *runtimeServiceClient) PlanReconcile(ctx context.Context, in *PlanReconcileRequest, opts ...grpc.CallOption) (*PlanReconcileResponse, error) {
+	out := new(PlanReconcileResponse)
+	err := c.cc.Invoke(ctx, RuntimeService_PlanReconcile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *runtimeServiceClient) WatchReconcile(ctx context.Context, in *WatchReconcileRequest, opts ...grpc.CallOption) (RuntimeService_WatchReconcileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RuntimeService_ServiceDesc.Streams[0], RuntimeService_WatchReconcile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &runtimeServiceWatchReconcileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RuntimeService_WatchReconcileClient interface {
+	Recv() (*WatchReconcileResponse, error)
+	grpc.ClientStream
+}
+
+type runtimeServiceWatchReconcileClient struct {
+	grpc.ClientStream
+}
+
+func (x *runtimeServiceWatchReconcileClient) Recv() (*WatchReconcileResponse, error) {
+	m := new(WatchReconcileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *runtimeServiceClient) PutFileAndReconcile(ctx context.Context, in *PutFileAndReconcileRequest, opts ...grpc.CallOption) (*PutFileAndReconcileResponse, error) {
 	out := new(PutFileAndReconcileResponse)
 	err := c.cc.Invoke(ctx, RuntimeService_PutFileAndReconcile_FullMethodName, in, out, opts...)
@@ -311,6 +397,12 @@ type RuntimeServiceServer interface {
 	EditInstance(context.Context, *EditInstanceRequest) (*EditInstanceResponse, error)
 	// DeleteInstance deletes an instance
 	DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error)
+	// GetInstanceUsage returns the resources used by an instance
+	GetInstanceUsage(context.Context, *GetInstanceUsageRequest) (*GetInstanceUsageResponse, error)
+	// ListRunningQueries lists the OLAP queries currently queued or executing for an instance
+	ListRunningQueries(context.Context, *ListRunningQueriesRequest) (*ListRunningQueriesResponse, error)
+	// CancelRunningQuery cancels a queued or executing OLAP query
+	CancelRunningQuery(context.Context, *CancelRunningQueryRequest) (*CancelRunningQueryResponse, error)
 	// ListFiles lists all the files matching a glob in a repo.
 	// The files are sorted by their full path.
 	ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error)
@@ -338,6 +430,13 @@ type RuntimeServiceServer interface {
 	// the desired state expressed in the artifacts. Any existing objects not described in the submitted
 	// artifacts will be deleted.
 	Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error)
+	// PlanReconcile previews the migrations Reconcile would apply without executing them.
+	// For each object, it returns the action, the reasons for it, the estimated size of the data a source would ingest,
+	// and the downstream objects that would be migrated as a consequence.
+	PlanReconcile(context.Context, *PlanReconcileRequest) (*PlanReconcileResponse, error)
+	// WatchReconcile watches the instance's repo for changes made outside of the runtime (e.g. in an editor),
+	// reconciles the changed files and streams the results. Only repos that can detect changes (i.e. "file") are supported.
+	WatchReconcile(*WatchReconcileRequest, RuntimeService_WatchReconcileServer) error
 	// PutFileAndReconcile combines PutFile and Reconcile in a single endpoint to reduce latency.
 	// It is equivalent to calling the two RPCs sequentially.
 	PutFileAndReconcile(context.Context, *PutFileAndReconcileRequest) (*PutFileAndReconcileResponse, error)
@@ -374,6 +473,15 @@ func (UnimplementedRuntimeServiceServer) EditInstance(context.Context, *EditInst
 func (UnimplementedRuntimeServiceServer) DeleteInstance(context.Context, *DeleteInstanceRequest) (*DeleteInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteInstance not implemented")
 }
+func (UnimplementedRuntimeServiceServer) GetInstanceUsage(context.Context, *GetInstanceUsageRequest) (*GetInstanceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstanceUsage not implemented")
+}
+func (UnimplementedRuntimeServiceServer) ListRunningQueries(context.Context, *ListRunningQueriesRequest) (*ListRunningQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunningQueries not implemented")
+}
+func (UnimplementedRuntimeServiceServer) CancelRunningQuery(context.Context, *CancelRunningQueryRequest) (*CancelRunningQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRunningQuery not implemented")
+}
 func (UnimplementedRuntimeServiceServer) ListFiles(context.Context, *ListFilesRequest) (*ListFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
 }
@@ -404,6 +512,12 @@ func (UnimplementedRuntimeServiceServer) TriggerSync(context.Context, *TriggerSy
 func (UnimplementedRuntimeServiceServer) Reconcile(context.Context, *ReconcileRequest) (*ReconcileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Reconcile not implemented")
 }
+func (UnimplementedRuntimeServiceServer) PlanReconcile(context.Context, *PlanReconcileRequest) (*PlanReconcileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanReconcile not implemented")
+}
+func (UnimplementedRuntimeServiceServer) WatchReconcile(*WatchReconcileRequest, RuntimeService_WatchReconcileServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchReconcile not implemented")
+}
 func (UnimplementedRuntimeServiceServer) PutFileAndReconcile(context.Context, *PutFileAndReconcileRequest) (*PutFileAndReconcileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PutFileAndReconcile not implemented")
 }
@@ -540,6 +654,60 @@ func _RuntimeService_DeleteInstance_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_GetInstanceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).GetInstanceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_GetInstanceUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).GetInstanceUsage(ctx, req.(*GetInstanceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_ListRunningQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunningQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).ListRunningQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_ListRunningQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).ListRunningQueries(ctx, req.(*ListRunningQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_CancelRunningQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelRunningQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).CancelRunningQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_CancelRunningQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).CancelRunningQuery(ctx, req.(*CancelRunningQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RuntimeService_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFilesRequest)
 	if err := dec(in); err != nil {
@@ -720,6 +888,45 @@ func _RuntimeService_Reconcile_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RuntimeService_PlanReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanReconcileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RuntimeServiceServer).PlanReconcile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RuntimeService_PlanReconcile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RuntimeServiceServer).PlanReconcile(ctx, req.(*PlanReconcileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RuntimeService_WatchReconcile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchReconcileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RuntimeServiceServer).WatchReconcile(m, &runtimeServiceWatchReconcileServer{stream})
+}
+
+type RuntimeService_WatchReconcileServer interface {
+	Send(*WatchReconcileResponse) error
+	grpc.ServerStream
+}
+
+type runtimeServiceWatchReconcileServer struct {
+	grpc.ServerStream
+}
+
+func (x *runtimeServiceWatchReconcileServer) Send(m *WatchReconcileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _RuntimeService_PutFileAndReconcile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PutFileAndReconcileRequest)
 	if err := dec(in); err != nil {
@@ -841,6 +1048,18 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteInstance",
 			Handler:    _RuntimeService_DeleteInstance_Handler,
 		},
+		{
+			MethodName: "GetInstanceUsage",
+			Handler:    _RuntimeService_GetInstanceUsage_Handler,
+		},
+		{
+			MethodName: "ListRunningQueries",
+			Handler:    _RuntimeService_ListRunningQueries_Handler,
+		},
+		{
+			MethodName: "CancelRunningQuery",
+			Handler:    _RuntimeService_CancelRunningQuery_Handler,
+		},
 		{
 			MethodName: "ListFiles",
 			Handler:    _RuntimeService_ListFiles_Handler,
@@ -881,6 +1100,10 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reconcile",
 			Handler:    _RuntimeService_Reconcile_Handler,
 		},
+		{
+			MethodName: "PlanReconcile",
+			Handler:    _RuntimeService_PlanReconcile_Handler,
+		},
 		{
 			MethodName: "PutFileAndReconcile",
 			Handler:    _RuntimeService_PutFileAndReconcile_Handler,
@@ -902,6 +1125,12 @@ var RuntimeService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _RuntimeService_ListConnectors_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchReconcile",
+			Handler:       _RuntimeService_WatchReconcile_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "rill/runtime/v1/api.proto",
 }
@@ -415,6 +415,58 @@ func (m *Model) validate(all bool) error {
 
 	// no validation rules for Materialize
 
+	// no validation rules for RenderedSql
+
+	{
+		sorted_keys := make([]string, len(m.GetProfiles()))
+		i := 0
+		for key := range m.GetProfiles() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetProfiles()[key]
+			_ = val
+
+			// no validation rules for Profiles[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, ModelValidationError{
+							field:  fmt.Sprintf("Profiles[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, ModelValidationError{
+							field:  fmt.Sprintf("Profiles[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return ModelValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	// no validation rules for RowLimit
+
+	// no validation rules for SampleFraction
+
 	if len(errors) > 0 {
 		return ModelMultiError(errors)
 	}
@@ -492,6 +544,113 @@ var _ interface {
 	ErrorName() string
 } = ModelValidationError{}
 
+// Validate checks the field values on ModelProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ModelProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModelProfile with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ModelProfileMultiError, or
+// nil if none found.
+func (m *ModelProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModelProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RowLimit
+
+	// no validation rules for SampleFraction
+
+	if m.Materialize != nil {
+		// no validation rules for Materialize
+	}
+
+	if len(errors) > 0 {
+		return ModelProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModelProfileMultiError is an error wrapping multiple validation errors
+// returned by ModelProfile.ValidateAll() if the designated constraints aren't met.
+type ModelProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModelProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModelProfileMultiError) AllErrors() []error { return m }
+
+// ModelProfileValidationError is the validation error returned by
+// ModelProfile.Validate if the designated constraints aren't met.
+type ModelProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModelProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModelProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModelProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModelProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModelProfileValidationError) ErrorName() string { return "ModelProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ModelProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModelProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModelProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModelProfileValidationError{}
+
 // Validate checks the field values on MetricsView with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -596,6 +755,71 @@ func (m *MetricsView) validate(all bool) error {
 
 	// no validation rules for DefaultTimeRange
 
+	// no validation rules for DefaultTimeZone
+
+	for idx, item := range m.GetRollups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsViewValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsViewValidationError{
+						field:  fmt.Sprintf("Rollups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsViewValidationError{
+					field:  fmt.Sprintf("Rollups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsViewValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsViewValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsViewValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MetricsViewMultiError(errors)
 	}
@@ -1002,3 +1226,357 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MetricsView_MeasureValidationError{}
+
+// Validate checks the field values on MetricsView_Rollup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsView_Rollup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsView_Rollup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsView_RollupMultiError, or nil if none found.
+func (m *MetricsView_Rollup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsView_Rollup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for TimeGrain
+
+	// no validation rules for Table
+
+	// no validation rules for RowCount
+
+	if len(errors) > 0 {
+		return MetricsView_RollupMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsView_RollupMultiError is an error wrapping multiple validation errors
+// returned by MetricsView_Rollup.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsView_RollupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsView_RollupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsView_RollupMultiError) AllErrors() []error { return m }
+
+// MetricsView_RollupValidationError is the validation error returned by
+// MetricsView_Rollup.Validate if the designated constraints aren't met.
+type MetricsView_RollupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsView_RollupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsView_RollupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsView_RollupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsView_RollupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsView_RollupValidationError) ErrorName() string {
+	return "MetricsView_RollupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsView_RollupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsView_Rollup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsView_RollupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsView_RollupValidationError{}
+
+// Validate checks the field values on MetricsView_Policy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsView_Policy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsView_Policy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsView_PolicyMultiError, or nil if none found.
+func (m *MetricsView_Policy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsView_Policy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for HasAccess
+
+	// no validation rules for RowFilter
+
+	for idx, item := range m.GetExclude() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MetricsView_PolicyValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MetricsView_PolicyValidationError{
+						field:  fmt.Sprintf("Exclude[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MetricsView_PolicyValidationError{
+					field:  fmt.Sprintf("Exclude[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MetricsView_PolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsView_PolicyMultiError is an error wrapping multiple validation errors
+// returned by MetricsView_Policy.ValidateAll() if the designated constraints
+// aren't met.
+type MetricsView_PolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsView_PolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsView_PolicyMultiError) AllErrors() []error { return m }
+
+// MetricsView_PolicyValidationError is the validation error returned by
+// MetricsView_Policy.Validate if the designated constraints aren't met.
+type MetricsView_PolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsView_PolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsView_PolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsView_PolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsView_PolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsView_PolicyValidationError) ErrorName() string {
+	return "MetricsView_PolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsView_PolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsView_Policy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsView_PolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsView_PolicyValidationError{}
+
+// Validate checks the field values on MetricsView_FieldCondition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsView_FieldCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsView_FieldCondition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsView_FieldConditionMultiError, or nil if none found.
+func (m *MetricsView_FieldCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsView_FieldCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Condition
+
+	if len(errors) > 0 {
+		return MetricsView_FieldConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsView_FieldConditionMultiError is an error wrapping multiple
+// validation errors returned by MetricsView_FieldCondition.ValidateAll() if
+// the designated constraints aren't met.
+type MetricsView_FieldConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsView_FieldConditionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsView_FieldConditionMultiError) AllErrors() []error { return m }
+
+// MetricsView_FieldConditionValidationError is the validation error returned
+// by MetricsView_FieldCondition.Validate if the designated constraints aren't met.
+type MetricsView_FieldConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsView_FieldConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsView_FieldConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsView_FieldConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsView_FieldConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsView_FieldConditionValidationError) ErrorName() string {
+	return "MetricsView_FieldConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsView_FieldConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsView_FieldCondition.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricsView_FieldConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsView_FieldConditionValidationError{}
@@ -505,6 +505,8 @@ func (m *MetricsViewToplistRequest) validate(all bool) error {
 
 	// no validation rules for Priority
 
+	// no validation rules for TimeDimension
+
 	if len(errors) > 0 {
 		return MetricsViewToplistRequestMultiError(errors)
 	}
@@ -872,6 +874,10 @@ func (m *MetricsViewTimeSeriesRequest) validate(all bool) error {
 
 	// no validation rules for Priority
 
+	// no validation rules for TimeDimension
+
+	// no validation rules for TimeZone
+
 	if len(errors) > 0 {
 		return MetricsViewTimeSeriesRequestMultiError(errors)
 	}
@@ -1239,6 +1245,8 @@ func (m *MetricsViewTotalsRequest) validate(all bool) error {
 
 	// no validation rules for Priority
 
+	// no validation rules for TimeDimension
+
 	if len(errors) > 0 {
 		return MetricsViewTotalsRequestMultiError(errors)
 	}
@@ -5247,6 +5255,8 @@ func (m *ColumnTimeSeriesRequest) validate(all bool) error {
 
 	// no validation rules for Priority
 
+	// no validation rules for TimeZone
+
 	if len(errors) > 0 {
 		return ColumnTimeSeriesRequestMultiError(errors)
 	}
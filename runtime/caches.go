@@ -5,7 +5,6 @@ import (
 	"errors"
 	"sync"
 
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/hashicorp/golang-lru/simplelru"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/services/catalog"
@@ -141,23 +140,3 @@ func (c *migrationMetaCache) evict(ctx context.Context, instID string) {
 	defer c.lock.Unlock()
 	c.cache.Remove(instID)
 }
-
-type queryCache struct {
-	cache *lru.Cache
-}
-
-func newQueryCache(size int) *queryCache {
-	cache, err := lru.New(size)
-	if err != nil {
-		panic(err)
-	}
-	return &queryCache{cache: cache}
-}
-
-func (c *queryCache) get(key queryCacheKey) (any, bool) {
-	return c.cache.Get(key)
-}
-
-func (c *queryCache) add(key queryCacheKey, value any) bool {
-	return c.cache.Add(key, value)
-}
@@ -8,6 +8,7 @@ import (
 	_ "github.com/rilldata/rill/runtime/drivers/sqlite"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func TestConnectionCache(t *testing.T) {
@@ -32,19 +33,94 @@ func TestConnectionCache(t *testing.T) {
 }
 
 func TestNilValues(t *testing.T) {
-	qc := newQueryCache(10)
+	ctx := context.Background()
+	qc := newTestQueryCache(t, queryCacheOptions{MaxEntries: 10})
 
-	qc.add(queryCacheKey{"1", "1", "1"}, "value")
-	v, ok := qc.get(queryCacheKey{"1", "1", "1"})
+	qc.add(ctx, queryCacheKey{"1", "1", "1"}, &QueryResult{Value: "value", Bytes: 5})
+	v, ok := qc.get(ctx, queryCacheKey{"1", "1", "1"})
 	require.Equal(t, "value", v)
 	require.True(t, ok)
 
-	qc.add(queryCacheKey{"1", "1", "1"}, nil)
-	v, ok = qc.get(queryCacheKey{"1", "1", "1"})
+	qc.add(ctx, queryCacheKey{"1", "1", "1"}, &QueryResult{Value: nil})
+	v, ok = qc.get(ctx, queryCacheKey{"1", "1", "1"})
 	require.Nil(t, v)
 	require.True(t, ok)
 
-	v, ok = qc.get(queryCacheKey{"nosuch", "nosuch", "nosuch"})
+	v, ok = qc.get(ctx, queryCacheKey{"nosuch", "nosuch", "nosuch"})
 	require.Nil(t, v)
 	require.False(t, ok)
 }
+
+func TestQueryCacheBytes(t *testing.T) {
+	ctx := context.Background()
+	qc := newTestQueryCache(t, queryCacheOptions{MaxBytes: 100})
+
+	qc.add(ctx, queryCacheKey{"1", "a", ""}, &QueryResult{Value: "a", Bytes: 40})
+	qc.add(ctx, queryCacheKey{"1", "b", ""}, &QueryResult{Value: "b", Bytes: 40})
+
+	// Touch "a" so "b" is the least recently used
+	_, ok := qc.get(ctx, queryCacheKey{"1", "a", ""})
+	require.True(t, ok)
+
+	qc.add(ctx, queryCacheKey{"1", "c", ""}, &QueryResult{Value: "c", Bytes: 40})
+	_, ok = qc.get(ctx, queryCacheKey{"1", "a", ""})
+	require.True(t, ok)
+	_, ok = qc.get(ctx, queryCacheKey{"1", "b", ""})
+	require.False(t, ok)
+	_, ok = qc.get(ctx, queryCacheKey{"1", "c", ""})
+	require.True(t, ok)
+	require.Equal(t, int64(80), qc.bytes)
+
+	// Results larger than the cache are not cached
+	qc.add(ctx, queryCacheKey{"1", "d", ""}, &QueryResult{Value: "d", Bytes: 101})
+	_, ok = qc.get(ctx, queryCacheKey{"1", "d", ""})
+	require.False(t, ok)
+	require.Equal(t, int64(80), qc.bytes)
+}
+
+func TestQueryCacheInstanceQuota(t *testing.T) {
+	ctx := context.Background()
+	qc := newTestQueryCache(t, queryCacheOptions{InstanceQuotaBytes: 50})
+
+	qc.add(ctx, queryCacheKey{"1", "a", ""}, &QueryResult{Value: "a", Bytes: 30})
+	qc.add(ctx, queryCacheKey{"2", "a", ""}, &QueryResult{Value: "a", Bytes: 30})
+	qc.add(ctx, queryCacheKey{"1", "b", ""}, &QueryResult{Value: "b", Bytes: 30})
+
+	// Instance 1 exceeded its quota, which should not affect instance 2
+	_, ok := qc.get(ctx, queryCacheKey{"1", "a", ""})
+	require.False(t, ok)
+	_, ok = qc.get(ctx, queryCacheKey{"1", "b", ""})
+	require.True(t, ok)
+	_, ok = qc.get(ctx, queryCacheKey{"2", "a", ""})
+	require.True(t, ok)
+	require.Equal(t, int64(30), qc.instanceBytes["1"])
+	require.Equal(t, int64(30), qc.instanceBytes["2"])
+}
+
+func TestQueryCacheDisk(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	key := queryCacheKey{"1", "a", "dep"}
+
+	qc := newTestQueryCache(t, queryCacheOptions{MaxEntries: 10, DiskPath: dir})
+	qc.add(ctx, key, &QueryResult{Value: structpb.NewStringValue("hello"), Bytes: 10})
+	qc.add(ctx, queryCacheKey{"1", "b", "dep"}, &QueryResult{Value: "not a proto", Bytes: 10})
+
+	// A new cache (e.g. after a restart) should serve protobuf results from disk
+	qc = newTestQueryCache(t, queryCacheOptions{MaxEntries: 10, DiskPath: dir})
+	v, ok := qc.get(ctx, key)
+	require.True(t, ok)
+	require.Equal(t, "hello", v.(*structpb.Value).GetStringValue())
+	_, ok = qc.get(ctx, queryCacheKey{"1", "b", "dep"})
+	require.False(t, ok)
+
+	// Changed dependencies should miss
+	_, ok = qc.get(ctx, queryCacheKey{"1", "a", "dep2"})
+	require.False(t, ok)
+}
+
+func newTestQueryCache(t *testing.T, opts queryCacheOptions) *queryCache {
+	qc, err := newQueryCache(opts, zap.NewNop())
+	require.NoError(t, err)
+	return qc
+}
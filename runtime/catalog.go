@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/services/catalog"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator/metricsviews"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -56,14 +58,45 @@ func (r *Runtime) Reconcile(ctx context.Context, instanceID string, changedPaths
 		ChangedPaths:      changedPaths,
 		ForcedPaths:       forcedPaths,
 		SafeSourceRefresh: r.opts.SafeSourceRefresh,
+		Transactional:     r.opts.TransactionalReconcile,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	// Report the commit that was reconciled. It's read after the sync since the repo may have pulled new commits.
+	if rv, ok := repo.(drivers.RepoVersioner); ok {
+		resp.Commit, err = rv.CommitHash(ctx, instanceID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return resp, nil
 }
 
+func (r *Runtime) PlanReconcile(ctx context.Context, instanceID string, changedPaths, forcedPaths []string) (*catalog.ReconcilePlan, error) {
+	repo, err := r.Repo(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = repo.Sync(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	cat, err := r.NewCatalogService(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return cat.Plan(ctx, catalog.ReconcileConfig{
+		ChangedPaths: changedPaths,
+		ForcedPaths:  forcedPaths,
+	})
+}
+
 func (r *Runtime) RefreshSource(ctx context.Context, instanceID, name string) error {
 	repo, err := r.Repo(ctx, instanceID)
 	if err != nil {
@@ -90,6 +123,7 @@ func (r *Runtime) RefreshSource(ctx context.Context, instanceID, name string) er
 		ForcedPaths:       []string{path},
 		Strict:            true,
 		SafeSourceRefresh: r.opts.SafeSourceRefresh,
+		Transactional:     r.opts.TransactionalReconcile,
 	})
 	if err != nil {
 		return err
@@ -137,6 +171,15 @@ func (r *Runtime) SyncExistingTables(ctx context.Context, instanceID string) err
 	added := 0
 	updated := 0
 	for _, t := range tables {
+		// Rollups are managed by their metrics view
+		if strings.HasPrefix(t.Name, metricsviews.RollupTablePrefix) {
+			continue
+		}
+		// Staging tables are managed by transactional reconciles
+		if strings.HasPrefix(t.Name, catalog.StagingTablePrefix) {
+			continue
+		}
+
 		obj, ok := objMap[t.Name]
 
 		// Track that the object still exists
@@ -0,0 +1,107 @@
+package rillv1beta
+
+import (
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog/artifacts/sql"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// globConnectors are the connectors that support the glob.* properties
+var globConnectors = map[string]bool{"s3": true, "gcs": true}
+
+// ApplyDefaults sets the settings the entry read from its artifact doesn't set to the project defaults.
+// profile is the instance's profile, which selects the dev or prod settings of models.
+func (p *ProjectConfig) ApplyDefaults(entry *drivers.CatalogEntry, profile string) {
+	switch entry.Type {
+	case drivers.ObjectTypeSource:
+		p.applySourceDefaults(entry.GetSource())
+	case drivers.ObjectTypeModel:
+		p.applyModelDefaults(entry.GetModel(), profile)
+	case drivers.ObjectTypeMetricsView:
+		p.applyDashboardDefaults(entry.GetMetricsView())
+	}
+}
+
+func (p *ProjectConfig) applySourceDefaults(source *runtimev1.Source) {
+	if source.TimeoutSeconds == 0 {
+		source.TimeoutSeconds = p.Sources.Timeout
+	}
+
+	if !globConnectors[source.Connector] {
+		return
+	}
+	defaults := map[string]int64{
+		"glob.max_total_size":      p.Sources.GlobMaxTotalSize,
+		"glob.max_objects_matched": int64(p.Sources.GlobMaxObjectsMatched),
+		"glob.max_objects_listed":  p.Sources.GlobMaxObjectsListed,
+		"glob.page_size":           int64(p.Sources.GlobPageSize),
+	}
+	for key, val := range defaults {
+		if val == 0 {
+			continue
+		}
+		if source.Properties == nil {
+			source.Properties = &structpb.Struct{Fields: make(map[string]*structpb.Value)}
+		}
+		if _, ok := source.Properties.Fields[key]; !ok {
+			source.Properties.Fields[key] = structpb.NewNumberValue(float64(val))
+		}
+	}
+}
+
+// applyModelDefaults resolves the model's settings for the profile. The settings are applied in order of precedence:
+// the top level defaults, the model's own settings, the defaults in the profile's section and the model's settings for the profile.
+func (p *ProjectConfig) applyModelDefaults(model *runtimev1.Model, profile string) {
+	settings := &runtimev1.ModelProfile{
+		RowLimit:       p.Models.Limit,
+		SampleFraction: p.Models.Sample,
+	}
+	// the top level default only applies if the model doesn't use the @materialize tag
+	if p.Models.Materialize != nil && !sql.MaterializedRegex.MatchString(model.RenderedSql+"\n") {
+		settings.Materialize = p.Models.Materialize
+	}
+
+	if conf := p.Profile(profile); conf != nil {
+		if conf.Models.Materialize != nil {
+			settings.Materialize = conf.Models.Materialize
+		}
+		if conf.Models.Limit != 0 {
+			settings.RowLimit = conf.Models.Limit
+		}
+		if conf.Models.Sample != 0 {
+			settings.SampleFraction = conf.Models.Sample
+		}
+	}
+	if override, ok := model.Profiles[profile]; ok {
+		if override.Materialize != nil {
+			settings.Materialize = override.Materialize
+		}
+		if override.RowLimit != 0 {
+			settings.RowLimit = override.RowLimit
+		}
+		if override.SampleFraction != 0 {
+			settings.SampleFraction = override.SampleFraction
+		}
+	}
+
+	if settings.Materialize != nil {
+		model.Materialize = *settings.Materialize
+	}
+	model.RowLimit = settings.RowLimit
+	model.SampleFraction = settings.SampleFraction
+}
+
+func (p *ProjectConfig) applyDashboardDefaults(mv *runtimev1.MetricsView) {
+	if mv.DefaultTimeRange == "" {
+		mv.DefaultTimeRange = p.Dashboards.DefaultTimeRange
+	}
+	if mv.DefaultTimeZone == "" {
+		mv.DefaultTimeZone = p.Dashboards.DefaultTimeZone
+	}
+	if mv.SmallestTimeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && p.Dashboards.SmallestTimeGrain != "" {
+		mv.SmallestTimeGrain = runtimev1.TimeGrain(runtimev1.TimeGrain_value["TIME_GRAIN_"+strings.ToUpper(p.Dashboards.SmallestTimeGrain)])
+	}
+}
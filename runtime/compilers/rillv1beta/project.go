@@ -16,6 +16,9 @@ import (
 
 const Version = "rill-beta"
 
+// ProjectConfigPath is the path of the project's rill.yaml
+const ProjectConfigPath = "rill.yaml"
+
 type Codec struct {
 	Repo       drivers.RepoStore
 	InstanceID string
@@ -26,12 +29,12 @@ func New(repo drivers.RepoStore, instanceID string) *Codec {
 }
 
 func (c *Codec) IsInit(ctx context.Context) bool {
-	_, err := c.Repo.Get(ctx, c.InstanceID, "rill.yaml")
+	_, err := c.Repo.Get(ctx, c.InstanceID, ProjectConfigPath)
 	return err == nil
 }
 
 func (c *Codec) InitEmpty(ctx context.Context, name, rillVersion string) error {
-	err := c.Repo.Put(ctx, c.InstanceID, "rill.yaml", strings.NewReader(fmt.Sprintf("compiler: %s\nrill_version: %s\n\ntitle: %s\n", Version, rillVersion, name)))
+	err := c.Repo.Put(ctx, c.InstanceID, ProjectConfigPath, strings.NewReader(fmt.Sprintf("compiler: %s\nrill_version: %s\n\ntitle: %s\n", Version, rillVersion, name)))
 	if err != nil {
 		return err
 	}
@@ -120,7 +123,7 @@ func (c *Codec) DeleteSource(ctx context.Context, name string) (string, error) {
 }
 
 func (c *Codec) ProjectConfig(ctx context.Context) (*ProjectConfig, error) {
-	content, err := c.Repo.Get(ctx, c.InstanceID, "rill.yaml")
+	content, err := c.Repo.Get(ctx, c.InstanceID, ProjectConfigPath)
 	// rill.yaml is not guaranteed to exist in case of older projects
 	if os.IsNotExist(err) {
 		return &ProjectConfig{Variables: make(map[string]string)}, nil
@@ -130,29 +133,29 @@ func (c *Codec) ProjectConfig(ctx context.Context) (*ProjectConfig, error) {
 		return nil, err
 	}
 
-	r := &ProjectConfig{Variables: make(map[string]string)}
-	if err := yaml.Unmarshal([]byte(content), r); err != nil {
+	return ParseProjectConfig([]byte(content))
+}
+
+// ReadProjectConfig parses the rill.yaml of the project in dir.
+func ReadProjectConfig(dir string) (*ProjectConfig, error) {
+	content, err := os.ReadFile(filepath.Join(dir, ProjectConfigPath))
+	if err != nil {
 		return nil, err
 	}
 
-	return r, nil
+	return ParseProjectConfig(content)
 }
 
 func ProjectName(dir string) (string, error) {
-	content, err := os.ReadFile(filepath.Join(dir, "rill.yaml"))
+	c, err := ReadProjectConfig(dir)
 	if err != nil {
 		return "", err
 	}
 
-	c := &ProjectConfig{Variables: make(map[string]string)}
-	if err := yaml.Unmarshal(content, c); err != nil {
-		return "", err
-	}
-
 	return c.SanitizedName(), nil
 }
 
 func HasRillProject(dir string) bool {
-	_, err := os.Open(filepath.Join(dir, "rill.yaml"))
+	_, err := os.Open(filepath.Join(dir, ProjectConfigPath))
 	return err == nil
 }
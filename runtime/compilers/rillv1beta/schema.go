@@ -0,0 +1,335 @@
+package rillv1beta
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rilldata/rill/runtime/pkg/duration"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigError is an invalid value in rill.yaml.
+// Line and Column are 1-based, and 0 if the error doesn't relate to a position in the file.
+type ConfigError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ConfigError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	if e.Column == 0 {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+}
+
+func newConfigError(node *yaml.Node, format string, args ...any) *ConfigError {
+	return &ConfigError{Line: node.Line, Column: node.Column, Message: fmt.Sprintf(format, args...)}
+}
+
+// yamlErrorRegex matches the position in syntax errors from yaml, e.g. "yaml: line 3: did not find expected key"
+var yamlErrorRegex = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// ParseProjectConfig parses and validates the contents of rill.yaml.
+// Unknown fields don't fail validation, they're ignored and reported in the config's Warnings.
+// Invalid contents return a *ConfigError, along with the config decoded on a best-effort basis if the file is valid YAML,
+// so callers can still apply its variables.
+func ParseProjectConfig(content []byte) (*ProjectConfig, error) {
+	conf := &ProjectConfig{Variables: make(map[string]string)}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		if match := yamlErrorRegex.FindStringSubmatch(err.Error()); match != nil {
+			line, _ := strconv.Atoi(match[1])
+			return nil, &ConfigError{Line: line, Message: match[2]}
+		}
+		return nil, &ConfigError{Message: err.Error()}
+	}
+	if len(doc.Content) == 0 {
+		// empty file
+		return conf, nil
+	}
+
+	root := doc.Content[0]
+	var warnings []*ConfigError
+	validateErr := validateNode(root, reflect.TypeOf(conf).Elem(), "", "", &warnings)
+	// Decoding skips values of the wrong type, so it still decodes the valid parts of an invalid config
+	decodeErr := root.Decode(conf)
+	if conf.Variables == nil {
+		conf.Variables = make(map[string]string)
+	}
+	conf.Warnings = warnings
+	if validateErr != nil {
+		return conf, validateErr
+	}
+	if decodeErr != nil {
+		return conf, newConfigError(root, "%s", decodeErr.Error())
+	}
+	return conf, nil
+}
+
+// constraints are parsed from the schema tag of a field, e.g. `schema:"enum=a|b,min=0,max=1,format=duration"`
+type constraints struct {
+	enum   []string
+	min    *float64
+	max    *float64
+	format string
+}
+
+func parseConstraints(tag string) constraints {
+	var c constraints
+	for _, part := range strings.Split(tag, ",") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "enum":
+			c.enum = strings.Split(val, "|")
+		case "min":
+			f, err := strconv.ParseFloat(val, 64)
+			if err == nil {
+				c.min = &f
+			}
+		case "max":
+			f, err := strconv.ParseFloat(val, 64)
+			if err == nil {
+				c.max = &f
+			}
+		case "format":
+			c.format = val
+		}
+	}
+	return c
+}
+
+// yamlName returns the key of a struct field in YAML
+func yamlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+	if name == "" {
+		return strings.ToLower(field.Name)
+	}
+	return name
+}
+
+// validateNode checks that node can be decoded into typ and satisfies the constraints in the schema tag.
+// path is the dotted path of node in the file, used in error messages. Unknown fields are appended to warnings.
+func validateNode(node *yaml.Node, typ reflect.Type, path, schemaTag string, warnings *[]*ConfigError) error {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return nil
+	}
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return newConfigError(node, "%s must be a mapping", describePath(path))
+		}
+		fields := make(map[string]reflect.StructField, typ.NumField())
+		for i := 0; i < typ.NumField(); i++ {
+			if name := yamlName(typ.Field(i)); name != "-" {
+				fields[name] = typ.Field(i)
+			}
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, val := node.Content[i], node.Content[i+1]
+			field, ok := fields[key.Value]
+			if !ok {
+				*warnings = append(*warnings, newConfigError(key, "unknown field %q", joinPath(path, key.Value)))
+				continue
+			}
+			if err := validateNode(val, field.Type, joinPath(path, key.Value), field.Tag.Get("schema"), warnings); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return newConfigError(node, "%s must be a mapping", describePath(path))
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, val := node.Content[i], node.Content[i+1]
+			if err := validateNode(val, typ.Elem(), joinPath(path, key.Value), schemaTag, warnings); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			return newConfigError(node, "%s must be a list", describePath(path))
+		}
+		for i, item := range node.Content {
+			if err := validateNode(item, typ.Elem(), fmt.Sprintf("%s[%d]", path, i), schemaTag, warnings); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if node.Kind != yaml.ScalarNode {
+		return newConfigError(node, "%s must be a single value", describePath(path))
+	}
+	c := parseConstraints(schemaTag)
+
+	switch typ.Kind() {
+	case reflect.String:
+		return validateString(node, path, c)
+	case reflect.Bool:
+		if node.Tag != "!!bool" {
+			return newConfigError(node, "%s must be true or false", describePath(path))
+		}
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if node.Tag != "!!int" {
+			return newConfigError(node, "%s must be an integer", describePath(path))
+		}
+		n, err := strconv.ParseInt(node.Value, 0, typ.Bits())
+		if err != nil {
+			return newConfigError(node, "%s is out of range", describePath(path))
+		}
+		return validateRange(node, path, float64(n), c)
+	case reflect.Float32, reflect.Float64:
+		if node.Tag != "!!int" && node.Tag != "!!float" {
+			return newConfigError(node, "%s must be a number", describePath(path))
+		}
+		f, err := strconv.ParseFloat(node.Value, 64)
+		if err != nil {
+			return newConfigError(node, "%s must be a number", describePath(path))
+		}
+		return validateRange(node, path, f, c)
+	default:
+		return nil
+	}
+}
+
+func validateString(node *yaml.Node, path string, c constraints) error {
+	if len(c.enum) > 0 {
+		found := false
+		for _, v := range c.enum {
+			if strings.EqualFold(v, node.Value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return newConfigError(node, "invalid %s %q (options: %s)", path, node.Value, strings.Join(c.enum, ", "))
+		}
+	}
+	switch c.format {
+	case "duration":
+		if _, err := duration.ParseISO8601(node.Value); err != nil {
+			return newConfigError(node, "invalid %s: %s", path, err.Error())
+		}
+	case "timezone":
+		if _, err := time.LoadLocation(node.Value); err != nil {
+			return newConfigError(node, "invalid %s: %s", path, err.Error())
+		}
+	}
+	return nil
+}
+
+func validateRange(node *yaml.Node, path string, val float64, c constraints) error {
+	if c.min != nil && val < *c.min {
+		return newConfigError(node, "%s must be at least %v", path, *c.min)
+	}
+	if c.max != nil && val > *c.max {
+		return newConfigError(node, "%s must be at most %v", path, *c.max)
+	}
+	return nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func describePath(path string) string {
+	if path == "" {
+		return "rill.yaml"
+	}
+	return path
+}
+
+// JSONSchema returns a JSON Schema for rill.yaml, generated from ProjectConfig.
+// It's published as rill.schema.json next to this file, which editors can use for completion and validation.
+func JSONSchema() ([]byte, error) {
+	schema := typeSchema(reflect.TypeOf(ProjectConfig{}), "")
+	schema["$schema"] = "http://json-schema.org/draft-07/schema#"
+	schema["title"] = "rill.yaml"
+	schema["description"] = "Rill project configuration"
+	b, err := json.MarshalIndent(schema, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func typeSchema(typ reflect.Type, schemaTag string) map[string]any {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	schema := make(map[string]any)
+	switch typ.Kind() {
+	case reflect.Struct:
+		props := make(map[string]any, typ.NumField())
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if yamlName(field) == "-" {
+				continue
+			}
+			prop := typeSchema(field.Type, field.Tag.Get("schema"))
+			if desc := field.Tag.Get("description"); desc != "" {
+				prop["description"] = desc
+			}
+			props[yamlName(field)] = prop
+		}
+		schema["type"] = "object"
+		schema["properties"] = props
+		schema["additionalProperties"] = false
+		return schema
+	case reflect.Map:
+		schema["type"] = "object"
+		schema["additionalProperties"] = typeSchema(typ.Elem(), schemaTag)
+		return schema
+	case reflect.Slice:
+		schema["type"] = "array"
+		schema["items"] = typeSchema(typ.Elem(), schemaTag)
+		return schema
+	case reflect.String:
+		schema["type"] = "string"
+	case reflect.Bool:
+		schema["type"] = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		schema["type"] = "integer"
+	case reflect.Float32, reflect.Float64:
+		schema["type"] = "number"
+	}
+
+	c := parseConstraints(schemaTag)
+	if len(c.enum) > 0 {
+		schema["enum"] = c.enum
+	}
+	if c.min != nil {
+		schema["minimum"] = *c.min
+	}
+	if c.max != nil {
+		schema["maximum"] = *c.max
+	}
+	return schema
+}
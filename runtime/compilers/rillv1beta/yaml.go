@@ -3,6 +3,8 @@ package rillv1beta
 import (
 	"regexp"
 	"strings"
+
+	"github.com/rilldata/rill/runtime/drivers"
 )
 
 var alphaNumericRegex = regexp.MustCompile("[^A-Za-z0-9]+")
@@ -15,10 +17,75 @@ type Source struct {
 	CSVDelimiter string `yaml:"csv.delimiter,omitempty"`
 }
 
+// ProjectConfig is the project's rill.yaml.
+// The description and schema tags are used to validate it and to generate its JSON Schema (see JSONSchema).
 type ProjectConfig struct {
+	Compiler    string `yaml:"compiler,omitempty" description:"Version of the project format"`
+	RillVersion string `yaml:"rill_version,omitempty" description:"Version of Rill the project was created with"`
+	Title       string `yaml:"title,omitempty" description:"Display name of the project"`
+	Name        string `yaml:"name,omitempty" description:"Name of the project"`
 	// Project variables
-	Variables map[string]string `yaml:"env,omitempty"`
-	Name      string            `yaml:"name,omitempty"`
+	Variables map[string]string `yaml:"env,omitempty" description:"Default values of the variables available in templates as .env.KEY"`
+	// OLAPConnector is the OLAP driver used by rill start when none is passed
+	OLAPConnector string `yaml:"olap_connector,omitempty" description:"Default OLAP connector" schema:"enum=duckdb|druid"`
+	// IngestLimitBytes applies in addition to the instance's ingestion limit
+	IngestLimitBytes int64             `yaml:"ingest_limit_bytes,omitempty" description:"Total data allowed to ingest across all sources, 0 means no limit" schema:"min=0"`
+	Sources          SourceDefaults    `yaml:"sources,omitempty" description:"Defaults for all sources"`
+	Models           ModelDefaults     `yaml:"models,omitempty" description:"Defaults for all models"`
+	Dashboards       DashboardDefaults `yaml:"dashboards,omitempty" description:"Defaults for all dashboards"`
+	Features         Features          `yaml:"features,omitempty" description:"Feature flags"`
+	// Settings for instances using the dev or prod profile
+	Dev  *Profile `yaml:"dev,omitempty" description:"Settings for the dev profile, used by rill start"`
+	Prod *Profile `yaml:"prod,omitempty" description:"Settings for the prod profile, used by deployments"`
+	// Warnings are the unknown fields found when parsing the config, which are ignored
+	Warnings []*ConfigError `yaml:"-"`
+}
+
+// SourceDefaults apply to the sources that don't set them
+type SourceDefaults struct {
+	Timeout               int32 `yaml:"timeout,omitempty" description:"Timeout for source ingestion in seconds" schema:"min=0"`
+	GlobMaxTotalSize      int64 `yaml:"glob.max_total_size,omitempty" description:"Max total size of the files matched by a glob in bytes" schema:"min=0"`
+	GlobMaxObjectsMatched int   `yaml:"glob.max_objects_matched,omitempty" description:"Max number of files matched by a glob" schema:"min=0"`
+	GlobMaxObjectsListed  int64 `yaml:"glob.max_objects_listed,omitempty" description:"Max number of objects listed to match a glob" schema:"min=0"`
+	GlobPageSize          int   `yaml:"glob.page_size,omitempty" description:"Page size when listing objects to match a glob" schema:"min=0"`
+}
+
+// ModelDefaults apply to the models that don't set them
+type ModelDefaults struct {
+	Materialize *bool   `yaml:"materialize,omitempty" description:"Materialize models as tables instead of views"`
+	Limit       int64   `yaml:"limit,omitempty" description:"Max number of rows in models, 0 means no limit" schema:"min=0"`
+	Sample      float64 `yaml:"sample,omitempty" description:"Fraction of rows sampled from models, 0 means no sampling" schema:"min=0,max=1"`
+}
+
+// DashboardDefaults apply to the dashboards that don't set them
+type DashboardDefaults struct {
+	DefaultTimeRange  string `yaml:"default_time_range,omitempty" description:"Default time range as an ISO 8601 duration" schema:"format=duration"`
+	DefaultTimeZone   string `yaml:"default_time_zone,omitempty" description:"Default time zone as an IANA name" schema:"format=timezone"`
+	SmallestTimeGrain string `yaml:"smallest_time_grain,omitempty" description:"Smallest time grain" schema:"enum=millisecond|second|minute|hour|day|week|month|year"`
+}
+
+// Features are flags that enable optional runtime behavior for the project
+type Features struct {
+	TransactionalReconcile bool `yaml:"transactional_reconcile,omitempty" description:"Roll back all migrations of a reconcile if one fails"`
+	SafeSourceRefresh      bool `yaml:"safe_source_refresh,omitempty" description:"Keep the previous data of a source if refreshing it fails"`
+}
+
+// Profile contains the settings of the dev: or prod: section
+type Profile struct {
+	// Overrides the top level model defaults. A model can override them in its frontmatter.
+	Models ModelDefaults `yaml:"models,omitempty" description:"Defaults for all models, overriding the top level defaults"`
+}
+
+// Profile returns the settings for the named profile, or nil if there are none.
+func (p *ProjectConfig) Profile(name string) *Profile {
+	switch name {
+	case drivers.ProfileDev:
+		return p.Dev
+	case drivers.ProfileProd:
+		return p.Prod
+	default:
+		return nil
+	}
 }
 
 func (p *ProjectConfig) SanitizedName() string {
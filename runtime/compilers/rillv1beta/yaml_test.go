@@ -1,6 +1,17 @@
 package rillv1beta
 
-import "testing"
+import (
+	"flag"
+	"os"
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var updateSchema = flag.Bool("update-schema", false, "Update rill.schema.json")
 
 func TestProjectConfig_SanitizedName(t *testing.T) {
 	tests := []struct {
@@ -40,3 +51,136 @@ func TestProjectConfig_SanitizedName(t *testing.T) {
 		})
 	}
 }
+
+func TestParseProjectConfig(t *testing.T) {
+	conf, err := ParseProjectConfig([]byte(`compiler: rill-beta
+title: Ad bids
+env:
+  limit: 10
+olap_connector: duckdb
+ingest_limit_bytes: 1000
+sources:
+  timeout: 60
+  glob.max_objects_matched: 100
+models:
+  materialize: true
+dashboards:
+  default_time_range: P1W
+  smallest_time_grain: day
+features:
+  transactional_reconcile: true
+dev:
+  models:
+    limit: 1000
+    sample: 0.1
+`))
+	require.NoError(t, err)
+	require.Equal(t, "Ad bids", conf.Title)
+	require.Equal(t, map[string]string{"limit": "10"}, conf.Variables)
+	require.Equal(t, "duckdb", conf.OLAPConnector)
+	require.Equal(t, int64(1000), conf.IngestLimitBytes)
+	require.Equal(t, int32(60), conf.Sources.Timeout)
+	require.Equal(t, 100, conf.Sources.GlobMaxObjectsMatched)
+	require.True(t, *conf.Models.Materialize)
+	require.Equal(t, "P1W", conf.Dashboards.DefaultTimeRange)
+	require.True(t, conf.Features.TransactionalReconcile)
+	require.Equal(t, int64(1000), conf.Profile("dev").Models.Limit)
+	require.Nil(t, conf.Profile("prod"))
+
+	conf, err = ParseProjectConfig(nil)
+	require.NoError(t, err)
+	require.NotNil(t, conf.Variables)
+
+	invalid := []struct {
+		content string
+		line    int
+		column  int
+		message string
+	}{
+		{"olap_connector: postgres\n", 1, 17, `invalid olap_connector "postgres" (options: duckdb, druid)`},
+		{"dev:\n  models:\n    sample: 2\n", 3, 13, "dev.models.sample must be at most 1"},
+		{"sources:\n  timeout: ten\n", 2, 12, "sources.timeout must be an integer"},
+		{"dashboards:\n  default_time_zone: Not/AZone\n", 2, 22, "invalid dashboards.default_time_zone: unknown time zone Not/AZone"},
+		{"env: [a, b]\n", 1, 6, "env must be a mapping"},
+		{"title: x\n  name: y\n", 2, 0, "mapping values are not allowed in this context"},
+	}
+	for _, tt := range invalid {
+		_, err := ParseProjectConfig([]byte(tt.content))
+		var configErr *ConfigError
+		require.ErrorAs(t, err, &configErr, tt.content)
+		require.Equal(t, tt.line, configErr.Line, tt.content)
+		require.Equal(t, tt.column, configErr.Column, tt.content)
+		require.Equal(t, tt.message, configErr.Message, tt.content)
+	}
+
+	// Unknown fields are reported as warnings, and the rest of the config still applies
+	conf, err = ParseProjectConfig([]byte("title: x\nunknown: 1\nmodels:\n  materialized: true\nenv:\n  foo: bar\n"))
+	require.NoError(t, err)
+	require.Equal(t, "x", conf.Title)
+	require.Equal(t, map[string]string{"foo": "bar"}, conf.Variables)
+	require.Len(t, conf.Warnings, 2)
+	require.Equal(t, &ConfigError{Line: 2, Column: 1, Message: `unknown field "unknown"`}, conf.Warnings[0])
+	require.Equal(t, &ConfigError{Line: 4, Column: 3, Message: `unknown field "models.materialized"`}, conf.Warnings[1])
+
+	// The variables of an invalid config can still be applied
+	conf, err = ParseProjectConfig([]byte("olap_connector: postgres\nenv:\n  foo: bar\n"))
+	require.Error(t, err)
+	require.Equal(t, map[string]string{"foo": "bar"}, conf.Variables)
+}
+
+func TestJSONSchema(t *testing.T) {
+	schema, err := JSONSchema()
+	require.NoError(t, err)
+
+	if *updateSchema {
+		require.NoError(t, os.WriteFile("rill.schema.json", schema, 0o644))
+	}
+
+	published, err := os.ReadFile("rill.schema.json")
+	require.NoError(t, err)
+	require.Equal(t, string(published), string(schema), "rill.schema.json is outdated, run: go test ./runtime/compilers/rillv1beta -run TestJSONSchema -update-schema")
+}
+
+func TestApplyDefaults(t *testing.T) {
+	conf, err := ParseProjectConfig([]byte(`models:
+  materialize: true
+dev:
+  models:
+    limit: 1000
+sources:
+  timeout: 60
+  glob.max_total_size: 1024
+dashboards:
+  default_time_zone: America/New_York
+  smallest_time_grain: hour
+`))
+	require.NoError(t, err)
+
+	// the profile's settings in the model override rill.yaml
+	model := &runtimev1.Model{
+		RenderedSql: "-- @dev.sample: 0.1\nselect * from AdBids",
+		Profiles:    map[string]*runtimev1.ModelProfile{"dev": {SampleFraction: 0.1}},
+	}
+	conf.ApplyDefaults(&drivers.CatalogEntry{Type: drivers.ObjectTypeModel, Object: model}, drivers.ProfileDev)
+	require.True(t, model.Materialize)
+	require.Equal(t, int64(1000), model.RowLimit)
+	require.Equal(t, 0.1, model.SampleFraction)
+
+	// the model's @materialize tag overrides the top level default
+	model = &runtimev1.Model{RenderedSql: "-- @materialize: false\nselect * from AdBids"}
+	conf.ApplyDefaults(&drivers.CatalogEntry{Type: drivers.ObjectTypeModel, Object: model}, drivers.ProfileProd)
+	require.False(t, model.Materialize)
+	require.Equal(t, int64(0), model.RowLimit)
+
+	props, err := structpb.NewStruct(map[string]any{"path": "s3://bucket/*.csv", "glob.max_total_size": 10})
+	require.NoError(t, err)
+	source := &runtimev1.Source{Connector: "s3", Properties: props}
+	conf.ApplyDefaults(&drivers.CatalogEntry{Type: drivers.ObjectTypeSource, Object: source}, drivers.ProfileDev)
+	require.Equal(t, int32(60), source.TimeoutSeconds)
+	require.Equal(t, float64(10), source.Properties.AsMap()["glob.max_total_size"])
+
+	mv := &runtimev1.MetricsView{DefaultTimeZone: "UTC"}
+	conf.ApplyDefaults(&drivers.CatalogEntry{Type: drivers.ObjectTypeMetricsView, Object: mv}, drivers.ProfileDev)
+	require.Equal(t, "UTC", mv.DefaultTimeZone)
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_HOUR, mv.SmallestTimeGrain)
+}
@@ -57,6 +57,18 @@ func (r *Runtime) OLAP(ctx context.Context, instanceID string) (drivers.OLAPStor
 	return olap, nil
 }
 
+// QueryOLAP returns the instance's OLAP store for user-facing queries.
+// Unlike OLAP, its statements are tracked and subject to the configured timeouts and concurrency limits.
+// Internal work like reconciling the catalog and ingesting sources should use OLAP instead.
+func (r *Runtime) QueryOLAP(ctx context.Context, instanceID string) (drivers.OLAPStore, error) {
+	olap, err := r.OLAP(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trackedOLAPStore{OLAPStore: olap, queries: r.runningQueries, instanceID: instanceID}, nil
+}
+
 func (r *Runtime) Catalog(ctx context.Context, instanceID string) (drivers.CatalogStore, error) {
 	inst, err := r.FindInstance(ctx, instanceID)
 	if err != nil {
@@ -35,6 +35,12 @@ type Connector interface {
 	HasAnonymousAccess(ctx context.Context, env *Env, source *Source) (bool, error)
 }
 
+// SizeEstimator is implemented by connectors that can estimate the number of bytes a source
+// will ingest without ingesting it. It's used to preview the impact of a reconcile.
+type SizeEstimator interface {
+	EstimateSize(ctx context.Context, env *Env, source *Source) (int64, error)
+}
+
 // Spec provides metadata about a connector and the properties it supports.
 type Spec struct {
 	DisplayName        string
@@ -3,9 +3,14 @@ package localfile
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/bmatcuk/doublestar/v4"
 	"github.com/mitchellh/mapstructure"
 	"github.com/rilldata/rill/runtime/connectors"
+	"github.com/rilldata/rill/runtime/pkg/fileutil"
 )
 
 func init() {
@@ -75,3 +80,40 @@ func (c connector) ConsumeAsIterator(ctx context.Context, env *connectors.Env, s
 func (c connector) HasAnonymousAccess(ctx context.Context, env *connectors.Env, source *connectors.Source) (bool, error) {
 	return true, nil
 }
+
+// EstimateSize implements connectors.SizeEstimator. It returns the total size of the files matched by the source's path.
+func (c connector) EstimateSize(ctx context.Context, env *connectors.Env, source *connectors.Source) (int64, error) {
+	conf, err := ParseConfig(source.Properties)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	path, err := fileutil.ExpandHome(conf.Path)
+	if err != nil {
+		return 0, err
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(env.RepoRoot, path)
+	}
+	if !env.AllowHostAccess && !strings.HasPrefix(path, env.RepoRoot) {
+		return 0, fmt.Errorf("file connector cannot ingest source '%s': path is outside repo root", source.Name)
+	}
+
+	matches, err := doublestar.FilepathGlob(path)
+	if err != nil {
+		return 0, err
+	}
+	if len(matches) == 0 {
+		return 0, fmt.Errorf("file does not exist at %s", conf.Path)
+	}
+
+	var size int64
+	for _, match := range matches {
+		info, err := os.Stat(match)
+		if err != nil {
+			return 0, err
+		}
+		size += info.Size()
+	}
+	return size, nil
+}
@@ -28,6 +28,20 @@ type CatalogStore interface {
 	UpdateEntry(ctx context.Context, instanceID string, entry *CatalogEntry) error
 	DeleteEntry(ctx context.Context, instanceID string, name string) error
 	DeleteEntries(ctx context.Context, instanceID string) error
+	// FindMigrationMeta returns the migration meta saved by SetMigrationMeta. It returns ErrNotFound if none has been saved.
+	FindMigrationMeta(ctx context.Context, instanceID string) (*MigrationMeta, error)
+	SetMigrationMeta(ctx context.Context, instanceID string, meta *MigrationMeta) error
+}
+
+// MigrationMeta is the state of the catalog after the last reconcile.
+// It's persisted so that a restarted runtime only needs to reconcile the files that changed while it was stopped.
+type MigrationMeta struct {
+	LastMigration time.Time `json:"last_migration"`
+	// Dependencies maps the normalized name of each object to the normalized names of the objects it depends on
+	Dependencies map[string][]string `json:"dependencies"`
+	NameToPath   map[string]string   `json:"name_to_path"`
+	// FileHashes maps paths to a hash of the file contents when they were last reconciled
+	FileHashes map[string]string `json:"file_hashes"`
 }
 
 // CatalogEntry represents one object in the catalog, such as a source.
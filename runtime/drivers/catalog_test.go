@@ -88,4 +88,32 @@ func testCatalog(t *testing.T, catalog drivers.CatalogStore) {
 	obj, found = catalog.FindEntry(ctx, instanceID, "bar")
 	require.False(t, found)
 	require.Nil(t, obj)
+
+	_, err = catalog.FindMigrationMeta(ctx, instanceID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
+
+	meta := &drivers.MigrationMeta{
+		LastMigration: time.Now().UTC().Truncate(time.Millisecond),
+		Dependencies:  map[string][]string{"bar": {}, "baz": {"bar"}},
+		NameToPath:    map[string]string{"bar": "sources/bar.yaml", "baz": "models/baz.sql"},
+		FileHashes:    map[string]string{"sources/bar.yaml": "abc", "models/baz.sql": "def"},
+	}
+	err = catalog.SetMigrationMeta(ctx, instanceID, meta)
+	require.NoError(t, err)
+
+	meta.FileHashes["models/baz.sql"] = "ghi"
+	err = catalog.SetMigrationMeta(ctx, instanceID, meta)
+	require.NoError(t, err)
+
+	found2, err := catalog.FindMigrationMeta(ctx, instanceID)
+	require.NoError(t, err)
+	require.True(t, meta.LastMigration.Equal(found2.LastMigration))
+	require.Equal(t, meta.Dependencies, found2.Dependencies)
+	require.Equal(t, meta.NameToPath, found2.NameToPath)
+	require.Equal(t, meta.FileHashes, found2.FileHashes)
+
+	err = catalog.DeleteEntries(ctx, instanceID)
+	require.NoError(t, err)
+	_, err = catalog.FindMigrationMeta(ctx, instanceID)
+	require.ErrorIs(t, err, drivers.ErrNotFound)
 }
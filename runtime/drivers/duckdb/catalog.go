@@ -2,6 +2,9 @@ package duckdb
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -169,5 +172,66 @@ func (c *connection) DeleteEntries(ctx context.Context, instanceID string) error
 	defer func() { _ = release() }()
 
 	_, err = conn.ExecContext(ctx, "DELETE FROM rill.catalog")
+	if err != nil {
+		return err
+	}
+
+	_, err = conn.ExecContext(ctx, "DELETE FROM rill.migration_meta")
 	return err
 }
+
+func (c *connection) FindMigrationMeta(ctx context.Context, instanceID string) (*drivers.MigrationMeta, error) {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = release() }()
+
+	var data string
+	err = conn.QueryRowxContext(ctx, "SELECT data FROM rill.migration_meta").Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	meta := &drivers.MigrationMeta{}
+	err = json.Unmarshal([]byte(data), meta)
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+// SetMigrationMeta replaces the migration meta. The catalog is scoped to a single instance, so the table has a single row.
+func (c *connection) SetMigrationMeta(ctx context.Context, instanceID string, meta *drivers.MigrationMeta) error {
+	conn, release, err := c.acquireMetaConn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = release() }()
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, "DELETE FROM rill.migration_meta")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "INSERT INTO rill.migration_meta(data, updated_on) VALUES (?, ?)", string(data), time.Now())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,4 @@
+CREATE TABLE rill.migration_meta (
+	data TEXT NOT NULL,
+	updated_on TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,111 @@
+package file
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+var _ drivers.RepoWatcher = &connection{}
+
+// Watch implements drivers.RepoWatcher.
+// Since fsnotify is not recursive, it watches every directory in the repo (except hidden directories like .git),
+// and starts watching new directories as they are created.
+func (c *connection) Watch(ctx context.Context, instID string, callback drivers.WatchCallback) error {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	err = c.watchDir(w, c.root, nil)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err, ok := <-w.Errors:
+			if !ok {
+				return nil
+			}
+			return err
+		case e, ok := <-w.Events:
+			if !ok {
+				return nil
+			}
+
+			// Permission changes don't affect contents
+			if e.Op == fsnotify.Chmod {
+				continue
+			}
+
+			if e.Op.Has(fsnotify.Create) {
+				info, err := os.Stat(e.Name)
+				if err == nil && info.IsDir() {
+					// Files may have been added before the directory was watched, so report them as well
+					_ = c.watchDir(w, e.Name, callback)
+					continue
+				}
+			}
+
+			if isHidden(c.root, e.Name) {
+				continue
+			}
+
+			callback(c.relPath(e.Name))
+		}
+	}
+}
+
+// watchDir adds dir and its non-hidden subdirectories to w. If callback is not nil, it's called for the files found in them.
+func (c *connection) watchDir(w *fsnotify.Watcher, dir string, callback drivers.WatchCallback) error {
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if isHidden(c.root, p) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() {
+			return w.Add(p)
+		}
+		if callback != nil {
+			callback(c.relPath(p))
+		}
+		return nil
+	})
+}
+
+// relPath converts an absolute path in the repo to the format returned by ListRecursive
+func (c *connection) relPath(p string) string {
+	rel, err := filepath.Rel(c.root, p)
+	if err != nil {
+		return p
+	}
+	return filepath.Join("/", rel)
+}
+
+// isHidden returns true if any element of p relative to root starts with a dot
+func isHidden(root, p string) bool {
+	rel, err := filepath.Rel(root, p)
+	if err != nil || rel == "." {
+		return false
+	}
+	for _, elem := range strings.Split(rel, string(filepath.Separator)) {
+		if strings.HasPrefix(elem, ".") {
+			return true
+		}
+	}
+	return false
+}
@@ -30,6 +30,8 @@ type DSN struct {
 	GithubURL      string `json:"github_url"`
 	Branch         string `json:"branch"`
 	InstallationID int64  `json:"installation_id"`
+	// Commit pins the repo to a commit instead of the head of Branch. It must be a full SHA.
+	Commit string `json:"commit,omitempty"`
 }
 
 func init() {
@@ -136,20 +138,40 @@ func (c *connection) clone(ctx context.Context) error {
 		return err
 	}
 
-	_, err = git.PlainClone(c.tempdir, false, &git.CloneOptions{
-		URL:           cloneURL,
-		ReferenceName: plumbing.NewBranchReferenceName(c.dsn.Branch),
-		SingleBranch:  true,
-	})
+	opts := &git.CloneOptions{URL: cloneURL}
+	if c.dsn.Commit == "" {
+		opts.ReferenceName = plumbing.NewBranchReferenceName(c.dsn.Branch)
+		opts.SingleBranch = true
+	}
+
+	// A pinned commit may not be on Branch (e.g. when it was promoted from another environment), so all branches are cloned
+	repo, err := git.PlainClone(c.tempdir, false, opts)
 	if err != nil {
 		return err
 	}
 
+	if c.dsn.Commit != "" {
+		wt, err := repo.Worktree()
+		if err != nil {
+			return err
+		}
+
+		err = wt.Checkout(&git.CheckoutOptions{Hash: plumbing.NewHash(c.dsn.Commit), Force: true})
+		if err != nil {
+			return fmt.Errorf("failed to checkout commit %q: %w", c.dsn.Commit, err)
+		}
+	}
+
 	return nil
 }
 
 // pull pulls changes from the repo. It must have been successfully cloned already.
 func (c *connection) pull(ctx context.Context) error {
+	// A pinned commit never changes. Pinning another commit changes the DSN, which opens a new connection.
+	if c.dsn.Commit != "" {
+		return nil
+	}
+
 	cloneURL, err := c.cloneURL(ctx)
 	if err != nil {
 		return err
@@ -11,11 +11,14 @@ import (
 
 	doublestar "github.com/bmatcuk/doublestar/v4"
 	"github.com/eapache/go-resiliency/retrier"
+	"github.com/go-git/go-git/v5"
 	"github.com/rilldata/rill/runtime/drivers"
 )
 
 var limit = 500
 
+var _ drivers.RepoVersioner = &connection{}
+
 // Driver implements drivers.RepoStore.
 func (c *connection) Driver() string {
 	return "github"
@@ -106,3 +109,18 @@ func (c *connection) Sync(ctx context.Context, instID string) error {
 	}
 	return nil
 }
+
+// CommitHash implements drivers.RepoVersioner.
+func (c *connection) CommitHash(ctx context.Context, instID string) (string, error) {
+	repo, err := git.PlainOpen(c.tempdir)
+	if err != nil {
+		return "", err
+	}
+
+	ref, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+
+	return ref.Hash().String(), nil
+}
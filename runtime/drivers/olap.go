@@ -3,6 +3,7 @@ package drivers
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -34,6 +35,8 @@ type Statement struct {
 	Args     []any
 	DryRun   bool
 	Priority int
+	// Timeout bounds the statement's execution time. If zero, the runtime's default statement timeout applies.
+	Timeout time.Duration
 }
 
 // Result wraps the results of query.
@@ -51,6 +54,22 @@ func (r *Result) SetCleanupFunc(fn func() error) {
 	r.cleanupFn = fn
 }
 
+// AddCleanupFunc adds a function to be called after the Result's existing cleanup function (if any) when it's closed.
+func (r *Result) AddCleanupFunc(fn func() error) {
+	prev := r.cleanupFn
+	if prev == nil {
+		r.cleanupFn = fn
+		return
+	}
+	r.cleanupFn = func() error {
+		err := prev()
+		if err2 := fn(); err == nil {
+			err = err2
+		}
+		return err
+	}
+}
+
 // Close wraps rows.Close and calls the Result's cleanup function (if it is set).
 // Close should be idempotent.
 func (r *Result) Close() error {
@@ -42,8 +42,21 @@ type Instance struct {
 	// IngestionLimitBytes is total data allowed to ingest across all sources
 	// 0 means there is no limit
 	IngestionLimitBytes int64 `db:"ingestion_limit_bytes"`
+	// ReconcileConcurrency is the max number of independent migrations run concurrently during reconcile
+	// 0 means the default is used
+	ReconcileConcurrency int `db:"reconcile_concurrency"`
+	// Profile selects the dev or prod settings of models (see ProfileDev and ProfileProd)
+	// Empty means no profile settings apply
+	Profile string `db:"profile"`
 }
 
+const (
+	// ProfileDev is used for local development, where models can be limited for fast iteration
+	ProfileDev = "dev"
+	// ProfileProd is used for deployments
+	ProfileProd = "prod"
+)
+
 // ResolveVariables returns the final resolved variables
 func (i *Instance) ResolveVariables() map[string]string {
 	r := make(map[string]string, len(i.ProjectVariables))
@@ -13,12 +13,14 @@ import (
 func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	ctx := context.Background()
 	inst := &drivers.Instance{
-		OLAPDriver:          "duckdb",
-		OLAPDSN:             ":memory:",
-		RepoDriver:          "file",
-		RepoDSN:             ".",
-		EmbedCatalog:        true,
-		IngestionLimitBytes: 102345,
+		OLAPDriver:           "duckdb",
+		OLAPDSN:              ":memory:",
+		RepoDriver:           "file",
+		RepoDSN:              ".",
+		EmbedCatalog:         true,
+		IngestionLimitBytes:  102345,
+		ReconcileConcurrency: 8,
+		Profile:              drivers.ProfileDev,
 	}
 
 	err := reg.CreateInstance(ctx, inst)
@@ -41,6 +43,8 @@ func testRegistry(t *testing.T, reg drivers.RegistryStore) {
 	require.Equal(t, inst.RepoDSN, res.RepoDSN)
 	require.Equal(t, inst.EmbedCatalog, res.EmbedCatalog)
 	require.Equal(t, inst.IngestionLimitBytes, res.IngestionLimitBytes)
+	require.Equal(t, inst.ReconcileConcurrency, res.ReconcileConcurrency)
+	require.Equal(t, inst.Profile, res.Profile)
 
 	err = reg.CreateInstance(ctx, &drivers.Instance{OLAPDriver: "druid"})
 	require.NoError(t, err)
@@ -27,3 +27,20 @@ type RepoObjectStat struct {
 }
 
 var ErrFileAlreadyExists = errors.New("file already exists")
+
+// RepoWatcher is implemented by repo stores that can detect changes made to their files by other processes.
+type RepoWatcher interface {
+	// Watch calls callback for every file that is created, changed or removed until ctx is cancelled.
+	// The path passed to callback is relative to the repo root (like the paths returned from ListRecursive).
+	Watch(ctx context.Context, instID string, callback WatchCallback) error
+}
+
+// WatchCallback is called by RepoWatcher.Watch with the path of a changed file.
+// It's called from a single goroutine, so it should not block.
+type WatchCallback func(path string)
+
+// RepoVersioner is implemented by repo stores that are checked out from a version control system.
+type RepoVersioner interface {
+	// CommitHash returns the commit that the repo's files are currently checked out at.
+	CommitHash(ctx context.Context, instID string) (string, error)
+}
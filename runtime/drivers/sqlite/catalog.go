@@ -2,6 +2,9 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
@@ -153,5 +156,50 @@ func (c *connection) DeleteEntries(_ context.Context, instanceID string) error {
 	ctx := context.Background()
 
 	_, err := c.db.ExecContext(ctx, "DELETE FROM catalog WHERE instance_id = ?", instanceID)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(ctx, "DELETE FROM migration_meta WHERE instance_id = ?", instanceID)
+	return err
+}
+
+func (c *connection) FindMigrationMeta(_ context.Context, instanceID string) (*drivers.MigrationMeta, error) {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	var data []byte
+	err := c.db.QueryRowxContext(ctx, "SELECT data FROM migration_meta WHERE instance_id = ?", instanceID).Scan(&data)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, drivers.ErrNotFound
+		}
+		return nil, err
+	}
+
+	meta := &drivers.MigrationMeta{}
+	err = json.Unmarshal(data, meta)
+	if err != nil {
+		return nil, err
+	}
+	return meta, nil
+}
+
+func (c *connection) SetMigrationMeta(_ context.Context, instanceID string, meta *drivers.MigrationMeta) error {
+	// Override ctx because sqlite sometimes segfaults on context cancellation
+	ctx := context.Background()
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.db.ExecContext(
+		ctx,
+		"INSERT INTO migration_meta(instance_id, data, updated_on) VALUES (?, ?, ?) ON CONFLICT(instance_id) DO UPDATE SET data = excluded.data, updated_on = excluded.updated_on",
+		instanceID,
+		data,
+		time.Now(),
+	)
 	return err
 }
@@ -0,0 +1,6 @@
+CREATE TABLE migration_meta (
+	instance_id TEXT NOT NULL,
+	data BLOB NOT NULL,
+	updated_on TIMESTAMP NOT NULL,
+	PRIMARY KEY (instance_id)
+);
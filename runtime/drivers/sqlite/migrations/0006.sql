@@ -0,0 +1 @@
+ALTER TABLE instances ADD COLUMN reconcile_concurrency integer default 0 NOT NULL;
@@ -0,0 +1 @@
+ALTER TABLE instances ADD COLUMN profile TEXT default '' NOT NULL;
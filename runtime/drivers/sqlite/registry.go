@@ -31,7 +31,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 	// Override ctx because sqlite sometimes segfaults on context cancellation
 	ctx := context.Background()
 
-	sql := fmt.Sprintf("SELECT id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes FROM instances %s ORDER BY id", whereClause)
+	sql := fmt.Sprintf("SELECT id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes, reconcile_concurrency, profile FROM instances %s ORDER BY id", whereClause)
 
 	rows, err := c.db.QueryxContext(ctx, sql, args...)
 	if err != nil {
@@ -44,7 +44,7 @@ func (c *connection) findInstances(_ context.Context, whereClause string, args .
 		// sqlite doesn't support maps need to read as bytes and convert to map
 		var variables, projectVariables []byte
 		i := &drivers.Instance{}
-		err := rows.Scan(&i.ID, &i.OLAPDriver, &i.OLAPDSN, &i.RepoDriver, &i.RepoDSN, &i.EmbedCatalog, &i.CreatedOn, &i.UpdatedOn, &variables, &projectVariables, &i.IngestionLimitBytes)
+		err := rows.Scan(&i.ID, &i.OLAPDriver, &i.OLAPDSN, &i.RepoDriver, &i.RepoDSN, &i.EmbedCatalog, &i.CreatedOn, &i.UpdatedOn, &variables, &projectVariables, &i.IngestionLimitBytes, &i.ReconcileConcurrency, &i.Profile)
 		if err != nil {
 			return nil, err
 		}
@@ -87,8 +87,8 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 	now := time.Now()
 	_, err = c.db.ExecContext(
 		ctx,
-		"INSERT INTO instances(id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes) "+
-			"VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8, $9, $10)",
+		"INSERT INTO instances(id, olap_driver, olap_dsn, repo_driver, repo_dsn, embed_catalog, created_on, updated_on, variables, project_variables, ingestion_limit_bytes, reconcile_concurrency, profile) "+
+			"VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8, $9, $10, $11, $12)",
 		inst.ID,
 		inst.OLAPDriver,
 		inst.OLAPDSN,
@@ -99,6 +99,8 @@ func (c *connection) CreateInstance(_ context.Context, inst *drivers.Instance) e
 		variables,
 		projectVariables,
 		inst.IngestionLimitBytes,
+		inst.ReconcileConcurrency,
+		inst.Profile,
 	)
 	if err != nil {
 		return err
@@ -124,7 +126,7 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 	now := time.Now()
 	_, err = c.db.ExecContext(
 		ctx,
-		"UPDATE instances SET olap_driver = $2, olap_dsn = $3, repo_driver = $4, repo_dsn = $5, embed_catalog = $6, variables = $7, updated_on = $8, ingestion_limit_bytes = $9 "+
+		"UPDATE instances SET olap_driver = $2, olap_dsn = $3, repo_driver = $4, repo_dsn = $5, embed_catalog = $6, variables = $7, updated_on = $8, ingestion_limit_bytes = $9, reconcile_concurrency = $10, profile = $11 "+
 			"WHERE id = $1",
 		inst.ID,
 		inst.OLAPDriver,
@@ -135,6 +137,8 @@ func (c *connection) EditInstance(_ context.Context, inst *drivers.Instance) err
 		variables,
 		now,
 		inst.IngestionLimitBytes,
+		inst.ReconcileConcurrency,
+		inst.Profile,
 	)
 	if err != nil {
 		return err
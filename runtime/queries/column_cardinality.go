@@ -25,8 +25,11 @@ func (q *ColumnCardinality) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *ColumnCardinality) MarshalResult() any {
-	return q.Result
+func (q *ColumnCardinality) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: 8,
+	}
 }
 
 func (q *ColumnCardinality) UnmarshalResult(v any) error {
@@ -39,7 +42,7 @@ func (q *ColumnCardinality) UnmarshalResult(v any) error {
 }
 
 func (q *ColumnCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -24,8 +24,11 @@ func (q *ColumnNullCount) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *ColumnNullCount) MarshalResult() any {
-	return q.Result
+func (q *ColumnNullCount) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: 8,
+	}
 }
 
 func (q *ColumnNullCount) UnmarshalResult(v any) error {
@@ -38,7 +41,7 @@ func (q *ColumnNullCount) UnmarshalResult(v any) error {
 }
 
 func (q *ColumnNullCount) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
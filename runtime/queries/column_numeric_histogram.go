@@ -29,8 +29,11 @@ func (q *ColumnNumericHistogram) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *ColumnNumericHistogram) MarshalResult() any {
-	return q.Result
+func (q *ColumnNumericHistogram) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessages(q.Result),
+	}
 }
 
 func (q *ColumnNumericHistogram) UnmarshalResult(v any) error {
@@ -113,7 +116,7 @@ func (q *ColumnNumericHistogram) calculateBucketSize(ctx context.Context, olap d
 }
 
 func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -221,7 +224,7 @@ func (q *ColumnNumericHistogram) calculateFDMethod(ctx context.Context, rt *runt
 }
 
 func (q *ColumnNumericHistogram) calculateDiagnosticMethod(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
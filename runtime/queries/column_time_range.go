@@ -16,21 +16,26 @@ import (
 type ColumnTimeRange struct {
 	TableName  string
 	ColumnName string
-	Result     *runtimev1.TimeRangeSummary
+	// Filter is an optional SQL expression that rows must match, such as a metrics view policy's row filter
+	Filter string
+	Result *runtimev1.TimeRangeSummary
 }
 
 var _ runtime.Query = &ColumnTimeRange{}
 
 func (q *ColumnTimeRange) Key() string {
-	return fmt.Sprintf("ColumnTimeRange:%s:%s", q.TableName, q.ColumnName)
+	return fmt.Sprintf("ColumnTimeRange:%s:%s:%s", q.TableName, q.ColumnName, q.Filter)
 }
 
 func (q *ColumnTimeRange) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *ColumnTimeRange) MarshalResult() any {
-	return q.Result
+func (q *ColumnTimeRange) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
 }
 
 func (q *ColumnTimeRange) UnmarshalResult(v any) error {
@@ -43,7 +48,7 @@ func (q *ColumnTimeRange) UnmarshalResult(v any) error {
 }
 
 func (q *ColumnTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -58,11 +63,19 @@ func (q *ColumnTimeRange) Resolve(ctx context.Context, rt *runtime.Runtime, inst
 	}
 }
 
+func (q *ColumnTimeRange) whereClause() string {
+	if q.Filter == "" {
+		return ""
+	}
+	return fmt.Sprintf(" WHERE %s", q.Filter)
+}
+
 func (q *ColumnTimeRange) resolveDuckDB(ctx context.Context, olap drivers.OLAPStore, priority int) error {
 	rangeSQL := fmt.Sprintf(
-		"SELECT min(%[1]s) as \"min\", max(%[1]s) as \"max\", max(%[1]s) - min(%[1]s) as \"interval\" FROM %[2]s",
+		"SELECT min(%[1]s) as \"min\", max(%[1]s) as \"max\", max(%[1]s) - min(%[1]s) as \"interval\" FROM %[2]s%[3]s",
 		safeName(q.ColumnName),
 		safeName(q.TableName),
+		q.whereClause(),
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -124,9 +137,10 @@ func handleDuckDBInterval(interval any) (*runtimev1.TimeRangeSummary_Interval, e
 
 func (q *ColumnTimeRange) resolveDruid(ctx context.Context, olap drivers.OLAPStore, priority int) error {
 	rangeSQL := fmt.Sprintf(
-		"SELECT min(%[1]s) as \"min\", max(%[1]s) as \"max\", timestampdiff(SECOND, min(%[1]s), max(%[1]s)) as \"interval\" FROM %[2]s",
+		"SELECT min(%[1]s) as \"min\", max(%[1]s) as \"max\", timestampdiff(SECOND, min(%[1]s), max(%[1]s)) as \"interval\" FROM %[2]s%[3]s",
 		safeName(q.ColumnName),
 		safeName(q.TableName),
+		q.whereClause(),
 	)
 
 	rows, err := olap.Execute(ctx, &drivers.Statement{
@@ -26,6 +26,13 @@ type ColumnTimeseriesResult struct {
 	SampleSize int32
 }
 
+func (r *ColumnTimeseriesResult) size() int64 {
+	if r == nil {
+		return 0
+	}
+	return sizeProtoMessages(r.Meta) + sizeProtoMessages(r.Results) + sizeProtoMessages(r.Spark) + sizeProtoMessage(r.TimeRange) + 4
+}
+
 type ColumnTimeseries struct {
 	TableName           string                                            `json:"table_name"`
 	Measures            []*runtimev1.ColumnTimeSeriesRequest_BasicMeasure `json:"measures"`
@@ -34,6 +41,8 @@ type ColumnTimeseries struct {
 	Filters             *runtimev1.MetricsViewFilter                      `json:"filters"`
 	Pixels              int32                                             `json:"pixels"`
 	SampleSize          int32                                             `json:"sample_size"`
+	TimeZone            string                                            `json:"time_zone,omitempty"`
+	RowFilter           string                                            `json:"row_filter,omitempty"`
 	Result              *ColumnTimeseriesResult                           `json:"-"`
 }
 
@@ -51,8 +60,11 @@ func (q *ColumnTimeseries) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *ColumnTimeseries) MarshalResult() any {
-	return q.Result
+func (q *ColumnTimeseries) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: q.Result.size(),
+	}
 }
 
 func (q *ColumnTimeseries) UnmarshalResult(v any) error {
@@ -65,7 +77,7 @@ func (q *ColumnTimeseries) UnmarshalResult(v any) error {
 }
 
 func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -74,6 +86,11 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 
+	loc, err := loadTimeZone(q.TimeZone)
+	if err != nil {
+		return err
+	}
+
 	return olap.WithConnection(ctx, priority, func(ctx context.Context, ensuredCtx context.Context) error {
 		timeRange, err := q.resolveNormaliseTimeRange(ctx, rt, instanceID, priority)
 		if err != nil {
@@ -89,12 +106,28 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		if err != nil {
 			return err
 		}
+		if q.RowFilter != "" {
+			filter += " " + q.RowFilter
+		}
 		if filter != "" {
 			filter = "WHERE 1=1 " + filter
 		}
 
 		measures := normaliseMeasures(q.Measures, q.Pixels != 0)
 		dateTruncSpecifier := convertToDateTruncSpecifier(timeRange.Interval)
+
+		// For day and larger grains in a non-UTC time zone, we bucket on the wall clock time in the time zone.
+		// The buckets are converted back to UTC after the query.
+		tsExpr := safeName(q.TimestampColumnName)
+		start := timeRange.Start.AsTime()
+		end := timeRange.End.AsTime()
+		zoned := loc != time.UTC && isZonedTimeGrain(timeRange.Interval)
+		if zoned {
+			tsExpr = localTimestampExpr(tsExpr, loc, start, end)
+			start = toWallClock(start, loc)
+			end = toWallClock(end, loc)
+		}
+
 		tsAlias := tempName("_ts_")
 		temporaryTableName := tempName("_timeseries_")
 		querySQL := `CREATE TEMPORARY TABLE ` + temporaryTableName + ` AS (
@@ -104,14 +137,14 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 				range as ` + tsAlias + `
 			FROM
 				range(
-				date_trunc('` + dateTruncSpecifier + `', TIMESTAMP '` + timeRange.Start.AsTime().Format(IsoFormat) + `'),
-				date_trunc('` + dateTruncSpecifier + `', TIMESTAMP '` + timeRange.End.AsTime().Format(IsoFormat) + `'),
+				date_trunc('` + dateTruncSpecifier + `', TIMESTAMP '` + start.Format(IsoFormat) + `'),
+				date_trunc('` + dateTruncSpecifier + `', TIMESTAMP '` + end.Format(IsoFormat) + `'),
 				INTERVAL '1 ` + dateTruncSpecifier + `')
 			),
 			-- transform the original data, and optionally sample it.
 			series AS (
 			SELECT
-				date_trunc('` + dateTruncSpecifier + `', ` + safeName(q.TimestampColumnName) + `) as ` + tsAlias + `,` + getExpressionColumnsFromMeasures(measures) + `
+				date_trunc('` + dateTruncSpecifier + `', ` + tsExpr + `) as ` + tsAlias + `,` + getExpressionColumnsFromMeasures(measures) + `
 			FROM ` + safeName(q.TableName) + ` ` + filter + `
 			GROUP BY ` + tsAlias + ` ORDER BY ` + tsAlias + `
 			)
@@ -166,6 +199,9 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 				rows.Close()
 				panic(fmt.Sprintf("unexpected type for timestamp column: %T", v))
 			}
+			if zoned {
+				t = fromWallClock(t, loc)
+			}
 
 			delete(rowMap, tsAlias)
 			records, err := pbutil.ToStruct(rowMap)
@@ -188,6 +224,11 @@ func (q *ColumnTimeseries) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 			if err != nil {
 				return err
 			}
+			if zoned {
+				for _, v := range sparkValues {
+					v.Ts = timestamppb.New(fromWallClock(v.Ts.AsTime(), loc))
+				}
+			}
 		}
 
 		q.Result = &ColumnTimeseriesResult{
@@ -166,6 +166,41 @@ func TestTimeseries_SparkOnly(t *testing.T) {
 	require.Equal(t, 1.0, values[11].Records.Fields["count"].GetNumberValue())
 }
 
+func TestTimeseries_TimeZone_DST(t *testing.T) {
+	// America/New_York switches from EST (UTC-5) to EDT (UTC-4) on 2023-03-12
+	rt, instanceID := testruntime.NewInstanceWithModel(t, "test", `
+		SELECT TIMESTAMP '2023-03-12 04:30:00' AS time
+		UNION ALL
+		SELECT TIMESTAMP '2023-03-12 05:30:00' AS time
+		UNION ALL
+		SELECT TIMESTAMP '2023-03-13 03:30:00' AS time
+		UNION ALL
+		SELECT TIMESTAMP '2023-03-13 04:30:00' AS time
+	`)
+
+	q := &ColumnTimeseries{
+		TableName:           "test",
+		TimestampColumnName: "time",
+		TimeZone:            "America/New_York",
+		TimeRange: &runtimev1.TimeSeriesTimeRange{
+			Start:    timestamppb.New(time.Date(2023, 3, 11, 5, 0, 0, 0, time.UTC)),
+			End:      timestamppb.New(time.Date(2023, 3, 14, 4, 0, 0, 0, time.UTC)),
+			Interval: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		},
+	}
+	err := q.Resolve(context.Background(), rt, instanceID, 0)
+	require.NoError(t, err)
+
+	results := q.Result.Results
+	require.Len(t, results, 3)
+	require.Equal(t, time.Date(2023, 3, 11, 5, 0, 0, 0, time.UTC), results[0].Ts.AsTime())
+	require.Equal(t, 1.0, results[0].Records.Fields["count"].GetNumberValue())
+	require.Equal(t, time.Date(2023, 3, 12, 5, 0, 0, 0, time.UTC), results[1].Ts.AsTime())
+	require.Equal(t, 2.0, results[1].Records.Fields["count"].GetNumberValue())
+	require.Equal(t, time.Date(2023, 3, 13, 4, 0, 0, 0, time.UTC), results[2].Ts.AsTime())
+	require.Equal(t, 1.0, results[2].Records.Fields["count"].GetNumberValue())
+}
+
 func TestTimeseries_Key(t *testing.T) {
 	q := &ColumnTimeseries{
 		TableName:           "test",
@@ -28,8 +28,11 @@ func (q *ColumnTopK) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *ColumnTopK) MarshalResult() any {
-	return q.Result
+func (q *ColumnTopK) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
 }
 
 func (q *ColumnTopK) UnmarshalResult(v any) error {
@@ -43,7 +46,7 @@ func (q *ColumnTopK) UnmarshalResult(v any) error {
 
 func (q *ColumnTopK) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
 	// Get OLAP connection
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
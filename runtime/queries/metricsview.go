@@ -27,6 +27,37 @@ func lookupMetricsView(ctx context.Context, rt *runtime.Runtime, instanceID, nam
 	return obj.GetMetricsView(), nil
 }
 
+// resolveTimeDimension returns the name of the time dimension to use for a query against the metrics view.
+// If name is empty, it returns the primary time dimension (which may also be empty).
+func resolveTimeDimension(mv *runtimev1.MetricsView, name string) (string, error) {
+	if name == "" || name == mv.TimeDimension {
+		return mv.TimeDimension, nil
+	}
+
+	for _, td := range mv.TimeDimensions {
+		if td == name {
+			return td, nil
+		}
+	}
+
+	return "", status.Errorf(codes.InvalidArgument, "time dimension '%s' not found in metrics view '%s'", name, mv.Name)
+}
+
+// resolveTimeZone returns the time zone to use for a query against the metrics view.
+// If name is empty, it returns the metrics view's default time zone.
+func resolveTimeZone(mv *runtimev1.MetricsView, name string) (string, error) {
+	if name == "" {
+		name = mv.DefaultTimeZone
+	}
+
+	_, err := loadTimeZone(name)
+	if err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return name, nil
+}
+
 func metricsQuery(ctx context.Context, olap drivers.OLAPStore, priority int, sql string, args []any) ([]*runtimev1.MetricsViewColumn, []*structpb.Struct, error) {
 	rows, err := olap.Execute(ctx, &drivers.Statement{
 		Query:    sql,
@@ -0,0 +1,238 @@
+package queries
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// MetricsViewPolicy is a metrics view's access policy resolved for a specific user.
+// It's set on metrics queries by the caller and is part of their cache key.
+type MetricsViewPolicy struct {
+	// RowFilter is a SQL expression that all queried rows must match
+	RowFilter string `json:"row_filter,omitempty"`
+	// Exclude lists names of dimensions and measures the user can't access
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// ResolveMetricsViewPolicy evaluates the policy of a metrics view for a user with the given attributes.
+// It returns nil if the metrics view doesn't have a policy, and a PermissionDenied error if the user doesn't have access.
+func ResolveMetricsViewPolicy(mv *runtimev1.MetricsView, attrs map[string]any) (*MetricsViewPolicy, error) {
+	if mv.Policy == nil {
+		return nil, nil
+	}
+
+	if attrs == nil {
+		attrs = map[string]any{}
+	}
+	data := map[string]any{"user": attrs}
+
+	if mv.Policy.HasAccess != "" {
+		ok, err := evaluatePolicyCondition(mv.Policy.HasAccess, data)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, status.Errorf(codes.PermissionDenied, "access to metrics view '%s' denied", mv.Name)
+		}
+	}
+
+	res := &MetricsViewPolicy{}
+
+	if mv.Policy.RowFilter != "" {
+		filter, err := renderPolicyTemplate(mv.Policy.RowFilter, data, true)
+		if err != nil {
+			return nil, err
+		}
+		res.RowFilter = strings.TrimSpace(filter)
+	}
+
+	for _, cond := range mv.Policy.Exclude {
+		ok, err := evaluatePolicyCondition(cond.Condition, data)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res.Exclude = append(res.Exclude, cond.Names...)
+		}
+	}
+
+	return res, nil
+}
+
+// Apply returns a copy of mv without the dimensions and measures excluded by the policy.
+func (p *MetricsViewPolicy) Apply(mv *runtimev1.MetricsView) *runtimev1.MetricsView {
+	if p == nil || len(p.Exclude) == 0 {
+		return mv
+	}
+
+	res := proto.Clone(mv).(*runtimev1.MetricsView)
+	res.Dimensions = nil
+	for _, d := range mv.Dimensions {
+		if !contains(p.Exclude, d.Name) {
+			res.Dimensions = append(res.Dimensions, d)
+		}
+	}
+	res.Measures = nil
+	for _, m := range mv.Measures {
+		if !contains(p.Exclude, m.Name) {
+			res.Measures = append(res.Measures, m)
+		}
+	}
+	return res
+}
+
+// checkAccess returns an error if any of the dimensions or measures referenced by a query are excluded by the policy.
+func (p *MetricsViewPolicy) checkAccess(names []string, filter *runtimev1.MetricsViewFilter) error {
+	if p == nil || len(p.Exclude) == 0 {
+		return nil
+	}
+
+	if filter != nil {
+		for _, cond := range append(filter.Include, filter.Exclude...) {
+			names = append(names, cond.Name)
+		}
+	}
+
+	for _, n := range names {
+		if contains(p.Exclude, n) {
+			return status.Errorf(codes.PermissionDenied, "access to '%s' denied", n)
+		}
+	}
+	return nil
+}
+
+// rowFilterClause returns the policy's row filter in the format "AND (...)", or an empty string if there is none.
+func (p *MetricsViewPolicy) rowFilterClause() string {
+	if p == nil || p.RowFilter == "" {
+		return ""
+	}
+	return fmt.Sprintf("AND (%s)", p.RowFilter)
+}
+
+func evaluatePolicyCondition(tmpl string, data map[string]any) (bool, error) {
+	res, err := renderPolicyTemplate(tmpl, data, false)
+	if err != nil {
+		return false, err
+	}
+	ok, err := strconv.ParseBool(strings.TrimSpace(res))
+	if err != nil {
+		return false, status.Errorf(codes.Internal, "policy condition %q did not evaluate to a boolean: %q", tmpl, res)
+	}
+	return ok, nil
+}
+
+// renderPolicyTemplate renders a policy template. Policies use [[ ]] as delimiters,
+// since {{ }} is already used for variables when the metrics view file is read.
+// If escapeSQL is true, the output of every action is escaped as a SQL literal unless it ends with raw or quote.
+func renderPolicyTemplate(tmpl string, data map[string]any, escapeSQL bool) (string, error) {
+	t, err := template.New("").Delims("[[", "]]").Option("missingkey=error").Funcs(policyFuncs).Parse(tmpl)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "invalid policy template: %s", err.Error())
+	}
+
+	if escapeSQL {
+		escapeActions(t.Tree.Root)
+	}
+
+	var buf bytes.Buffer
+	err = t.Execute(&buf, data)
+	if err != nil {
+		// Usually caused by a missing user attribute, so deny rather than fail
+		return "", status.Errorf(codes.PermissionDenied, "failed to evaluate policy: %s", err.Error())
+	}
+	return buf.String(), nil
+}
+
+// escapeActions appends the sql function to the pipeline of every action that outputs a value (similar to how html/template escapes output).
+func escapeActions(node parse.Node) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, c := range n.Nodes {
+			escapeActions(c)
+		}
+	case *parse.IfNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.RangeNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.WithNode:
+		escapeActions(n.List)
+		escapeActions(n.ElseList)
+	case *parse.ActionNode:
+		// Actions that declare variables don't output anything
+		if len(n.Pipe.Decl) > 0 || len(n.Pipe.Cmds) == 0 {
+			return
+		}
+		last := n.Pipe.Cmds[len(n.Pipe.Cmds)-1]
+		if id, ok := last.Args[0].(*parse.IdentifierNode); ok && (id.Ident == "raw" || id.Ident == "quote" || id.Ident == "sql") {
+			return
+		}
+		n.Pipe.Cmds = append(n.Pipe.Cmds, &parse.CommandNode{
+			NodeType: parse.NodeCommand,
+			Pos:      n.Pos,
+			Args:     []parse.Node{parse.NewIdentifier("sql").SetPos(n.Pos)},
+		})
+	}
+}
+
+// sqlLiteral formats a value as a SQL literal. Lists are formatted as comma-separated literals for use in IN (...) expressions.
+func sqlLiteral(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "NULL"
+	case bool:
+		if v {
+			return "TRUE"
+		}
+		return "FALSE"
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case []any:
+		vals := make([]string, len(v))
+		for i, e := range v {
+			vals[i] = sqlLiteral(e)
+		}
+		return strings.Join(vals, ", ")
+	default:
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(fmt.Sprint(v), "'", "''"))
+	}
+}
+
+var policyFuncs = template.FuncMap{
+	// has returns true if list (a JSON array claim) contains val
+	"has": func(list []any, val any) bool {
+		for _, v := range list {
+			if v == val {
+				return true
+			}
+		}
+		return false
+	},
+	// quote escapes a value for use as a SQL string literal
+	"quote": func(v any) string {
+		return fmt.Sprintf("'%s'", strings.ReplaceAll(fmt.Sprint(v), "'", "''"))
+	},
+	// sql formats a value as a SQL literal; it's applied by default to values output in row filters
+	"sql": sqlLiteral,
+	// raw outputs a value without escaping it
+	"raw": func(v any) string {
+		return fmt.Sprint(v)
+	},
+}
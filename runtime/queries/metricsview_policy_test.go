@@ -0,0 +1,71 @@
+package queries
+
+import (
+	"testing"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResolveMetricsViewPolicy(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Name: "mv",
+		Policy: &runtimev1.MetricsView_Policy{
+			HasAccess: "[[ .user.admin ]]",
+			RowFilter: "email = [[ quote .user.email ]]",
+			Exclude: []*runtimev1.MetricsView_FieldCondition{
+				{Condition: `[[ not (has .user.groups "finance") ]]`, Names: []string{"revenue"}},
+			},
+		},
+	}
+
+	p, err := ResolveMetricsViewPolicy(mv, map[string]any{"admin": true, "email": "o'neil@example.org", "groups": []any{}})
+	require.NoError(t, err)
+	require.Equal(t, "email = 'o''neil@example.org'", p.RowFilter)
+	require.Equal(t, []string{"revenue"}, p.Exclude)
+
+	p, err = ResolveMetricsViewPolicy(mv, map[string]any{"admin": true, "email": "a@example.org", "groups": []any{"finance"}})
+	require.NoError(t, err)
+	require.Empty(t, p.Exclude)
+
+	_, err = ResolveMetricsViewPolicy(mv, map[string]any{"admin": false, "email": "a@example.org", "groups": []any{}})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = ResolveMetricsViewPolicy(mv, nil)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	p, err = ResolveMetricsViewPolicy(&runtimev1.MetricsView{}, nil)
+	require.NoError(t, err)
+	require.Nil(t, p)
+}
+
+func TestResolveMetricsViewPolicy_RowFilterEscaping(t *testing.T) {
+	attrs := map[string]any{
+		"email":  "x' OR 1=1 --",
+		"domain": "example.org",
+		"groups": []any{"finance", "o'neil"},
+		"admin":  true,
+		"level":  float64(3),
+	}
+
+	cases := []struct {
+		filter string
+		want   string
+	}{
+		{"email = [[ .user.email ]]", "email = 'x'' OR 1=1 --'"},
+		{"email = [[ quote .user.email ]]", "email = 'x'' OR 1=1 --'"},
+		{"[[ .user.admin ]] AND level <= [[ .user.level ]]", "TRUE AND level <= 3"},
+		{"grp IN ([[ .user.groups ]])", "grp IN ('finance', 'o''neil')"},
+		{"[[ if has .user.groups \"finance\" ]]TRUE[[ else ]]domain = [[ .user.domain ]][[ end ]]", "TRUE"},
+		{"domain LIKE '%[[ raw .user.domain ]]'", "domain LIKE '%example.org'"},
+		{"[[ $d := .user.domain ]]domain = [[ $d ]]", "domain = 'example.org'"},
+	}
+	for _, c := range cases {
+		mv := &runtimev1.MetricsView{Policy: &runtimev1.MetricsView_Policy{RowFilter: c.filter}}
+		p, err := ResolveMetricsViewPolicy(mv, attrs)
+		require.NoError(t, err, c.filter)
+		require.Equal(t, c.want, p.RowFilter, c.filter)
+	}
+}
@@ -0,0 +1,178 @@
+package queries
+
+import (
+	"sort"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator/metricsviews"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// rollupQuery describes what a metrics query needs from a rollup to be answered by it.
+type rollupQuery struct {
+	dimensions    []string
+	measures      []string
+	filter        *runtimev1.MetricsViewFilter
+	rowFilter     string
+	timeDimension string
+	timeStart     *timestamppb.Timestamp
+	timeEnd       *timestamppb.Timestamp
+	timeGrain     runtimev1.TimeGrain
+	timeZone      string
+}
+
+// usesTime returns true if the query filters or groups by time.
+func (q *rollupQuery) usesTime() bool {
+	return q.timeStart != nil || q.timeEnd != nil || q.timeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED
+}
+
+// rewriteForRollup returns a copy of mv that reads from the smallest rollup that can answer q.
+// The copy's measures re-aggregate the rollup's pre-aggregated columns,
+// so the SQL builders can use it in place of mv without knowing about rollups.
+// It returns nil if no rollup can answer the query.
+func rewriteForRollup(mv *runtimev1.MetricsView, q *rollupQuery) *runtimev1.MetricsView {
+	var candidates []*runtimev1.MetricsView_Rollup
+	for _, r := range mv.Rollups {
+		if rollupCovers(mv, r, q) {
+			candidates = append(candidates, r)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].RowCount != candidates[j].RowCount {
+			return candidates[i].RowCount < candidates[j].RowCount
+		}
+		return len(candidates[i].Dimensions) < len(candidates[j].Dimensions)
+	})
+	rollup := candidates[0]
+
+	res := proto.Clone(mv).(*runtimev1.MetricsView)
+	res.Model = rollup.Table
+	res.Measures = nil
+	for _, m := range mv.Measures {
+		if !contains(rollup.Measures, m.Name) {
+			continue
+		}
+		expr, ok := metricsviews.ReaggregateExpression(m, m.Name)
+		if !ok {
+			return nil
+		}
+		m = proto.Clone(m).(*runtimev1.MetricsView_Measure)
+		m.Expression = expr
+		res.Measures = append(res.Measures, m)
+	}
+	return res
+}
+
+func rollupCovers(mv *runtimev1.MetricsView, r *runtimev1.MetricsView_Rollup, q *rollupQuery) bool {
+	if r.Table == "" {
+		return false
+	}
+
+	for _, m := range q.measures {
+		if !contains(r.Measures, m) {
+			return false
+		}
+	}
+
+	for _, d := range q.dimensions {
+		if !contains(r.Dimensions, d) {
+			return false
+		}
+	}
+
+	// The row filter of a policy is arbitrary SQL, so it may reference columns that aren't in the rollup
+	if q.rowFilter != "" {
+		return false
+	}
+
+	if q.filter != nil {
+		for _, cond := range append(q.filter.Include, q.filter.Exclude...) {
+			if !contains(r.Dimensions, cond.Name) {
+				return false
+			}
+		}
+	}
+
+	if !q.usesTime() {
+		return true
+	}
+
+	if r.TimeGrain == runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED || q.timeDimension != mv.TimeDimension {
+		return false
+	}
+
+	if q.timeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		if !timeGrainDivides(r.TimeGrain, q.timeGrain) {
+			return false
+		}
+		// Zoned buckets don't line up with UTC buckets, unless the rollup is fine enough to be shifted (some offsets are 15 minutes)
+		if isZonedTimeGrain(q.timeGrain) && !isUTC(q.timeZone) && r.TimeGrain > runtimev1.TimeGrain_TIME_GRAIN_MINUTE {
+			return false
+		}
+	}
+
+	return isTruncated(q.timeStart, r.TimeGrain) && isTruncated(q.timeEnd, r.TimeGrain)
+}
+
+// timeGrainDivides returns true if every bucket of the coarse grain is made up of whole buckets of the fine grain.
+func timeGrainDivides(fine, coarse runtimev1.TimeGrain) bool {
+	if fine == coarse {
+		return true
+	}
+	// weeks don't line up with months or years
+	return fine < coarse && fine <= runtimev1.TimeGrain_TIME_GRAIN_DAY
+}
+
+// isTruncated returns true if ts is nil or falls on a bucket boundary of the grain (in UTC).
+func isTruncated(ts *timestamppb.Timestamp, tg runtimev1.TimeGrain) bool {
+	if ts == nil {
+		return true
+	}
+	t := ts.AsTime()
+	return truncateTime(t, tg).Equal(t)
+}
+
+func truncateTime(t time.Time, tg runtimev1.TimeGrain) time.Time {
+	t = t.UTC()
+	switch tg {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		return t.Truncate(time.Millisecond)
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		return t.Truncate(time.Second)
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return t.Truncate(time.Minute)
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return t.Truncate(time.Hour)
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		// DuckDB's date_trunc uses ISO weeks, which start on Monday
+		d := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+		return d.AddDate(0, 0, -((int(d.Weekday()) + 6) % 7))
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return t
+	}
+}
+
+func isUTC(tz string) bool {
+	return tz == "" || tz == "UTC" || tz == "Etc/UTC"
+}
+
+func contains(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
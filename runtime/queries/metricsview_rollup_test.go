@@ -0,0 +1,67 @@
+package queries
+
+import (
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRewriteForRollup(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Model:         "bids",
+		TimeDimension: "ts",
+		Dimensions:    []*runtimev1.MetricsView_Dimension{{Name: "publisher"}, {Name: "domain"}},
+		Measures: []*runtimev1.MetricsView_Measure{
+			{Name: "count", Expression: "count(*)"},
+			{Name: "volume", Expression: "SUM(volume)"},
+		},
+		Rollups: []*runtimev1.MetricsView_Rollup{
+			{Name: "hourly", Dimensions: []string{"publisher", "domain"}, Measures: []string{"count", "volume"}, TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_HOUR, Table: "hourly", RowCount: 1000},
+			{Name: "daily", Dimensions: []string{"publisher"}, Measures: []string{"count"}, TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, Table: "daily", RowCount: 100},
+			{Name: "weekly", Measures: []string{"count"}, TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_WEEK, Table: "weekly", RowCount: 10},
+		},
+	}
+
+	ts := func(s string) *timestamppb.Timestamp {
+		v, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return timestamppb.New(v)
+	}
+
+	tests := []struct {
+		name  string
+		query *rollupQuery
+		model string
+	}{
+		{"smallest", &rollupQuery{measures: []string{"count"}}, "weekly"},
+		{"dimension", &rollupQuery{dimensions: []string{"publisher"}, measures: []string{"count"}}, "daily"},
+		{"filter", &rollupQuery{measures: []string{"count"}, filter: &runtimev1.MetricsViewFilter{Exclude: []*runtimev1.MetricsViewFilter_Cond{{Name: "domain"}}}}, "hourly"},
+		{"measure", &rollupQuery{measures: []string{"volume"}}, "hourly"},
+		{"aligned", &rollupQuery{measures: []string{"count"}, timeDimension: "ts", timeStart: ts("2023-01-02T00:00:00Z")}, "weekly"},
+		{"aligned to day", &rollupQuery{measures: []string{"count"}, timeDimension: "ts", timeStart: ts("2023-01-03T00:00:00Z")}, "daily"},
+		{"not aligned", &rollupQuery{measures: []string{"count"}, timeDimension: "ts", timeEnd: ts("2023-01-03T00:30:00Z")}, ""},
+		{"week doesn't divide month", &rollupQuery{measures: []string{"count"}, timeDimension: "ts", timeGrain: runtimev1.TimeGrain_TIME_GRAIN_MONTH}, "daily"},
+		{"finer grain", &rollupQuery{measures: []string{"count"}, timeDimension: "ts", timeGrain: runtimev1.TimeGrain_TIME_GRAIN_HOUR}, "hourly"},
+		{"time zone", &rollupQuery{measures: []string{"count"}, timeDimension: "ts", timeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, timeZone: "Asia/Kolkata"}, ""},
+		{"other time dimension", &rollupQuery{measures: []string{"count"}, timeDimension: "updated_at", timeStart: ts("2023-01-02T00:00:00Z")}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := rewriteForRollup(mv, tt.query)
+			if tt.model == "" {
+				require.Nil(t, res)
+				return
+			}
+			require.NotNil(t, res)
+			require.Equal(t, tt.model, res.Model)
+		})
+	}
+
+	res := rewriteForRollup(mv, &rollupQuery{measures: []string{"volume"}})
+	require.Equal(t, `sum("count")`, res.Measures[0].Expression)
+	require.Equal(t, `sum("volume")`, res.Measures[1].Expression)
+	require.Equal(t, "count(*)", mv.Measures[0].Expression)
+}
@@ -24,6 +24,9 @@ type MetricsViewTimeSeries struct {
 	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
 	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
 	TimeGranularity runtimev1.TimeGrain          `json:"time_granularity,omitempty"`
+	TimeDimension   string                       `json:"time_dimension,omitempty"`
+	TimeZone        string                       `json:"time_zone,omitempty"`
+	Policy          *MetricsViewPolicy           `json:"policy,omitempty"`
 
 	Result *runtimev1.MetricsViewTimeSeriesResponse `json:"-"`
 }
@@ -42,8 +45,11 @@ func (q *MetricsViewTimeSeries) Deps() []string {
 	return []string{q.MetricsViewName}
 }
 
-func (q *MetricsViewTimeSeries) MarshalResult() any {
-	return q.Result
+func (q *MetricsViewTimeSeries) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
 }
 
 func (q *MetricsViewTimeSeries) UnmarshalResult(v any) error {
@@ -61,26 +67,61 @@ func (q *MetricsViewTimeSeries) Resolve(ctx context.Context, rt *runtime.Runtime
 		return err
 	}
 
-	if mv.TimeDimension == "" {
+	err = q.Policy.checkAccess(q.MeasureNames, q.Filter)
+	if err != nil {
+		return err
+	}
+	mv = q.Policy.Apply(mv)
+
+	td, err := resolveTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return err
+	}
+
+	if td == "" {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
-	olap, err := rt.OLAP(ctx, instanceID)
+	tz, err := resolveTimeZone(mv, q.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
 
 	switch olap.Dialect() {
 	case drivers.DialectDuckDB:
-		return q.resolveDuckDB(ctx, rt, instanceID, mv, priority)
+		return q.resolveDuckDB(ctx, rt, instanceID, mv, td, tz, priority)
 	case drivers.DialectDruid:
-		return q.resolveDruid(ctx, olap, mv, priority)
+		return q.resolveDruid(ctx, olap, mv, td, tz, priority)
 	default:
 		return fmt.Errorf("not available for dialect '%s'", olap.Dialect())
 	}
 }
 
-func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, priority int) error {
+func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.Runtime, instanceID string, mv *runtimev1.MetricsView, timeDimension, timeZone string, priority int) error {
+	// Use a rollup if one can answer the query.
+	// The granularity must be known up front since it's otherwise inferred from the base table.
+	var rollup *runtimev1.MetricsView
+	if q.TimeGranularity != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		rollup = rewriteForRollup(mv, &rollupQuery{
+			measures:      q.MeasureNames,
+			filter:        q.Filter,
+			rowFilter:     q.Policy.rowFilterClause(),
+			timeDimension: timeDimension,
+			timeStart:     q.TimeStart,
+			timeEnd:       q.TimeEnd,
+			timeGrain:     q.TimeGranularity,
+			timeZone:      timeZone,
+		})
+		if rollup != nil {
+			mv = rollup
+		}
+	}
+
 	measures, err := toColumnTimeseriesMeasures(mv.Measures, q.MeasureNames)
 	if err != nil {
 		return err
@@ -88,16 +129,23 @@ func (q *MetricsViewTimeSeries) resolveDuckDB(ctx context.Context, rt *runtime.R
 
 	tsq := &ColumnTimeseries{
 		TableName:           mv.Model,
-		TimestampColumnName: mv.TimeDimension,
+		TimestampColumnName: timeDimension,
 		TimeRange: &runtimev1.TimeSeriesTimeRange{
 			Start:    q.TimeStart,
 			End:      q.TimeEnd,
 			Interval: q.TimeGranularity,
 		},
-		Measures: measures,
-		Filters:  q.Filter,
+		Measures:  measures,
+		Filters:   q.Filter,
+		RowFilter: q.Policy.rowFilterClause(),
+		TimeZone:  timeZone,
+	}
+	if rollup != nil {
+		// Rollup tables aren't catalog objects, so the query can't be cached on its own (this query is cached instead)
+		err = tsq.Resolve(ctx, rt, instanceID, priority)
+	} else {
+		err = rt.Query(ctx, instanceID, tsq, priority)
 	}
-	err = rt.Query(ctx, instanceID, tsq, priority)
 	if err != nil {
 		return err
 	}
@@ -132,8 +180,8 @@ func toColumnTimeseriesMeasures(measures []*runtimev1.MetricsView_Measure, measu
 	return res, nil
 }
 
-func (q *MetricsViewTimeSeries) resolveDruid(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsView, priority int) error {
-	sql, tsAlias, args, err := q.buildDruidMetricsTimeseriesSQL(mv)
+func (q *MetricsViewTimeSeries) resolveDruid(ctx context.Context, olap drivers.OLAPStore, mv *runtimev1.MetricsView, timeDimension, timeZone string, priority int) error {
+	sql, tsAlias, args, err := q.buildDruidMetricsTimeseriesSQL(mv, timeDimension, timeZone)
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
 	}
@@ -186,7 +234,7 @@ func (q *MetricsViewTimeSeries) resolveDruid(ctx context.Context, olap drivers.O
 	return nil
 }
 
-func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.MetricsView) (string, string, []any, error) {
+func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.MetricsView, timeDimension, timeZone string) (string, string, []any, error) {
 	selectCols := []string{}
 	for _, n := range q.MeasureNames {
 		found := false
@@ -206,11 +254,11 @@ func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.Met
 	whereClause := "1=1"
 	args := []any{}
 	if q.TimeStart != nil {
-		whereClause += fmt.Sprintf(" AND %s >= ?", safeName(mv.TimeDimension))
+		whereClause += fmt.Sprintf(" AND %s >= ?", safeName(timeDimension))
 		args = append(args, q.TimeStart.AsTime())
 	}
 	if q.TimeEnd != nil {
-		whereClause += fmt.Sprintf(" AND %s < ?", safeName(mv.TimeDimension))
+		whereClause += fmt.Sprintf(" AND %s < ?", safeName(timeDimension))
 		args = append(args, q.TimeEnd.AsTime())
 	}
 
@@ -223,13 +271,23 @@ func (q *MetricsViewTimeSeries) buildDruidMetricsTimeseriesSQL(mv *runtimev1.Met
 		args = append(args, clauseArgs...)
 	}
 
+	if clause := q.Policy.rowFilterClause(); clause != "" {
+		whereClause += " " + clause
+	}
+
 	tsAlias := tempName("_ts_")
-	tsSpecifier := convertToDateTruncSpecifier(q.TimeGranularity)
+
+	// Druid natively supports flooring timestamps in a time zone (accounting for DST)
+	var tsExpr string
+	if timeZone != "" && timeZone != "UTC" {
+		tsExpr = fmt.Sprintf("TIME_FLOOR(%s, '%s', NULL, '%s')", safeName(timeDimension), convertToISO8601Period(q.TimeGranularity), timeZone)
+	} else {
+		tsExpr = fmt.Sprintf("date_trunc('%s', %s)", convertToDateTruncSpecifier(q.TimeGranularity), safeName(timeDimension))
+	}
 
 	sql := fmt.Sprintf(
-		`SELECT date_trunc('%s', %s) AS %s, %s FROM %q WHERE %s GROUP BY 1 ORDER BY 1`,
-		tsSpecifier,
-		safeName(mv.TimeDimension),
+		`SELECT %s AS %s, %s FROM %q WHERE %s GROUP BY 1 ORDER BY 1`,
+		tsExpr,
 		tsAlias,
 		strings.Join(selectCols, ", "),
 		mv.Model,
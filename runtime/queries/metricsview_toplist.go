@@ -22,6 +22,8 @@ type MetricsViewToplist struct {
 	Offset          int64                        `json:"offset,omitempty"`
 	Sort            []*runtimev1.MetricsViewSort `json:"sort,omitempty"`
 	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
+	TimeDimension   string                       `json:"time_dimension,omitempty"`
+	Policy          *MetricsViewPolicy           `json:"policy,omitempty"`
 
 	Result *runtimev1.MetricsViewToplistResponse `json:"-"`
 }
@@ -40,8 +42,11 @@ func (q *MetricsViewToplist) Deps() []string {
 	return []string{q.MetricsViewName}
 }
 
-func (q *MetricsViewToplist) MarshalResult() any {
-	return q.Result
+func (q *MetricsViewToplist) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
 }
 
 func (q *MetricsViewToplist) UnmarshalResult(v any) error {
@@ -54,7 +59,7 @@ func (q *MetricsViewToplist) UnmarshalResult(v any) error {
 }
 
 func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -68,12 +73,37 @@ func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, i
 		return err
 	}
 
-	if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
+	err = q.Policy.checkAccess(append([]string{q.DimensionName}, q.MeasureNames...), q.Filter)
+	if err != nil {
+		return err
+	}
+	mv = q.Policy.Apply(mv)
+
+	td, err := resolveTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return err
+	}
+
+	if td == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
+	// Use a rollup if one can answer the query
+	rq := &rollupQuery{
+		dimensions:    []string{q.DimensionName},
+		measures:      q.MeasureNames,
+		filter:        q.Filter,
+		rowFilter:     q.Policy.rowFilterClause(),
+		timeDimension: td,
+		timeStart:     q.TimeStart,
+		timeEnd:       q.TimeEnd,
+	}
+	if rmv := rewriteForRollup(mv, rq); rmv != nil {
+		mv = rmv
+	}
+
 	// Build query
-	sql, args, err := q.buildMetricsTopListSQL(mv, olap.Dialect())
+	sql, args, err := q.buildMetricsTopListSQL(mv, td, olap.Dialect())
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
 	}
@@ -92,7 +122,7 @@ func (q *MetricsViewToplist) Resolve(ctx context.Context, rt *runtime.Runtime, i
 	return nil
 }
 
-func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, dialect drivers.Dialect) (string, []any, error) {
+func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, timeDimension string, dialect drivers.Dialect) (string, []any, error) {
 	dimName := safeName(q.DimensionName)
 	selectCols := []string{dimName}
 	for _, n := range q.MeasureNames {
@@ -112,13 +142,13 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 
 	whereClause := "1=1"
 	args := []any{}
-	if mv.TimeDimension != "" {
+	if timeDimension != "" {
 		if q.TimeStart != nil {
-			whereClause += fmt.Sprintf(" AND %s >= ?", safeName(mv.TimeDimension))
+			whereClause += fmt.Sprintf(" AND %s >= ?", safeName(timeDimension))
 			args = append(args, q.TimeStart.AsTime())
 		}
 		if q.TimeEnd != nil {
-			whereClause += fmt.Sprintf(" AND %s < ?", safeName(mv.TimeDimension))
+			whereClause += fmt.Sprintf(" AND %s < ?", safeName(timeDimension))
 			args = append(args, q.TimeEnd.AsTime())
 		}
 	}
@@ -132,6 +162,10 @@ func (q *MetricsViewToplist) buildMetricsTopListSQL(mv *runtimev1.MetricsView, d
 		args = append(args, clauseArgs...)
 	}
 
+	if clause := q.Policy.rowFilterClause(); clause != "" {
+		whereClause += " " + clause
+	}
+
 	sortingCriteria := make([]string, 0, len(q.Sort))
 	for _, s := range q.Sort {
 		sortCriterion := safeName(s.Name)
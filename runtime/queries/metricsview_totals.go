@@ -18,6 +18,8 @@ type MetricsViewTotals struct {
 	TimeStart       *timestamppb.Timestamp       `json:"time_start,omitempty"`
 	TimeEnd         *timestamppb.Timestamp       `json:"time_end,omitempty"`
 	Filter          *runtimev1.MetricsViewFilter `json:"filter,omitempty"`
+	TimeDimension   string                       `json:"time_dimension,omitempty"`
+	Policy          *MetricsViewPolicy           `json:"policy,omitempty"`
 
 	Result *runtimev1.MetricsViewTotalsResponse `json:"-"`
 }
@@ -36,8 +38,11 @@ func (q *MetricsViewTotals) Deps() []string {
 	return []string{q.MetricsViewName}
 }
 
-func (q *MetricsViewTotals) MarshalResult() any {
-	return q.Result
+func (q *MetricsViewTotals) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessage(q.Result),
+	}
 }
 
 func (q *MetricsViewTotals) UnmarshalResult(v any) error {
@@ -50,7 +55,7 @@ func (q *MetricsViewTotals) UnmarshalResult(v any) error {
 }
 
 func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -64,11 +69,35 @@ func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, in
 		return err
 	}
 
-	if mv.TimeDimension == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
+	err = q.Policy.checkAccess(q.MeasureNames, q.Filter)
+	if err != nil {
+		return err
+	}
+	mv = q.Policy.Apply(mv)
+
+	td, err := resolveTimeDimension(mv, q.TimeDimension)
+	if err != nil {
+		return err
+	}
+
+	if td == "" && (q.TimeStart != nil || q.TimeEnd != nil) {
 		return fmt.Errorf("metrics view '%s' does not have a time dimension", q.MetricsViewName)
 	}
 
-	ql, args, err := q.buildMetricsTotalsSQL(mv, olap.Dialect())
+	// Use a rollup if one can answer the query
+	rq := &rollupQuery{
+		measures:      q.MeasureNames,
+		filter:        q.Filter,
+		rowFilter:     q.Policy.rowFilterClause(),
+		timeDimension: td,
+		timeStart:     q.TimeStart,
+		timeEnd:       q.TimeEnd,
+	}
+	if rmv := rewriteForRollup(mv, rq); rmv != nil {
+		mv = rmv
+	}
+
+	ql, args, err := q.buildMetricsTotalsSQL(mv, td, olap.Dialect())
 	if err != nil {
 		return fmt.Errorf("error building query: %w", err)
 	}
@@ -90,7 +119,7 @@ func (q *MetricsViewTotals) Resolve(ctx context.Context, rt *runtime.Runtime, in
 	return nil
 }
 
-func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dialect drivers.Dialect) (string, []any, error) {
+func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, timeDimension string, dialect drivers.Dialect) (string, []any, error) {
 	selectCols := []string{}
 	for _, n := range q.MeasureNames {
 		found := false
@@ -109,13 +138,13 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dia
 
 	whereClause := "1=1"
 	args := []any{}
-	if mv.TimeDimension != "" {
+	if timeDimension != "" {
 		if q.TimeStart != nil {
-			whereClause += fmt.Sprintf(" AND %s >= ?", safeName(mv.TimeDimension))
+			whereClause += fmt.Sprintf(" AND %s >= ?", safeName(timeDimension))
 			args = append(args, q.TimeStart.AsTime())
 		}
 		if q.TimeEnd != nil {
-			whereClause += fmt.Sprintf(" AND %s < ?", safeName(mv.TimeDimension))
+			whereClause += fmt.Sprintf(" AND %s < ?", safeName(timeDimension))
 			args = append(args, q.TimeEnd.AsTime())
 		}
 	}
@@ -129,6 +158,10 @@ func (q *MetricsViewTotals) buildMetricsTotalsSQL(mv *runtimev1.MetricsView, dia
 		args = append(args, clauseArgs...)
 	}
 
+	if clause := q.Policy.rowFilterClause(); clause != "" {
+		whereClause += " " + clause
+	}
+
 	sql := fmt.Sprintf(
 		"SELECT %s FROM %q WHERE %s",
 		strings.Join(selectCols, ", "),
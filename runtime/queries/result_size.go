@@ -0,0 +1,23 @@
+package queries
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// sizeProtoMessage estimates the in-memory size of a query result by its serialized size.
+// It sizes a clone since proto.Size populates the message's internal size cache,
+// which would make the cached result differ from a freshly resolved one.
+func sizeProtoMessage(m proto.Message) int64 {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return 0
+	}
+	return int64(proto.Size(proto.Clone(m)))
+}
+
+func sizeProtoMessages[T proto.Message](ms []T) int64 {
+	var n int64
+	for _, m := range ms {
+		n += sizeProtoMessage(m)
+	}
+	return n
+}
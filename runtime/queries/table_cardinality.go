@@ -23,8 +23,11 @@ func (q *TableCardinality) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *TableCardinality) MarshalResult() any {
-	return q.Result
+func (q *TableCardinality) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: 8,
+	}
 }
 
 func (q *TableCardinality) UnmarshalResult(v any) error {
@@ -41,7 +44,7 @@ func (q *TableCardinality) Resolve(ctx context.Context, rt *runtime.Runtime, ins
 		safeName(q.TableName),
 	)
 
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -24,8 +24,11 @@ func (q *TableColumns) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *TableColumns) MarshalResult() any {
-	return q.Result
+func (q *TableColumns) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessages(q.Result),
+	}
 }
 
 func (q *TableColumns) UnmarshalResult(v any) error {
@@ -38,7 +41,7 @@ func (q *TableColumns) UnmarshalResult(v any) error {
 }
 
 func (q *TableColumns) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -25,8 +25,11 @@ func (q *TableHead) Deps() []string {
 	return []string{q.TableName}
 }
 
-func (q *TableHead) MarshalResult() any {
-	return q.Result
+func (q *TableHead) MarshalResult() *runtime.QueryResult {
+	return &runtime.QueryResult{
+		Value: q.Result,
+		Bytes: sizeProtoMessages(q.Result),
+	}
 }
 
 func (q *TableHead) UnmarshalResult(v any) error {
@@ -39,7 +42,7 @@ func (q *TableHead) UnmarshalResult(v any) error {
 }
 
 func (q *TableHead) Resolve(ctx context.Context, rt *runtime.Runtime, instanceID string, priority int) error {
-	olap, err := rt.OLAP(ctx, instanceID)
+	olap, err := rt.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,73 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+)
+
+// loadTimeZone resolves an IANA time zone name. An empty name resolves to UTC.
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// isZonedTimeGrain returns true for time grains where bucket boundaries follow the wall clock of a time zone.
+// Smaller grains are truncated in UTC.
+func isZonedTimeGrain(tg runtimev1.TimeGrain) bool {
+	switch tg {
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY, runtimev1.TimeGrain_TIME_GRAIN_WEEK, runtimev1.TimeGrain_TIME_GRAIN_MONTH, runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return true
+	default:
+		return false
+	}
+}
+
+// toWallClock returns the wall clock time of t in loc, represented as a UTC time.
+// It's the Go equivalent of the SQL returned by localTimestampExpr.
+func toWallClock(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// fromWallClock interprets the wall clock of t (ignoring its location) as a time in loc.
+func fromWallClock(t time.Time, loc *time.Location) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// localTimestampExpr returns a DuckDB expression that converts the UTC timestamp in expr to its wall clock time in loc.
+// DuckDB only knows about time zones through the ICU extension, which we can't rely on being installed,
+// so instead we look up the UTC offsets (and DST transitions) in effect between start and end and apply them with a CASE expression.
+// Values outside of [start, end) use the offset at the nearest boundary.
+func localTimestampExpr(expr string, loc *time.Location, start, end time.Time) string {
+	var whens []string
+	t := start.In(loc)
+	_, offset := t.Zone()
+	for {
+		_, next := t.ZoneBounds()
+		if next.IsZero() || !next.Before(end) {
+			break
+		}
+		whens = append(whens, fmt.Sprintf("WHEN %s < TIMESTAMP '%s' THEN %s", expr, next.UTC().Format(IsoFormat), offsetExpr(expr, offset)))
+		t = next
+		_, offset = t.Zone()
+	}
+
+	if len(whens) == 0 {
+		return offsetExpr(expr, offset)
+	}
+	return fmt.Sprintf("(CASE %s ELSE %s END)", strings.Join(whens, " "), offsetExpr(expr, offset))
+}
+
+func offsetExpr(expr string, offsetSeconds int) string {
+	return fmt.Sprintf("(%s + INTERVAL '%d seconds')", expr, offsetSeconds)
+}
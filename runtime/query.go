@@ -7,12 +7,17 @@ import (
 
 	"github.com/rilldata/rill/runtime/pkg/observability"
 	"go.opentelemetry.io/otel/metric/global"
+	"go.opentelemetry.io/otel/metric/instrument"
 )
 
 var (
-	meter                   = global.Meter("runtime")
-	queryCacheHitsCounter   = observability.Must(meter.Int64Counter("query_cache.hits"))
-	queryCacheMissesCounter = observability.Must(meter.Int64Counter("query_cache.misses"))
+	meter                      = global.Meter("runtime")
+	queryCacheHitsCounter      = observability.Must(meter.Int64Counter("query_cache.hits"))
+	queryCacheMissesCounter    = observability.Must(meter.Int64Counter("query_cache.misses"))
+	queryCacheEvictionsCounter = observability.Must(meter.Int64Counter("query_cache.evictions"))
+	queryCacheSizeCounter      = observability.Must(meter.Int64UpDownCounter("query_cache.size", instrument.WithUnit("bytes")))
+	queryCacheDiskHitsCounter  = observability.Must(meter.Int64Counter("query_cache.disk.hits"))
+	queryCacheDiskSizeCounter  = observability.Must(meter.Int64UpDownCounter("query_cache.disk.size", instrument.WithUnit("bytes")))
 )
 
 type Query interface {
@@ -21,9 +26,8 @@ type Query interface {
 	// Deps should return the source and model names that the query targets.
 	// It's used to invalidate cached queries when the underlying data changes.
 	Deps() []string
-	// MarshalResult should return the query result for caching.
-	// TODO: Also return estimated cost in bytes.
-	MarshalResult() any
+	// MarshalResult should return the query result and its estimated size for caching.
+	MarshalResult() *QueryResult
 	// UnmarshalResult should populate a query with a cached result
 	UnmarshalResult(v any) error
 	// Resolve should execute the query against the instance's infra.
@@ -31,6 +35,14 @@ type Query interface {
 	Resolve(ctx context.Context, rt *Runtime, instanceID string, priority int) error
 }
 
+// QueryResult is a query result along with its estimated size in bytes.
+// The size is used to bound the memory used by the query cache.
+// Results that are protobuf messages can also be persisted to the on-disk query cache.
+type QueryResult struct {
+	Value any
+	Bytes int64
+}
+
 type queryCacheKey struct {
 	instanceID    string
 	queryKey      string
@@ -41,6 +53,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 	// If key is empty, skip caching
 	qk := query.Key()
 	if qk == "" {
+		r.queryCounts.add(instanceID, false)
 		return query.Resolve(ctx, r, instanceID, priority)
 	}
 
@@ -65,6 +78,7 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 
 	// If there were no known dependencies, skip caching
 	if len(depKeys) == 0 {
+		r.queryCounts.add(instanceID, false)
 		return query.Resolve(ctx, r, instanceID, priority)
 	}
 
@@ -76,18 +90,20 @@ func (r *Runtime) Query(ctx context.Context, instanceID string, query Query, pri
 		dependencyKey: depKey,
 	}
 
-	val, ok := r.queryCache.get(key)
+	val, ok := r.queryCache.get(ctx, key)
 	if ok {
 		queryCacheHitsCounter.Add(ctx, 1)
+		r.queryCounts.add(instanceID, true)
 		return query.UnmarshalResult(val)
 	}
 	queryCacheMissesCounter.Add(ctx, 1)
+	r.queryCounts.add(instanceID, false)
 
 	// Cache miss. Run the query.
 	err := query.Resolve(ctx, r, instanceID, priority)
 	if err != nil {
 		return err
 	}
-	r.queryCache.add(key, query.MarshalResult())
+	r.queryCache.add(ctx, key, query.MarshalResult())
 	return nil
 }
@@ -0,0 +1,165 @@
+package runtime
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// queryCacheOptions configures the limits of a queryCache. Zero values mean no limit.
+type queryCacheOptions struct {
+	// MaxEntries bounds the number of cached results
+	MaxEntries int
+	// MaxBytes bounds the total estimated size of cached results
+	MaxBytes int64
+	// InstanceQuotaBytes bounds the total estimated size of cached results per instance
+	InstanceQuotaBytes int64
+	// DiskPath is a directory to persist results to. If empty, results are only cached in memory.
+	DiskPath string
+	// DiskMaxBytes bounds the size of the results persisted to DiskPath
+	DiskMaxBytes int64
+}
+
+// queryCache is an LRU cache of query results bounded by count and estimated size in bytes.
+// If a disk store is configured, results that are protobuf messages are written through to it,
+// which allows results to outlive evictions from memory and restarts.
+type queryCache struct {
+	opts          queryCacheOptions
+	logger        *zap.Logger
+	disk          *diskQueryCache
+	lock          sync.Mutex
+	lru           *list.List // front is most recently used
+	entries       map[queryCacheKey]*list.Element
+	bytes         int64
+	instanceBytes map[string]int64
+}
+
+type queryCacheEntry struct {
+	key   queryCacheKey
+	value any
+	bytes int64
+}
+
+func newQueryCache(opts queryCacheOptions, logger *zap.Logger) (*queryCache, error) {
+	c := &queryCache{
+		opts:          opts,
+		logger:        logger,
+		lru:           list.New(),
+		entries:       make(map[queryCacheKey]*list.Element),
+		instanceBytes: make(map[string]int64),
+	}
+
+	if opts.DiskPath != "" {
+		disk, err := openDiskQueryCache(opts.DiskPath, opts.DiskMaxBytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open query cache disk store: %w", err)
+		}
+		c.disk = disk
+	}
+
+	return c, nil
+}
+
+func (c *queryCache) get(ctx context.Context, key queryCacheKey) (any, bool) {
+	c.lock.Lock()
+	if el, ok := c.entries[key]; ok {
+		c.lru.MoveToFront(el)
+		c.lock.Unlock()
+		return el.Value.(*queryCacheEntry).value, true
+	}
+	c.lock.Unlock()
+
+	if c.disk == nil {
+		return nil, false
+	}
+
+	msg, ok := c.disk.get(ctx, key)
+	if !ok {
+		return nil, false
+	}
+	queryCacheDiskHitsCounter.Add(ctx, 1)
+
+	// Promote to memory (no need to write it back to disk)
+	c.addMemory(ctx, key, msg, int64(proto.Size(msg)))
+	return msg, true
+}
+
+func (c *queryCache) add(ctx context.Context, key queryCacheKey, res *QueryResult) {
+	if !c.addMemory(ctx, key, res.Value, res.Bytes) {
+		return
+	}
+
+	if c.disk != nil {
+		// Nil messages are not persisted since they'd be read back as empty messages
+		if msg, ok := res.Value.(proto.Message); ok && msg.ProtoReflect().IsValid() {
+			err := c.disk.put(ctx, key, msg)
+			if err != nil {
+				c.logger.Warn("failed to persist query result", zap.String("instance_id", key.instanceID), zap.Error(err))
+			}
+		}
+	}
+}
+
+// addMemory adds a result to the in-memory cache, evicting other results as necessary.
+// It returns false if the result is too large to be cached.
+func (c *queryCache) addMemory(ctx context.Context, key queryCacheKey, value any, bytes int64) bool {
+	if c.opts.MaxBytes > 0 && bytes > c.opts.MaxBytes || c.opts.InstanceQuotaBytes > 0 && bytes > c.opts.InstanceQuotaBytes {
+		return false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		c.remove(ctx, el)
+	}
+
+	entry := &queryCacheEntry{key: key, value: value, bytes: bytes}
+	c.entries[key] = c.lru.PushFront(entry)
+	c.bytes += bytes
+	c.instanceBytes[key.instanceID] += bytes
+	queryCacheSizeCounter.Add(ctx, bytes)
+
+	// Enforce the instance's quota by evicting its least recently used results
+	if c.opts.InstanceQuotaBytes > 0 {
+		for el := c.lru.Back(); el != nil && c.instanceBytes[key.instanceID] > c.opts.InstanceQuotaBytes; {
+			prev := el.Prev()
+			if el.Value.(*queryCacheEntry).key.instanceID == key.instanceID {
+				c.evict(ctx, el, "quota")
+			}
+			el = prev
+		}
+	}
+
+	// Enforce global limits
+	for c.opts.MaxBytes > 0 && c.bytes > c.opts.MaxBytes {
+		c.evict(ctx, c.lru.Back(), "bytes")
+	}
+	for c.opts.MaxEntries > 0 && c.lru.Len() > c.opts.MaxEntries {
+		c.evict(ctx, c.lru.Back(), "entries")
+	}
+
+	return true
+}
+
+func (c *queryCache) evict(ctx context.Context, el *list.Element, reason string) {
+	c.remove(ctx, el)
+	queryCacheEvictionsCounter.Add(ctx, 1, attribute.String("reason", reason))
+}
+
+func (c *queryCache) remove(ctx context.Context, el *list.Element) {
+	entry := el.Value.(*queryCacheEntry)
+	c.lru.Remove(el)
+	delete(c.entries, entry.key)
+	c.bytes -= entry.bytes
+	c.instanceBytes[entry.key.instanceID] -= entry.bytes
+	if c.instanceBytes[entry.key.instanceID] == 0 {
+		delete(c.instanceBytes, entry.key.instanceID)
+	}
+	queryCacheSizeCounter.Add(ctx, -entry.bytes)
+}
@@ -0,0 +1,197 @@
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const diskQueryCacheExt = ".pb"
+
+// diskQueryCache persists protobuf query results to a directory, one file per result.
+// It's bounded by size and evicts the least recently used files first (tracked using the files' mtime).
+// Since cache keys include the refresh time of a query's dependencies, stale files are never read and eventually evicted.
+type diskQueryCache struct {
+	path     string
+	maxBytes int64
+	lock     sync.Mutex
+	files    map[string]*diskQueryCacheFile
+	bytes    int64
+}
+
+type diskQueryCacheFile struct {
+	name    string
+	bytes   int64
+	touched time.Time
+}
+
+func openDiskQueryCache(path string, maxBytes int64) (*diskQueryCache, error) {
+	err := os.MkdirAll(path, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &diskQueryCache{
+		path:     path,
+		maxBytes: maxBytes,
+		files:    make(map[string]*diskQueryCacheFile),
+	}
+
+	// Load existing files from a previous run
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), diskQueryCacheExt) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, err
+		}
+		c.files[e.Name()] = &diskQueryCacheFile{name: e.Name(), bytes: info.Size(), touched: info.ModTime()}
+		c.bytes += info.Size()
+	}
+	queryCacheDiskSizeCounter.Add(context.Background(), c.bytes)
+
+	c.lock.Lock()
+	c.evictLocked(context.Background())
+	c.lock.Unlock()
+
+	return c, nil
+}
+
+func (c *diskQueryCache) get(ctx context.Context, key queryCacheKey) (proto.Message, bool) {
+	name := diskQueryCacheFileName(key)
+
+	c.lock.Lock()
+	f, ok := c.files[name]
+	if ok {
+		f.touched = time.Now()
+	}
+	c.lock.Unlock()
+	if !ok {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filepath.Join(c.path, name))
+	if err != nil {
+		return nil, false
+	}
+
+	a := &anypb.Any{}
+	if err := proto.Unmarshal(data, a); err != nil {
+		return nil, false
+	}
+	msg, err := a.UnmarshalNew()
+	if err != nil {
+		return nil, false
+	}
+
+	// Persist the access time so it survives restarts (best effort)
+	now := time.Now()
+	_ = os.Chtimes(filepath.Join(c.path, name), now, now)
+
+	return msg, true
+}
+
+func (c *diskQueryCache) put(ctx context.Context, key queryCacheKey, msg proto.Message) error {
+	a, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(a)
+	if err != nil {
+		return err
+	}
+
+	size := int64(len(data))
+	if c.maxBytes > 0 && size > c.maxBytes {
+		return nil
+	}
+
+	// Write to a temp file and rename it to avoid partially written files
+	name := diskQueryCacheFileName(key)
+	tmp, err := os.CreateTemp(c.path, name+".tmp*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	if err1 := tmp.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	err = os.Rename(tmp.Name(), filepath.Join(c.path, name))
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if f, ok := c.files[name]; ok {
+		c.bytes -= f.bytes
+		queryCacheDiskSizeCounter.Add(ctx, -f.bytes)
+	}
+	c.files[name] = &diskQueryCacheFile{name: name, bytes: size, touched: time.Now()}
+	c.bytes += size
+	queryCacheDiskSizeCounter.Add(ctx, size)
+
+	c.evictLocked(ctx)
+	return nil
+}
+
+// evictLocked removes the least recently used files until the store is within its size limit.
+// It must be called while holding c.lock.
+func (c *diskQueryCache) evictLocked(ctx context.Context) {
+	if c.maxBytes <= 0 || c.bytes <= c.maxBytes {
+		return
+	}
+
+	files := make([]*diskQueryCacheFile, 0, len(c.files))
+	for _, f := range c.files {
+		files = append(files, f)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].touched.Before(files[j].touched)
+	})
+
+	for _, f := range files {
+		if c.bytes <= c.maxBytes {
+			break
+		}
+		err := os.Remove(filepath.Join(c.path, f.name))
+		if err != nil && !os.IsNotExist(err) {
+			continue
+		}
+		delete(c.files, f.name)
+		c.bytes -= f.bytes
+		queryCacheDiskSizeCounter.Add(ctx, -f.bytes)
+		queryCacheEvictionsCounter.Add(ctx, 1, attribute.String("reason", "disk"))
+	}
+}
+
+func diskQueryCacheFileName(key queryCacheKey) string {
+	h := sha256.New()
+	h.Write([]byte(key.instanceID))
+	h.Write([]byte{0})
+	h.Write([]byte(key.queryKey))
+	h.Write([]byte{0})
+	h.Write([]byte(key.dependencyKey))
+	return hex.EncodeToString(h.Sum(nil)) + diskQueryCacheExt
+}
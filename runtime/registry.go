@@ -55,10 +55,17 @@ func (r *Runtime) CreateInstance(ctx context.Context, inst *drivers.Instance) er
 
 	c := rillv1beta.New(repoStore, inst.ID)
 	proj, err := c.ProjectConfig(ctx)
-	if err != nil {
+	var configErr *rillv1beta.ConfigError
+	if errors.As(err, &configErr) {
+		// an invalid rill.yaml is reported by reconcile, so it shouldn't prevent creating the instance
+		r.logger.Warn("invalid rill.yaml", zap.Error(err), zap.String("instance_id", inst.ID))
+	} else if err != nil {
 		return err
 	}
-	inst.ProjectVariables = proj.Variables
+	// the variables of an invalid rill.yaml are still decoded on a best-effort basis
+	if proj != nil {
+		inst.ProjectVariables = proj.Variables
+	}
 	// this is a hack to set variables and pass to connectors
 	// ideally the runtime should propagate this flag to connectors.Env
 	if inst.Variables == nil {
@@ -111,6 +118,7 @@ func (r *Runtime) DeleteInstance(ctx context.Context, instanceID string, dropDB
 	}
 
 	r.evictCaches(ctx, inst)
+	r.queryCounts.delete(instanceID)
 	// delete instance
 	return r.Registry().DeleteInstance(ctx, instanceID)
 }
@@ -188,6 +196,9 @@ func (r *Runtime) EditInstance(ctx context.Context, inst *drivers.Instance) erro
 
 // TODO :: this is a rudimentary solution and ideally should be done in some producer/consumer pattern
 func (r *Runtime) evictCaches(ctx context.Context, inst *drivers.Instance) {
+	// stop watching the repo (it's started again on demand with the new config)
+	r.stopRepoWatcher(inst.ID)
+
 	// evict and close exisiting connection
 	r.connCache.evict(ctx, inst.ID, inst.OLAPDriver, inst.OLAPDSN)
 	r.connCache.evict(ctx, inst.ID, inst.RepoDriver, inst.RepoDSN)
@@ -0,0 +1,236 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog"
+	"go.uber.org/zap"
+)
+
+// watchDebounce is how long the repo watcher waits for more changes before reconciling.
+// Editors and git commonly touch several files (or the same file several times) in quick succession.
+const watchDebounce = 250 * time.Millisecond
+
+// ErrWatchNotSupported is returned when watching a repo that can't detect changes.
+var ErrWatchNotSupported = errors.New("repo does not support watching for changes")
+
+// ReconcileEvent describes a reconcile triggered by changes to a watched repo.
+type ReconcileEvent struct {
+	ChangedPaths []string
+	Result       *catalog.ReconcileResult
+	Err          error
+}
+
+// WatchRepo starts watching the instance's repo and reconciles changed files automatically.
+// It's a no-op if the repo is already watched. The watcher runs until the instance is edited or deleted, or the runtime is closed.
+func (r *Runtime) WatchRepo(ctx context.Context, instanceID string) error {
+	_, err := r.repoWatcher(ctx, instanceID)
+	return err
+}
+
+// SubscribeReconcile calls fn for every reconcile done by the instance's repo watcher until ctx is cancelled.
+// It starts watching the repo if it isn't already.
+func (r *Runtime) SubscribeReconcile(ctx context.Context, instanceID string, fn func(e *ReconcileEvent)) error {
+	w, err := r.repoWatcher(ctx, instanceID)
+	if err != nil {
+		return err
+	}
+	return w.subscribe(ctx, fn)
+}
+
+func (r *Runtime) repoWatcher(ctx context.Context, instanceID string) (*repoWatcher, error) {
+	r.repoWatchersLock.Lock()
+	defer r.repoWatchersLock.Unlock()
+
+	if w, ok := r.repoWatchers[instanceID]; ok {
+		return w, nil
+	}
+
+	repo, err := r.Repo(ctx, instanceID)
+	if err != nil {
+		return nil, err
+	}
+
+	rw, ok := repo.(drivers.RepoWatcher)
+	if !ok {
+		return nil, ErrWatchNotSupported
+	}
+
+	w := newRepoWatcher(r, instanceID, rw)
+	r.repoWatchers[instanceID] = w
+	return w, nil
+}
+
+// stopRepoWatcher stops watching the instance's repo (if it's watched).
+func (r *Runtime) stopRepoWatcher(instanceID string) {
+	r.repoWatchersLock.Lock()
+	w, ok := r.repoWatchers[instanceID]
+	delete(r.repoWatchers, instanceID)
+	r.repoWatchersLock.Unlock()
+
+	if ok {
+		w.close()
+	}
+}
+
+// repoWatcher reconciles the files changed in a repo.
+// Changes are debounced and batched into a single reconcile. Changes that arrive while a reconcile is running
+// are queued and reconciled when it completes.
+type repoWatcher struct {
+	rt         *Runtime
+	instanceID string
+	logger     *zap.Logger
+	ctx        context.Context
+	cancel     context.CancelFunc
+	done       chan struct{}
+	trigger    chan struct{}
+	timer      *time.Timer
+
+	lock      sync.Mutex
+	pending   map[string]bool
+	subs      map[int]chan *ReconcileEvent
+	nextSubID int
+	err       error
+}
+
+func newRepoWatcher(rt *Runtime, instanceID string, repo drivers.RepoWatcher) *repoWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &repoWatcher{
+		rt:         rt,
+		instanceID: instanceID,
+		logger:     rt.logger.With(zap.String("instance_id", instanceID)),
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+		trigger:    make(chan struct{}, 1),
+		pending:    make(map[string]bool),
+		subs:       make(map[int]chan *ReconcileEvent),
+	}
+
+	w.timer = time.AfterFunc(watchDebounce, func() {
+		select {
+		case w.trigger <- struct{}{}:
+		default:
+		}
+	})
+	w.timer.Stop()
+
+	go w.watch(repo)
+	go w.reconcileLoop()
+
+	return w
+}
+
+func (w *repoWatcher) close() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *repoWatcher) watch(repo drivers.RepoWatcher) {
+	err := repo.Watch(w.ctx, w.instanceID, w.onChange)
+	if err != nil && !errors.Is(err, context.Canceled) {
+		w.logger.Error("repo watcher failed", zap.Error(err))
+		w.lock.Lock()
+		w.err = err
+		w.lock.Unlock()
+	}
+	w.cancel()
+}
+
+func (w *repoWatcher) onChange(path string) {
+	if !catalog.IsArtifactPath(path) && !catalog.IsSharedPath(path) {
+		return
+	}
+
+	w.lock.Lock()
+	w.pending[path] = true
+	w.lock.Unlock()
+
+	w.timer.Reset(watchDebounce)
+}
+
+func (w *repoWatcher) reconcileLoop() {
+	defer close(w.done)
+	defer w.timer.Stop()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-w.trigger:
+		}
+
+		w.lock.Lock()
+		paths := make([]string, 0, len(w.pending))
+		for p := range w.pending {
+			paths = append(paths, p)
+		}
+		w.pending = make(map[string]bool)
+		w.lock.Unlock()
+
+		if len(paths) == 0 {
+			continue
+		}
+		sort.Strings(paths)
+
+		res, err := w.rt.Reconcile(w.ctx, w.instanceID, paths, nil, false, false)
+		if w.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.logger.Warn("reconcile of changed files failed", zap.Strings("paths", paths), zap.Error(err))
+		}
+
+		w.publish(&ReconcileEvent{ChangedPaths: paths, Result: res, Err: err})
+	}
+}
+
+// publish sends e to all subscribers. Events are dropped for subscribers that are too slow to keep up.
+func (w *repoWatcher) publish(e *ReconcileEvent) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for _, ch := range w.subs {
+		select {
+		case ch <- e:
+		default:
+			w.logger.Warn("dropped reconcile event for slow subscriber")
+		}
+	}
+}
+
+func (w *repoWatcher) subscribe(ctx context.Context, fn func(e *ReconcileEvent)) error {
+	ch := make(chan *ReconcileEvent, 10)
+
+	w.lock.Lock()
+	id := w.nextSubID
+	w.nextSubID++
+	w.subs[id] = ch
+	w.lock.Unlock()
+
+	defer func() {
+		w.lock.Lock()
+		delete(w.subs, id)
+		w.lock.Unlock()
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.done:
+			w.lock.Lock()
+			defer w.lock.Unlock()
+			if w.err != nil {
+				return w.err
+			}
+			return errors.New("repo watcher stopped")
+		case e := <-ch:
+			fn(e)
+		}
+	}
+}
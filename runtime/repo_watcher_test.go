@@ -0,0 +1,92 @@
+package runtime
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	_ "github.com/rilldata/rill/runtime/drivers/duckdb"
+	_ "github.com/rilldata/rill/runtime/drivers/file"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepoWatcher(t *testing.T) {
+	ctx := context.Background()
+	rt := NewTestRunTime(t)
+	defer rt.Close()
+
+	repoDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, "models"), os.ModePerm))
+
+	inst := &drivers.Instance{
+		OLAPDriver:   "duckdb",
+		OLAPDSN:      "",
+		RepoDriver:   "file",
+		RepoDSN:      repoDir,
+		EmbedCatalog: true,
+	}
+	require.NoError(t, rt.CreateInstance(ctx, inst))
+
+	subCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	events := make(chan *ReconcileEvent, 10)
+	go func() {
+		_ = rt.SubscribeReconcile(subCtx, inst.ID, func(e *ReconcileEvent) {
+			events <- e
+		})
+	}()
+
+	// Give the watcher time to start
+	time.Sleep(100 * time.Millisecond)
+
+	// Files that aren't artifacts are ignored, changes to the same file are batched
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "README.md"), []byte("hello"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "models", "m1.sql"), []byte("SELECT 1 AS a"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "models", "m1.sql"), []byte("SELECT 2 AS a"), 0o644))
+
+	e := waitForReconcileEvent(t, events)
+	require.NoError(t, e.Err)
+	require.Equal(t, []string{"/models/m1.sql"}, e.ChangedPaths)
+	require.Empty(t, e.Result.Errors)
+	_, err := rt.GetCatalogEntry(ctx, inst.ID, "m1")
+	require.NoError(t, err)
+
+	// Deletes are reconciled
+	require.NoError(t, os.Remove(filepath.Join(repoDir, "models", "m1.sql")))
+	e = waitForReconcileEvent(t, events)
+	require.Equal(t, []string{"/models/m1.sql"}, e.ChangedPaths)
+	_, err = rt.GetCatalogEntry(ctx, inst.ID, "m1")
+	require.Error(t, err)
+}
+
+func TestRepoWatcherStoppedOnDelete(t *testing.T) {
+	ctx := context.Background()
+	rt := NewTestRunTime(t)
+	defer rt.Close()
+
+	inst := &drivers.Instance{
+		OLAPDriver:   "duckdb",
+		OLAPDSN:      "",
+		RepoDriver:   "file",
+		RepoDSN:      t.TempDir(),
+		EmbedCatalog: true,
+	}
+	require.NoError(t, rt.CreateInstance(ctx, inst))
+	require.NoError(t, rt.WatchRepo(ctx, inst.ID))
+
+	require.NoError(t, rt.DeleteInstance(ctx, inst.ID, false))
+	require.Empty(t, rt.repoWatchers)
+}
+
+func waitForReconcileEvent(t *testing.T, events chan *ReconcileEvent) *ReconcileEvent {
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for reconcile")
+		return nil
+	}
+}
@@ -0,0 +1,343 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rilldata/rill/runtime/drivers"
+	"golang.org/x/sync/semaphore"
+)
+
+// ErrQueryNotFound is returned when cancelling a query that is not running.
+var ErrQueryNotFound = errors.New("query not found")
+
+type querySubjectContextKey struct{}
+
+// WithQuerySubject returns a context that attributes OLAP queries issued with it to the given subject (usually a user or service ID).
+// The subject is used for per-subject concurrency limits and shown when listing running queries.
+func WithQuerySubject(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, querySubjectContextKey{}, subject)
+}
+
+func querySubjectFromContext(ctx context.Context) string {
+	subject, _ := ctx.Value(querySubjectContextKey{}).(string)
+	return subject
+}
+
+type queryLimitsHeldContextKey struct{}
+
+func withLimitsHeld(ctx context.Context) context.Context {
+	return context.WithValue(ctx, queryLimitsHeldContextKey{}, true)
+}
+
+func limitsHeld(ctx context.Context) bool {
+	held, _ := ctx.Value(queryLimitsHeldContextKey{}).(bool)
+	return held
+}
+
+// RunningQuery describes an OLAP query that is queued or executing.
+type RunningQuery struct {
+	ID         string
+	InstanceID string
+	SQL        string
+	Priority   int
+	Subject    string
+	StartedOn  time.Time
+	Queued     bool
+}
+
+// ListRunningQueries returns the queries currently queued or executing for an instance, oldest first.
+func (r *Runtime) ListRunningQueries(ctx context.Context, instanceID string) []*RunningQuery {
+	return r.runningQueries.list(instanceID)
+}
+
+// CancelRunningQuery cancels a queued or executing query. It returns ErrQueryNotFound if the query is not running.
+func (r *Runtime) CancelRunningQuery(ctx context.Context, instanceID, queryID string) error {
+	return r.runningQueries.cancel(instanceID, queryID)
+}
+
+// runningQueries tracks in-flight OLAP queries and enforces statement timeouts and concurrency limits.
+type runningQueries struct {
+	defaultTimeout      time.Duration
+	maxTimeout          time.Duration
+	instanceConcurrency int64
+	subjectConcurrency  int64
+
+	lock     sync.Mutex
+	queries  map[string]*runningQuery
+	limiters map[string]*queryLimiter
+}
+
+type runningQuery struct {
+	RunningQuery
+	timeout   time.Duration
+	cancel    context.CancelFunc
+	stop      context.CancelFunc
+	cancelled bool
+	release   func()
+}
+
+// queryLimiter is a reference counted semaphore, which is removed when no queries are using it.
+type queryLimiter struct {
+	sem  *semaphore.Weighted
+	refs int
+}
+
+func newRunningQueries(opts *Options) *runningQueries {
+	return &runningQueries{
+		defaultTimeout:      opts.QueryDefaultTimeout,
+		maxTimeout:          opts.QueryMaxTimeout,
+		instanceConcurrency: int64(opts.QueryInstanceConcurrency),
+		subjectConcurrency:  int64(opts.QuerySubjectConcurrency),
+		queries:             make(map[string]*runningQuery),
+		limiters:            make(map[string]*queryLimiter),
+	}
+}
+
+// start registers a query and blocks until it's within the concurrency limits.
+// It returns a context that enforces the statement timeout and cancellation. The caller must call finish when the query completes.
+// The timeout only covers execution, so time spent waiting for concurrency slots doesn't count against it.
+func (r *runningQueries) start(ctx context.Context, instanceID string, stmt *drivers.Statement) (context.Context, *runningQuery, error) {
+	timeout := stmt.Timeout
+	if timeout == 0 {
+		timeout = r.defaultTimeout
+	}
+	if r.maxTimeout > 0 && (timeout == 0 || timeout > r.maxTimeout) {
+		timeout = r.maxTimeout
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+
+	q := &runningQuery{
+		RunningQuery: RunningQuery{
+			ID:         uuid.NewString(),
+			InstanceID: instanceID,
+			SQL:        stmt.Query,
+			Priority:   stmt.Priority,
+			Subject:    querySubjectFromContext(ctx),
+			StartedOn:  time.Now(),
+			Queued:     true,
+		},
+		timeout: timeout,
+		cancel:  cancel,
+	}
+
+	r.lock.Lock()
+	r.queries[q.ID] = q
+	r.lock.Unlock()
+
+	// Acquire concurrency slots (unless already held by an enclosing WithConnection)
+	if !limitsHeld(ctx) {
+		release, err := r.acquireLimits(ctx, instanceID, q.Subject)
+		if err != nil {
+			err = r.err(ctx, q, err)
+			r.finish(q)
+			return nil, nil, err
+		}
+		q.release = release
+	}
+
+	// Start the deadline once the query is allowed to run.
+	// Cancelling the parent context (see cancel) also cancels the timeout context.
+	if timeout > 0 {
+		var stop context.CancelFunc
+		ctx, stop = context.WithTimeout(ctx, timeout)
+		q.stop = stop
+	}
+
+	r.lock.Lock()
+	q.Queued = false
+	r.lock.Unlock()
+
+	return ctx, q, nil
+}
+
+// finish releases the query's concurrency slots and unregisters it.
+func (r *runningQueries) finish(q *runningQuery) {
+	if q.release != nil {
+		q.release()
+		q.release = nil
+	}
+
+	r.lock.Lock()
+	delete(r.queries, q.ID)
+	r.lock.Unlock()
+
+	if q.stop != nil {
+		q.stop()
+	}
+	q.cancel()
+}
+
+// err returns a more helpful error for queries that failed because they timed out or were cancelled.
+// It must be called before finish.
+func (r *runningQueries) err(ctx context.Context, q *runningQuery, err error) error {
+	if err == nil || ctx.Err() == nil {
+		return err
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("query exceeded timeout of %s: %w", q.timeout, ctx.Err())
+	}
+	r.lock.Lock()
+	cancelled := q.cancelled
+	r.lock.Unlock()
+	if cancelled {
+		return fmt.Errorf("query was cancelled: %w", ctx.Err())
+	}
+	return err
+}
+
+// acquireLimits blocks until the instance and subject are within their concurrency limits.
+// It returns a func that releases the acquired slots.
+func (r *runningQueries) acquireLimits(ctx context.Context, instanceID, subject string) (func(), error) {
+	var releases []func()
+	release := func() {
+		for i := len(releases) - 1; i >= 0; i-- {
+			releases[i]()
+		}
+	}
+
+	if r.instanceConcurrency > 0 {
+		fn, err := r.acquire(ctx, "instance:"+instanceID, r.instanceConcurrency)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, fn)
+	}
+
+	if r.subjectConcurrency > 0 && subject != "" {
+		fn, err := r.acquire(ctx, "subject:"+subject, r.subjectConcurrency)
+		if err != nil {
+			release()
+			return nil, err
+		}
+		releases = append(releases, fn)
+	}
+
+	return release, nil
+}
+
+func (r *runningQueries) acquire(ctx context.Context, key string, limit int64) (func(), error) {
+	r.lock.Lock()
+	l, ok := r.limiters[key]
+	if !ok {
+		l = &queryLimiter{sem: semaphore.NewWeighted(limit)}
+		r.limiters[key] = l
+	}
+	l.refs++
+	r.lock.Unlock()
+
+	unref := func() {
+		r.lock.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(r.limiters, key)
+		}
+		r.lock.Unlock()
+	}
+
+	err := l.sem.Acquire(ctx, 1)
+	if err != nil {
+		unref()
+		return nil, err
+	}
+
+	return func() {
+		l.sem.Release(1)
+		unref()
+	}, nil
+}
+
+func (r *runningQueries) list(instanceID string) []*RunningQuery {
+	r.lock.Lock()
+	res := make([]*RunningQuery, 0, len(r.queries))
+	for _, q := range r.queries {
+		if q.InstanceID == instanceID {
+			cpy := q.RunningQuery
+			res = append(res, &cpy)
+		}
+	}
+	r.lock.Unlock()
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].StartedOn.Before(res[j].StartedOn)
+	})
+	return res
+}
+
+func (r *runningQueries) cancel(instanceID, queryID string) error {
+	r.lock.Lock()
+	q, ok := r.queries[queryID]
+	if !ok || q.InstanceID != instanceID {
+		r.lock.Unlock()
+		return ErrQueryNotFound
+	}
+	q.cancelled = true
+	r.lock.Unlock()
+
+	q.cancel()
+	return nil
+}
+
+// trackedOLAPStore wraps an OLAPStore to register its queries with the runtime's running queries.
+type trackedOLAPStore struct {
+	drivers.OLAPStore
+	queries    *runningQueries
+	instanceID string
+}
+
+// WithConnection acquires the concurrency slots before the connection, so queries issued on the connection
+// don't wait for slots while holding it (which could deadlock with queries waiting for a connection).
+func (o *trackedOLAPStore) WithConnection(ctx context.Context, priority int, fn drivers.WithConnectionFunc) error {
+	release, err := o.queries.acquireLimits(ctx, o.instanceID, querySubjectFromContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return o.OLAPStore.WithConnection(ctx, priority, func(wrappedCtx, ensuredCtx context.Context) error {
+		return fn(withLimitsHeld(wrappedCtx), withLimitsHeld(ensuredCtx))
+	})
+}
+
+func (o *trackedOLAPStore) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	ctx, q, err := o.queries.start(ctx, o.instanceID, stmt)
+	if err != nil {
+		return err
+	}
+	defer o.queries.finish(q)
+
+	err = o.OLAPStore.Exec(ctx, stmt)
+	return o.queries.err(ctx, q, err)
+}
+
+func (o *trackedOLAPStore) Execute(ctx context.Context, stmt *drivers.Statement) (*drivers.Result, error) {
+	ctx, q, err := o.queries.start(ctx, o.instanceID, stmt)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.OLAPStore.Execute(ctx, stmt)
+	if err != nil {
+		err = o.queries.err(ctx, q, err)
+		o.queries.finish(q)
+		return nil, err
+	}
+	if res == nil {
+		// Dry runs don't return a result
+		o.queries.finish(q)
+		return nil, nil
+	}
+
+	// The query is running until the result is closed
+	res.AddCleanupFunc(func() error {
+		o.queries.finish(q)
+		return nil
+	})
+	return res, nil
+}
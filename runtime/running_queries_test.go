@@ -0,0 +1,121 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunningQueriesCancel(t *testing.T) {
+	rq := newRunningQueries(&Options{})
+	olap := &trackedOLAPStore{OLAPStore: blockingOLAPStore{}, queries: rq, instanceID: "default"}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- olap.Exec(WithQuerySubject(context.Background(), "alice"), &drivers.Statement{Query: "SELECT 1", Priority: 2})
+	}()
+
+	qs := waitForRunningQueries(t, rq, "default", 1)
+	require.Equal(t, "SELECT 1", qs[0].SQL)
+	require.Equal(t, 2, qs[0].Priority)
+	require.Equal(t, "alice", qs[0].Subject)
+	require.False(t, qs[0].Queued)
+
+	require.ErrorIs(t, rq.cancel("other", qs[0].ID), ErrQueryNotFound)
+	require.NoError(t, rq.cancel("default", qs[0].ID))
+
+	err := <-errCh
+	require.ErrorContains(t, err, "query was cancelled")
+	require.Empty(t, rq.list("default"))
+}
+
+func TestRunningQueriesTimeout(t *testing.T) {
+	rq := newRunningQueries(&Options{QueryDefaultTimeout: time.Hour, QueryMaxTimeout: 10 * time.Millisecond})
+	olap := &trackedOLAPStore{OLAPStore: blockingOLAPStore{}, queries: rq, instanceID: "default"}
+
+	err := olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT 1"})
+	require.ErrorContains(t, err, "query exceeded timeout of 10ms")
+}
+
+func TestRunningQueriesTimeoutExcludesQueueing(t *testing.T) {
+	rq := newRunningQueries(&Options{QueryMaxTimeout: 50 * time.Millisecond, QueryInstanceConcurrency: 1})
+	olap := &trackedOLAPStore{OLAPStore: blockingOLAPStore{}, queries: rq, instanceID: "default"}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT 1"})
+	}()
+	waitForRunningQueries(t, rq, "default", 1)
+	go func() {
+		errCh <- olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT 2"})
+	}()
+
+	// The second query only starts its timeout once the first one has timed out and released its slot
+	require.ErrorContains(t, <-errCh, "query exceeded timeout")
+	require.Eventually(t, func() bool {
+		qs := rq.list("default")
+		return len(qs) == 1 && qs[0].SQL == "SELECT 2" && !qs[0].Queued
+	}, time.Second, time.Millisecond)
+	require.ErrorContains(t, <-errCh, "query exceeded timeout")
+}
+
+func TestRunningQueriesConcurrency(t *testing.T) {
+	rq := newRunningQueries(&Options{QueryInstanceConcurrency: 1})
+	olap := &trackedOLAPStore{OLAPStore: blockingOLAPStore{}, queries: rq, instanceID: "default"}
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT 1"})
+	}()
+	qs := waitForRunningQueries(t, rq, "default", 1)
+	first := qs[0].ID
+
+	go func() {
+		errCh <- olap.Exec(context.Background(), &drivers.Statement{Query: "SELECT 2"})
+	}()
+	qs = waitForRunningQueries(t, rq, "default", 2)
+	require.Equal(t, "SELECT 2", qs[1].SQL)
+	require.True(t, qs[1].Queued)
+
+	// Other instances are not affected by the limit
+	other := &trackedOLAPStore{OLAPStore: blockingOLAPStore{}, queries: rq, instanceID: "other"}
+	go func() {
+		errCh <- other.Exec(context.Background(), &drivers.Statement{Query: "SELECT 3"})
+	}()
+	qs = waitForRunningQueries(t, rq, "other", 1)
+	require.False(t, qs[0].Queued)
+	require.NoError(t, rq.cancel("other", qs[0].ID))
+	require.Error(t, <-errCh)
+
+	// Cancelling the first query lets the second one run
+	require.NoError(t, rq.cancel("default", first))
+	require.Error(t, <-errCh)
+	require.Eventually(t, func() bool {
+		qs := rq.list("default")
+		return len(qs) == 1 && !qs[0].Queued
+	}, time.Second, time.Millisecond)
+
+	qs = rq.list("default")
+	require.NoError(t, rq.cancel("default", qs[0].ID))
+	require.Error(t, <-errCh)
+}
+
+func waitForRunningQueries(t *testing.T, rq *runningQueries, instanceID string, n int) []*RunningQuery {
+	require.Eventually(t, func() bool {
+		return len(rq.list(instanceID)) == n
+	}, time.Second, time.Millisecond)
+	return rq.list(instanceID)
+}
+
+// blockingOLAPStore is an OLAPStore that blocks until the statement's context is done.
+type blockingOLAPStore struct {
+	drivers.OLAPStore
+}
+
+func (blockingOLAPStore) Exec(ctx context.Context, stmt *drivers.Statement) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
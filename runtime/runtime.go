@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
+	"time"
 
 	"github.com/rilldata/rill/runtime/drivers"
 	"go.uber.org/zap"
@@ -14,8 +16,26 @@ type Options struct {
 	MetastoreDriver     string
 	MetastoreDSN        string
 	QueryCacheSize      int
-	AllowHostAccess     bool
-	SafeSourceRefresh   bool
+	// QueryCacheSizeBytes bounds the estimated memory used by cached query results (0 means no limit)
+	QueryCacheSizeBytes int64
+	// QueryCacheInstanceQuotaBytes bounds the estimated memory used by each instance's cached query results (0 means no limit)
+	QueryCacheInstanceQuotaBytes int64
+	// QueryCacheDiskPath is a directory for persisting cached query results. If empty, results are only cached in memory.
+	QueryCacheDiskPath string
+	// QueryCacheDiskSizeBytes bounds the size of the results persisted to QueryCacheDiskPath (0 means no limit)
+	QueryCacheDiskSizeBytes int64
+	// QueryDefaultTimeout is the timeout for OLAP statements that don't set one (0 means no timeout)
+	QueryDefaultTimeout time.Duration
+	// QueryMaxTimeout caps the timeout of OLAP statements (0 means no cap)
+	QueryMaxTimeout time.Duration
+	// QueryInstanceConcurrency limits the number of concurrent OLAP statements per instance (0 means no limit)
+	QueryInstanceConcurrency int
+	// QuerySubjectConcurrency limits the number of concurrent OLAP statements per subject (0 means no limit)
+	QuerySubjectConcurrency int
+	AllowHostAccess         bool
+	SafeSourceRefresh       bool
+	// TransactionalReconcile reverts all the migrations of a reconcile if any of them fails
+	TransactionalReconcile bool
 }
 
 type Runtime struct {
@@ -25,6 +45,10 @@ type Runtime struct {
 	connCache          *connectionCache
 	migrationMetaCache *migrationMetaCache
 	queryCache         *queryCache
+	runningQueries     *runningQueries
+	queryCounts        *queryCounts
+	repoWatchers       map[string]*repoWatcher
+	repoWatchersLock   sync.Mutex
 }
 
 func New(opts *Options, logger *zap.Logger) (*Runtime, error) {
@@ -44,17 +68,41 @@ func New(opts *Options, logger *zap.Logger) (*Runtime, error) {
 		return nil, fmt.Errorf("server metastore must be a valid registry")
 	}
 
+	queryCache, err := newQueryCache(queryCacheOptions{
+		MaxEntries:         opts.QueryCacheSize,
+		MaxBytes:           opts.QueryCacheSizeBytes,
+		InstanceQuotaBytes: opts.QueryCacheInstanceQuotaBytes,
+		DiskPath:           opts.QueryCacheDiskPath,
+		DiskMaxBytes:       opts.QueryCacheDiskSizeBytes,
+	}, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Runtime{
 		opts:               opts,
 		metastore:          metastore,
 		logger:             logger,
 		connCache:          newConnectionCache(opts.ConnectionCacheSize, logger),
 		migrationMetaCache: newMigrationMetaCache(math.MaxInt),
-		queryCache:         newQueryCache(opts.QueryCacheSize),
+		queryCache:         queryCache,
+		runningQueries:     newRunningQueries(opts),
+		queryCounts:        newQueryCounts(),
+		repoWatchers:       make(map[string]*repoWatcher),
 	}, nil
 }
 
 func (r *Runtime) Close() error {
+	r.repoWatchersLock.Lock()
+	ids := make([]string, 0, len(r.repoWatchers))
+	for id := range r.repoWatchers {
+		ids = append(ids, id)
+	}
+	r.repoWatchersLock.Unlock()
+	for _, id := range ids {
+		r.stopRepoWatcher(id)
+	}
+
 	err1 := r.metastore.Close()
 	err2 := r.connCache.Close()
 	if err1 != nil {
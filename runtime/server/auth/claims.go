@@ -12,6 +12,8 @@ type Claims interface {
 	Can(p Permission) bool
 	// CanInstance resolves instance-level permissions.
 	CanInstance(instanceID string, p Permission) bool
+	// Attributes returns attributes of the requester (e.g. email or groups) used to evaluate access policies.
+	Attributes() map[string]any
 }
 
 // jwtClaims implements Claims and resolve permissions based on a JWT payload.
@@ -19,6 +21,7 @@ type jwtClaims struct {
 	jwt.RegisteredClaims
 	System    []Permission            `json:"sys,omitempty"`
 	Instances map[string][]Permission `json:"ins,omitempty"`
+	Attrs     map[string]any          `json:"attr,omitempty"`
 }
 
 func (c *jwtClaims) Subject() string {
@@ -43,6 +46,10 @@ func (c *jwtClaims) CanInstance(instanceID string, p Permission) bool {
 	return c.Can(p)
 }
 
+func (c *jwtClaims) Attributes() map[string]any {
+	return c.Attrs
+}
+
 // openClaims implements Claims and allows all actions.
 // It is used for servers with auth disabled.
 type openClaims struct{}
@@ -59,6 +66,10 @@ func (c openClaims) CanInstance(instanceID string, p Permission) bool {
 	return true
 }
 
+func (c openClaims) Attributes() map[string]any {
+	return nil
+}
+
 // anonClaims imeplements Claims with no permissions.
 // It is used for unauthorized requests when auth is enabled.
 type anonClaims struct{}
@@ -74,3 +85,7 @@ func (c anonClaims) Can(p Permission) bool {
 func (c anonClaims) CanInstance(instanceID string, p Permission) bool {
 	return false
 }
+
+func (c anonClaims) Attributes() map[string]any {
+	return nil
+}
@@ -137,3 +137,12 @@ func parseClaims(ctx context.Context, aud *Audience, authorizationHeader string)
 func WithOpen(ctx context.Context) context.Context {
 	return context.WithValue(ctx, claimsContextKey{}, openClaims{})
 }
+
+// WithUser wraps a context with claims for a user with dashboard access to an instance and the given attributes. It's used for testing.
+func WithUser(ctx context.Context, instanceID string, attrs map[string]any) context.Context {
+	claims := &jwtClaims{
+		Instances: map[string][]Permission{instanceID: {ReadObjects, ReadMetrics, ReadProfiling}},
+		Attrs:     attrs,
+	}
+	return context.WithValue(ctx, claimsContextKey{}, claims)
+}
@@ -21,11 +21,14 @@ func TestTokens(t *testing.T) {
 			TTL:                 time.Duration(time.Hour),
 			SystemPermissions:   []Permission{ManageInstances},
 			InstancePermissions: map[string][]Permission{"example": {ReadOLAP}},
+			Attributes:          map[string]any{"email": "alice@example.org", "groups": []string{"sales"}},
 		})
 
 		claims, err := aud.ParseAndValidate(token)
 		require.NoError(t, err)
 		require.Equal(t, "alice", claims.Subject())
+		require.Equal(t, "alice@example.org", claims.Attributes()["email"])
+		require.Equal(t, []any{"sales"}, claims.Attributes()["groups"])
 		require.True(t, claims.Can(ManageInstances))
 		require.False(t, claims.Can(ReadOLAP))
 		require.True(t, claims.CanInstance("example", ReadOLAP))
@@ -2,12 +2,15 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/server/auth"
+	"github.com/rilldata/rill/runtime/services/catalog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -24,13 +27,20 @@ func (s *Server) ListCatalogEntries(ctx context.Context, req *runtimev1.ListCata
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
-	pbs := make([]*runtimev1.CatalogEntry, len(entries))
-	for i, obj := range entries {
-		var err error
-		pbs[i], err = catalogObjectToPB(obj)
+	pbs := make([]*runtimev1.CatalogEntry, 0, len(entries))
+	for _, obj := range entries {
+		pb, err := catalogObjectToPB(obj)
 		if err != nil {
 			return nil, status.Error(codes.Unknown, err.Error())
 		}
+
+		ok, err := applyMetricsViewPolicy(ctx, pb)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			pbs = append(pbs, pb)
+		}
 	}
 
 	return &runtimev1.ListCatalogEntriesResponse{Entries: pbs}, nil
@@ -52,6 +62,14 @@ func (s *Server) GetCatalogEntry(ctx context.Context, req *runtimev1.GetCatalogE
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
 
+	ok, err := applyMetricsViewPolicy(ctx, pb)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "access to metrics view '%s' denied", req.Name)
+	}
+
 	return &runtimev1.GetCatalogEntryResponse{Entry: pb}, nil
 }
 
@@ -69,9 +87,80 @@ func (s *Server) Reconcile(ctx context.Context, req *runtimev1.ReconcileRequest)
 	return &runtimev1.ReconcileResponse{
 		Errors:        res.Errors,
 		AffectedPaths: res.AffectedPaths,
+		Commit:        res.Commit,
+	}, nil
+}
+
+// PlanReconcile implements RuntimeService.
+func (s *Server) PlanReconcile(ctx context.Context, req *runtimev1.PlanReconcileRequest) (*runtimev1.PlanReconcileResponse, error) {
+	if !auth.GetClaims(ctx).CanInstance(req.InstanceId, auth.EditInstance) {
+		return nil, ErrForbidden
+	}
+
+	plan, err := s.runtime.PlanReconcile(ctx, req.InstanceId, req.ChangedPaths, req.ForcedPaths)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	items := make([]*runtimev1.ReconcilePlanItem, len(plan.Items))
+	for i, item := range plan.Items {
+		reasons := make([]runtimev1.ReconcilePlanItem_Reason, len(item.Reasons))
+		for j, reason := range item.Reasons {
+			// catalog.PlanReason values match the proto enum
+			reasons[j] = runtimev1.ReconcilePlanItem_Reason(reason)
+		}
+		items[i] = &runtimev1.ReconcilePlanItem{
+			Name:           item.Name,
+			Path:           item.Path,
+			Type:           objectTypeToPB(item.Type),
+			Action:         planActionToPB(item.Action),
+			Reasons:        reasons,
+			FromName:       item.FromName,
+			EstimatedBytes: item.EstimatedBytes,
+			Downstream:     item.Downstream,
+		}
+	}
+
+	return &runtimev1.PlanReconcileResponse{
+		Items:  items,
+		Errors: plan.Errors,
 	}, nil
 }
 
+// WatchReconcile implements RuntimeService.
+func (s *Server) WatchReconcile(req *runtimev1.WatchReconcileRequest, srv runtimev1.RuntimeService_WatchReconcileServer) error {
+	if !auth.GetClaims(srv.Context()).CanInstance(req.InstanceId, auth.EditInstance) {
+		return ErrForbidden
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	var sendErr error
+	err := s.runtime.SubscribeReconcile(ctx, req.InstanceId, func(e *runtime.ReconcileEvent) {
+		res := &runtimev1.WatchReconcileResponse{ChangedPaths: e.ChangedPaths}
+		if e.Err != nil {
+			res.Errors = []*runtimev1.ReconcileError{{Message: e.Err.Error()}}
+		} else {
+			res.Errors = e.Result.Errors
+			res.AffectedPaths = e.Result.AffectedPaths
+		}
+
+		sendErr = srv.Send(res)
+		if sendErr != nil {
+			cancel()
+		}
+	})
+	if err != nil {
+		if errors.Is(err, runtime.ErrWatchNotSupported) {
+			return status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return sendErr
+}
+
 // PutFileAndReconcile implements RuntimeService.
 func (s *Server) PutFileAndReconcile(ctx context.Context, req *runtimev1.PutFileAndReconcileRequest) (*runtimev1.PutFileAndReconcileResponse, error) {
 	claims := auth.GetClaims(ctx)
@@ -204,6 +293,40 @@ func pbToObjectType(in runtimev1.ObjectType) drivers.ObjectType {
 	panic(fmt.Errorf("unhandled object type %s", in))
 }
 
+func objectTypeToPB(in drivers.ObjectType) runtimev1.ObjectType {
+	switch in {
+	case drivers.ObjectTypeUnspecified:
+		return runtimev1.ObjectType_OBJECT_TYPE_UNSPECIFIED
+	case drivers.ObjectTypeTable:
+		return runtimev1.ObjectType_OBJECT_TYPE_TABLE
+	case drivers.ObjectTypeSource:
+		return runtimev1.ObjectType_OBJECT_TYPE_SOURCE
+	case drivers.ObjectTypeModel:
+		return runtimev1.ObjectType_OBJECT_TYPE_MODEL
+	case drivers.ObjectTypeMetricsView:
+		return runtimev1.ObjectType_OBJECT_TYPE_METRICS_VIEW
+	}
+	panic(fmt.Errorf("unhandled object type %d", in))
+}
+
+func planActionToPB(in catalog.PlanAction) runtimev1.ReconcilePlanItem_Action {
+	switch in {
+	case catalog.PlanActionNoOp:
+		return runtimev1.ReconcilePlanItem_ACTION_NO_OP
+	case catalog.PlanActionCreate:
+		return runtimev1.ReconcilePlanItem_ACTION_CREATE
+	case catalog.PlanActionUpdate:
+		return runtimev1.ReconcilePlanItem_ACTION_UPDATE
+	case catalog.PlanActionRename:
+		return runtimev1.ReconcilePlanItem_ACTION_RENAME
+	case catalog.PlanActionDrop:
+		return runtimev1.ReconcilePlanItem_ACTION_DROP
+	case catalog.PlanActionRefresh:
+		return runtimev1.ReconcilePlanItem_ACTION_REFRESH
+	}
+	return runtimev1.ReconcilePlanItem_ACTION_UNSPECIFIED
+}
+
 func catalogObjectToPB(obj *drivers.CatalogEntry) (*runtimev1.CatalogEntry, error) {
 	catalog := &runtimev1.CatalogEntry{
 		Name:        obj.Name,
@@ -6,9 +6,11 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"strings"
 
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/server/auth"
+	"google.golang.org/grpc/status"
 )
 
 // ExportTable exports a table or view as a flat file and triggers a HTTP download of it.
@@ -22,33 +24,92 @@ func (s *Server) ExportTable(w http.ResponseWriter, req *http.Request, pathParam
 		return
 	}
 
+	if pathParams["instance_id"] == "" || pathParams["table_name"] == "" {
+		http.Error(w, "missing params", http.StatusBadRequest)
+		return
+	}
+
+	sql := fmt.Sprintf("SELECT * FROM %q", pathParams["table_name"])
+	s.export(w, req, pathParams["instance_id"], pathParams["table_name"], pathParams["format"], sql)
+}
+
+// ExportMetricsView exports the underlying rows of a metrics view as a flat file and triggers a HTTP download of it.
+// Only the metrics view's time dimensions and dimensions are exported, and its access policy is applied.
+// It's mounted as a REST API only, and is not available over gRPC.
+func (s *Server) ExportMetricsView(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+	ctx := req.Context()
+	instanceID := pathParams["instance_id"]
+	name := pathParams["metrics_view_name"]
+
+	if !auth.GetClaims(ctx).CanInstance(instanceID, auth.ReadMetrics) {
+		http.Error(w, "action not allowed", http.StatusUnauthorized)
+		return
+	}
+
+	if instanceID == "" || name == "" {
+		http.Error(w, "missing params", http.StatusBadRequest)
+		return
+	}
+
+	mv, err := s.lookupMetricsView(ctx, instanceID, name)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+		return
+	}
+
+	policy, err := metricsViewPolicy(ctx, mv)
+	if err != nil {
+		http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+		return
+	}
+	mv = policy.Apply(mv)
+
+	var cols []string
+	if mv.TimeDimension != "" {
+		cols = append(cols, safeSQLName(mv.TimeDimension))
+	}
+	for _, td := range mv.TimeDimensions {
+		if td != mv.TimeDimension {
+			cols = append(cols, safeSQLName(td))
+		}
+	}
+	for _, dim := range mv.Dimensions {
+		cols = append(cols, safeSQLName(dim.Name))
+	}
+
+	sql := fmt.Sprintf("SELECT %s FROM %s", strings.Join(cols, ", "), safeSQLName(mv.Model))
+	if policy != nil && policy.RowFilter != "" {
+		sql += fmt.Sprintf(" WHERE %s", policy.RowFilter)
+	}
+
+	s.export(w, req, instanceID, name, pathParams["format"], sql)
+}
+
+// export writes the result of sql to a file in the requested format and streams it to the client (DuckDB only).
+func (s *Server) export(w http.ResponseWriter, req *http.Request, instanceID, name, format, sql string) {
 	var exportString string
-	switch pathParams["format"] {
+	switch format {
 	case "csv":
 		exportString = "FORMAT CSV, HEADER"
 	case "parquet":
 		exportString = "FORMAT PARQUET"
 	default:
-		http.Error(w, fmt.Sprintf("unknown format: %s", pathParams), http.StatusBadRequest)
-	}
-
-	if pathParams["instance_id"] == "" || pathParams["table_name"] == "" {
-		http.Error(w, "missing params", http.StatusBadRequest)
+		http.Error(w, fmt.Sprintf("unknown format: %s", format), http.StatusBadRequest)
 		return
 	}
 
-	fileName := fmt.Sprintf("%s.%s", pathParams["table_name"], pathParams["format"])
+	fileName := fmt.Sprintf("%s.%s", name, format)
 	filePath := path.Join(os.TempDir(), fileName)
 	defer os.Remove(filePath)
 
-	// select * from the table and write to the temp file (DuckDB only)
-	olap, err := s.runtime.OLAP(req.Context(), pathParams["instance_id"])
+	ctx := withQuerySubject(req.Context())
+	olap, err := s.runtime.QueryOLAP(ctx, instanceID)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
-	err = olap.Exec(req.Context(), &drivers.Statement{
-		Query: fmt.Sprintf("COPY (SELECT * FROM %q) TO '%s' (%s)", pathParams["table_name"], filePath, exportString),
+	err = olap.Exec(ctx, &drivers.Statement{
+		Query: fmt.Sprintf("COPY (%s) TO '%s' (%s)", sql, filePath, exportString),
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
@@ -65,9 +126,14 @@ func (s *Server) ExportTable(w http.ResponseWriter, req *http.Request, pathParam
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
+	defer file.Close()
 	_, err = io.Copy(w, file)
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 }
+
+func safeSQLName(name string) string {
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rilldata/rill/runtime/server/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // ListInstances implements RuntimeService.
@@ -56,14 +57,16 @@ func (s *Server) CreateInstance(ctx context.Context, req *runtimev1.CreateInstan
 	}
 
 	inst := &drivers.Instance{
-		ID:                  req.InstanceId,
-		OLAPDriver:          req.OlapDriver,
-		OLAPDSN:             req.OlapDsn,
-		RepoDriver:          req.RepoDriver,
-		RepoDSN:             req.RepoDsn,
-		EmbedCatalog:        req.EmbedCatalog,
-		Variables:           req.Variables,
-		IngestionLimitBytes: req.IngestionLimitBytes,
+		ID:                   req.InstanceId,
+		OLAPDriver:           req.OlapDriver,
+		OLAPDSN:              req.OlapDsn,
+		RepoDriver:           req.RepoDriver,
+		RepoDSN:              req.RepoDsn,
+		EmbedCatalog:         req.EmbedCatalog,
+		Variables:            req.Variables,
+		IngestionLimitBytes:  req.IngestionLimitBytes,
+		ReconcileConcurrency: int(req.ReconcileConcurrency),
+		Profile:              req.Profile,
 	}
 
 	err := s.runtime.CreateInstance(ctx, inst)
@@ -83,14 +86,16 @@ func (s *Server) EditInstance(ctx context.Context, req *runtimev1.EditInstanceRe
 	}
 
 	inst := &drivers.Instance{
-		ID:                  req.InstanceId,
-		OLAPDriver:          req.OlapDriver,
-		OLAPDSN:             req.OlapDsn,
-		RepoDriver:          req.RepoDriver,
-		RepoDSN:             req.RepoDsn,
-		EmbedCatalog:        req.EmbedCatalog,
-		Variables:           req.Variables,
-		IngestionLimitBytes: req.IngestionLimitBytes,
+		ID:                   req.InstanceId,
+		OLAPDriver:           req.OlapDriver,
+		OLAPDSN:              req.OlapDsn,
+		RepoDriver:           req.RepoDriver,
+		RepoDSN:              req.RepoDsn,
+		EmbedCatalog:         req.EmbedCatalog,
+		Variables:            req.Variables,
+		IngestionLimitBytes:  req.IngestionLimitBytes,
+		ReconcileConcurrency: int(req.ReconcileConcurrency),
+		Profile:              req.Profile,
 	}
 
 	err := s.runtime.EditInstance(ctx, inst)
@@ -117,16 +122,40 @@ func (s *Server) DeleteInstance(ctx context.Context, req *runtimev1.DeleteInstan
 	return &runtimev1.DeleteInstanceResponse{}, nil
 }
 
+// GetInstanceUsage implements RuntimeService.
+func (s *Server) GetInstanceUsage(ctx context.Context, req *runtimev1.GetInstanceUsageRequest) (*runtimev1.GetInstanceUsageResponse, error) {
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	usage, err := s.runtime.Usage(ctx, req.InstanceId)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			return nil, status.Error(codes.InvalidArgument, "instance not found")
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &runtimev1.GetInstanceUsageResponse{
+		BytesIngested:     usage.BytesIngested,
+		Queries:           usage.Queries,
+		CachedQueries:     usage.CachedQueries,
+		CountersStartedOn: timestamppb.New(usage.CountersStartedOn),
+	}, nil
+}
+
 func instanceToPB(inst *drivers.Instance) *runtimev1.Instance {
 	return &runtimev1.Instance{
-		InstanceId:          inst.ID,
-		OlapDriver:          inst.OLAPDriver,
-		OlapDsn:             inst.OLAPDSN,
-		RepoDriver:          inst.RepoDriver,
-		RepoDsn:             inst.RepoDSN,
-		EmbedCatalog:        inst.EmbedCatalog,
-		Variables:           inst.Variables,
-		ProjectVariables:    inst.ProjectVariables,
-		IngestionLimitBytes: inst.IngestionLimitBytes,
+		InstanceId:           inst.ID,
+		OlapDriver:           inst.OLAPDriver,
+		OlapDsn:              inst.OLAPDSN,
+		RepoDriver:           inst.RepoDriver,
+		RepoDsn:              inst.RepoDSN,
+		EmbedCatalog:         inst.EmbedCatalog,
+		Variables:            inst.Variables,
+		ProjectVariables:     inst.ProjectVariables,
+		IngestionLimitBytes:  inst.IngestionLimitBytes,
+		ReconcileConcurrency: int32(inst.ReconcileConcurrency),
+		Profile:              inst.Profile,
 	}
 }
@@ -0,0 +1,122 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/queries"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// resolveMetricsViewPolicy evaluates the access policy of a metrics view for the requester.
+// It returns nil if the metrics view doesn't have a policy or the requester isn't subject to it.
+func (s *Server) resolveMetricsViewPolicy(ctx context.Context, instanceID, name string) (*queries.MetricsViewPolicy, error) {
+	if auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, nil
+	}
+
+	mv, err := s.lookupMetricsView(ctx, instanceID, name)
+	if err != nil {
+		return nil, err
+	}
+	return metricsViewPolicy(ctx, mv)
+}
+
+// metricsViewPolicy evaluates a metrics view's access policy against the attributes in the request's claims.
+// Policies don't apply to system admins, which includes all requests when auth is disabled.
+func metricsViewPolicy(ctx context.Context, mv *runtimev1.MetricsView) (*queries.MetricsViewPolicy, error) {
+	claims := auth.GetClaims(ctx)
+	if mv.Policy == nil || claims.Can(auth.ManageInstances) {
+		return nil, nil
+	}
+	return queries.ResolveMetricsViewPolicy(mv, claims.Attributes())
+}
+
+// applyMetricsViewPolicy hides the dimensions and measures of a metrics view catalog entry that the requester can't access.
+// It returns false if the requester doesn't have access to the metrics view at all.
+func applyMetricsViewPolicy(ctx context.Context, entry *runtimev1.CatalogEntry) (bool, error) {
+	mv := entry.GetMetricsView()
+	if mv == nil {
+		return true, nil
+	}
+
+	policy, err := metricsViewPolicy(ctx, mv)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			return false, nil
+		}
+		return false, err
+	}
+	if policy == nil {
+		return true, nil
+	}
+
+	// Apply only copies mv if it excludes something, and mv may be shared with the catalog cache
+	mv = policy.Apply(mv)
+	if mv == entry.GetMetricsView() {
+		mv = proto.Clone(mv).(*runtimev1.MetricsView)
+	}
+	// The policy itself may reveal information about other users
+	mv.Policy = nil
+	entry.Object = &runtimev1.CatalogEntry_MetricsView{MetricsView: mv}
+	return true, nil
+}
+
+func (s *Server) lookupMetricsView(ctx context.Context, instanceID, name string) (*runtimev1.MetricsView, error) {
+	obj, err := s.runtime.GetCatalogEntry(ctx, instanceID, name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if obj.GetMetricsView() == nil {
+		return nil, status.Errorf(codes.NotFound, "object named '%s' is not a metrics view", name)
+	}
+
+	return obj.GetMetricsView(), nil
+}
+
+// checkTableAccess returns a PermissionDenied error if the table is the model of a metrics view with a policy that applies to the requester.
+// Profiling queries read tables directly, so they would otherwise bypass the policy's row filter and excluded fields.
+func (s *Server) checkTableAccess(ctx context.Context, instanceID, tableName string) error {
+	policies, err := s.tablePolicies(ctx, instanceID, tableName)
+	if err != nil {
+		return err
+	}
+	if len(policies) > 0 {
+		return status.Errorf(codes.PermissionDenied, "access to table '%s' is restricted by a metrics view policy", tableName)
+	}
+	return nil
+}
+
+// tablePolicies evaluates the policies of the metrics views on a table for the requester.
+// It returns a PermissionDenied error if one of the policies denies access.
+func (s *Server) tablePolicies(ctx context.Context, instanceID, tableName string) ([]*queries.MetricsViewPolicy, error) {
+	if auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, nil
+	}
+
+	entries, err := s.runtime.ListCatalogEntries(ctx, instanceID, drivers.ObjectTypeMetricsView)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var res []*queries.MetricsViewPolicy
+	for _, entry := range entries {
+		mv := entry.GetMetricsView()
+		if mv.Policy == nil || !strings.EqualFold(mv.Model, tableName) {
+			continue
+		}
+
+		policy, err := metricsViewPolicy(ctx, mv)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, policy)
+	}
+	return res, nil
+}
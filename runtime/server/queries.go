@@ -23,7 +23,7 @@ func (s *Server) Query(ctx context.Context, req *runtimev1.QueryRequest) (*runti
 		args[i] = arg.AsInterface()
 	}
 
-	olap, err := s.runtime.OLAP(ctx, req.InstanceId)
+	olap, err := s.runtime.QueryOLAP(ctx, req.InstanceId)
 	if err != nil {
 		return nil, err
 	}
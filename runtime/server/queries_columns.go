@@ -2,6 +2,8 @@ package server
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/queries"
@@ -15,6 +17,10 @@ func (s *Server) ColumnTopK(ctx context.Context, req *runtimev1.ColumnTopKReques
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	agg := "count(*)"
 	if req.Agg != "" {
 		agg = req.Agg
@@ -51,6 +57,10 @@ func (s *Server) ColumnNullCount(ctx context.Context, req *runtimev1.ColumnNullC
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.ColumnNullCount{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
@@ -71,6 +81,10 @@ func (s *Server) ColumnDescriptiveStatistics(ctx context.Context, req *runtimev1
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.ColumnDescriptiveStatistics{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
@@ -125,6 +139,10 @@ func (s *Server) ColumnTimeGrain(ctx context.Context, req *runtimev1.ColumnTimeG
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.ColumnTimeGrain{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
@@ -143,6 +161,10 @@ func (s *Server) ColumnNumericHistogram(ctx context.Context, req *runtimev1.Colu
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.ColumnNumericHistogram{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
@@ -171,6 +193,10 @@ func (s *Server) ColumnRugHistogram(ctx context.Context, req *runtimev1.ColumnRu
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.ColumnRugHistogram{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
@@ -195,11 +221,24 @@ func (s *Server) ColumnTimeRange(ctx context.Context, req *runtimev1.ColumnTimeR
 		return nil, ErrForbidden
 	}
 
+	// Dashboards use the time range of the model, so apply the row filters of its metrics views' policies instead of denying access
+	policies, err := s.tablePolicies(ctx, req.InstanceId, req.TableName)
+	if err != nil {
+		return nil, err
+	}
+	var filters []string
+	for _, policy := range policies {
+		if policy.RowFilter != "" {
+			filters = append(filters, fmt.Sprintf("(%s)", policy.RowFilter))
+		}
+	}
+
 	q := &queries.ColumnTimeRange{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
+		Filter:     strings.Join(filters, " AND "),
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, status.Error(codes.Unknown, err.Error())
 	}
@@ -213,6 +252,10 @@ func (s *Server) ColumnCardinality(ctx context.Context, req *runtimev1.ColumnCar
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.ColumnCardinality{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
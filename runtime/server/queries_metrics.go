@@ -17,6 +17,11 @@ func (s *Server) MetricsViewToplist(ctx context.Context, req *runtimev1.MetricsV
 		return nil, ErrForbidden
 	}
 
+	policy, err := s.resolveMetricsViewPolicy(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewToplist{
 		MetricsViewName: req.MetricsViewName,
 		DimensionName:   req.DimensionName,
@@ -27,8 +32,10 @@ func (s *Server) MetricsViewToplist(ctx context.Context, req *runtimev1.MetricsV
 		Offset:          req.Offset,
 		Sort:            req.Sort,
 		Filter:          req.Filter,
+		TimeDimension:   req.TimeDimension,
+		Policy:          policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -42,6 +49,11 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		return nil, ErrForbidden
 	}
 
+	policy, err := s.resolveMetricsViewPolicy(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewTimeSeries{
 		MetricsViewName: req.MetricsViewName,
 		MeasureNames:    req.MeasureNames,
@@ -49,9 +61,12 @@ func (s *Server) MetricsViewTimeSeries(ctx context.Context, req *runtimev1.Metri
 		TimeEnd:         req.TimeEnd,
 		TimeGranularity: req.TimeGranularity,
 		Filter:          req.Filter,
+		TimeDimension:   req.TimeDimension,
+		TimeZone:        req.TimeZone,
+		Policy:          policy,
 	}
 
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
@@ -64,31 +79,23 @@ func (s *Server) MetricsViewTotals(ctx context.Context, req *runtimev1.MetricsVi
 		return nil, ErrForbidden
 	}
 
+	policy, err := s.resolveMetricsViewPolicy(ctx, req.InstanceId, req.MetricsViewName)
+	if err != nil {
+		return nil, err
+	}
+
 	q := &queries.MetricsViewTotals{
 		MetricsViewName: req.MetricsViewName,
 		MeasureNames:    req.MeasureNames,
 		TimeStart:       req.TimeStart,
 		TimeEnd:         req.TimeEnd,
 		Filter:          req.Filter,
+		TimeDimension:   req.TimeDimension,
+		Policy:          policy,
 	}
-	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
+	err = s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
 		return nil, err
 	}
 	return q.Result, nil
 }
-
-// Commenting as its unused
-
-// func (s *Server) lookupMetricsView(ctx context.Context, instanceID, name string) (*runtimev1.MetricsView, error) {
-// 	obj, err := s.runtime.GetCatalogEntry(ctx, instanceID, name)
-// 	if err != nil {
-// 		return nil, status.Error(codes.InvalidArgument, err.Error())
-// 	}
-
-// 	if obj.GetMetricsView() == nil {
-// 		return nil, status.Errorf(codes.NotFound, "object named '%s' is not a metrics view", name)
-// 	}
-
-// 	return obj.GetMetricsView(), nil
-// }
@@ -1,11 +1,15 @@
 package server
 
 import (
+	"context"
 	"testing"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/server/auth"
 	"github.com/rilldata/rill/runtime/testruntime"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -855,6 +859,48 @@ func TestServer_MetricsViewTimeSeries(t *testing.T) {
 	require.Equal(t, 1.0, tr.Data[1].Records.Fields["measure_2"].GetNumberValue())
 }
 
+func TestServer_MetricsViewTimeSeries_TimeZone(t *testing.T) {
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	tr, err := server.MetricsViewTimeSeries(testCtx(), &runtimev1.MetricsViewTimeSeriesRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		MeasureNames:    []string{"measure_0"},
+		TimeZone:        "Asia/Kolkata",
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tr.Data))
+
+	require.Equal(t, parseTime(t, "2021-12-31T18:30:00Z"), tr.Data[0].Ts)
+	require.Equal(t, 1.0, tr.Data[0].Records.Fields["measure_0"].GetNumberValue())
+
+	require.Equal(t, parseTime(t, "2022-01-01T18:30:00Z"), tr.Data[1].Ts)
+	require.Equal(t, 1.0, tr.Data[1].Records.Fields["measure_0"].GetNumberValue())
+}
+
+func TestServer_MetricsViewTimeSeries_InvalidTimeDimensionAndZone(t *testing.T) {
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	_, err := server.MetricsViewTimeSeries(testCtx(), &runtimev1.MetricsViewTimeSeriesRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		MeasureNames:    []string{"measure_0"},
+		TimeDimension:   "publisher",
+	})
+	require.ErrorContains(t, err, "time dimension 'publisher' not found")
+
+	_, err = server.MetricsViewTimeSeries(testCtx(), &runtimev1.MetricsViewTimeSeriesRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_metrics",
+		TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		MeasureNames:    []string{"measure_0"},
+		TimeZone:        "Mars/Olympus_Mons",
+	})
+	require.ErrorContains(t, err, "invalid time zone")
+}
+
 func TestServer_MetricsViewTimeSeries_TimeEnd_exclusive(t *testing.T) {
 	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
 
@@ -902,3 +948,183 @@ func TestServer_MetricsViewTimeSeries_complete_source_sanity_test(t *testing.T)
 	require.True(t, tr.Data[0].Records.Fields["measure_0"].GetNumberValue() > 0)
 	require.True(t, tr.Data[0].Records.Fields["measure_1"].GetNumberValue() > 0)
 }
+
+func TestServer_MetricsView_Rollups(t *testing.T) {
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	entry, err := server.runtime.GetCatalogEntry(testCtx(), instanceId, "ad_bids_rollup_metrics")
+	require.NoError(t, err)
+	rollups := entry.GetMetricsView().Rollups
+	require.Equal(t, 2, len(rollups))
+	require.Equal(t, "__rill_rollup_ad_bids_rollup_metrics_publisher_daily", rollups[0].Table)
+	require.Equal(t, int64(2), rollups[0].RowCount)
+
+	// Served from the publisher_daily rollup
+	tl, err := server.MetricsViewToplist(testCtx(), &runtimev1.MetricsViewToplistRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_rollup_metrics",
+		DimensionName:   "publisher",
+		MeasureNames:    []string{"measure_0", "measure_1"},
+		TimeStart:       parseTime(t, "2022-01-01T00:00:00Z"),
+		TimeEnd:         parseTime(t, "2022-01-03T00:00:00Z"),
+		Sort:            []*runtimev1.MetricsViewSort{{Name: "publisher", Ascending: true}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tl.Data))
+	require.Equal(t, "Yahoo", tl.Data[0].Fields["publisher"].GetStringValue())
+	require.Equal(t, 1.0, tl.Data[0].Fields["measure_0"].GetNumberValue())
+	require.Equal(t, 4.0, tl.Data[0].Fields["measure_1"].GetNumberValue())
+
+	// Served from the daily rollup
+	tr, err := server.MetricsViewTotals(testCtx(), &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_rollup_metrics",
+		MeasureNames:    []string{"measure_0"},
+		TimeStart:       parseTime(t, "2022-01-02T00:00:00Z"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1.0, tr.Data.Fields["measure_0"].GetNumberValue())
+
+	// Not aligned to the rollup's time grain, so served from the model
+	tr, err = server.MetricsViewTotals(testCtx(), &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_rollup_metrics",
+		MeasureNames:    []string{"measure_0", "measure_2"},
+		TimeStart:       parseTime(t, "2022-01-01T12:00:00Z"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2.0, tr.Data.Fields["measure_0"].GetNumberValue())
+	require.Equal(t, 4.0, tr.Data.Fields["measure_2"].GetNumberValue())
+
+	ts, err := server.MetricsViewTimeSeries(testCtx(), &runtimev1.MetricsViewTimeSeriesRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_rollup_metrics",
+		TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+		MeasureNames:    []string{"measure_0", "measure_1"},
+		Filter: &runtimev1.MetricsViewFilter{
+			Include: []*runtimev1.MetricsViewFilter_Cond{{Name: "publisher", In: []*structpb.Value{structpb.NewStringValue("Yahoo")}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ts.Data))
+	require.Equal(t, parseTime(t, "2022-01-02T00:00:00Z"), ts.Data[1].Ts)
+	require.Equal(t, 1.0, ts.Data[1].Records.Fields["measure_0"].GetNumberValue())
+	require.Equal(t, 4.0, ts.Data[1].Records.Fields["measure_1"].GetNumberValue())
+}
+
+func TestServer_MetricsView_Policy(t *testing.T) {
+	server, instanceId := getMetricsTestServer(t, "ad_bids_2rows")
+
+	yahoo := auth.WithUser(context.Background(), instanceId, map[string]any{"domain": "yahoo.com", "groups": []any{}})
+	msn := auth.WithUser(context.Background(), instanceId, map[string]any{"domain": "msn.com", "groups": []any{"finance"}})
+	denied := auth.WithUser(context.Background(), instanceId, map[string]any{"domain": "example.org", "groups": []any{}})
+	anon := auth.WithUser(context.Background(), instanceId, nil)
+
+	// Admins are not subject to the policy
+	tr, err := server.MetricsViewTotals(testCtx(), &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_policy_metrics",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2.0, tr.Data.Fields["measure_0"].GetNumberValue())
+
+	// The row filter is applied
+	tr, err = server.MetricsViewTotals(yahoo, &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_policy_metrics",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1.0, tr.Data.Fields["measure_0"].GetNumberValue())
+
+	tl, err := server.MetricsViewToplist(yahoo, &runtimev1.MetricsViewToplistRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_policy_metrics",
+		DimensionName:   "publisher",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tl.Data))
+	require.Equal(t, "Yahoo", tl.Data[0].Fields["publisher"].GetStringValue())
+
+	ts, err := server.MetricsViewTimeSeries(msn, &runtimev1.MetricsViewTimeSeriesRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_policy_metrics",
+		MeasureNames:    []string{"measure_0", "measure_1"},
+		TimeGranularity: runtimev1.TimeGrain_TIME_GRAIN_DAY,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(ts.Data))
+	require.Equal(t, 1.0, ts.Data[0].Records.Fields["measure_0"].GetNumberValue())
+	require.Equal(t, 4.0, ts.Data[0].Records.Fields["measure_1"].GetNumberValue())
+	require.Equal(t, 0.0, ts.Data[1].Records.Fields["measure_0"].GetNumberValue())
+
+	// Excluded measures can't be queried or filtered on
+	_, err = server.MetricsViewTotals(yahoo, &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_policy_metrics",
+		MeasureNames:    []string{"measure_1"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Users that don't match the access condition, or are missing attributes, are denied
+	_, err = server.MetricsViewTotals(denied, &runtimev1.MetricsViewTotalsRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_policy_metrics",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.MetricsViewToplist(anon, &runtimev1.MetricsViewToplistRequest{
+		InstanceId:      instanceId,
+		MetricsViewName: "ad_bids_policy_metrics",
+		DimensionName:   "publisher",
+		MeasureNames:    []string{"measure_0"},
+	})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The catalog hides excluded fields and inaccessible metrics views
+	entry, err := server.GetCatalogEntry(yahoo, &runtimev1.GetCatalogEntryRequest{InstanceId: instanceId, Name: "ad_bids_policy_metrics"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(entry.Entry.GetMetricsView().Measures))
+	require.Nil(t, entry.Entry.GetMetricsView().Policy)
+
+	_, err = server.GetCatalogEntry(denied, &runtimev1.GetCatalogEntryRequest{InstanceId: instanceId, Name: "ad_bids_policy_metrics"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	entries, err := server.ListCatalogEntries(denied, &runtimev1.ListCatalogEntriesRequest{InstanceId: instanceId, Type: runtimev1.ObjectType_OBJECT_TYPE_METRICS_VIEW})
+	require.NoError(t, err)
+	for _, e := range entries.Entries {
+		require.NotEqual(t, "ad_bids_policy_metrics", e.Name)
+	}
+
+	// Profiling queries can't bypass the policy
+	_, err = server.TableRows(yahoo, &runtimev1.TableRowsRequest{InstanceId: instanceId, TableName: "ad_bids"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.ColumnTopK(yahoo, &runtimev1.ColumnTopKRequest{InstanceId: instanceId, TableName: "AD_BIDS", ColumnName: "domain"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = server.TableRows(yahoo, &runtimev1.TableRowsRequest{InstanceId: instanceId, TableName: "no_rows"})
+	require.NoError(t, err)
+
+	// The time range used by dashboards applies the row filter
+	trr, err := server.ColumnTimeRange(yahoo, &runtimev1.ColumnTimeRangeRequest{InstanceId: instanceId, TableName: "ad_bids", ColumnName: "timestamp"})
+	require.NoError(t, err)
+	require.Equal(t, parseTime(t, "2022-01-02T11:58:12.475Z"), trr.TimeRangeSummary.Min)
+	require.Equal(t, trr.TimeRangeSummary.Min, trr.TimeRangeSummary.Max)
+
+	trr, err = server.ColumnTimeRange(testCtx(), &runtimev1.ColumnTimeRangeRequest{InstanceId: instanceId, TableName: "ad_bids", ColumnName: "timestamp"})
+	require.NoError(t, err)
+	require.NotEqual(t, trr.TimeRangeSummary.Min, trr.TimeRangeSummary.Max)
+
+	_, err = server.ColumnTimeRange(denied, &runtimev1.ColumnTimeRangeRequest{InstanceId: instanceId, TableName: "ad_bids", ColumnName: "timestamp"})
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// The original catalog entry is not modified
+	entry, err = server.GetCatalogEntry(testCtx(), &runtimev1.GetCatalogEntryRequest{InstanceId: instanceId, Name: "ad_bids_policy_metrics"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(entry.Entry.GetMetricsView().Measures))
+	require.NotNil(t, entry.Entry.GetMetricsView().Policy)
+}
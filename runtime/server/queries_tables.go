@@ -16,6 +16,10 @@ func (s *Server) TableCardinality(ctx context.Context, req *runtimev1.TableCardi
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.TableCardinality{
 		TableName: req.TableName,
 	}
@@ -39,6 +43,10 @@ func (s *Server) TableColumns(ctx context.Context, req *runtimev1.TableColumnsRe
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.TableColumns{
 		TableName: req.TableName,
 	}
@@ -58,6 +66,10 @@ func (s *Server) TableRows(ctx context.Context, req *runtimev1.TableRowsRequest)
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	limit := int(req.Limit)
 	if limit == 0 {
 		limit = _tableHeadDefaultLimit
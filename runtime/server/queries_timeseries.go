@@ -14,6 +14,10 @@ func (s *Server) ColumnRollupInterval(ctx context.Context, req *runtimev1.Column
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.RollupInterval{
 		TableName:  req.TableName,
 		ColumnName: req.ColumnName,
@@ -31,6 +35,10 @@ func (s *Server) ColumnTimeSeries(ctx context.Context, req *runtimev1.ColumnTime
 		return nil, ErrForbidden
 	}
 
+	if err := s.checkTableAccess(ctx, req.InstanceId, req.TableName); err != nil {
+		return nil, err
+	}
+
 	q := &queries.ColumnTimeseries{
 		TableName:           req.TableName,
 		TimestampColumnName: req.TimestampColumnName,
@@ -39,6 +47,7 @@ func (s *Server) ColumnTimeSeries(ctx context.Context, req *runtimev1.ColumnTime
 		TimeRange:           req.TimeRange,
 		Pixels:              req.Pixels,
 		SampleSize:          req.SampleSize,
+		TimeZone:            req.TimeZone,
 	}
 	err := s.runtime.Query(ctx, req.InstanceId, q, int(req.Priority))
 	if err != nil {
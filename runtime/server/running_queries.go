@@ -0,0 +1,89 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime"
+	"github.com/rilldata/rill/runtime/server/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListRunningQueries implements RuntimeService.
+func (s *Server) ListRunningQueries(ctx context.Context, req *runtimev1.ListRunningQueriesRequest) (*runtimev1.ListRunningQueriesResponse, error) {
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	qs := s.runtime.ListRunningQueries(ctx, req.InstanceId)
+
+	pbs := make([]*runtimev1.RunningQuery, len(qs))
+	for i, q := range qs {
+		pbs[i] = &runtimev1.RunningQuery{
+			Id:         q.ID,
+			InstanceId: q.InstanceID,
+			Sql:        q.SQL,
+			Priority:   int32(q.Priority),
+			Subject:    q.Subject,
+			StartedOn:  timestamppb.New(q.StartedOn),
+			ElapsedMs:  time.Since(q.StartedOn).Milliseconds(),
+			Queued:     q.Queued,
+		}
+	}
+
+	return &runtimev1.ListRunningQueriesResponse{Queries: pbs}, nil
+}
+
+// CancelRunningQuery implements RuntimeService.
+func (s *Server) CancelRunningQuery(ctx context.Context, req *runtimev1.CancelRunningQueryRequest) (*runtimev1.CancelRunningQueryResponse, error) {
+	if !auth.GetClaims(ctx).Can(auth.ManageInstances) {
+		return nil, ErrForbidden
+	}
+
+	err := s.runtime.CancelRunningQuery(ctx, req.InstanceId, req.QueryId)
+	if err != nil {
+		if errors.Is(err, runtime.ErrQueryNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &runtimev1.CancelRunningQueryResponse{}, nil
+}
+
+// querySubjectUnaryServerInterceptor attributes OLAP queries issued during a request to the requester.
+// It must run after the auth interceptor.
+func querySubjectUnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(withQuerySubject(ctx), req)
+	}
+}
+
+// querySubjectStreamServerInterceptor is the streaming equivalent of querySubjectUnaryServerInterceptor.
+func querySubjectStreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &querySubjectServerStream{ServerStream: ss, ctx: withQuerySubject(ss.Context())})
+	}
+}
+
+type querySubjectServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *querySubjectServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func withQuerySubject(ctx context.Context) context.Context {
+	claims := auth.GetClaims(ctx)
+	if claims == nil || claims.Subject() == "" {
+		return ctx
+	}
+	return runtime.WithQuerySubject(ctx, claims.Subject())
+}
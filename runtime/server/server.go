@@ -96,6 +96,7 @@ func (s *Server) ServeGRPC(ctx context.Context) error {
 			observability.RecovererStreamServerInterceptor(),
 			grpc_validator.StreamServerInterceptor(),
 			auth.StreamServerInterceptor(s.aud),
+			querySubjectStreamServerInterceptor(),
 		),
 		grpc.ChainUnaryInterceptor(
 			observability.TracingUnaryServerInterceptor(),
@@ -103,6 +104,7 @@ func (s *Server) ServeGRPC(ctx context.Context) error {
 			observability.RecovererUnaryServerInterceptor(),
 			grpc_validator.UnaryServerInterceptor(),
 			auth.UnaryServerInterceptor(s.aud),
+			querySubjectUnaryServerInterceptor(),
 		),
 	)
 
@@ -153,6 +155,12 @@ func (s *Server) HTTPHandler(ctx context.Context, registerAdditionalHandlers fun
 		panic(err)
 	}
 
+	// One-off REST-only path for metrics view export, which applies the metrics view's access policy
+	err = gwMux.HandlePath("GET", "/v1/instances/{instance_id}/metrics-views/{metrics_view_name}/export/{format}", auth.GatewayMiddleware(s.aud, s.ExportMetricsView))
+	if err != nil {
+		panic(err)
+	}
+
 	// Call callback to register additional paths
 	// NOTE: This is so ugly, but not worth refactoring it properly right now.
 	httpMux := http.NewServeMux()
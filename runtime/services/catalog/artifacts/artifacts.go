@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 	"text/template"
 	"unicode"
@@ -22,6 +23,63 @@ var (
 	ErrInvalidFileName = errors.New("invalid file name")
 )
 
+// MacrosGlob matches the files that define templates (using {{ define "name" }}) that can be used from any artifact
+const MacrosGlob = "macros/*.sql"
+
+// TemplateError is returned by Read when the template of an artifact fails to parse or render.
+// Line and Column are 0 if the error doesn't relate to a position in the artifact (e.g. it's in a macro).
+type TemplateError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *TemplateError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.Err
+}
+
+// templateErrorRegex matches the position in errors from text/template, e.g. "template: models/a.sql:3:5: ..."
+var templateErrorRegex = regexp.MustCompile(`^template: ([^:]+):(\d+)(?::(\d+))?:`)
+
+func newTemplateError(name string, err error) *TemplateError {
+	templateErr := &TemplateError{Err: err}
+	match := templateErrorRegex.FindStringSubmatch(err.Error())
+	if match == nil || match[1] != name {
+		return templateErr
+	}
+	templateErr.Line, _ = strconv.Atoi(match[2])
+	if match[3] != "" {
+		templateErr.Column, _ = strconv.Atoi(match[3])
+	}
+	return templateErr
+}
+
+// Position is a 1-based line and column in an artifact
+type Position struct {
+	Line   int
+	Column int
+}
+
+// ParseError is returned by DeSerialise when the contents of an artifact are invalid at a known position.
+// End is zero if only the start of the invalid value is known.
+type ParseError struct {
+	Start Position
+	End   Position
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
 func Register(name string, artifact Artifact) {
 	if Artifacts[name] != nil {
 		panic(fmt.Errorf("already registered artifact type with name '%s'", name))
@@ -34,6 +92,12 @@ type Artifact interface {
 	Serialise(ctx context.Context, catalogObject *drivers.CatalogEntry) (string, error)
 }
 
+// Locator is implemented by artifacts that can map a property path of their catalog object back to a position in the artifact.
+// It's used to add positions to validation errors, which are reported against the catalog object.
+type Locator interface {
+	Locate(blob string, propertyPath []string) (start, end Position, ok bool)
+}
+
 func Read(ctx context.Context, repoStore drivers.RepoStore, registryStore drivers.RegistryStore, instID, filePath string) (*drivers.CatalogEntry, error) {
 	extension := fileutil.FullExt(filePath)
 	artifact, ok := Artifacts[extension]
@@ -41,14 +105,55 @@ func Read(ctx context.Context, repoStore drivers.RepoStore, registryStore driver
 		return nil, fmt.Errorf("no artifact found for %s", extension)
 	}
 
+	blob, refs, err := render(ctx, repoStore, registryStore, instID, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := artifact.DeSerialise(ctx, filePath, blob)
+	if err != nil {
+		return nil, err
+	}
+
+	if catalog.Type == drivers.ObjectTypeModel {
+		catalog.GetModel().Refs = refs
+	}
+
+	if !IsValidName(fileutil.Stem(filePath)) {
+		return nil, ErrInvalidFileName
+	}
+
+	catalog.Path = filePath
+	return catalog, nil
+}
+
+// Locate returns the position in the artifact at filePath of a property of its catalog object.
+// propertyPath is in the format of runtimev1.ReconcileError.PropertyPath.
+// ok is false if the artifact type doesn't support locating properties or the property isn't in the file.
+func Locate(ctx context.Context, repoStore drivers.RepoStore, registryStore drivers.RegistryStore, instID, filePath string, propertyPath []string) (start, end Position, ok bool) {
+	locator, isLocator := Artifacts[fileutil.FullExt(filePath)].(Locator)
+	if !isLocator || len(propertyPath) == 0 {
+		return Position{}, Position{}, false
+	}
+
+	blob, _, err := render(ctx, repoStore, registryStore, instID, filePath)
+	if err != nil {
+		return Position{}, Position{}, false
+	}
+	return locator.Locate(blob, propertyPath)
+}
+
+// render executes the template of the artifact at filePath.
+// It returns the rendered artifact and the names passed to ref.
+func render(ctx context.Context, repoStore drivers.RepoStore, registryStore drivers.RegistryStore, instID, filePath string) (string, []string, error) {
 	blob, err := repoStore.Get(ctx, instID, filePath)
 	if err != nil {
-		return nil, ErrFileRead
+		return "", nil, ErrFileRead
 	}
 
 	instance, err := registryStore.FindInstance(ctx, instID)
 	if err != nil {
-		return nil, err
+		return "", nil, err
 	}
 
 	// this is required in order to be able to use .env.KEY and not .KEY in template placeholders
@@ -60,28 +165,45 @@ func Read(ctx context.Context, repoStore drivers.RepoStore, registryStore driver
 	delete(funcMap, "env")
 	delete(funcMap, "expandenv")
 
-	// convert templatised artifact
-	t, err := template.New("source").Funcs(funcMap).Option("missingkey=error").Parse(blob)
-	if err != nil {
-		return nil, err
+	// ref returns the name of another object and records it as an explicit dependency
+	var refs []string
+	funcMap["ref"] = func(name string) (string, error) {
+		if !IsValidName(name) {
+			return "", fmt.Errorf("invalid name in ref: %q", name)
+		}
+		refs = append(refs, name)
+		return name, nil
 	}
 
-	bw := new(bytes.Buffer)
-	if err := t.Execute(bw, env); err != nil {
-		return nil, err
-	}
+	t := template.New(filePath).Funcs(funcMap).Option("missingkey=error")
 
-	catalog, err := artifact.DeSerialise(ctx, filePath, bw.String())
+	// add the templates defined in macros
+	macroPaths, err := repoStore.ListRecursive(ctx, instID, MacrosGlob)
 	if err != nil {
-		return nil, err
+		return "", nil, err
+	}
+	for _, macroPath := range macroPaths {
+		macro, err := repoStore.Get(ctx, instID, macroPath)
+		if err != nil {
+			return "", nil, err
+		}
+		_, err = t.New(macroPath).Parse(macro)
+		if err != nil {
+			return "", nil, newTemplateError(filePath, err)
+		}
 	}
 
-	if !IsValidName(fileutil.Stem(filePath)) {
-		return nil, ErrInvalidFileName
+	// convert templatised artifact
+	_, err = t.Parse(blob)
+	if err != nil {
+		return "", nil, newTemplateError(filePath, err)
 	}
 
-	catalog.Path = filePath
-	return catalog, nil
+	bw := new(bytes.Buffer)
+	if err := t.Execute(bw, env); err != nil {
+		return "", nil, newTemplateError(filePath, err)
+	}
+	return bw.String(), refs, nil
 }
 
 func Write(ctx context.Context, repoStore drivers.RepoStore, instID string, catalog *drivers.CatalogEntry) error {
@@ -79,9 +79,10 @@ region: us-east-2
 				Path: "models/Model.sql",
 				Type: drivers.ObjectTypeModel,
 				Object: &runtimev1.Model{
-					Name:    "Model",
-					Sql:     "select * from A",
-					Dialect: runtimev1.Model_DIALECT_DUCKDB,
+					Name:        "Model",
+					Sql:         "select * from A",
+					Dialect:     runtimev1.Model_DIALECT_DUCKDB,
+					RenderedSql: "select * from A",
 				},
 			},
 			"select * from A",
@@ -215,6 +216,95 @@ measures: []
 	}, readCatalog)
 }
 
+func TestMetricsViewTimeDimensionsAndZone(t *testing.T) {
+	dir := t.TempDir()
+	fileStore, err := drivers.Open("file", dir, zap.NewNop())
+	require.NoError(t, err)
+	repoStore, _ := fileStore.RepoStore()
+	ctx := context.Background()
+
+	require.NoError(t, repoStore.Put(ctx, "test", "dashboards/MetricsView.yaml", bytes.NewReader([]byte(`title: dashboard name
+model: Model
+time_dimensions:
+- event_time
+- ingested_at
+default_time_zone: America/New_York
+dimensions: []
+measures: []
+`))))
+
+	readCatalog, err := artifacts.Read(ctx, repoStore, registryStore(t), "test", "dashboards/MetricsView.yaml")
+	require.NoError(t, err)
+	mv := readCatalog.GetMetricsView()
+	require.Equal(t, "event_time", mv.TimeDimension)
+	require.Equal(t, []string{"event_time", "ingested_at"}, mv.TimeDimensions)
+	require.Equal(t, "America/New_York", mv.DefaultTimeZone)
+
+	require.NoError(t, repoStore.Put(ctx, "test", "dashboards/MetricsView.yaml", bytes.NewReader([]byte(`title: dashboard name
+model: Model
+timeseries: time
+default_time_zone: Not/AZone
+dimensions: []
+measures: []
+`))))
+
+	_, err = artifacts.Read(ctx, repoStore, registryStore(t), "test", "dashboards/MetricsView.yaml")
+	require.ErrorContains(t, err, "invalid default_time_zone")
+}
+
+func TestMetricsViewRollups(t *testing.T) {
+	dir := t.TempDir()
+	fileStore, err := drivers.Open("file", dir, zap.NewNop())
+	require.NoError(t, err)
+	repoStore, _ := fileStore.RepoStore()
+	ctx := context.Background()
+
+	require.NoError(t, repoStore.Put(ctx, "test", "dashboards/MetricsView.yaml", bytes.NewReader([]byte(`title: dashboard name
+model: Model
+timeseries: time
+dimensions:
+- property: publisher
+measures:
+- expression: count(*)
+rollups:
+- name: by_publisher
+  time_grain: day
+  dimensions: [publisher]
+  measures: [measure_0]
+- measures: [measure_0]
+`))))
+
+	readCatalog, err := artifacts.Read(ctx, repoStore, registryStore(t), "test", "dashboards/MetricsView.yaml")
+	require.NoError(t, err)
+	rollups := readCatalog.GetMetricsView().Rollups
+	require.Equal(t, 2, len(rollups))
+	require.Equal(t, "by_publisher", rollups[0].Name)
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_DAY, rollups[0].TimeGrain)
+	require.Equal(t, []string{"publisher"}, rollups[0].Dimensions)
+	require.Equal(t, []string{"measure_0"}, rollups[0].Measures)
+	require.Equal(t, "rollup_1", rollups[1].Name)
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED, rollups[1].TimeGrain)
+
+	err = artifacts.Write(ctx, repoStore, "test", readCatalog)
+	require.NoError(t, err)
+	readCatalog2, err := artifacts.Read(ctx, repoStore, registryStore(t), "test", "dashboards/MetricsView.yaml")
+	require.NoError(t, err)
+	require.Equal(t, runtimev1.TimeGrain_TIME_GRAIN_DAY, readCatalog2.GetMetricsView().Rollups[0].TimeGrain)
+
+	require.NoError(t, repoStore.Put(ctx, "test", "dashboards/MetricsView.yaml", bytes.NewReader([]byte(`title: dashboard name
+model: Model
+timeseries: time
+measures:
+- expression: count(*)
+rollups:
+- time_grain: fortnight
+  measures: [measure_0]
+`))))
+
+	_, err = artifacts.Read(ctx, repoStore, registryStore(t), "test", "dashboards/MetricsView.yaml")
+	require.ErrorContains(t, err, "invalid time grain")
+}
+
 func TestReadFailure(t *testing.T) {
 	files := []struct {
 		Name string
@@ -346,9 +436,10 @@ region: {{.env.region}}
 				Path: "models/Model.sql",
 				Type: drivers.ObjectTypeModel,
 				Object: &runtimev1.Model{
-					Name:    "Model",
-					Sql:     "select * from FOO limit 10",
-					Dialect: runtimev1.Model_DIALECT_DUCKDB,
+					Name:        "Model",
+					Sql:         "select * from FOO limit 10",
+					Dialect:     runtimev1.Model_DIALECT_DUCKDB,
+					RenderedSql: "select * from FOO limit 10",
 				},
 			},
 			wantErr: false,
@@ -376,6 +467,39 @@ region: {{.env.region}}
 	}
 }
 
+func TestReadWithMacrosAndRefs(t *testing.T) {
+	repoStore := repoStore(t)
+	registryStore := registryStore(t)
+	ctx := context.Background()
+
+	macros := `{{ define "bids_since" }}select * from {{ ref "AdBids" }} where timestamp >= '{{ . }}'{{ end }}`
+	require.NoError(t, repoStore.Put(ctx, "test", "macros/bids.sql", bytes.NewReader([]byte(macros))))
+
+	content := `-- recent bids
+{{ template "bids_since" "2022-01-01" }}`
+	require.NoError(t, repoStore.Put(ctx, "test", "models/Recent.sql", bytes.NewReader([]byte(content))))
+
+	entry, err := artifacts.Read(ctx, repoStore, registryStore, "test", "models/Recent.sql")
+	require.NoError(t, err)
+	model := entry.GetModel()
+	require.Equal(t, "select * from AdBids where timestamp >= '2022-01-01'", model.Sql)
+	require.Equal(t, "-- recent bids\nselect * from AdBids where timestamp >= '2022-01-01'", model.RenderedSql)
+	require.Equal(t, []string{"AdBids"}, model.Refs)
+
+	// errors in the artifact have a position
+	require.NoError(t, repoStore.Put(ctx, "test", "models/Invalid.sql", bytes.NewReader([]byte("select 1\nfrom {{ ref }}"))))
+	_, err = artifacts.Read(ctx, repoStore, registryStore, "test", "models/Invalid.sql")
+	var templateErr *artifacts.TemplateError
+	require.ErrorAs(t, err, &templateErr)
+	require.Equal(t, 2, templateErr.Line)
+
+	// errors in macros don't
+	require.NoError(t, repoStore.Put(ctx, "test", "macros/invalid.sql", bytes.NewReader([]byte("{{ define \"x\" }}"))))
+	_, err = artifacts.Read(ctx, repoStore, registryStore, "test", "models/Recent.sql")
+	require.ErrorAs(t, err, &templateErr)
+	require.Equal(t, 0, templateErr.Line)
+}
+
 func repoStore(t *testing.T) drivers.RepoStore {
 	dir := t.TempDir()
 	fileStore, err := drivers.Open("file", dir, zap.NewNop())
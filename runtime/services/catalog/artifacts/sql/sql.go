@@ -3,7 +3,9 @@ package sql
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -31,6 +33,10 @@ func (r *artifact) DeSerialise(ctx context.Context, filePath, blob string) (*dri
 	if materialize == MaterializeInvalid {
 		return nil, errors.New("invalid materialize type")
 	}
+	profiles, err := parseProfiles(blob)
+	if err != nil {
+		return nil, err
+	}
 	sanitizedSQL := sanitizeQuery(blob)
 	return &drivers.CatalogEntry{
 		Type: drivers.ObjectTypeModel,
@@ -39,6 +45,8 @@ func (r *artifact) DeSerialise(ctx context.Context, filePath, blob string) (*dri
 			Sql:         sanitizedSQL,
 			Dialect:     runtimev1.Model_DIALECT_DUCKDB,
 			Materialize: materialize.Materialize(),
+			RenderedSql: strings.TrimSpace(blob),
+			Profiles:    profiles,
 		},
 		Name: name,
 		Path: filePath,
@@ -57,6 +65,7 @@ var (
 	MultipleSpacesRegex   = regexp.MustCompile(`\s\s+`)
 	SpacesAfterCommaRegex = regexp.MustCompile(`,\s+`)
 	MaterializedRegex     = regexp.MustCompile(`(?m)^--[ \t]*@materialize[ \t]?:[ \t]*([a-zA-Z]*)\s+`)
+	ProfileRegex          = regexp.MustCompile(`(?m)^--[ \t]*@(dev|prod)\.([a-z_]+)[ \t]?:[ \t]*([^\s]*)`)
 )
 
 // TODO: use this while extracting source names to get case insensitive dag
@@ -117,3 +126,46 @@ func parseMaterializationInfo(query string) MaterializationInfo {
 		return MaterializeInvalid
 	}
 }
+
+// parseProfiles parses the settings for the dev and prod profiles, specified using tags like @dev.limit: in the comments.
+// Supported settings are materialize (true or false), limit (max number of rows) and sample (fraction of rows between 0 and 1).
+func parseProfiles(query string) (map[string]*runtimev1.ModelProfile, error) {
+	matches := ProfileRegex.FindAllStringSubmatch(query, -1)
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	profiles := make(map[string]*runtimev1.ModelProfile)
+	for _, match := range matches {
+		name, key, val := match[1], match[2], match[3]
+		profile, ok := profiles[name]
+		if !ok {
+			profile = &runtimev1.ModelProfile{}
+			profiles[name] = profile
+		}
+
+		switch key {
+		case "materialize":
+			materialize, err := strconv.ParseBool(val)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for @%s.materialize: %q", name, val)
+			}
+			profile.Materialize = &materialize
+		case "limit":
+			limit, err := strconv.ParseInt(val, 10, 64)
+			if err != nil || limit < 0 {
+				return nil, fmt.Errorf("invalid value for @%s.limit: %q", name, val)
+			}
+			profile.RowLimit = limit
+		case "sample":
+			sample, err := strconv.ParseFloat(val, 64)
+			if err != nil || sample <= 0 || sample > 1 {
+				return nil, fmt.Errorf("invalid value for @%s.sample: %q (must be between 0 and 1)", name, val)
+			}
+			profile.SampleFraction = sample
+		default:
+			return nil, fmt.Errorf("unknown setting @%s.%s", name, key)
+		}
+	}
+	return profiles, nil
+}
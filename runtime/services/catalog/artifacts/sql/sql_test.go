@@ -347,3 +347,34 @@ SELECT * from whatever;
 		})
 	}
 }
+
+func Test_parseProfiles(t *testing.T) {
+	profiles, err := parseProfiles(`
+-- @materialize: true
+-- @dev.materialize: false
+-- @dev.limit: 1000
+-- @prod.sample: 0.5
+SELECT * from whatever;
+`)
+	require.NoError(t, err)
+	require.Len(t, profiles, 2)
+	require.False(t, *profiles["dev"].Materialize)
+	require.Equal(t, int64(1000), profiles["dev"].RowLimit)
+	require.Nil(t, profiles["prod"].Materialize)
+	require.Equal(t, 0.5, profiles["prod"].SampleFraction)
+
+	profiles, err = parseProfiles("SELECT * from whatever;")
+	require.NoError(t, err)
+	require.Nil(t, profiles)
+
+	invalid := []string{
+		"-- @dev.materialize: yes\nSELECT 1",
+		"-- @dev.limit: -1\nSELECT 1",
+		"-- @prod.sample: 2\nSELECT 1",
+		"-- @dev.rows: 10\nSELECT 1",
+	}
+	for _, query := range invalid {
+		_, err := parseProfiles(query)
+		require.Error(t, err, query)
+	}
+}
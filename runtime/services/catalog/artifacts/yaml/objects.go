@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/c2h5oh/datasize"
 	"github.com/jinzhu/copier"
@@ -50,11 +51,15 @@ type MetricsView struct {
 	DisplayName       string `yaml:"display_name,omitempty"` // for backwards compatibility
 	Description       string
 	Model             string
-	TimeDimension     string `yaml:"timeseries"`
-	SmallestTimeGrain string `yaml:"smallest_time_grain"`
-	DefaultTimeRange  string `yaml:"default_time_range"`
+	TimeDimension     string   `yaml:"timeseries"`
+	TimeDimensions    []string `yaml:"time_dimensions,omitempty"`
+	DefaultTimeZone   string   `yaml:"default_time_zone,omitempty"`
+	SmallestTimeGrain string   `yaml:"smallest_time_grain"`
+	DefaultTimeRange  string   `yaml:"default_time_range"`
 	Dimensions        []*Dimension
 	Measures          []*Measure
+	Rollups           []*Rollup `yaml:"rollups,omitempty"`
+	Policy            *Policy   `yaml:"policy,omitempty"`
 }
 
 type Measure struct {
@@ -66,6 +71,24 @@ type Measure struct {
 	Ignore      bool   `yaml:"ignore,omitempty"`
 }
 
+type Rollup struct {
+	Name       string
+	Grain      string `yaml:"time_grain,omitempty"` // not named TimeGrain to keep copier from converting it
+	Dimensions []string
+	Measures   []string
+}
+
+type Policy struct {
+	HasAccess string            `yaml:"has_access,omitempty"`
+	RowFilter string            `yaml:"row_filter,omitempty"`
+	Exclude   []*FieldCondition `yaml:"exclude,omitempty"`
+}
+
+type FieldCondition struct {
+	Condition string `yaml:"if"`
+	Names     []string
+}
+
 type Dimension struct {
 	Label       string
 	Property    string `copier:"Name"`
@@ -131,6 +154,9 @@ func toMetricsViewArtifact(catalog *drivers.CatalogEntry) (*MetricsView, error)
 	if err != nil {
 		return nil, err
 	}
+	for i, rollup := range catalog.GetMetricsView().Rollups {
+		metricsArtifact.Rollups[i].Grain = getTimeGrainString(rollup.TimeGrain)
+	}
 
 	return metricsArtifact, nil
 }
@@ -213,7 +239,7 @@ func fromExtractArtifact(policy *ExtractPolicy) (*runtimev1.Source_ExtractPolicy
 		// parse strategy
 		strategy, err := parseStrategy(policy.File.Strategy)
 		if err != nil {
-			return nil, err
+			return nil, newFieldError(err, "extract", "files", "strategy")
 		}
 
 		extractPolicy.FilesStrategy = strategy
@@ -221,10 +247,10 @@ func fromExtractArtifact(policy *ExtractPolicy) (*runtimev1.Source_ExtractPolicy
 		// parse size
 		size, err := strconv.ParseUint(policy.File.Size, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("invalid size, parse failed with error %w", err)
+			return nil, newFieldError(fmt.Errorf("invalid size, parse failed with error %w", err), "extract", "files", "size")
 		}
 		if size <= 0 {
-			return nil, fmt.Errorf("invalid size %q", size)
+			return nil, newFieldError(fmt.Errorf("invalid size %q", size), "extract", "files", "size")
 		}
 
 		extractPolicy.FilesLimit = size
@@ -235,7 +261,7 @@ func fromExtractArtifact(policy *ExtractPolicy) (*runtimev1.Source_ExtractPolicy
 		// parse strategy
 		strategy, err := parseStrategy(policy.Row.Strategy)
 		if err != nil {
-			return nil, err
+			return nil, newFieldError(err, "extract", "rows", "strategy")
 		}
 
 		extractPolicy.RowsStrategy = strategy
@@ -244,10 +270,10 @@ func fromExtractArtifact(policy *ExtractPolicy) (*runtimev1.Source_ExtractPolicy
 		// todo :: add support for number of rows
 		size, err := getBytes(policy.Row.Size)
 		if err != nil {
-			return nil, fmt.Errorf("invalid size, parse failed with error %w", err)
+			return nil, newFieldError(fmt.Errorf("invalid size, parse failed with error %w", err), "extract", "rows", "size")
 		}
 		if size <= 0 {
-			return nil, fmt.Errorf("invalid size %q", size)
+			return nil, newFieldError(fmt.Errorf("invalid size %q", size), "extract", "rows", "size")
 		}
 
 		extractPolicy.RowsLimitBytes = size
@@ -306,11 +332,24 @@ func fromMetricsViewArtifact(metrics *MetricsView, path string) (*drivers.Catalo
 	if metrics.DefaultTimeRange != "" {
 		_, err := duration.ParseISO8601(metrics.DefaultTimeRange)
 		if err != nil {
-			return nil, fmt.Errorf("invalid default_time_range: %w", err)
+			return nil, newFieldError(fmt.Errorf("invalid default_time_range: %w", err), "default_time_range")
 		}
 		apiMetrics.DefaultTimeRange = metrics.DefaultTimeRange
 	}
 
+	// validate correctness of default time zone
+	if metrics.DefaultTimeZone != "" {
+		_, err := time.LoadLocation(metrics.DefaultTimeZone)
+		if err != nil {
+			return nil, newFieldError(fmt.Errorf("invalid default_time_zone: %w", err), "default_time_zone")
+		}
+	}
+
+	// the primary time dimension can be omitted if time dimensions are listed
+	if metrics.TimeDimension == "" && len(metrics.TimeDimensions) > 0 {
+		metrics.TimeDimension = metrics.TimeDimensions[0]
+	}
+
 	err := copier.Copy(apiMetrics, metrics)
 	if err != nil {
 		return nil, err
@@ -325,10 +364,26 @@ func fromMetricsViewArtifact(metrics *MetricsView, path string) (*drivers.Catalo
 
 	timeGrainEnum, err := getTimeGrainEnum(metrics.SmallestTimeGrain)
 	if err != nil {
-		return nil, err
+		return nil, newFieldError(err, "smallest_time_grain")
 	}
 	apiMetrics.SmallestTimeGrain = timeGrainEnum
 
+	rollupNames := make(map[string]bool)
+	for i, rollup := range apiMetrics.Rollups {
+		if rollup.Name == "" {
+			rollup.Name = fmt.Sprintf("rollup_%d", i)
+		}
+		if rollupNames[rollup.Name] {
+			return nil, newFieldError(fmt.Errorf("duplicate rollup name: %s", rollup.Name), "rollups", strconv.Itoa(i))
+		}
+		rollupNames[rollup.Name] = true
+
+		rollup.TimeGrain, err = getTimeGrainEnum(metrics.Rollups[i].Grain)
+		if err != nil {
+			return nil, newFieldError(fmt.Errorf("invalid rollup %q: %w", rollup.Name, err), "rollups", strconv.Itoa(i), "time_grain")
+		}
+	}
+
 	name := fileutil.Stem(path)
 	apiMetrics.Name = name
 	return &drivers.CatalogEntry{
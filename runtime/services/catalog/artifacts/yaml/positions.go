@@ -0,0 +1,221 @@
+package yaml
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// fieldError is an invalid value at path in the artifact, where path is a list of YAML keys and sequence indexes.
+// DeSerialise converts it to an *artifacts.ParseError with the position of the value.
+type fieldError struct {
+	path []string
+	err  error
+}
+
+func (e *fieldError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+func newFieldError(err error, path ...string) error {
+	return &fieldError{path: path, err: err}
+}
+
+// lineErrorRegex matches the line in errors from yaml, e.g. "yaml: line 3: did not find expected key"
+var lineErrorRegex = regexp.MustCompile(`line (\d+): `)
+
+// parseDocument parses blob into a node tree that retains the positions of values.
+// An empty blob returns a nil node.
+func parseDocument(blob string) (*yamlv3.Node, error) {
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal([]byte(blob), &doc); err != nil {
+		return nil, lineError(err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+	return doc.Content[0], nil
+}
+
+// decodeNode decodes node into out and adds the position of the first invalid value to errors.
+func decodeNode(node *yamlv3.Node, out any) error {
+	if node == nil {
+		return nil
+	}
+	if err := node.Decode(out); err != nil {
+		return lineError(err)
+	}
+	return nil
+}
+
+// lineError adds the first line in errors from yaml to err.
+// Only the line is known, so the column is 0.
+func lineError(err error) error {
+	match := lineErrorRegex.FindStringSubmatch(err.Error())
+	if match == nil {
+		return err
+	}
+	line, _ := strconv.Atoi(match[1])
+	return &artifacts.ParseError{
+		Start: artifacts.Position{Line: line},
+		Err:   err,
+	}
+}
+
+// positionError adds the position of a *fieldError in root to err
+func positionError(root *yamlv3.Node, err error) error {
+	var fieldErr *fieldError
+	if !errors.As(err, &fieldErr) {
+		return err
+	}
+	node := lookup(root, fieldErr.path)
+	if node == nil {
+		return fieldErr.err
+	}
+	return &artifacts.ParseError{
+		Start: artifacts.Position{Line: node.Line, Column: node.Column},
+		End:   nodeEnd(node),
+		Err:   fieldErr.err,
+	}
+}
+
+// lookup returns the node at path, where path is a list of YAML keys and sequence indexes
+func lookup(node *yamlv3.Node, path []string) *yamlv3.Node {
+	for _, key := range path {
+		if node == nil {
+			return nil
+		}
+		switch node.Kind {
+		case yamlv3.MappingNode:
+			node = mappingValue(node, key)
+		case yamlv3.SequenceNode:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return nil
+			}
+			node = node.Content[i]
+		default:
+			return nil
+		}
+	}
+	return node
+}
+
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// propertyKeys maps the fields of catalog objects that have a different key in YAML
+var propertyKeys = map[string]string{
+	"Format":        "format_preset",
+	"TimeDimension": "timeseries",
+	"TimeGrain":     "time_grain",
+}
+
+// Locate implements artifacts.Locator.
+// The property path refers to fields of the catalog object, e.g. ["Measures", "2"], so the fields are mapped to their keys in YAML,
+// and indexes skip the items that are removed by "ignore: true".
+func (r *artifact) Locate(blob string, propertyPath []string) (artifacts.Position, artifacts.Position, bool) {
+	node, err := parseDocument(blob)
+	if err != nil || node == nil {
+		return artifacts.Position{}, artifacts.Position{}, false
+	}
+
+	for _, prop := range propertyPath {
+		switch node.Kind {
+		case yamlv3.MappingNode:
+			key, ok := propertyKeys[prop]
+			if !ok {
+				key = toSnakeCase(prop)
+			}
+			node = mappingValue(node, key)
+		case yamlv3.SequenceNode:
+			i, err := strconv.Atoi(prop)
+			if err != nil {
+				return artifacts.Position{}, artifacts.Position{}, false
+			}
+			node = nthIncluded(node, i)
+		default:
+			node = nil
+		}
+		if node == nil {
+			return artifacts.Position{}, artifacts.Position{}, false
+		}
+	}
+
+	return artifacts.Position{Line: node.Line, Column: node.Column}, nodeEnd(node), true
+}
+
+// nthIncluded returns the i-th item of a sequence that isn't ignored
+func nthIncluded(seq *yamlv3.Node, i int) *yamlv3.Node {
+	for _, item := range seq.Content {
+		if item.Kind == yamlv3.MappingNode {
+			if ignore := mappingValue(item, "ignore"); ignore != nil && ignore.Value == "true" {
+				continue
+			}
+		}
+		if i == 0 {
+			return item
+		}
+		i--
+	}
+	return nil
+}
+
+// nodeEnd returns the position right after the value of node.
+// The yaml parser only tracks where values start, so the end of block scalars is the start of the next line.
+func nodeEnd(node *yamlv3.Node) artifacts.Position {
+	switch node.Kind {
+	case yamlv3.MappingNode, yamlv3.SequenceNode:
+		if len(node.Content) == 0 {
+			// "{}" or "[]"
+			return artifacts.Position{Line: node.Line, Column: node.Column + 2}
+		}
+		end := nodeEnd(node.Content[len(node.Content)-1])
+		if node.Style&yamlv3.FlowStyle != 0 {
+			// closing bracket
+			end.Column++
+		}
+		return end
+	case yamlv3.AliasNode:
+		return artifacts.Position{Line: node.Line, Column: node.Column + len(node.Value) + 1}
+	}
+
+	switch {
+	case node.Style&(yamlv3.LiteralStyle|yamlv3.FoldedStyle) != 0:
+		lines := strings.Count(strings.TrimSuffix(node.Value, "\n"), "\n") + 1
+		return artifacts.Position{Line: node.Line + lines + 1, Column: 1}
+	case node.Style&(yamlv3.DoubleQuotedStyle|yamlv3.SingleQuotedStyle) != 0:
+		return artifacts.Position{Line: node.Line, Column: node.Column + len(node.Value) + 2}
+	default:
+		return artifacts.Position{Line: node.Line, Column: node.Column + len(node.Value)}
+	}
+}
+
+func toSnakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
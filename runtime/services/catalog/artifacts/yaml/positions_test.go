@@ -0,0 +1,139 @@
+package yaml
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeSerialiseErrorPositions(t *testing.T) {
+	tests := []struct {
+		name     string
+		filePath string
+		blob     string
+		start    artifacts.Position
+		end      artifacts.Position
+	}{
+		{
+			name:     "syntax error",
+			filePath: "/dashboards/AdBids.yaml",
+			blob:     "model: AdBids_model\nmeasures:\n- expression: count(*)\n name: count\n",
+			start:    artifacts.Position{Line: 3},
+		},
+		{
+			name:     "type error",
+			filePath: "/sources/AdBids.yaml",
+			blob:     "type: local_file\npath:\n  - data/AdBids.csv\n",
+			start:    artifacts.Position{Line: 3},
+		},
+		{
+			name:     "invalid time grain",
+			filePath: "/dashboards/AdBids.yaml",
+			blob:     "model: AdBids_model\nsmallest_time_grain: fortnight\n",
+			start:    artifacts.Position{Line: 2, Column: 22},
+			end:      artifacts.Position{Line: 2, Column: 31},
+		},
+		{
+			name:     "invalid rollup time grain",
+			filePath: "/dashboards/AdBids.yaml",
+			blob:     "model: AdBids_model\nrollups:\n- name: daily\n  time_grain: days\n",
+			start:    artifacts.Position{Line: 4, Column: 15},
+			end:      artifacts.Position{Line: 4, Column: 19},
+		},
+		{
+			name:     "invalid default time range",
+			filePath: "/dashboards/AdBids.yaml",
+			blob:     "model: AdBids_model\ndefault_time_range: \"1 day\"\n",
+			start:    artifacts.Position{Line: 2, Column: 21},
+			end:      artifacts.Position{Line: 2, Column: 28},
+		},
+		{
+			name:     "invalid extract size",
+			filePath: "/sources/AdBids.yaml",
+			blob:     "type: s3\nuri: s3://bucket/*.csv\nextract:\n  files:\n    strategy: head\n    size: lots\n",
+			start:    artifacts.Position{Line: 6, Column: 11},
+			end:      artifacts.Position{Line: 6, Column: 15},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := (&artifact{}).DeSerialise(context.Background(), tt.filePath, tt.blob)
+			var parseErr *artifacts.ParseError
+			require.True(t, errors.As(err, &parseErr), "expected a parse error, got %v", err)
+			require.Equal(t, tt.start, parseErr.Start)
+			require.Equal(t, tt.end, parseErr.End)
+		})
+	}
+}
+
+func Test_Locate(t *testing.T) {
+	blob := `model: AdBids_model
+timeseries: timestamp
+dimensions:
+- property: publisher
+- property: domain
+  ignore: true
+- property: bid_price
+measures:
+- expression: count(*)
+  ignore: true
+- expression: avg(bid_price)
+  format_preset: "humanise"
+policy:
+  exclude:
+  - if: "true"
+    names: [domain]
+`
+	tests := []struct {
+		name         string
+		propertyPath []string
+		start        artifacts.Position
+		end          artifacts.Position
+		notFound     bool
+	}{
+		{
+			name:         "time dimension",
+			propertyPath: []string{"TimeDimension"},
+			start:        artifacts.Position{Line: 2, Column: 13},
+			end:          artifacts.Position{Line: 2, Column: 22},
+		},
+		{
+			name:         "dimension after an ignored dimension",
+			propertyPath: []string{"Dimensions", "1"},
+			start:        artifacts.Position{Line: 7, Column: 3},
+			end:          artifacts.Position{Line: 7, Column: 22},
+		},
+		{
+			name:         "format preset of a measure",
+			propertyPath: []string{"Measures", "0", "Format"},
+			start:        artifacts.Position{Line: 12, Column: 18},
+			end:          artifacts.Position{Line: 12, Column: 28},
+		},
+		{
+			name:         "policy exclude",
+			propertyPath: []string{"Policy", "Exclude", "0"},
+			start:        artifacts.Position{Line: 15, Column: 5},
+			end:          artifacts.Position{Line: 16, Column: 20},
+		},
+		{
+			name:         "missing property",
+			propertyPath: []string{"Rollups", "0"},
+			notFound:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := (&artifact{}).Locate(blob, tt.propertyPath)
+			if tt.notFound {
+				require.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			require.Equal(t, tt.start, start)
+			require.Equal(t, tt.end, end)
+		})
+	}
+}
@@ -21,24 +21,35 @@ func init() {
 
 func (r *artifact) DeSerialise(ctx context.Context, filePath, blob string) (*drivers.CatalogEntry, error) {
 	dir := filepath.Base(filepath.Dir(filePath))
+	if dir != "sources" && dir != "dashboards" {
+		return nil, ErrNotSupported
+	}
+
+	// the node tree retains the positions of values, which are added to errors
+	node, err := parseDocument(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	var catalog *drivers.CatalogEntry
 	switch dir {
 	case "sources":
 		source := &Source{}
-		err := yaml.Unmarshal([]byte(blob), &source)
-		if err != nil {
+		if err := decodeNode(node, source); err != nil {
 			return nil, err
 		}
-		return fromSourceArtifact(source, filePath)
+		catalog, err = fromSourceArtifact(source, filePath)
 	case "dashboards":
 		metrics := &MetricsView{}
-		err := yaml.Unmarshal([]byte(blob), &metrics)
-		if err != nil {
+		if err := decodeNode(node, metrics); err != nil {
 			return nil, err
 		}
-		return fromMetricsViewArtifact(metrics, filePath)
+		catalog, err = fromMetricsViewArtifact(metrics, filePath)
 	}
-
-	return nil, ErrNotSupported
+	if err != nil {
+		return nil, positionError(node, err)
+	}
+	return catalog, nil
 }
 
 func (r *artifact) Serialise(ctx context.Context, catalogObject *drivers.CatalogEntry) (string, error) {
@@ -2,9 +2,11 @@ package catalog
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/pkg/dag"
 	"go.uber.org/zap"
@@ -19,6 +21,10 @@ type Service struct {
 	logger        *zap.Logger
 
 	Meta *MigrationMeta
+
+	// project is rill.yaml and profile is the instance's profile, loaded at the start of a reconcile
+	project *rillv1beta.ProjectConfig
+	profile string
 }
 
 func NewService(
@@ -46,6 +52,7 @@ func NewService(
 }
 
 func (s *Service) FindEntries(ctx context.Context, typ drivers.ObjectType) []*drivers.CatalogEntry {
+	s.loadMeta(ctx)
 	entries := s.Catalog.FindEntries(ctx, s.InstID, typ)
 	for _, entry := range entries {
 		s.Meta.fillDAGInEntry(entry)
@@ -54,6 +61,7 @@ func (s *Service) FindEntries(ctx context.Context, typ drivers.ObjectType) []*dr
 }
 
 func (s *Service) FindEntry(ctx context.Context, name string) (*drivers.CatalogEntry, bool) {
+	s.loadMeta(ctx)
 	entry, ok := s.Catalog.FindEntry(ctx, s.InstID, name)
 	if ok {
 		s.Meta.fillDAGInEntry(entry)
@@ -61,8 +69,18 @@ func (s *Service) FindEntry(ctx context.Context, name string) (*drivers.CatalogE
 	return entry, ok
 }
 
+// loadMeta loads the persisted migration meta if it hasn't been loaded yet.
+// Failures are logged since the meta can always be rebuilt by a full reconcile.
+func (s *Service) loadMeta(ctx context.Context) {
+	err := s.Meta.load(ctx, s.Catalog, s.InstID)
+	if err != nil {
+		s.logger.Warn("failed to load migration meta", zap.Error(err))
+	}
+}
+
+// MigrationMeta is persisted in the catalog store after every reconcile (see drivers.MigrationMeta)
+// and loaded when the first catalog service for the instance is used.
 type MigrationMeta struct {
-	// temporary information. should this be persisted into olap?
 	// LastMigration stores the last time migrate was run. Used to filter out repos that didnt change since this time
 	LastMigration time.Time
 	dag           *dag.DAG
@@ -70,18 +88,132 @@ type MigrationMeta struct {
 	// TODO: should we add path to the DAG instead
 	NameToPath map[string]string
 
+	// fileHashes maps paths to a hash of the contents that were last reconciled successfully
+	fileHashes map[string]string
+	// resumed is true until the first full reconcile after loading persisted meta
+	resumed bool
+
 	hasMigrated bool
 	lock        sync.Mutex
+	loaded      bool
+	loadLock    sync.Mutex
+	// dagLock guards dag and NameToPath against concurrent reads from FindEntry/FindEntries while reconciling,
+	// and against concurrent writes from migrations that run in parallel.
+	dagLock sync.RWMutex
+	// ingestLock serializes source ingestion when the instance has an ingestion limit
+	ingestLock sync.Mutex
 }
 
 func NewMigrationMeta() *MigrationMeta {
 	return &MigrationMeta{
 		dag:        dag.NewDAG(),
 		NameToPath: make(map[string]string),
+		fileHashes: make(map[string]string),
+	}
+}
+
+func (m *MigrationMeta) load(ctx context.Context, store drivers.CatalogStore, instID string) error {
+	m.loadLock.Lock()
+	defer m.loadLock.Unlock()
+	if m.loaded {
+		return nil
 	}
+
+	persisted, err := store.FindMigrationMeta(ctx, instID)
+	if err != nil {
+		if errors.Is(err, drivers.ErrNotFound) {
+			m.loaded = true
+			return nil
+		}
+		return err
+	}
+
+	m.dagLock.Lock()
+	defer m.dagLock.Unlock()
+	for name, deps := range persisted.Dependencies {
+		_, err := m.dag.Add(name, deps)
+		if err != nil {
+			return err
+		}
+	}
+
+	m.LastMigration = persisted.LastMigration
+	for name, path := range persisted.NameToPath {
+		m.NameToPath[name] = path
+	}
+	for path, hash := range persisted.FileHashes {
+		m.fileHashes[path] = hash
+	}
+	m.resumed = true
+	m.hasMigrated = true
+	m.loaded = true
+	return nil
+}
+
+func (m *MigrationMeta) save(ctx context.Context, store drivers.CatalogStore, instID string) error {
+	m.dagLock.RLock()
+	deps := m.dagDependencies()
+	m.dagLock.RUnlock()
+
+	return store.SetMigrationMeta(ctx, instID, &drivers.MigrationMeta{
+		LastMigration: m.LastMigration,
+		Dependencies:  deps,
+		NameToPath:    m.NameToPath,
+		FileHashes:    m.fileHashes,
+	})
+}
+
+// dagDependencies returns the dependencies of the objects in the dag. The caller must hold dagLock.
+func (m *MigrationMeta) dagDependencies() map[string][]string {
+	deps := make(map[string][]string)
+	for name, node := range m.dag.NameMap {
+		if !node.Present {
+			continue
+		}
+		// Include parents that don't exist (yet) so the object is updated if they're added later
+		parents := make([]string, 0, len(node.Parents))
+		for parent := range node.Parents {
+			parents = append(parents, parent)
+		}
+		deps[name] = parents
+	}
+	return deps
 }
 
 func (m *MigrationMeta) fillDAGInEntry(entry *drivers.CatalogEntry) {
+	m.dagLock.RLock()
+	defer m.dagLock.RUnlock()
 	entry.Children = m.dag.GetChildren(normalizeName(entry.Name))
 	entry.Parents = m.dag.GetParents(normalizeName(entry.Name))
 }
+
+func (m *MigrationMeta) addToDAG(name string, dependencies []string) (*dag.Node, error) {
+	m.dagLock.Lock()
+	defer m.dagLock.Unlock()
+	return m.dag.Add(name, dependencies)
+}
+
+func (m *MigrationMeta) deleteFromDAG(name string) {
+	m.dagLock.Lock()
+	defer m.dagLock.Unlock()
+	m.dag.Delete(name)
+}
+
+func (m *MigrationMeta) hasPath(name string) bool {
+	m.dagLock.RLock()
+	defer m.dagLock.RUnlock()
+	_, ok := m.NameToPath[name]
+	return ok
+}
+
+func (m *MigrationMeta) setPath(name, path string) {
+	m.dagLock.Lock()
+	defer m.dagLock.Unlock()
+	m.NameToPath[name] = path
+}
+
+func (m *MigrationMeta) deletePath(name string) {
+	m.dagLock.Lock()
+	defer m.dagLock.Unlock()
+	delete(m.NameToPath, name)
+}
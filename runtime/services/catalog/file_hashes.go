@@ -0,0 +1,102 @@
+package catalog
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
+)
+
+// hashFiles returns the content hashes of paths, or of all artifacts, macros and rill.yaml if paths is empty.
+// Paths that can't be read (usually because they were deleted) are omitted.
+func (s *Service) hashFiles(ctx context.Context, paths []string) (map[string]string, error) {
+	if len(paths) == 0 {
+		var err error
+		paths, err = s.Repo.ListRecursive(ctx, s.InstID, ArtifactsGlob)
+		if err != nil {
+			return nil, err
+		}
+		macroPaths, err := s.Repo.ListRecursive(ctx, s.InstID, artifacts.MacrosGlob)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, macroPaths...)
+		paths = append(paths, projectConfigRepoPath)
+	}
+
+	hashes := make(map[string]string, len(paths))
+	for _, path := range paths {
+		blob, err := s.Repo.Get(ctx, s.InstID, path)
+		if err != nil {
+			continue
+		}
+		sum := sha256.Sum256([]byte(blob))
+		hashes[path] = hex.EncodeToString(sum[:])
+	}
+	return hashes, nil
+}
+
+// changedPaths returns the paths that were added, changed or deleted since the hashes were saved.
+// Files that failed to reconcile don't have a saved hash, so they're always returned.
+func (m *MigrationMeta) changedPaths(hashes map[string]string) []string {
+	changed := make(map[string]bool)
+	for path, hash := range hashes {
+		if m.fileHashes[path] != hash {
+			changed[path] = true
+		}
+	}
+	for path := range m.fileHashes {
+		if _, ok := hashes[path]; !ok {
+			changed[path] = true
+		}
+	}
+
+	paths := make([]string, 0, len(changed))
+	for path := range changed {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// sharedPathsChanged returns true if the hashes of the macros and rill.yaml differ from the saved ones,
+// including macros that were deleted or renamed since the hashes were saved.
+func (m *MigrationMeta) sharedPathsChanged(hashes map[string]string) bool {
+	for path, hash := range hashes {
+		if IsSharedPath(path) && m.fileHashes[path] != hash {
+			return true
+		}
+	}
+	for path := range m.fileHashes {
+		if _, ok := hashes[path]; !ok && IsSharedPath(path) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateFileHashes saves the hashes of the files reconciled without errors.
+// If all is true, the hashes cover the whole repo and replace the existing ones.
+func (m *MigrationMeta) updateFileHashes(paths []string, hashes map[string]string, all bool, errs []*runtimev1.ReconcileError) {
+	if all {
+		m.fileHashes = make(map[string]string, len(hashes))
+		for path, hash := range hashes {
+			m.fileHashes[path] = hash
+		}
+	} else {
+		for _, path := range paths {
+			if hash, ok := hashes[path]; ok {
+				m.fileHashes[path] = hash
+			} else {
+				delete(m.fileHashes, path)
+			}
+		}
+	}
+
+	for _, err := range errs {
+		delete(m.fileHashes, err.FilePath)
+	}
+}
@@ -0,0 +1,27 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedPathsChanged(t *testing.T) {
+	m := &MigrationMeta{fileHashes: map[string]string{
+		"/macros/a.sql":     "1",
+		"/rill.yaml":        "2",
+		"/models/model.sql": "3",
+	}}
+
+	require.False(t, m.sharedPathsChanged(map[string]string{"/macros/a.sql": "1", "/rill.yaml": "2"}))
+	// A macro was updated
+	require.True(t, m.sharedPathsChanged(map[string]string{"/macros/a.sql": "4", "/rill.yaml": "2"}))
+	// A macro was added
+	require.True(t, m.sharedPathsChanged(map[string]string{"/macros/a.sql": "1", "/macros/b.sql": "4", "/rill.yaml": "2"}))
+	// A macro was deleted
+	require.True(t, m.sharedPathsChanged(map[string]string{"/rill.yaml": "2"}))
+	// A macro was renamed
+	require.True(t, m.sharedPathsChanged(map[string]string{"/macros/b.sql": "1", "/rill.yaml": "2"}))
+	// rill.yaml was deleted
+	require.True(t, m.sharedPathsChanged(map[string]string{"/macros/a.sql": "1"}))
+}
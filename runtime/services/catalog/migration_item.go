@@ -21,6 +21,7 @@ type MigrationItem struct {
 	CatalogInStore         *drivers.CatalogEntry
 	NewCatalog             *drivers.CatalogEntry
 	HasChanged             bool
+	UpstreamChanged        bool
 	FromName               string
 	FromNormalizedName     string
 	FromPath               string
@@ -62,6 +63,7 @@ func (s *Service) getMigrationItems(
 		item = s.newMigrationItemFromError(repoPath, err)
 		items = []*MigrationItem{item}
 	} else {
+		s.applyProjectDefaults(catalog)
 		item, items = s.newMigrationItemFromFile(ctx, repoPath, catalog, storeObjectsMap)
 		items = append(items, item)
 		hasFileObject = true
@@ -140,6 +142,14 @@ func (s *Service) newMigrationItemFromError(repoPath string, err error) *Migrati
 			Message:  err.Error(),
 			FilePath: repoPath,
 		}
+		var templateErr *artifacts.TemplateError
+		var parseErr *artifacts.ParseError
+		if errors.As(err, &templateErr) {
+			item.Error.StartLocation = charLocation(artifacts.Position{Line: templateErr.Line, Column: templateErr.Column})
+		} else if errors.As(err, &parseErr) {
+			item.Error.StartLocation = charLocation(parseErr.Start)
+			item.Error.EndLocation = charLocation(parseErr.End)
+		}
 	}
 	item.Type = MigrationDelete
 
@@ -148,6 +158,33 @@ func (s *Service) newMigrationItemFromError(repoPath string, err error) *Migrati
 	return item
 }
 
+// locateErrors sets the positions of validation errors that relate to a property of an artifact
+func (s *Service) locateErrors(ctx context.Context, errs []*runtimev1.ReconcileError) {
+	for _, e := range errs {
+		if e.FilePath == "" || len(e.PropertyPath) == 0 || e.StartLocation != nil {
+			continue
+		}
+		start, end, ok := artifacts.Locate(ctx, s.Repo, s.RegistryStore, s.InstID, e.FilePath, e.PropertyPath)
+		if !ok {
+			continue
+		}
+		e.StartLocation = charLocation(start)
+		e.EndLocation = charLocation(end)
+	}
+}
+
+// charLocation converts a position in an artifact to a location in a ReconcileError.
+// It returns nil if the position is unknown.
+func charLocation(pos artifacts.Position) *runtimev1.ReconcileError_CharLocation {
+	if pos.Line == 0 {
+		return nil
+	}
+	return &runtimev1.ReconcileError_CharLocation{
+		Line:   uint32(pos.Line),
+		Column: uint32(pos.Column),
+	}
+}
+
 func (s *Service) newMigrationItemFromFile(
 	ctx context.Context,
 	repoPath string,
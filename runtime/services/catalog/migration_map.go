@@ -4,28 +4,71 @@ import (
 	"context"
 	"strings"
 
+	"github.com/bmatcuk/doublestar/v4"
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
 	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog/artifacts"
 	"github.com/rilldata/rill/runtime/services/catalog/migrator"
 )
 
+// ArtifactsGlob matches the paths of the code artifacts in a repo
+const ArtifactsGlob = "{sources,models,dashboards}/*.{sql,yaml,yml}"
+
+// IsArtifactPath returns true if path (relative to the repo root) is a code artifact.
+func IsArtifactPath(path string) bool {
+	ok, _ := doublestar.Match(ArtifactsGlob, strings.TrimPrefix(path, "/"))
+	return ok
+}
+
+// IsMacroPath returns true if path (relative to the repo root) defines macros that can be used from any artifact.
+func IsMacroPath(path string) bool {
+	ok, _ := doublestar.Match(artifacts.MacrosGlob, strings.TrimPrefix(path, "/"))
+	return ok
+}
+
+// IsSharedPath returns true if path (relative to the repo root) can change how any artifact is read,
+// i.e. it's a macro or the project's rill.yaml.
+func IsSharedPath(path string) bool {
+	return IsMacroPath(path) || strings.TrimPrefix(path, "/") == rillv1beta.ProjectConfigPath
+}
+
 // getMigrationMap returns a map of string to MigrationItem for all paths or selected paths.
 func (s *Service) getMigrationMap(ctx context.Context, conf ReconcileConfig) (map[string]*MigrationItem, []*runtimev1.ReconcileError, error) {
+	reconcileErrors := make([]*runtimev1.ReconcileError, 0)
+	configErr, err := s.loadProjectConfig(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if configErr != nil {
+		reconcileErrors = append(reconcileErrors, configErr)
+	}
+
 	// TODO: if the repo folder is source controlled we should leverage it to find changes
 	// TODO: ListRecursive needs some kind of cache or optimisation
-	repoPaths := conf.ChangedPaths
-	changedPathsHint := len(conf.ChangedPaths) > 0
+	repoPaths := make([]string, 0, len(conf.ChangedPaths))
 	changedPathsMap := make(map[string]bool)
-	if changedPathsHint {
-		for _, changedPath := range conf.ChangedPaths {
-			changedPathsMap[changedPath] = true
+	sharedChanged := false
+	for _, changedPath := range conf.ChangedPaths {
+		if IsSharedPath(changedPath) {
+			sharedChanged = true
+			continue
 		}
-	} else {
+		repoPaths = append(repoPaths, changedPath)
+		changedPathsMap[changedPath] = true
+	}
+	// every artifact can use a macro or the defaults in rill.yaml, so they all have to be checked when those change
+	changedPathsHint := len(conf.ChangedPaths) > 0 && !sharedChanged
+	if !changedPathsHint {
 		var err error
-		repoPaths, err = s.Repo.ListRecursive(ctx, s.InstID, "{sources,models,dashboards}/*.{sql,yaml,yml}")
+		repoPaths, err = s.Repo.ListRecursive(ctx, s.InstID, ArtifactsGlob)
 		if err != nil {
 			return nil, nil, err
 		}
+		changedPathsMap = make(map[string]bool)
+		if !sharedChanged {
+			sharedChanged = s.sharedChanged(ctx)
+		}
 	}
 
 	forcedPathMap := make(map[string]bool)
@@ -43,7 +86,6 @@ func (s *Service) getMigrationMap(ctx context.Context, conf ReconcileConfig) (ma
 	}
 
 	migrationMap := make(map[string]*MigrationItem)
-	reconcileErrors := make([]*runtimev1.ReconcileError, 0)
 	deletions := make(map[string]*MigrationItem)
 	additions := make(map[string]*MigrationItem)
 
@@ -56,6 +98,10 @@ func (s *Service) getMigrationMap(ctx context.Context, conf ReconcileConfig) (ma
 			items = s.getMigrationItems(ctx, repoPath, storeObjectsMap, forcedPathMap)
 		}
 		for _, item := range items {
+			if sharedChanged {
+				checkRenderedChange(ctx, item)
+			}
+
 			keepNew, errPath := s.isInvalidDuplicate(migrationMap, changedPathsHint, changedPathsMap, item)
 			if errPath != "" {
 				reconcileErrors = append(reconcileErrors, &runtimev1.ReconcileError{
@@ -260,3 +306,44 @@ func (s *Service) checkDeletionForEmbeddedSources(
 		migrationMap[parent] = s.newEmbeddedMigrationItem(parentEntry, MigrationReportUpdate)
 	}
 }
+
+// sharedChanged returns true if a macro or rill.yaml was added, updated or deleted since the last migration.
+// Deletions and renames don't update any modification time, so the files are also compared with the hashes saved by the last migration.
+func (s *Service) sharedChanged(ctx context.Context) bool {
+	paths, err := s.Repo.ListRecursive(ctx, s.InstID, artifacts.MacrosGlob)
+	if err != nil {
+		return false
+	}
+	paths = append(paths, projectConfigRepoPath)
+	for _, path := range paths {
+		stat, err := s.Repo.Stat(ctx, s.InstID, path)
+		if err == nil && stat.LastUpdated.After(s.Meta.LastMigration) {
+			return true
+		}
+	}
+
+	// Nothing to compare with before the first migration
+	if len(s.Meta.fileHashes) == 0 {
+		return false
+	}
+	hashes, err := s.hashFiles(ctx, paths)
+	if err != nil {
+		return false
+	}
+	return s.Meta.sharedPathsChanged(hashes)
+}
+
+// checkRenderedChange marks an unchanged item as updated if it renders differently than the object in the store.
+// Used when a macro or rill.yaml changed, since the artifact itself might not have.
+func checkRenderedChange(ctx context.Context, item *MigrationItem) {
+	if item.Type != MigrationNoChange || item.CatalogInFile == nil || item.CatalogInStore == nil || item.CatalogInFile.Embedded {
+		return
+	}
+	// metrics views never equal the stored object, they're updated when their model is
+	if item.CatalogInFile.Type == drivers.ObjectTypeMetricsView {
+		return
+	}
+	if !migrator.IsEqual(ctx, item.CatalogInFile, item.CatalogInStore) {
+		item.Type = MigrationUpdate
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -12,6 +13,7 @@ import (
 	"github.com/rilldata/rill/runtime/pkg/arrayutil"
 	"github.com/rilldata/rill/runtime/pkg/dag"
 	"github.com/rilldata/rill/runtime/services/catalog/migrator"
+	"go.uber.org/zap"
 
 	// Load migrators
 	_ "github.com/rilldata/rill/runtime/services/catalog/artifacts/sql"
@@ -27,6 +29,9 @@ type ReconcileConfig struct {
 	ChangedPaths      []string
 	ForcedPaths       []string
 	SafeSourceRefresh bool
+	// Transactional makes the reconcile all or nothing. If any migration fails, the remaining migrations are skipped
+	// and the objects changed by the previous ones are restored. It implies SafeSourceRefresh.
+	Transactional bool
 }
 
 type ReconcileResult struct {
@@ -35,6 +40,10 @@ type ReconcileResult struct {
 	DroppedObjects []*drivers.CatalogEntry
 	AffectedPaths  []string
 	Errors         []*runtimev1.ReconcileError
+	// RolledBack is true if a transactional reconcile failed and its migrations were reverted
+	RolledBack bool
+	// Commit is the commit of the repo that was reconciled. It's empty if the repo isn't versioned.
+	Commit string
 }
 
 func NewReconcileResult() *ReconcileResult {
@@ -47,6 +56,13 @@ func NewReconcileResult() *ReconcileResult {
 	}
 }
 
+func (r *ReconcileResult) merge(other *ReconcileResult) {
+	r.AddedObjects = append(r.AddedObjects, other.AddedObjects...)
+	r.UpdatedObjects = append(r.UpdatedObjects, other.UpdatedObjects...)
+	r.DroppedObjects = append(r.DroppedObjects, other.DroppedObjects...)
+	r.Errors = append(r.Errors, other.Errors...)
+}
+
 func (r *ReconcileResult) collectAffectedPaths() {
 	pathDuplicates := make(map[string]bool)
 	for _, added := range r.AddedObjects {
@@ -81,37 +97,106 @@ type ArtifactError struct {
 	Path  string
 }
 
-// TODO: support loading existing projects
-
 func (s *Service) Reconcile(ctx context.Context, conf ReconcileConfig) (*ReconcileResult, error) {
 	s.Meta.lock.Lock()
 	defer s.Meta.lock.Unlock()
 
+	err := s.Meta.load(ctx, s.Catalog, s.InstID)
+	if err != nil {
+		return nil, err
+	}
+
 	result := NewReconcileResult()
 
+	// Files changed while the migration runs must be considered changed by the next reconcile,
+	// so LastMigration is set to the time the migration started rather than when it completed.
+	start := time.Now()
+
+	// Hash the files before migrating them, so changes made while migrating are detected after a restart
+	var hashes map[string]string
+	allPaths := len(conf.ChangedPaths) == 0
+	if !conf.DryRun {
+		hashes, err = s.hashFiles(ctx, conf.ChangedPaths)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// After a restart, a full reconcile only needs to look at the files that changed while the runtime was stopped
+	unchanged := false
+	if allPaths && !conf.DryRun && len(conf.ForcedPaths) == 0 && s.Meta.resumed {
+		conf.ChangedPaths = s.Meta.changedPaths(hashes)
+		unchanged = len(conf.ChangedPaths) == 0
+	}
+
+	if !unchanged {
+		err = s.migrate(ctx, conf, result)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// a reconcile that was rolled back must run again for the same files
+	if !conf.DryRun && !result.RolledBack {
+		s.Meta.LastMigration = start
+		s.Meta.hasMigrated = true
+		if allPaths {
+			s.Meta.resumed = false
+		}
+		s.Meta.updateFileHashes(conf.ChangedPaths, hashes, allPaths, result.Errors)
+		err = s.Meta.save(ctx, s.Catalog, s.InstID)
+		if err != nil {
+			s.logger.Warn("failed to save migration meta", zap.Error(err))
+		}
+	}
+	result.collectAffectedPaths()
+	return result, nil
+}
+
+// migrate collects and runs the migrations for the paths in conf.
+func (s *Service) migrate(ctx context.Context, conf ReconcileConfig, result *ReconcileResult) error {
 	// collect repos and create migration items
 	migrationMap, reconcileErrors, err := s.getMigrationMap(ctx, conf)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	result.Errors = reconcileErrors
 
+	// feature flags in rill.yaml enable the same behavior as the runtime's options
+	if s.project.Features.TransactionalReconcile {
+		conf.Transactional = true
+	}
+	if s.project.Features.SafeSourceRefresh {
+		conf.SafeSourceRefresh = true
+	}
+
 	// order the items to have parents before children
 	migrations, reconcileErrors := s.collectMigrationItems(migrationMap)
 	result.Errors = append(result.Errors, reconcileErrors...)
 
-	err = s.runMigrationItems(ctx, conf, migrations, result)
-	if err != nil {
-		return nil, err
+	var tx *reconcileTx
+	if conf.Transactional && !conf.DryRun {
+		tx = s.beginTx()
 	}
 
-	if !conf.DryRun {
-		// TODO: changes to the file will not be picked up if done while running migration
-		s.Meta.LastMigration = time.Now()
-		s.Meta.hasMigrated = true
+	err = s.runMigrationItems(ctx, conf, tx, migrations, result)
+	if err != nil || tx == nil {
+		return err
 	}
-	result.collectAffectedPaths()
-	return result, nil
+
+	if !tx.failed {
+		err = s.commitTx(ctx, tx, result)
+		if err == nil {
+			return nil
+		}
+		result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+			Code:    runtimev1.ReconcileError_CODE_OLAP,
+			Message: err.Error(),
+		})
+	}
+	s.rollbackTx(ctx, tx, result)
+	result.RolledBack = true
+	return nil
 }
 
 // collectMigrationItems collects all valid MigrationItem
@@ -140,6 +225,9 @@ func (s *Service) collectMigrationItems(
 	}
 
 	for name, item := range migrationMap {
+		if update[name] {
+			item.UpstreamChanged = true
+		}
 		if item.Type == MigrationNoChange {
 			if update[name] {
 				// items identified as to created/updated because a parent changed
@@ -207,6 +295,7 @@ func (s *Service) collectMigrationItems(
 			if item.Type == MigrationNoChange {
 				continue
 			}
+			childItem.UpstreamChanged = true
 			if childItem.Type == MigrationNoChange || childItem.Error != nil {
 				// if the child has no change then mark it as update or create based on presence of catalog in store
 				if childItem.CatalogInStore == nil {
@@ -232,132 +321,289 @@ func (s *Service) collectMigrationItems(
 
 // TODO: test changing source make an invalid model valid. should propagate validity to metrics
 
+// defaultReconcileConcurrency is the number of independent migrations run concurrently
+// when the instance doesn't set ReconcileConcurrency.
+const defaultReconcileConcurrency = 4
+
 // runMigrationItems runs various actions from MigrationItem based on MigrationItem.Type.
+// Items that don't depend on each other are run concurrently, bounded by the instance's ReconcileConcurrency.
+// An item only starts after all the items before it in the order that it depends on have completed.
 func (s *Service) runMigrationItems(
 	ctx context.Context,
 	conf ReconcileConfig,
+	tx *reconcileTx,
 	migrations []*MigrationItem,
 	result *ReconcileResult,
 ) error {
-	for _, item := range migrations {
-		if item.Error != nil {
-			result.Errors = append(result.Errors, item.Error)
-		}
+	inst, err := s.RegistryStore.FindInstance(ctx, s.InstID)
+	if err != nil {
+		return err
+	}
+	concurrency := inst.ReconcileConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultReconcileConcurrency
+	}
+
+	parents := s.migrationParents(migrations)
+	done := make([]chan struct{}, len(migrations))
+	for i := range done {
+		done[i] = make(chan struct{})
+	}
+	sem := make(chan struct{}, concurrency)
 
-		var validationErrors []*runtimev1.ReconcileError
+	var (
+		wg        sync.WaitGroup
+		resultMu  sync.Mutex
+		stopped   bool
+		strictErr error
+	)
+	for i, item := range migrations {
+		wg.Add(1)
+		go func(i int, item *MigrationItem) {
+			defer wg.Done()
+			defer close(done[i])
 
-		if item.CatalogInFile != nil {
-			validationErrors = migrator.Validate(ctx, s.Olap, item.CatalogInFile)
+			for _, parent := range parents[i] {
+				<-done[parent]
+			}
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			resultMu.Lock()
+			skip := stopped
+			resultMu.Unlock()
+			if skip {
+				return
+			}
+
+			itemResult := NewReconcileResult()
+			stop, err := s.runMigrationItem(ctx, conf, tx, item, itemResult)
+
+			resultMu.Lock()
+			defer resultMu.Unlock()
+			result.merge(itemResult)
+			if stop && !stopped {
+				stopped = true
+				strictErr = err
+			}
+		}(i, item)
+	}
+	wg.Wait()
+
+	return strictErr
+}
+
+// migrationParents returns the indexes of the items each item has to wait for.
+// These are the items before it in the order that it depends on, either directly or through objects that are not migrated.
+// Dependencies from the previous reconcile are included, so that children are migrated after a parent is renamed or deleted.
+func (s *Service) migrationParents(migrations []*MigrationItem) [][]int {
+	indexes := make(map[string]int, len(migrations))
+	dependencies := make(map[string][]string, len(migrations))
+	for i, item := range migrations {
+		indexes[item.NormalizedName] = i
+		dependencies[item.NormalizedName] = item.NormalizedDependencies
+		if item.FromName != "" {
+			indexes[strings.ToLower(item.FromNormalizedName)] = i
 		}
+	}
 
-		var err error
-		failed := false
-		if len(validationErrors) > 0 {
-			// do not run migration if validation failed
-			result.Errors = append(result.Errors, validationErrors...)
-			failed = true
-		} else if !conf.DryRun {
-			if item.CatalogInStore != nil {
-				// make sure store catalog has the correct name
-				// could be different in cases like "rename with different case"
-				item.CatalogInStore.Name = item.Name
+	s.Meta.dagLock.RLock()
+	defer s.Meta.dagLock.RUnlock()
+
+	parents := make([][]int, len(migrations))
+	for i, item := range migrations {
+		visited := map[string]bool{item.NormalizedName: true}
+		queue := []string{item.NormalizedName}
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			names := dependencies[name]
+			if s.Meta.dag.Has(name) {
+				names = append(s.Meta.dag.GetParents(name), names...)
 			}
-			// only run the actual migration if in dry run
-			switch item.Type {
-			case MigrationNoChange:
-				recErr := s.addToDag(item)
-				if recErr != nil {
-					result.Errors = append(result.Errors, recErr)
-				}
-			case MigrationCreate:
-				if item.CatalogInFile == nil {
-					break
-				}
-				err = s.createInStore(ctx, item)
-				result.AddedObjects = append(result.AddedObjects, item.CatalogInFile)
-			case MigrationRename:
-				if item.CatalogInFile == nil {
-					break
+			for _, parent := range names {
+				if visited[parent] {
+					continue
 				}
-				err = s.renameInStore(ctx, item)
-				result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
-			case MigrationUpdate:
-				if item.CatalogInFile == nil {
-					break
+				visited[parent] = true
+				queue = append(queue, parent)
+				if j, ok := indexes[parent]; ok && j < i {
+					parents[i] = append(parents[i], j)
 				}
-				err = s.updateInStore(ctx, item)
-				result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
-			case MigrationReportUpdate:
-				// only report the update
-				// UI needs to know when dag changed. we use this for now to notify it
-				// TODO: have a better way to notify UI of DAG changes
-				result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
-				recErr := s.addToDag(item)
-				if recErr != nil {
-					result.Errors = append(result.Errors, recErr)
-				}
-			case MigrationDelete:
-				err = s.deleteInStore(ctx, item)
-				result.DroppedObjects = append(result.DroppedObjects, item.CatalogInStore)
 			}
 		}
+	}
+	return parents
+}
+
+// runMigrationItem runs a single MigrationItem and adds its outcome to result.
+// It returns true if the reconcile should stop because the item failed in strict or transactional mode.
+// In transactional mode, the item is only staged in tx (see stage).
+func (s *Service) runMigrationItem(
+	ctx context.Context,
+	conf ReconcileConfig,
+	tx *reconcileTx,
+	item *MigrationItem,
+	result *ReconcileResult,
+) (bool, error) {
+	if tx != nil {
+		return s.runStagedMigrationItem(ctx, tx, item, result), nil
+	}
+
+	if item.Error != nil {
+		result.Errors = append(result.Errors, item.Error)
+	}
+
+	var validationErrors []*runtimev1.ReconcileError
+
+	if item.CatalogInFile != nil {
+		validationErrors = migrator.Validate(ctx, s.Olap, item.CatalogInFile)
+		s.locateErrors(ctx, validationErrors)
+	}
+
+	var err error
+	failed := false
+	if len(validationErrors) > 0 {
+		// do not run migration if validation failed
+		result.Errors = append(result.Errors, validationErrors...)
+		failed = true
+	} else if !conf.DryRun {
+		if item.CatalogInStore != nil {
+			// make sure store catalog has the correct name
+			// could be different in cases like "rename with different case"
+			item.CatalogInStore.Name = item.Name
+		}
+		// only run the actual migration if in dry run
+		switch item.Type {
+		case MigrationNoChange:
+			recErr := s.addToDag(item)
+			if recErr != nil {
+				result.Errors = append(result.Errors, recErr)
+			}
+		case MigrationCreate:
+			if item.CatalogInFile == nil {
+				break
+			}
+			err = s.createInStore(ctx, item)
+			result.AddedObjects = append(result.AddedObjects, item.CatalogInFile)
+		case MigrationRename:
+			if item.CatalogInFile == nil {
+				break
+			}
+			err = s.renameInStore(ctx, item)
+			result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+		case MigrationUpdate:
+			if item.CatalogInFile == nil {
+				break
+			}
+			err = s.updateInStore(ctx, item)
+			result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+		case MigrationReportUpdate:
+			// only report the update
+			// UI needs to know when dag changed. we use this for now to notify it
+			// TODO: have a better way to notify UI of DAG changes
+			result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+			recErr := s.addToDag(item)
+			if recErr != nil {
+				result.Errors = append(result.Errors, recErr)
+			}
+		case MigrationDelete:
+			err = s.deleteInStore(ctx, item)
+			result.DroppedObjects = append(result.DroppedObjects, item.CatalogInStore)
+		}
+	}
 
+	if err != nil {
+		result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+			Code:     runtimev1.ReconcileError_CODE_OLAP,
+			Message:  err.Error(),
+			FilePath: item.Path,
+		})
+		failed = true
+	}
+
+	if failed && !conf.DryRun {
+		shouldDelete := !conf.SafeSourceRefresh || item.NewCatalog.Type != drivers.ObjectTypeSource
+		var err error
+		if shouldDelete {
+			// remove entity from catalog and OLAP if it failed validation or during migration
+			err = s.Catalog.DeleteEntry(ctx, s.InstID, item.Name)
+			if err != nil {
+				// shouldn't ideally happen
+				result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+					Code:     runtimev1.ReconcileError_CODE_OLAP,
+					Message:  err.Error(),
+					FilePath: item.Path,
+				})
+			}
+		}
+		_, err = s.Meta.addToDAG(item.NormalizedName, item.NormalizedDependencies)
 		if err != nil {
 			result.Errors = append(result.Errors, &runtimev1.ReconcileError{
 				Code:     runtimev1.ReconcileError_CODE_OLAP,
 				Message:  err.Error(),
 				FilePath: item.Path,
 			})
-			failed = true
 		}
-
-		if failed && !conf.DryRun {
-			shouldDelete := !conf.SafeSourceRefresh || item.NewCatalog.Type != drivers.ObjectTypeSource
-			var err error
-			if shouldDelete {
-				// remove entity from catalog and OLAP if it failed validation or during migration
-				err = s.Catalog.DeleteEntry(ctx, s.InstID, item.Name)
-				if err != nil {
-					// shouldn't ideally happen
-					result.Errors = append(result.Errors, &runtimev1.ReconcileError{
-						Code:     runtimev1.ReconcileError_CODE_OLAP,
-						Message:  err.Error(),
-						FilePath: item.Path,
-					})
-				}
-			}
-			_, err = s.Meta.dag.Add(item.NormalizedName, item.NormalizedDependencies)
+		if item.CatalogInStore != nil && shouldDelete {
+			err := migrator.Delete(ctx, s.Olap, item.CatalogInStore)
 			if err != nil {
+				// shouldn't ideally happen
 				result.Errors = append(result.Errors, &runtimev1.ReconcileError{
 					Code:     runtimev1.ReconcileError_CODE_OLAP,
 					Message:  err.Error(),
 					FilePath: item.Path,
 				})
 			}
-			if item.CatalogInStore != nil && shouldDelete {
-				err := migrator.Delete(ctx, s.Olap, item.CatalogInStore)
-				if err != nil {
-					// shouldn't ideally happen
-					result.Errors = append(result.Errors, &runtimev1.ReconcileError{
-						Code:     runtimev1.ReconcileError_CODE_OLAP,
-						Message:  err.Error(),
-						FilePath: item.Path,
-					})
-				}
-			}
-			if conf.Strict {
-				return err
-			}
+		}
+		if conf.Strict {
+			return true, err
 		}
 	}
 
-	return nil
+	return false, nil
+}
+
+// runStagedMigrationItem validates and stages a single MigrationItem of a transactional reconcile.
+// It returns true if the item failed, which stops the reconcile and rolls it back.
+func (s *Service) runStagedMigrationItem(ctx context.Context, tx *reconcileTx, item *MigrationItem, result *ReconcileResult) bool {
+	if item.Error != nil {
+		result.Errors = append(result.Errors, item.Error)
+	}
+
+	if item.CatalogInFile != nil {
+		validationErrors := s.validateStaged(ctx, tx, item)
+		if len(validationErrors) > 0 {
+			s.locateErrors(ctx, validationErrors)
+			result.Errors = append(result.Errors, validationErrors...)
+			tx.fail()
+			return true
+		}
+	}
+
+	if item.CatalogInStore != nil {
+		// make sure store catalog has the correct name
+		// could be different in cases like "rename with different case"
+		item.CatalogInStore.Name = item.Name
+	}
+
+	err := s.stage(ctx, tx, item, result)
+	if err != nil {
+		result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+			Code:     runtimev1.ReconcileError_CODE_OLAP,
+			Message:  err.Error(),
+			FilePath: item.Path,
+		})
+		tx.fail()
+		return true
+	}
+	return false
 }
 
 func (s *Service) createInStore(ctx context.Context, item *MigrationItem) error {
-	s.Meta.NameToPath[item.NormalizedName] = item.Path
+	s.Meta.setPath(item.NormalizedName, item.Path)
 	// add the item to dag
-	_, err := s.Meta.dag.Add(item.NormalizedName, item.NormalizedDependencies)
+	_, err := s.Meta.addToDAG(item.NormalizedName, item.NormalizedDependencies)
 	if err != nil {
 		return err
 	}
@@ -367,7 +613,12 @@ func (s *Service) createInStore(ctx context.Context, item *MigrationItem) error
 		return err
 	}
 
-	// NOTE :: IngestStorageLimitInBytes check will only work if sources are ingested in serial
+	// The remaining limit is computed from the sources ingested so far, so sources are ingested one at a time when there is a limit
+	if item.CatalogInFile.Type == drivers.ObjectTypeSource && s.ingestionLimit(inst) != 0 {
+		s.Meta.ingestLock.Lock()
+		defer s.Meta.ingestLock.Unlock()
+	}
+
 	opts := migrator.Options{
 		InstanceEnv:               inst.ResolveVariables(),
 		IngestStorageLimitInBytes: s.getSourceIngestionLimit(ctx, inst),
@@ -396,12 +647,12 @@ func (s *Service) createInStore(ctx context.Context, item *MigrationItem) error
 
 func (s *Service) renameInStore(ctx context.Context, item *MigrationItem) error {
 	fromLowerName := strings.ToLower(item.FromName)
-	delete(s.Meta.NameToPath, fromLowerName)
-	s.Meta.NameToPath[item.NormalizedName] = item.Path
+	s.Meta.deletePath(fromLowerName)
+	s.Meta.setPath(item.NormalizedName, item.Path)
 
 	// delete old item and add new item to dag
-	s.Meta.dag.Delete(fromLowerName)
-	_, err := s.Meta.dag.Add(item.NormalizedName, item.NormalizedDependencies)
+	s.Meta.deleteFromDAG(fromLowerName)
+	_, err := s.Meta.addToDAG(item.NormalizedName, item.NormalizedDependencies)
 	if err != nil {
 		return err
 	}
@@ -427,9 +678,9 @@ func (s *Service) renameInStore(ctx context.Context, item *MigrationItem) error
 }
 
 func (s *Service) updateInStore(ctx context.Context, item *MigrationItem) error {
-	s.Meta.NameToPath[item.NormalizedName] = item.Path
+	s.Meta.setPath(item.NormalizedName, item.Path)
 	// add the item to dag with new dependencies
-	_, err := s.Meta.dag.Add(item.NormalizedName, item.NormalizedDependencies)
+	_, err := s.Meta.addToDAG(item.NormalizedName, item.NormalizedDependencies)
 	if err != nil {
 		return err
 	}
@@ -439,12 +690,18 @@ func (s *Service) updateInStore(ctx context.Context, item *MigrationItem) error
 		return err
 	}
 
+	if item.CatalogInFile.Type == drivers.ObjectTypeSource && s.ingestionLimit(inst) != 0 {
+		s.Meta.ingestLock.Lock()
+		defer s.Meta.ingestLock.Unlock()
+	}
+
 	// update in olap
 	if item.Type == MigrationUpdate {
 		err = s.wrapMigrator(item.CatalogInFile, func() error {
 			opts := migrator.Options{
 				InstanceEnv:               inst.ResolveVariables(),
 				IngestStorageLimitInBytes: s.getSourceIngestionLimit(ctx, inst),
+				UpstreamChanged:           item.UpstreamChanged,
 			}
 			return migrator.Update(ctx, s.Olap, s.Repo, opts, item.CatalogInStore, item.CatalogInFile)
 		})
@@ -461,10 +718,10 @@ func (s *Service) updateInStore(ctx context.Context, item *MigrationItem) error
 }
 
 func (s *Service) deleteInStore(ctx context.Context, item *MigrationItem) error {
-	delete(s.Meta.NameToPath, item.NormalizedName)
+	s.Meta.deletePath(item.NormalizedName)
 
 	// delete item from dag
-	s.Meta.dag.Delete(item.NormalizedName)
+	s.Meta.deleteFromDAG(item.NormalizedName)
 	// delete item from olap
 	err := migrator.Delete(ctx, s.Olap, item.CatalogInStore)
 	if err != nil {
@@ -519,12 +776,12 @@ func (s *Service) wrapMigrator(catalogEntry *drivers.CatalogEntry, run func() er
 }
 
 func (s *Service) addToDag(item *MigrationItem) *runtimev1.ReconcileError {
-	if _, ok := s.Meta.NameToPath[item.NormalizedName]; ok {
+	if s.Meta.hasPath(item.NormalizedName) {
 		return nil
 	}
 	// this is perhaps an init. so populate cache data
-	s.Meta.NameToPath[item.NormalizedName] = item.Path
-	_, err := s.Meta.dag.Add(item.NormalizedName, item.NormalizedDependencies)
+	s.Meta.setPath(item.NormalizedName, item.Path)
+	_, err := s.Meta.addToDAG(item.NormalizedName, item.NormalizedDependencies)
 	if err != nil {
 		return &runtimev1.ReconcileError{
 			Code:     runtimev1.ReconcileError_CODE_SOURCE,
@@ -536,7 +793,8 @@ func (s *Service) addToDag(item *MigrationItem) *runtimev1.ReconcileError {
 }
 
 func (s *Service) getSourceIngestionLimit(ctx context.Context, inst *drivers.Instance) int64 {
-	if inst.IngestionLimitBytes == 0 {
+	limitInBytes := s.ingestionLimit(inst)
+	if limitInBytes == 0 {
 		return math.MaxInt64
 	}
 
@@ -546,7 +804,6 @@ func (s *Service) getSourceIngestionLimit(ctx context.Context, inst *drivers.Ins
 		sizeSoFar += entry.BytesIngested
 	}
 
-	limitInBytes := inst.IngestionLimitBytes
 	limitInBytes -= sizeSoFar
 	if limitInBytes < 0 {
 		return 0
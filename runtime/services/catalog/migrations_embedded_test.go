@@ -202,9 +202,8 @@ func TestEmbeddedSourceOnNewService(t *testing.T) {
 	addEmbeddedModel(t, s)
 
 	sc, result := copyService(t, s)
-	// no updates other than when a new service is started
-	// dashboards don't have equals check implemented right now. hence it is updated here
-	testutils.AssertMigration(t, result, 0, 0, 2, 0, []string{AdBidsDashboardRepoPath, EmbeddedSourcePath})
+	// no updates since the new service resumes from the persisted migration meta
+	testutils.AssertMigration(t, result, 0, 0, 0, 0, []string{})
 
 	addEmbeddedNewModel(t, s)
 
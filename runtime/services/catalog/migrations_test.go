@@ -613,6 +613,9 @@ measures:
 	// duplicate measure names throws error
 	testutils.AssertMigration(t, result, 1, 0, 0, 0, []string{AdBidsDashboardRepoPath})
 	require.Equal(t, "duplicate measure name", result.Errors[0].Message)
+	// the error points to the measure in the file
+	require.NotNil(t, result.Errors[0].StartLocation)
+	require.NotNil(t, result.Errors[0].EndLocation)
 }
 
 func TestInvalidFiles(t *testing.T) {
@@ -627,6 +630,7 @@ path:
 	require.NoError(t, err)
 	testutils.AssertMigration(t, result, 3, 0, 0, 1, AdBidsAffectedPaths)
 	require.Contains(t, result.Errors[0].Message, "yaml: unmarshal errors")
+	require.Equal(t, uint32(3), result.Errors[0].StartLocation.Line)
 
 	testutils.CreateSource(t, s, "Ad-Bids", "AdBids.csv", "/sources/Ad-Bids.yaml")
 	result, err = s.Reconcile(context.Background(), catalog.ReconcileConfig{
@@ -719,6 +723,120 @@ path: "data/AdBids.csv`))
 	testutils.AssertMigration(t, result, 1, 0, 0, 0, []string{AdBidsNewRepoPath})
 }
 
+func TestReconcileAfterRestart(t *testing.T) {
+	s, _ := initBasicService(t)
+	ctx := context.Background()
+
+	// a new service with an empty meta behaves like a restarted runtime
+	restart := func() *catalog.Service {
+		return catalog.NewService(s.Catalog, s.Repo, s.Olap, s.RegistryStore, s.InstID, nil, catalog.NewMigrationMeta())
+	}
+
+	sc := restart()
+	// the dag is restored before the first reconcile
+	entry, ok := sc.FindEntry(ctx, "AdBids")
+	require.True(t, ok)
+	require.ElementsMatch(t, []string{"adbids_model"}, entry.Children)
+	result, err := sc.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 0, 0, []string{})
+
+	// only the changed model and its children are reconciled
+	testutils.CreateModel(t, s, "AdBids_model",
+		"select id, timestamp, publisher, domain, bid_price, 1 as one from AdBids", AdBidsModelRepoPath)
+	sc = restart()
+	result, err = sc.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 2, 0, AdBidsDashboardAffectedPaths)
+
+	// files that failed are reconciled again after a restart
+	testutils.CreateModel(t, s, "AdBids_model", "select * from AdImpressions", AdBidsModelRepoPath)
+	result, err = sc.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 2, 0, 0, 0, AdBidsDashboardAffectedPaths)
+	sc = restart()
+	result, err = sc.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 2, 0, 0, 0, AdBidsDashboardAffectedPaths)
+
+	// deleted files are dropped
+	testutils.CreateModel(t, s, "AdBids_model",
+		"select id, timestamp, publisher, domain, bid_price from AdBids", AdBidsModelRepoPath)
+	result, err = sc.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 2, 0, 0, AdBidsDashboardAffectedPaths)
+	require.NoError(t, s.Repo.Delete(ctx, s.InstID, AdBidsDashboardRepoPath))
+	sc = restart()
+	result, err = sc.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 0, 0, 1, []string{AdBidsDashboardRepoPath})
+}
+
+func TestReconcileIndependentBranches(t *testing.T) {
+	s, _ := testutils.GetService(t)
+	ctx := context.Background()
+
+	names := []string{"AdBids1", "AdBids2", "AdBids3", "AdBids4", "AdBids5", "AdBids6"}
+	affectedPaths := make([]string, 0)
+	for _, name := range names {
+		sourcePath := "/sources/" + name + ".yaml"
+		modelPath := "/models/" + name + "_model.sql"
+		testutils.CreateSource(t, s, name, AdBidsCsvPath, sourcePath)
+		testutils.CreateModel(t, s, name+"_model", "select id, timestamp, publisher from "+name, modelPath)
+		affectedPaths = append(affectedPaths, sourcePath, modelPath)
+	}
+
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 0, 12, 0, 0, affectedPaths)
+	for _, name := range names {
+		testutils.AssertTable(t, s, name, "/sources/"+name+".yaml")
+		testutils.AssertTable(t, s, name+"_model", "/models/"+name+"_model.sql")
+	}
+
+	// a failing branch doesn't affect the others
+	testutils.CreateModel(t, s, "AdBids1_model", "select id, unknown_column from AdBids1", "/models/AdBids1_model.sql")
+	testutils.CreateModel(t, s, "AdBids2_model", "select id, timestamp from AdBids2", "/models/AdBids2_model.sql")
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	testutils.AssertMigration(t, result, 1, 0, 1, 0, []string{"/models/AdBids1_model.sql", "/models/AdBids2_model.sql"})
+	testutils.AssertTableAbsence(t, s, "AdBids1_model")
+	testutils.AssertTable(t, s, "AdBids2_model", "/models/AdBids2_model.sql")
+}
+
+func TestReconcileTransactional(t *testing.T) {
+	s, _ := initBasicService(t)
+	ctx := context.Background()
+
+	// the model fails to validate once the source has different columns
+	testutils.CreateSource(t, s, "AdBids", AdImpressionsCsvPath, AdBidsRepoPath)
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{Transactional: true})
+	require.NoError(t, err)
+	require.True(t, result.RolledBack)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, AdBidsModelRepoPath, result.Errors[0].FilePath)
+
+	// the source is restored with its previous data
+	entry := testutils.AssertTable(t, s, "AdBids", AdBidsRepoPath)
+	require.Equal(t, "AdBids.csv", filepath.Base(entry.GetSource().Properties.AsMap()["path"].(string)))
+	testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+	testutils.AssertInCatalogStore(t, s, "AdBids_dashboard", AdBidsDashboardRepoPath)
+	testutils.AssertTableAbsence(t, s, "__rill_tx_AdBids")
+
+	// the source is migrated again with the fixed model
+	testutils.CreateModel(t, s, "AdBids_model",
+		"select id, cast('2022-01-01' as timestamp) as timestamp, city as publisher, country as domain, user_id as bid_price from AdBids",
+		AdBidsModelRepoPath)
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{Transactional: true})
+	require.NoError(t, err)
+	require.False(t, result.RolledBack)
+	testutils.AssertMigration(t, result, 0, 0, 3, 0, AdBidsAffectedPaths)
+	entry = testutils.AssertTable(t, s, "AdBids", AdBidsRepoPath)
+	require.Equal(t, "AdImpressions.tsv", filepath.Base(entry.GetSource().Properties.AsMap()["path"].(string)))
+	testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+	testutils.AssertTableAbsence(t, s, "__rill_tx_AdBids")
+}
+
 func initBasicService(t *testing.T) (*catalog.Service, string) {
 	s, dir := testutils.GetService(t)
 	testutils.CreateSource(t, s, "AdBids", AdBidsCsvPath, AdBidsRepoPath)
@@ -764,3 +882,26 @@ func initBasicService(t *testing.T) (*catalog.Service, string) {
 
 	return s, dir
 }
+
+func TestReconcileProjectConfig(t *testing.T) {
+	s, _ := initBasicService(t)
+	ctx := context.Background()
+
+	// models are re-read when the defaults in rill.yaml change
+	require.NoError(t, s.Repo.Put(ctx, s.InstID, "rill.yaml", strings.NewReader("models:\n  materialize: true\n")))
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{ChangedPaths: []string{"/rill.yaml"}})
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+	require.Contains(t, result.AffectedPaths, AdBidsModelRepoPath)
+	entry := testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+	require.True(t, entry.GetModel().Materialize)
+
+	// an invalid rill.yaml is reported with its position
+	require.NoError(t, s.Repo.Put(ctx, s.InstID, "rill.yaml", strings.NewReader("models:\n  materialized: true\n")))
+	result, err = s.Reconcile(ctx, catalog.ReconcileConfig{ChangedPaths: []string{"/rill.yaml"}})
+	require.NoError(t, err)
+	require.Len(t, result.Errors, 1)
+	require.Equal(t, "/rill.yaml", result.Errors[0].FilePath)
+	require.Equal(t, uint32(2), result.Errors[0].StartLocation.Line)
+	require.Equal(t, uint32(3), result.Errors[0].StartLocation.Column)
+}
@@ -10,6 +10,7 @@ import (
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
 	"github.com/rilldata/rill/runtime/drivers"
 	"github.com/rilldata/rill/runtime/services/catalog/migrator"
+	"google.golang.org/protobuf/proto"
 )
 
 func init() {
@@ -25,22 +26,45 @@ const (
 	MissingMeasure       = "at least one measure should be present"
 )
 
+// FormatPresets are the values of format_preset that dashboards can display measures with
+var FormatPresets = map[string]bool{
+	"humanize":     true,
+	"none":         true,
+	"currency_usd": true,
+	"percentage":   true,
+}
+
 type metricsViewMigrator struct{}
 
 func (m *metricsViewMigrator) Create(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, opts migrator.Options, catalogObj *drivers.CatalogEntry) error {
-	return nil
+	return createRollups(ctx, olap, catalogObj)
 }
 
 func (m *metricsViewMigrator) Update(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, opts migrator.Options, oldCatalogObj, newCatalogObj *drivers.CatalogEntry) error {
-	return nil
+	// rollups are only rebuilt if their definition or the model they aggregate changed
+	err := updateRollups(ctx, olap, oldCatalogObj, newCatalogObj, opts.UpstreamChanged)
+	if err != nil {
+		return err
+	}
+	return dropRollups(ctx, olap, oldCatalogObj, newCatalogObj)
 }
 
 func (m *metricsViewMigrator) Rename(ctx context.Context, olap drivers.OLAPStore, from string, catalogObj *drivers.CatalogEntry) error {
-	return nil
+	for _, rollup := range catalogObj.GetMetricsView().Rollups {
+		err := olap.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", migrator.SafeName(rollupTableName(from, rollup.Name))),
+			Priority: 100,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	// the rollup tables are named after the metrics view, so recreate them under the new name
+	return createRollups(ctx, olap, catalogObj)
 }
 
 func (m *metricsViewMigrator) Delete(ctx context.Context, olap drivers.OLAPStore, catalogObj *drivers.CatalogEntry) error {
-	return nil
+	return dropRollups(ctx, olap, catalogObj, nil)
 }
 
 func (m *metricsViewMigrator) GetDependencies(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.CatalogEntry) ([]string, []*drivers.CatalogEntry) {
@@ -55,7 +79,12 @@ func (m *metricsViewMigrator) Validate(ctx context.Context, olap drivers.OLAPSto
 	model, err := olap.InformationSchema().Lookup(ctx, mv.Model)
 	if err != nil {
 		if errors.Is(err, drivers.ErrNotFound) {
-			return migrator.CreateValidationError(catalog.Path, SourceNotFound)
+			return []*runtimev1.ReconcileError{{
+				Code:         runtimev1.ReconcileError_CODE_VALIDATION,
+				FilePath:     catalog.Path,
+				Message:      SourceNotFound,
+				PropertyPath: []string{"Model"},
+			}}
 		}
 		return migrator.CreateValidationError(catalog.Path, err.Error())
 	}
@@ -68,12 +97,28 @@ func (m *metricsViewMigrator) Validate(ctx context.Context, olap drivers.OLAPSto
 	// if a time dimension is selected it should exist
 	if mv.TimeDimension != "" {
 		if _, ok := fieldsMap[strings.ToLower(mv.TimeDimension)]; !ok {
-			return migrator.CreateValidationError(catalog.Path, TimestampNotFound)
+			return []*runtimev1.ReconcileError{{
+				Code:         runtimev1.ReconcileError_CODE_VALIDATION,
+				FilePath:     catalog.Path,
+				Message:      TimestampNotFound,
+				PropertyPath: []string{"TimeDimension"},
+			}}
 		}
 	}
 
 	var validationErrors []*runtimev1.ReconcileError
 
+	for i, td := range mv.TimeDimensions {
+		if _, ok := fieldsMap[strings.ToLower(td)]; !ok {
+			validationErrors = append(validationErrors, &runtimev1.ReconcileError{
+				Code:         runtimev1.ReconcileError_CODE_VALIDATION,
+				FilePath:     catalog.Path,
+				Message:      fmt.Sprintf("time dimension not found: %s", td),
+				PropertyPath: []string{"TimeDimensions", strconv.Itoa(i)},
+			})
+		}
+	}
+
 	for i, dimension := range mv.Dimensions {
 		if _, ok := fieldsMap[strings.ToLower(dimension.Name)]; !ok {
 			validationErrors = append(validationErrors, &runtimev1.ReconcileError{
@@ -98,6 +143,15 @@ func (m *metricsViewMigrator) Validate(ctx context.Context, olap drivers.OLAPSto
 		}
 		measureNames[measure.Name] = true
 
+		if measure.Format != "" && !FormatPresets[measure.Format] {
+			validationErrors = append(validationErrors, &runtimev1.ReconcileError{
+				Code:         runtimev1.ReconcileError_CODE_VALIDATION,
+				FilePath:     catalog.Path,
+				Message:      fmt.Sprintf("invalid format preset: %s", measure.Format),
+				PropertyPath: []string{"Measures", strconv.Itoa(i), "Format"},
+			})
+		}
+
 		err := validateMeasure(ctx, olap, model, measure)
 		if err != nil {
 			validationErrors = append(validationErrors, &runtimev1.ReconcileError{
@@ -108,6 +162,23 @@ func (m *metricsViewMigrator) Validate(ctx context.Context, olap drivers.OLAPSto
 			})
 		}
 	}
+	validationErrors = append(validationErrors, validateRollups(olap, catalog)...)
+
+	if mv.Policy != nil {
+		for i, cond := range mv.Policy.Exclude {
+			for _, name := range cond.Names {
+				if !hasField(mv, name) {
+					validationErrors = append(validationErrors, &runtimev1.ReconcileError{
+						Code:         runtimev1.ReconcileError_CODE_VALIDATION,
+						FilePath:     catalog.Path,
+						Message:      fmt.Sprintf("policy excludes unknown dimension or measure: %s", name),
+						PropertyPath: []string{"Policy", "Exclude", strconv.Itoa(i)},
+					})
+				}
+			}
+		}
+	}
+
 	// at least one measure has to be there in the metrics view
 	if len(mv.Measures) == 0 {
 		validationErrors = append(validationErrors, &runtimev1.ReconcileError{
@@ -121,11 +192,29 @@ func (m *metricsViewMigrator) Validate(ctx context.Context, olap drivers.OLAPSto
 }
 
 func (m *metricsViewMigrator) IsEqual(ctx context.Context, cat1, cat2 *drivers.CatalogEntry) bool {
-	// TODO: do we need a deep check here?
-	return false
+	return proto.Equal(comparableMetricsView(cat1), comparableMetricsView(cat2))
+}
+
+// comparableMetricsView returns a copy of the entry's metrics view without its name and the fields that are set on reconcile.
+func comparableMetricsView(catalog *drivers.CatalogEntry) *runtimev1.MetricsView {
+	mv := proto.Clone(catalog.GetMetricsView()).(*runtimev1.MetricsView)
+	mv.Name = ""
+	for _, rollup := range mv.Rollups {
+		rollup.Table = ""
+		rollup.RowCount = 0
+	}
+	return mv
 }
 
 func (m *metricsViewMigrator) ExistsInOlap(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.CatalogEntry) (bool, error) {
+	for _, rollup := range catalog.GetMetricsView().Rollups {
+		_, err := olap.InformationSchema().Lookup(ctx, rollupTableName(catalog.Name, rollup.Name))
+		if errors.Is(err, drivers.ErrNotFound) {
+			return false, nil
+		} else if err != nil {
+			return false, err
+		}
+	}
 	return true, nil
 }
 
@@ -136,3 +225,17 @@ func validateMeasure(ctx context.Context, olap drivers.OLAPStore, model *drivers
 	})
 	return err
 }
+
+func hasField(mv *runtimev1.MetricsView, name string) bool {
+	for _, dim := range mv.Dimensions {
+		if dim.Name == name {
+			return true
+		}
+	}
+	for _, measure := range mv.Measures {
+		if measure.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+package metricsviews
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIsEqual(t *testing.T) {
+	newEntry := func(mv *runtimev1.MetricsView) *drivers.CatalogEntry {
+		return &drivers.CatalogEntry{
+			Name:      mv.Name,
+			Type:      drivers.ObjectTypeMetricsView,
+			Object:    mv,
+			UpdatedOn: time.Now(),
+		}
+	}
+
+	mv := &runtimev1.MetricsView{
+		Name:          "dashboard",
+		Model:         "model",
+		TimeDimension: "timestamp",
+		Dimensions:    []*runtimev1.MetricsView_Dimension{{Name: "publisher"}},
+		Measures:      []*runtimev1.MetricsView_Measure{{Name: "count", Expression: "count(*)"}},
+		Rollups: []*runtimev1.MetricsView_Rollup{
+			{Name: "daily", Dimensions: []string{"publisher"}, Measures: []string{"count"}, TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY},
+		},
+	}
+
+	// materialized rollups and timestamps are ignored
+	stored := proto.Clone(mv).(*runtimev1.MetricsView)
+	stored.Rollups[0].Table = rollupTableName("dashboard", "daily")
+	stored.Rollups[0].RowCount = 10
+	storedEntry := newEntry(stored)
+	storedEntry.UpdatedOn = time.Now().Add(-time.Hour)
+
+	m := &metricsViewMigrator{}
+	require.True(t, m.IsEqual(context.Background(), newEntry(mv), storedEntry))
+
+	// the comparison doesn't change the entries
+	require.Equal(t, int64(10), stored.Rollups[0].RowCount)
+
+	changed := proto.Clone(mv).(*runtimev1.MetricsView)
+	changed.Measures[0].Label = "Count"
+	require.False(t, m.IsEqual(context.Background(), newEntry(changed), storedEntry))
+
+	changed = proto.Clone(mv).(*runtimev1.MetricsView)
+	changed.Rollups[0].TimeGrain = runtimev1.TimeGrain_TIME_GRAIN_MONTH
+	require.False(t, m.IsEqual(context.Background(), newEntry(changed), storedEntry))
+}
+
+func TestRollupUnchanged(t *testing.T) {
+	mv := &runtimev1.MetricsView{
+		Name:          "dashboard",
+		Model:         "model",
+		TimeDimension: "timestamp",
+		Dimensions:    []*runtimev1.MetricsView_Dimension{{Name: "publisher"}},
+		Measures:      []*runtimev1.MetricsView_Measure{{Name: "count", Expression: "count(*)"}},
+		Rollups: []*runtimev1.MetricsView_Rollup{
+			{Name: "daily", Dimensions: []string{"publisher"}, Measures: []string{"count"}, TimeGrain: runtimev1.TimeGrain_TIME_GRAIN_DAY, Table: rollupTableName("dashboard", "daily")},
+		},
+	}
+	table := rollupTableName("dashboard", "daily")
+
+	// changes to labels don't affect the materialized rollup
+	changed := proto.Clone(mv).(*runtimev1.MetricsView)
+	changed.Measures[0].Label = "Count"
+	changed.Rollups[0].Table = ""
+	require.True(t, rollupUnchanged(mv, mv.Rollups[0], changed, changed.Rollups[0], table))
+
+	// changes to the expression of a rolled up measure do
+	changed = proto.Clone(mv).(*runtimev1.MetricsView)
+	changed.Measures[0].Expression = "sum(bids)"
+	require.False(t, rollupUnchanged(mv, mv.Rollups[0], changed, changed.Rollups[0], table))
+
+	// so do changes to the model
+	changed = proto.Clone(mv).(*runtimev1.MetricsView)
+	changed.Model = "other_model"
+	require.False(t, rollupUnchanged(mv, mv.Rollups[0], changed, changed.Rollups[0], table))
+
+	// and to the table name, e.g. when the metrics view is renamed
+	require.False(t, rollupUnchanged(mv, mv.Rollups[0], mv, mv.Rollups[0], rollupTableName("other", "daily")))
+}
@@ -0,0 +1,269 @@
+package metricsviews
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator"
+)
+
+// RollupTablePrefix is the prefix of the tables rollups are materialized to.
+const RollupTablePrefix = "__rill_rollup_"
+
+// aggregateRegex matches measure expressions that consist of a single count, sum, min or max call.
+var aggregateRegex = regexp.MustCompile(`(?is)^\s*(count|sum|min|max)\s*\((.*)\)\s*$`)
+
+// ReaggregateExpression returns an expression that combines partial aggregates of a measure, which are stored in column.
+// It returns false if the measure's expression can't be computed from partial aggregates (for example, averages or distinct counts).
+func ReaggregateExpression(measure *runtimev1.MetricsView_Measure, column string) (string, bool) {
+	match := aggregateRegex.FindStringSubmatch(measure.Expression)
+	if match == nil {
+		return "", false
+	}
+
+	// Make sure the outer call spans the whole expression, i.e. reject expressions like "sum(a) / sum(b)"
+	arg := match[2]
+	depth := 0
+	for _, c := range arg {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		}
+		if depth < 0 {
+			return "", false
+		}
+	}
+	if depth != 0 {
+		return "", false
+	}
+
+	if strings.HasPrefix(strings.ToLower(strings.TrimSpace(arg)), "distinct") {
+		return "", false
+	}
+
+	fn := strings.ToLower(match[1])
+	if fn == "count" {
+		fn = "sum"
+	}
+	return fmt.Sprintf("%s(%s)", fn, migrator.SafeName(column)), true
+}
+
+func rollupTableName(metricsView, rollup string) string {
+	return fmt.Sprintf("%s%s_%s", RollupTablePrefix, metricsView, rollup)
+}
+
+// createRollups materializes the rollups of the metrics view and records their table names and row counts.
+func createRollups(ctx context.Context, olap drivers.OLAPStore, catalogObj *drivers.CatalogEntry) error {
+	mv := catalogObj.GetMetricsView()
+	for _, rollup := range mv.Rollups {
+		err := createRollup(ctx, olap, catalogObj.Name, mv, rollup)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateRollups materializes the rollups in newObj that are new or whose query changed since oldObj.
+// The tables of unchanged rollups are kept, unless upstreamChanged is true since their model's data may have changed.
+func updateRollups(ctx context.Context, olap drivers.OLAPStore, oldObj, newObj *drivers.CatalogEntry, upstreamChanged bool) error {
+	oldMV := oldObj.GetMetricsView()
+	oldRollups := make(map[string]*runtimev1.MetricsView_Rollup, len(oldMV.Rollups))
+	for _, rollup := range oldMV.Rollups {
+		oldRollups[rollup.Name] = rollup
+	}
+
+	mv := newObj.GetMetricsView()
+	for _, rollup := range mv.Rollups {
+		prev, ok := oldRollups[rollup.Name]
+		if ok && !upstreamChanged && rollupUnchanged(oldMV, prev, mv, rollup, rollupTableName(newObj.Name, rollup.Name)) {
+			rollup.Table = prev.Table
+			rollup.RowCount = prev.RowCount
+			continue
+		}
+
+		err := createRollup(ctx, olap, newObj.Name, mv, rollup)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rollupUnchanged returns true if the materialized table of an old rollup can be used for a new rollup with the given table name.
+func rollupUnchanged(oldMV *runtimev1.MetricsView, oldRollup *runtimev1.MetricsView_Rollup, newMV *runtimev1.MetricsView, newRollup *runtimev1.MetricsView_Rollup, table string) bool {
+	return oldRollup.Table == table && buildRollupSQL(oldMV, oldRollup) == buildRollupSQL(newMV, newRollup)
+}
+
+// createRollup materializes a rollup of a metrics view and records its table name and row count.
+func createRollup(ctx context.Context, olap drivers.OLAPStore, metricsView string, mv *runtimev1.MetricsView, rollup *runtimev1.MetricsView_Rollup) error {
+	rollup.Table = rollupTableName(metricsView, rollup.Name)
+
+	err := olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("CREATE OR REPLACE TABLE %s AS (%s)", migrator.SafeName(rollup.Table), buildRollupSQL(mv, rollup)),
+		Priority: 100,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create rollup %q: %w", rollup.Name, err)
+	}
+
+	rows, err := olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT count(*) FROM %s", migrator.SafeName(rollup.Table)),
+		Priority: 100,
+	})
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		err = rows.Scan(&rollup.RowCount)
+		if err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+// dropRollups drops the tables of rollups in oldObj that are not present in newObj.
+// newObj may be nil to drop all of them.
+func dropRollups(ctx context.Context, olap drivers.OLAPStore, oldObj, newObj *drivers.CatalogEntry) error {
+	keep := make(map[string]bool)
+	if newObj != nil {
+		for _, rollup := range newObj.GetMetricsView().Rollups {
+			keep[rollup.Table] = true
+		}
+	}
+
+	for _, rollup := range oldObj.GetMetricsView().Rollups {
+		if rollup.Table == "" || keep[rollup.Table] {
+			continue
+		}
+		err := olap.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", migrator.SafeName(rollup.Table)),
+			Priority: 100,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildRollupSQL builds the query that pre-aggregates the rollup's measures from the metrics view's model.
+// Columns are named after the time dimension, dimensions and measures they hold.
+func buildRollupSQL(mv *runtimev1.MetricsView, rollup *runtimev1.MetricsView_Rollup) string {
+	var cols []string
+	if rollup.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED {
+		cols = append(cols, fmt.Sprintf("date_trunc('%s', %s) AS %s", timeGrainSpecifier(rollup.TimeGrain), migrator.SafeName(mv.TimeDimension), migrator.SafeName(mv.TimeDimension)))
+	}
+	for _, dim := range rollup.Dimensions {
+		cols = append(cols, migrator.SafeName(dim))
+	}
+	groupBy := make([]string, len(cols))
+	for i := range cols {
+		groupBy[i] = strconv.Itoa(i + 1)
+	}
+
+	for _, name := range rollup.Measures {
+		for _, measure := range mv.Measures {
+			if measure.Name == name {
+				cols = append(cols, fmt.Sprintf("%s AS %s", measure.Expression, migrator.SafeName(measure.Name)))
+				break
+			}
+		}
+	}
+
+	sql := fmt.Sprintf("SELECT %s FROM %s", strings.Join(cols, ", "), migrator.SafeName(mv.Model))
+	if len(groupBy) > 0 {
+		sql += " GROUP BY " + strings.Join(groupBy, ", ")
+	}
+	return sql
+}
+
+// validateRollups checks that the rollups only reference dimensions and re-aggregatable measures of the metrics view.
+func validateRollups(olap drivers.OLAPStore, catalog *drivers.CatalogEntry) []*runtimev1.ReconcileError {
+	mv := catalog.GetMetricsView()
+	if len(mv.Rollups) == 0 {
+		return nil
+	}
+
+	var validationErrors []*runtimev1.ReconcileError
+	addError := func(i int, msg string) {
+		validationErrors = append(validationErrors, &runtimev1.ReconcileError{
+			Code:         runtimev1.ReconcileError_CODE_VALIDATION,
+			FilePath:     catalog.Path,
+			Message:      msg,
+			PropertyPath: []string{"Rollups", strconv.Itoa(i)},
+		})
+	}
+
+	if olap.Dialect() != drivers.DialectDuckDB {
+		addError(0, fmt.Sprintf("rollups are not supported for %s", olap.Dialect().String()))
+		return validationErrors
+	}
+
+	dimensions := make(map[string]bool)
+	for _, dim := range mv.Dimensions {
+		dimensions[dim.Name] = true
+	}
+	measures := make(map[string]*runtimev1.MetricsView_Measure)
+	for _, measure := range mv.Measures {
+		measures[measure.Name] = measure
+	}
+
+	for i, rollup := range mv.Rollups {
+		if rollup.TimeGrain != runtimev1.TimeGrain_TIME_GRAIN_UNSPECIFIED && mv.TimeDimension == "" {
+			addError(i, fmt.Sprintf("rollup %s has a time grain but the metrics view has no time dimension", rollup.Name))
+		}
+		if len(rollup.Measures) == 0 {
+			addError(i, fmt.Sprintf("rollup %s has no measures", rollup.Name))
+		}
+		for _, dim := range rollup.Dimensions {
+			if !dimensions[dim] {
+				addError(i, fmt.Sprintf("rollup %s: dimension not found: %s", rollup.Name, dim))
+			}
+		}
+		for _, name := range rollup.Measures {
+			measure, ok := measures[name]
+			if !ok {
+				addError(i, fmt.Sprintf("rollup %s: measure not found: %s", rollup.Name, name))
+				continue
+			}
+			if _, ok := ReaggregateExpression(measure, name); !ok {
+				addError(i, fmt.Sprintf("rollup %s: measure %s can't be rolled up, only count, sum, min and max are supported", rollup.Name, name))
+			}
+		}
+	}
+	return validationErrors
+}
+
+func timeGrainSpecifier(tg runtimev1.TimeGrain) string {
+	switch tg {
+	case runtimev1.TimeGrain_TIME_GRAIN_MILLISECOND:
+		return "millisecond"
+	case runtimev1.TimeGrain_TIME_GRAIN_SECOND:
+		return "second"
+	case runtimev1.TimeGrain_TIME_GRAIN_MINUTE:
+		return "minute"
+	case runtimev1.TimeGrain_TIME_GRAIN_HOUR:
+		return "hour"
+	case runtimev1.TimeGrain_TIME_GRAIN_DAY:
+		return "day"
+	case runtimev1.TimeGrain_TIME_GRAIN_WEEK:
+		return "week"
+	case runtimev1.TimeGrain_TIME_GRAIN_MONTH:
+		return "month"
+	case runtimev1.TimeGrain_TIME_GRAIN_YEAR:
+		return "year"
+	default:
+		panic(fmt.Errorf("unsupported time grain %q", tg))
+	}
+}
@@ -3,6 +3,7 @@ package migrator
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -30,6 +31,8 @@ func Register(t drivers.ObjectType, artifact EntityMigrator) {
 type Options struct {
 	InstanceEnv               map[string]string
 	IngestStorageLimitInBytes int64
+	// UpstreamChanged is true if an object the migrated object depends on was changed in the same reconcile
+	UpstreamChanged bool
 }
 
 type EntityMigrator interface {
@@ -157,6 +160,11 @@ func LastUpdated(ctx context.Context, instID string, repo drivers.RepoStore, cat
 	return stat.LastUpdated, nil
 }
 
+// SafeName quotes an identifier for use in SQL statements.
+func SafeName(name string) string {
+	return fmt.Sprintf("\"%s\"", strings.ReplaceAll(name, "\"", "\"\""))
+}
+
 func CreateValidationError(filePath, message string) []*runtimev1.ReconcileError {
 	return []*runtimev1.ReconcileError{
 		{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
@@ -19,7 +20,7 @@ func init() {
 type modelMigrator struct{}
 
 func (m *modelMigrator) Create(ctx context.Context, olap drivers.OLAPStore, repo drivers.RepoStore, opts migrator.Options, catalogObj *drivers.CatalogEntry) error {
-	sql := catalogObj.GetModel().Sql
+	sql := limitedSQL(catalogObj.GetModel())
 	materialize := catalogObj.GetModel().Materialize
 	materializeType := getMaterializeType(materialize)
 	return olap.Exec(ctx, &drivers.Statement{
@@ -42,13 +43,14 @@ func (m *modelMigrator) Update(ctx context.Context, olap drivers.OLAPStore, repo
 	newModel := newCatalogObj.GetModel()
 	oldMaterializeType := getMaterializeType(oldModel.Materialize)
 	newMaterializeType := getMaterializeType(newModel.Materialize)
+	sameSQL := limitedSQL(oldModel) == limitedSQL(newModel)
 	// check if sql and materialize type are same and if so, do nothing
 	// this includes the cases where materialize is changed from true to inferred or false to unspecified and vice versa
-	if oldModel.Sql == newModel.Sql && oldMaterializeType == newMaterializeType {
+	if sameSQL && oldMaterializeType == newMaterializeType {
 		return nil
 	}
 	// if sql is changed and materialize type is the same then just update the sql
-	if oldModel.Sql != newModel.Sql && oldMaterializeType == newMaterializeType {
+	if !sameSQL && oldMaterializeType == newMaterializeType {
 		return m.Create(ctx, olap, repo, opts, newCatalogObj)
 	}
 	// else drop the old type and create new materialized type using new sql
@@ -59,6 +61,18 @@ func (m *modelMigrator) Update(ctx context.Context, olap drivers.OLAPStore, repo
 	return m.Create(ctx, olap, repo, opts, newCatalogObj)
 }
 
+// limitedSQL returns the model's SQL with the row limit and sampling of the instance's profile applied
+func limitedSQL(model *runtimev1.Model) string {
+	sql := model.Sql
+	if model.SampleFraction > 0 && model.SampleFraction < 1 {
+		sql = fmt.Sprintf("SELECT * FROM (%s) USING SAMPLE %s PERCENT (bernoulli)", sql, strconv.FormatFloat(model.SampleFraction*100, 'f', -1, 64))
+	}
+	if model.RowLimit > 0 {
+		sql = fmt.Sprintf("SELECT * FROM (%s) LIMIT %d", sql, model.RowLimit)
+	}
+	return sql
+}
+
 func getMaterializeType(materialize bool) string {
 	if materialize {
 		return "TABLE"
@@ -97,6 +111,19 @@ func (m *modelMigrator) Delete(ctx context.Context, olap drivers.OLAPStore, cata
 func (m *modelMigrator) GetDependencies(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.CatalogEntry) ([]string, []*drivers.CatalogEntry) {
 	model := catalog.GetModel()
 	dependencies := ExtractTableNames(model.Sql)
+	// add the explicit dependencies from ref that the regex missed
+	for _, ref := range model.Refs {
+		found := false
+		for _, dependency := range dependencies {
+			if strings.EqualFold(dependency, ref) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			dependencies = append(dependencies, ref)
+		}
+	}
 
 	embeddedSourcesMap := make(map[string]*drivers.CatalogEntry)
 	for i, dependency := range dependencies {
@@ -142,7 +169,8 @@ func (m *modelMigrator) Validate(ctx context.Context, olap drivers.OLAPStore, ca
 }
 
 func (m *modelMigrator) IsEqual(ctx context.Context, cat1, cat2 *drivers.CatalogEntry) bool {
-	return cat1.GetModel().Dialect == cat2.GetModel().Dialect && strings.EqualFold(cat1.GetModel().Sql, cat2.GetModel().Sql) && cat1.GetModel().Materialize == cat2.GetModel().Materialize
+	return cat1.GetModel().Dialect == cat2.GetModel().Dialect && strings.EqualFold(cat1.GetModel().Sql, cat2.GetModel().Sql) && cat1.GetModel().Materialize == cat2.GetModel().Materialize &&
+		cat1.GetModel().RowLimit == cat2.GetModel().RowLimit && cat1.GetModel().SampleFraction == cat2.GetModel().SampleFraction
 }
 
 func (m *modelMigrator) ExistsInOlap(ctx context.Context, olap drivers.OLAPStore, catalog *drivers.CatalogEntry) (bool, error) {
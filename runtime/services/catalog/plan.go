@@ -0,0 +1,366 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/connectors"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator"
+	"go.uber.org/zap"
+)
+
+type PlanAction int
+
+const (
+	PlanActionNoOp    PlanAction = 0
+	PlanActionCreate  PlanAction = 1
+	PlanActionUpdate  PlanAction = 2
+	PlanActionRename  PlanAction = 3
+	PlanActionDrop    PlanAction = 4
+	PlanActionRefresh PlanAction = 5
+)
+
+func (a PlanAction) String() string {
+	switch a {
+	case PlanActionNoOp:
+		return "no-op"
+	case PlanActionCreate:
+		return "create"
+	case PlanActionUpdate:
+		return "update"
+	case PlanActionRename:
+		return "rename"
+	case PlanActionDrop:
+		return "drop"
+	case PlanActionRefresh:
+		return "refresh"
+	}
+	return fmt.Sprintf("PlanAction(%d)", int(a))
+}
+
+type PlanReason int
+
+const (
+	// PlanReasonNew means the object doesn't exist in the catalog
+	PlanReasonNew PlanReason = 1
+	// PlanReasonDefinitionChanged means the artifact changed, e.g. a model's SQL or a source's properties
+	PlanReasonDefinitionChanged PlanReason = 2
+	// PlanReasonSchemaChanged means a model's SQL returns different columns than the existing model
+	PlanReasonSchemaChanged PlanReason = 3
+	// PlanReasonUpstreamChanged means an object it depends on is created, updated, renamed or dropped
+	PlanReasonUpstreamChanged PlanReason = 4
+	// PlanReasonForced means the path was in ReconcileConfig.ForcedPaths
+	PlanReasonForced PlanReason = 5
+	// PlanReasonDataChanged means the local file a source reads was updated
+	PlanReasonDataChanged PlanReason = 6
+	// PlanReasonMissingInOLAP means the object is in the catalog but not in the OLAP store
+	PlanReasonMissingInOLAP PlanReason = 7
+	// PlanReasonRenamed means the artifact was renamed without other changes
+	PlanReasonRenamed PlanReason = 8
+	// PlanReasonDeleted means the artifact was deleted
+	PlanReasonDeleted PlanReason = 9
+	// PlanReasonInvalid means the artifact can't be parsed
+	PlanReasonInvalid PlanReason = 10
+	// PlanReasonUnreferenced means an embedded source is no longer used by any model
+	PlanReasonUnreferenced PlanReason = 11
+)
+
+func (r PlanReason) String() string {
+	switch r {
+	case PlanReasonNew:
+		return "new"
+	case PlanReasonDefinitionChanged:
+		return "definition changed"
+	case PlanReasonSchemaChanged:
+		return "schema changed"
+	case PlanReasonUpstreamChanged:
+		return "upstream changed"
+	case PlanReasonForced:
+		return "forced"
+	case PlanReasonDataChanged:
+		return "data changed"
+	case PlanReasonMissingInOLAP:
+		return "missing in OLAP"
+	case PlanReasonRenamed:
+		return "renamed"
+	case PlanReasonDeleted:
+		return "deleted"
+	case PlanReasonInvalid:
+		return "invalid"
+	case PlanReasonUnreferenced:
+		return "unreferenced"
+	}
+	return fmt.Sprintf("PlanReason(%d)", int(r))
+}
+
+// ReconcilePlan is a preview of the migrations Reconcile would run.
+type ReconcilePlan struct {
+	// Items are ordered the way they would be migrated, followed by the unchanged objects
+	Items  []*PlanItem
+	Errors []*runtimev1.ReconcileError
+}
+
+// PlanItem describes the change Reconcile would make to one catalog object.
+type PlanItem struct {
+	Name     string
+	Path     string
+	Type     drivers.ObjectType
+	Action   PlanAction
+	Reasons  []PlanReason
+	FromName string
+	// EstimatedBytes is the estimated size of the data a source would ingest, or 0 if unknown
+	EstimatedBytes int64
+	// Downstream lists the objects that would be migrated again because of this change
+	Downstream []string
+}
+
+// Plan collects the migrations for conf like Reconcile, but only explains them instead of running them.
+func (s *Service) Plan(ctx context.Context, conf ReconcileConfig) (*ReconcilePlan, error) {
+	s.Meta.lock.Lock()
+	defer s.Meta.lock.Unlock()
+
+	err := s.Meta.load(ctx, s.Catalog, s.InstID)
+	if err != nil {
+		return nil, err
+	}
+
+	inst, err := s.RegistryStore.FindInstance(ctx, s.InstID)
+	if err != nil {
+		return nil, err
+	}
+
+	migrationMap, reconcileErrors, err := s.getMigrationMap(ctx, conf)
+	if err != nil {
+		return nil, err
+	}
+	migrations, collectErrors := s.collectMigrationItems(migrationMap)
+
+	plan := &ReconcilePlan{
+		Items:  make([]*PlanItem, 0, len(migrationMap)),
+		Errors: append(reconcileErrors, collectErrors...),
+	}
+
+	forcedPathMap := make(map[string]bool)
+	for _, forcedPath := range conf.ForcedPaths {
+		forcedPathMap[forcedPath] = true
+	}
+
+	parents := s.migrationParents(migrations)
+	planned := make(map[string]bool, len(migrations))
+	for i, item := range migrations {
+		planned[item.NormalizedName] = true
+
+		planItem := newPlanItem(ctx, item)
+		plan.Items = append(plan.Items, planItem)
+
+		upstreamChanged := false
+		for _, parent := range parents[i] {
+			parentItem := plan.Items[parent]
+			if parentItem.Action == PlanActionNoOp {
+				continue
+			}
+			upstreamChanged = true
+			if planItem.Action != PlanActionNoOp {
+				parentItem.Downstream = append(parentItem.Downstream, planItem.Name)
+			}
+		}
+
+		if item.Error != nil {
+			plan.Errors = append(plan.Errors, item.Error)
+		}
+		if planItem.Action == PlanActionNoOp {
+			continue
+		}
+		planItem.Reasons = s.planReasons(ctx, item, planItem.Action, forcedPathMap[item.Path], upstreamChanged)
+
+		// objects can only be validated against the current state of their parents
+		if item.CatalogInFile != nil && planItem.Action != PlanActionDrop && !upstreamChanged {
+			validationErrors := migrator.Validate(ctx, s.Olap, item.CatalogInFile)
+			s.locateErrors(ctx, validationErrors)
+			plan.Errors = append(plan.Errors, validationErrors...)
+		}
+
+		if planItem.Type == drivers.ObjectTypeSource && planItem.Action != PlanActionDrop && planItem.Action != PlanActionRename {
+			planItem.EstimatedBytes = s.estimateIngestedBytes(ctx, inst, item.NewCatalog)
+		}
+	}
+
+	// add the objects that don't change
+	unchanged := make([]*PlanItem, 0)
+	for name, item := range migrationMap {
+		if planned[name] || item.NewCatalog == nil {
+			continue
+		}
+		unchanged = append(unchanged, &PlanItem{
+			Name:   item.Name,
+			Path:   item.Path,
+			Type:   item.NewCatalog.Type,
+			Action: PlanActionNoOp,
+		})
+	}
+	sort.Slice(unchanged, func(i, j int) bool {
+		return unchanged[i].Name < unchanged[j].Name
+	})
+	plan.Items = append(plan.Items, unchanged...)
+
+	return plan, nil
+}
+
+func newPlanItem(ctx context.Context, item *MigrationItem) *PlanItem {
+	planItem := &PlanItem{
+		Name:     item.Name,
+		Path:     item.Path,
+		FromName: item.FromName,
+	}
+	if item.NewCatalog != nil {
+		planItem.Type = item.NewCatalog.Type
+	}
+
+	switch item.Type {
+	case MigrationCreate:
+		if item.CatalogInFile != nil {
+			planItem.Action = PlanActionCreate
+		}
+	case MigrationRename:
+		if item.CatalogInFile != nil {
+			planItem.Action = PlanActionRename
+		}
+	case MigrationUpdate:
+		if item.CatalogInFile == nil {
+			break
+		}
+		planItem.Action = PlanActionUpdate
+		if item.CatalogInFile.Type == drivers.ObjectTypeSource && item.CatalogInStore != nil &&
+			migrator.IsEqual(ctx, item.CatalogInFile, item.CatalogInStore) {
+			planItem.Action = PlanActionRefresh
+		}
+	case MigrationDelete:
+		planItem.Action = PlanActionDrop
+	}
+
+	return planItem
+}
+
+// planReasons returns the reasons the action would be applied to item.
+func (s *Service) planReasons(ctx context.Context, item *MigrationItem, action PlanAction, forced, upstreamChanged bool) []PlanReason {
+	reasons := make([]PlanReason, 0)
+	switch action {
+	case PlanActionCreate:
+		if item.CatalogInStore == nil {
+			reasons = append(reasons, PlanReasonNew)
+		} else {
+			reasons = append(reasons, PlanReasonMissingInOLAP)
+		}
+	case PlanActionRename:
+		reasons = append(reasons, PlanReasonRenamed)
+	case PlanActionUpdate, PlanActionRefresh:
+		if forced {
+			reasons = append(reasons, PlanReasonForced)
+		}
+		if item.CatalogInStore == nil {
+			break
+		}
+		if definitionChanged(ctx, item) {
+			reasons = append(reasons, PlanReasonDefinitionChanged)
+		} else if action == PlanActionRefresh && item.HasChanged && !forced {
+			reasons = append(reasons, PlanReasonDataChanged)
+		}
+		// the new schema can only be inferred if the parents don't change
+		if !upstreamChanged && s.modelSchemaChanged(ctx, item) {
+			reasons = append(reasons, PlanReasonSchemaChanged)
+		}
+	case PlanActionDrop:
+		if item.Error != nil {
+			reasons = append(reasons, PlanReasonInvalid)
+		} else if item.NewCatalog != nil && item.NewCatalog.Embedded {
+			reasons = append(reasons, PlanReasonUnreferenced)
+		} else {
+			reasons = append(reasons, PlanReasonDeleted)
+		}
+	}
+	if upstreamChanged {
+		reasons = append(reasons, PlanReasonUpstreamChanged)
+	}
+	return reasons
+}
+
+func definitionChanged(ctx context.Context, item *MigrationItem) bool {
+	if item.CatalogInFile.Embedded {
+		return false
+	}
+	return !migrator.IsEqual(ctx, item.CatalogInFile, item.CatalogInStore)
+}
+
+// modelSchemaChanged checks if a model's SQL returns different columns than the model in the catalog.
+func (s *Service) modelSchemaChanged(ctx context.Context, item *MigrationItem) bool {
+	if item.CatalogInFile.Type != drivers.ObjectTypeModel || item.CatalogInStore.GetModel().Schema == nil {
+		return false
+	}
+
+	res, err := s.Olap.Execute(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("SELECT * FROM (%s) LIMIT 0", item.CatalogInFile.GetModel().Sql),
+		Priority: 100,
+	})
+	if err != nil {
+		// validation reports the error
+		return false
+	}
+	defer res.Close()
+
+	oldFields := item.CatalogInStore.GetModel().Schema.Fields
+	newFields := res.Schema.Fields
+	if len(oldFields) != len(newFields) {
+		return true
+	}
+	for i, field := range newFields {
+		if !strings.EqualFold(field.Name, oldFields[i].Name) || field.Type.Code != oldFields[i].Type.Code {
+			return true
+		}
+	}
+	return false
+}
+
+// estimateIngestedBytes returns the estimated size of the data a source would ingest, or 0 if its connector can't estimate it.
+func (s *Service) estimateIngestedBytes(ctx context.Context, inst *drivers.Instance, entry *drivers.CatalogEntry) int64 {
+	if entry == nil || entry.Embedded {
+		return 0
+	}
+	apiSource := entry.GetSource()
+	connector, ok := connectors.Connectors[apiSource.Connector]
+	if !ok {
+		return 0
+	}
+	estimator, ok := connector.(connectors.SizeEstimator)
+	if !ok {
+		return 0
+	}
+
+	variables := make(map[string]string)
+	for key, value := range inst.ResolveVariables() {
+		variables[strings.ToUpper(key)] = value
+	}
+	env := &connectors.Env{
+		RepoDriver:      s.Repo.Driver(),
+		RepoRoot:        s.Repo.Root(),
+		Variables:       variables,
+		AllowHostAccess: strings.EqualFold(variables["ALLOW_HOST_ACCESS"], "true"),
+	}
+	source := &connectors.Source{
+		Name:          apiSource.Name,
+		Connector:     apiSource.Connector,
+		Properties:    apiSource.Properties.AsMap(),
+		ExtractPolicy: apiSource.GetPolicy(),
+		Timeout:       apiSource.GetTimeoutSeconds(),
+	}
+
+	size, err := estimator.EstimateSize(ctx, env, source)
+	if err != nil {
+		s.logger.Debug("failed to estimate source size", zap.String("source", apiSource.Name), zap.Error(err))
+		return 0
+	}
+	return size
+}
@@ -0,0 +1,86 @@
+package catalog_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/rilldata/rill/runtime/services/catalog"
+	"github.com/rilldata/rill/runtime/services/catalog/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlanReconcile(t *testing.T) {
+	s, _ := initBasicService(t)
+	ctx := context.Background()
+
+	plan, err := s.Plan(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Empty(t, plan.Errors)
+	require.Len(t, plan.Items, 3)
+	for _, item := range plan.Items {
+		require.Equal(t, catalog.PlanActionNoOp, item.Action, item.Name)
+	}
+
+	// a new column changes the model's definition and schema, and cascades to the dashboard
+	testutils.CreateModel(t, s, "AdBids_model",
+		"select id, timestamp, publisher, domain, bid_price, 1 as one from AdBids", AdBidsModelRepoPath)
+	plan, err = s.Plan(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Empty(t, plan.Errors)
+	model := planItem(t, plan, "AdBids_model")
+	require.Equal(t, catalog.PlanActionUpdate, model.Action)
+	require.ElementsMatch(t, []catalog.PlanReason{catalog.PlanReasonDefinitionChanged, catalog.PlanReasonSchemaChanged}, model.Reasons)
+	require.Equal(t, []string{"AdBids_dashboard"}, model.Downstream)
+	dashboard := planItem(t, plan, "AdBids_dashboard")
+	require.Equal(t, catalog.PlanActionUpdate, dashboard.Action)
+	require.Equal(t, []catalog.PlanReason{catalog.PlanReasonUpstreamChanged}, dashboard.Reasons)
+	require.Equal(t, catalog.PlanActionNoOp, planItem(t, plan, "AdBids").Action)
+
+	// planning doesn't migrate anything
+	entry := testutils.AssertTable(t, s, "AdBids_model", AdBidsModelRepoPath)
+	require.Len(t, entry.GetModel().Schema.Fields, 5)
+
+	// new sources have an estimated size
+	testutils.CreateSource(t, s, "AdImpressions", AdImpressionsCsvPath, AdImpressionsRepoPath)
+	info, err := os.Stat(AdImpressionsCsvPath)
+	require.NoError(t, err)
+	plan, err = s.Plan(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	source := planItem(t, plan, "AdImpressions")
+	require.Equal(t, catalog.PlanActionCreate, source.Action)
+	require.Equal(t, []catalog.PlanReason{catalog.PlanReasonNew}, source.Reasons)
+	require.Equal(t, info.Size(), source.EstimatedBytes)
+
+	// forced sources are refreshed
+	result, err := s.Reconcile(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	require.Empty(t, result.Errors)
+	plan, err = s.Plan(ctx, catalog.ReconcileConfig{
+		ChangedPaths: []string{AdBidsRepoPath},
+		ForcedPaths:  []string{AdBidsRepoPath},
+	})
+	require.NoError(t, err)
+	source = planItem(t, plan, "AdBids")
+	require.Equal(t, catalog.PlanActionRefresh, source.Action)
+	require.Equal(t, []catalog.PlanReason{catalog.PlanReasonForced}, source.Reasons)
+	require.ElementsMatch(t, []string{"AdBids_model"}, source.Downstream)
+
+	// deleted files are dropped
+	require.NoError(t, s.Repo.Delete(ctx, s.InstID, AdBidsDashboardRepoPath))
+	plan, err = s.Plan(ctx, catalog.ReconcileConfig{})
+	require.NoError(t, err)
+	dashboard = planItem(t, plan, "AdBids_dashboard")
+	require.Equal(t, catalog.PlanActionDrop, dashboard.Action)
+	require.Equal(t, []catalog.PlanReason{catalog.PlanReasonDeleted}, dashboard.Reasons)
+}
+
+func planItem(t *testing.T, plan *catalog.ReconcilePlan, name string) *catalog.PlanItem {
+	for _, item := range plan.Items {
+		if item.Name == name {
+			return item
+		}
+	}
+	require.Failf(t, "item not found in plan", name)
+	return nil
+}
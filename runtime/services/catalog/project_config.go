@@ -0,0 +1,69 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/compilers/rillv1beta"
+	"github.com/rilldata/rill/runtime/drivers"
+	"go.uber.org/zap"
+)
+
+// projectConfigRepoPath is the path of rill.yaml as returned by the repo
+const projectConfigRepoPath = "/" + rillv1beta.ProjectConfigPath
+
+// loadProjectConfig parses rill.yaml for the defaults applied to the artifacts read during a reconcile.
+// An invalid rill.yaml is returned as a reconcile error, and no defaults are applied.
+func (s *Service) loadProjectConfig(ctx context.Context) (*runtimev1.ReconcileError, error) {
+	s.project = &rillv1beta.ProjectConfig{}
+
+	inst, err := s.RegistryStore.FindInstance(ctx, s.InstID)
+	if err != nil {
+		return nil, err
+	}
+	s.profile = inst.Profile
+
+	conf, err := rillv1beta.New(s.Repo, s.InstID).ProjectConfig(ctx)
+	if conf != nil {
+		for _, w := range conf.Warnings {
+			s.logger.Warn("ignoring invalid field in rill.yaml", zap.String("instance_id", s.InstID), zap.Error(w))
+		}
+	}
+	if err != nil {
+		reconcileErr := &runtimev1.ReconcileError{
+			Code:     runtimev1.ReconcileError_CODE_SYNTAX,
+			Message:  err.Error(),
+			FilePath: projectConfigRepoPath,
+		}
+		var configErr *rillv1beta.ConfigError
+		if errors.As(err, &configErr) && configErr.Line > 0 {
+			reconcileErr.Message = configErr.Message
+			reconcileErr.StartLocation = &runtimev1.ReconcileError_CharLocation{
+				Line:   uint32(configErr.Line),
+				Column: uint32(configErr.Column),
+			}
+		}
+		return reconcileErr, nil
+	}
+	s.project = conf
+	return nil, nil
+}
+
+// applyProjectDefaults sets the settings the entry doesn't set to the defaults in rill.yaml.
+func (s *Service) applyProjectDefaults(entry *drivers.CatalogEntry) {
+	if s.project == nil {
+		return
+	}
+	s.project.ApplyDefaults(entry, s.profile)
+}
+
+// ingestionLimit returns the total data allowed to ingest across all sources, 0 means there is no limit.
+// It's the lowest of the instance's limit and the limit in rill.yaml.
+func (s *Service) ingestionLimit(inst *drivers.Instance) int64 {
+	limit := inst.IngestionLimitBytes
+	if s.project != nil && s.project.IngestLimitBytes != 0 && (limit == 0 || s.project.IngestLimitBytes < limit) {
+		limit = s.project.IngestLimitBytes
+	}
+	return limit
+}
@@ -0,0 +1,395 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	runtimev1 "github.com/rilldata/rill/proto/gen/rill/runtime/v1"
+	"github.com/rilldata/rill/runtime/drivers"
+	"github.com/rilldata/rill/runtime/pkg/dag"
+	"github.com/rilldata/rill/runtime/services/catalog/migrator"
+	"google.golang.org/protobuf/proto"
+)
+
+// StagingTablePrefix is the prefix of the tables and views a transactional reconcile builds sources and models into
+// before swapping them in.
+const StagingTablePrefix = "__rill_tx_"
+
+// reconcileTx tracks the changes made by a transactional reconcile (see ReconcileConfig.Transactional).
+// Sources and models are built into staging tables and views while the current objects stay in place.
+// Once every item is staged, all the changes are swapped in with a single OLAP transaction,
+// so readers either see the state before the reconcile or after it.
+type reconcileTx struct {
+	lock sync.Mutex
+	// items are in the order they were staged, so parents are before their children
+	items []*MigrationItem
+	// staged maps the normalized names of built sources and models to the names of their staging tables or views
+	staged map[string]string
+	// renamed maps the normalized new names of renamed objects to their current names
+	renamed map[string]string
+	// deleted has the normalized names of deleted objects
+	deleted map[string]bool
+	failed  bool
+
+	// state of the migration meta before the reconcile
+	dependencies map[string][]string
+	nameToPath   map[string]string
+}
+
+func (s *Service) beginTx() *reconcileTx {
+	s.Meta.dagLock.RLock()
+	defer s.Meta.dagLock.RUnlock()
+
+	tx := &reconcileTx{
+		staged:       make(map[string]string),
+		renamed:      make(map[string]string),
+		deleted:      make(map[string]bool),
+		dependencies: s.Meta.dagDependencies(),
+		nameToPath:   make(map[string]string, len(s.Meta.NameToPath)),
+	}
+	for name, path := range s.Meta.NameToPath {
+		tx.nameToPath[name] = path
+	}
+	return tx
+}
+
+func (tx *reconcileTx) fail() {
+	tx.lock.Lock()
+	tx.failed = true
+	tx.lock.Unlock()
+}
+
+// relation returns the name of the table or view that has the new state of an object, or an empty string if the reconcile didn't change it.
+func (tx *reconcileTx) relation(name string) string {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+	name = strings.ToLower(name)
+	if staging, ok := tx.staged[name]; ok {
+		return staging
+	}
+	return tx.renamed[name]
+}
+
+// stagedEntry returns a copy of entry that reads the new state of its dependencies.
+// Models select from the staging tables of their dependencies, and metrics views use the staging table of their model.
+func (tx *reconcileTx) stagedEntry(entry *drivers.CatalogEntry, dependencies []string) *drivers.CatalogEntry {
+	res := *entry
+	res.Object = proto.Clone(entry.Object)
+
+	switch res.Type {
+	case drivers.ObjectTypeModel:
+		// CTEs named after the dependencies shadow the current tables while the SQL is evaluated
+		var ctes []string
+		for _, dep := range dependencies {
+			if rel := tx.relation(dep); rel != "" {
+				ctes = append(ctes, fmt.Sprintf("%s AS (SELECT * FROM %s)", migrator.SafeName(dep), migrator.SafeName(rel)))
+			}
+		}
+		if len(ctes) > 0 {
+			model := res.GetModel()
+			model.Sql = fmt.Sprintf("WITH %s SELECT * FROM (%s)", strings.Join(ctes, ", "), model.Sql)
+		}
+	case drivers.ObjectTypeMetricsView:
+		mv := res.GetMetricsView()
+		if rel := tx.relation(mv.Model); rel != "" {
+			mv.Model = rel
+		}
+	}
+	return &res
+}
+
+// validateStaged validates an item against the new state of its dependencies.
+func (s *Service) validateStaged(ctx context.Context, tx *reconcileTx, item *MigrationItem) []*runtimev1.ReconcileError {
+	tx.lock.Lock()
+	for _, dep := range item.NormalizedDependencies {
+		if tx.deleted[dep] && tx.staged[dep] == "" {
+			tx.lock.Unlock()
+			return migrator.CreateValidationError(item.Path, fmt.Sprintf("dependency %q is deleted", dep))
+		}
+	}
+	tx.lock.Unlock()
+
+	return migrator.Validate(ctx, s.Olap, tx.stagedEntry(item.CatalogInFile, item.NormalizedDependencies))
+}
+
+// stage builds the new state of an item without changing the current objects.
+// The migration meta is updated right away, since it's restored if the reconcile is rolled back.
+func (s *Service) stage(ctx context.Context, tx *reconcileTx, item *MigrationItem, result *ReconcileResult) error {
+	switch item.Type {
+	case MigrationNoChange:
+		recErr := s.addToDag(item)
+		if recErr != nil {
+			result.Errors = append(result.Errors, recErr)
+		}
+		return nil
+	case MigrationReportUpdate:
+		result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+		recErr := s.addToDag(item)
+		if recErr != nil {
+			result.Errors = append(result.Errors, recErr)
+		}
+		return nil
+	case MigrationCreate, MigrationUpdate:
+		if item.CatalogInFile == nil {
+			return nil
+		}
+		s.Meta.setPath(item.NormalizedName, item.Path)
+		_, err := s.Meta.addToDAG(item.NormalizedName, item.NormalizedDependencies)
+		if err != nil {
+			return err
+		}
+		err = s.buildStaging(ctx, tx, item)
+		if err != nil {
+			return err
+		}
+	case MigrationRename:
+		if item.CatalogInFile == nil {
+			return nil
+		}
+		fromLowerName := strings.ToLower(item.FromName)
+		s.Meta.deletePath(fromLowerName)
+		s.Meta.setPath(item.NormalizedName, item.Path)
+		s.Meta.deleteFromDAG(fromLowerName)
+		_, err := s.Meta.addToDAG(item.NormalizedName, item.NormalizedDependencies)
+		if err != nil {
+			return err
+		}
+		tx.lock.Lock()
+		tx.renamed[item.NormalizedName] = item.FromName
+		tx.lock.Unlock()
+	case MigrationDelete:
+		s.Meta.deletePath(item.NormalizedName)
+		s.Meta.deleteFromDAG(item.NormalizedName)
+		tx.lock.Lock()
+		tx.deleted[item.NormalizedName] = true
+		tx.lock.Unlock()
+	}
+
+	tx.lock.Lock()
+	tx.items = append(tx.items, item)
+	tx.lock.Unlock()
+	return nil
+}
+
+// buildStaging ingests a source or builds a model into its staging table or view.
+func (s *Service) buildStaging(ctx context.Context, tx *reconcileTx, item *MigrationItem) error {
+	entry := item.CatalogInFile
+	if entry.Type != drivers.ObjectTypeSource && entry.Type != drivers.ObjectTypeModel {
+		return nil
+	}
+
+	inst, err := s.RegistryStore.FindInstance(ctx, s.InstID)
+	if err != nil {
+		return err
+	}
+
+	if entry.Type == drivers.ObjectTypeSource && s.ingestionLimit(inst) != 0 {
+		s.Meta.ingestLock.Lock()
+		defer s.Meta.ingestLock.Unlock()
+	}
+
+	opts := migrator.Options{
+		InstanceEnv:               inst.ResolveVariables(),
+		IngestStorageLimitInBytes: s.getSourceIngestionLimit(ctx, inst),
+	}
+
+	staging := tx.stagedEntry(entry, item.NormalizedDependencies)
+	staging.Name = StagingTablePrefix + entry.Name
+	if staging.Type == drivers.ObjectTypeSource {
+		staging.GetSource().Name = staging.Name
+	}
+
+	// a previous reconcile may have been interrupted before dropping its staging objects
+	err = s.dropStaging(ctx, staging.Name)
+	if err != nil {
+		return err
+	}
+
+	// the staging object is registered first, so that it's dropped on rollback even if the build fails halfway
+	tx.lock.Lock()
+	tx.staged[item.NormalizedName] = staging.Name
+	tx.lock.Unlock()
+
+	err = s.wrapMigrator(entry, func() error {
+		return migrator.Create(ctx, s.Olap, s.Repo, opts, staging)
+	})
+	if err != nil {
+		return err
+	}
+	entry.BytesIngested = staging.BytesIngested
+	return nil
+}
+
+// commitTx swaps in the staged changes with a single OLAP transaction, then updates the catalog.
+// If the swap fails, the OLAP transaction is rolled back and the current objects are left untouched.
+func (s *Service) commitTx(ctx context.Context, tx *reconcileTx, result *ReconcileResult) error {
+	inst, err := s.RegistryStore.FindInstance(ctx, s.InstID)
+	if err != nil {
+		return err
+	}
+	opts := migrator.Options{
+		InstanceEnv: inst.ResolveVariables(),
+	}
+
+	err = s.Olap.WithConnection(ctx, 100, func(ctx, ensuredCtx context.Context) error {
+		err := s.Olap.Exec(ctx, &drivers.Statement{Query: "BEGIN TRANSACTION", Priority: 100})
+		if err != nil {
+			return err
+		}
+
+		for _, item := range tx.items {
+			err = s.swap(ctx, tx, opts, item)
+			if err != nil {
+				_ = s.Olap.Exec(ensuredCtx, &drivers.Statement{Query: "ROLLBACK", Priority: 100})
+				return fmt.Errorf("failed to swap in %q: %w", item.Name, err)
+			}
+		}
+
+		return s.Olap.Exec(ctx, &drivers.Statement{Query: "COMMIT", Priority: 100})
+	})
+	if err != nil {
+		return err
+	}
+
+	// The catalog store is separate from the OLAP store, so it's updated once the swap has committed
+	for _, item := range tx.items {
+		err := s.commitCatalogEntry(ctx, item, result)
+		if err != nil {
+			result.Errors = append(result.Errors, txError(item, err))
+		}
+	}
+	return nil
+}
+
+// swap replaces the current object of an item with its staged state. It runs in the OLAP transaction of commitTx.
+func (s *Service) swap(ctx context.Context, tx *reconcileTx, opts migrator.Options, item *MigrationItem) error {
+	switch item.Type {
+	case MigrationDelete:
+		return migrator.Delete(ctx, s.Olap, item.CatalogInStore)
+	case MigrationRename:
+		return migrator.Rename(ctx, s.Olap, item.FromName, item.CatalogInFile)
+	case MigrationCreate, MigrationUpdate:
+		entry := item.CatalogInFile
+		if entry.Type == drivers.ObjectTypeMetricsView {
+			if item.Type == MigrationUpdate {
+				return migrator.Update(ctx, s.Olap, s.Repo, opts, item.CatalogInStore, entry)
+			}
+			return migrator.Create(ctx, s.Olap, s.Repo, opts, entry)
+		}
+
+		if item.CatalogInStore != nil {
+			err := migrator.Delete(ctx, s.Olap, item.CatalogInStore)
+			if err != nil {
+				return err
+			}
+		}
+
+		staging := tx.relation(item.NormalizedName)
+		if entry.Type == drivers.ObjectTypeModel && !entry.GetModel().Materialize {
+			// views are bound to names, so the view is created with its own SQL now that its dependencies have been swapped in
+			err := migrator.Create(ctx, s.Olap, s.Repo, opts, entry)
+			if err != nil {
+				return err
+			}
+			return s.Olap.Exec(ctx, &drivers.Statement{
+				Query:    fmt.Sprintf("DROP VIEW IF EXISTS %s", migrator.SafeName(staging)),
+				Priority: 100,
+			})
+		}
+
+		return s.Olap.Exec(ctx, &drivers.Statement{
+			Query:    fmt.Sprintf("ALTER TABLE %s RENAME TO %s", migrator.SafeName(staging), migrator.SafeName(entry.Name)),
+			Priority: 100,
+		})
+	}
+	return nil
+}
+
+// commitCatalogEntry updates the catalog store for an item that was swapped in.
+func (s *Service) commitCatalogEntry(ctx context.Context, item *MigrationItem, result *ReconcileResult) error {
+	switch item.Type {
+	case MigrationDelete:
+		result.DroppedObjects = append(result.DroppedObjects, item.CatalogInStore)
+		return s.Catalog.DeleteEntry(ctx, s.InstID, item.Name)
+	case MigrationRename:
+		result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+		err := s.Catalog.DeleteEntry(ctx, s.InstID, item.FromName)
+		if err != nil {
+			return err
+		}
+		catalog, err := s.updateCatalogObject(ctx, item)
+		if err != nil {
+			return err
+		}
+		return s.Catalog.CreateEntry(ctx, s.InstID, catalog)
+	case MigrationCreate, MigrationUpdate:
+		if item.Type == MigrationCreate {
+			result.AddedObjects = append(result.AddedObjects, item.CatalogInFile)
+		} else {
+			result.UpdatedObjects = append(result.UpdatedObjects, item.CatalogInFile)
+		}
+		catalog, err := s.updateCatalogObject(ctx, item)
+		if err != nil {
+			return err
+		}
+		if _, found := s.Catalog.FindEntry(ctx, s.InstID, item.Name); found {
+			return s.Catalog.UpdateEntry(ctx, s.InstID, catalog)
+		}
+		return s.Catalog.CreateEntry(ctx, s.InstID, catalog)
+	}
+	return nil
+}
+
+// rollbackTx drops the staging objects and restores the migration meta. The current objects and catalog were never changed.
+func (s *Service) rollbackTx(ctx context.Context, tx *reconcileTx, result *ReconcileResult) {
+	for name, staging := range tx.staged {
+		err := s.dropStaging(ctx, staging)
+		if err != nil {
+			result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+				Code:     runtimev1.ReconcileError_CODE_OLAP,
+				Message:  fmt.Sprintf("rollback failed: %s", err.Error()),
+				FilePath: tx.nameToPath[name],
+			})
+		}
+	}
+
+	s.Meta.dagLock.Lock()
+	defer s.Meta.dagLock.Unlock()
+	s.Meta.dag = dag.NewDAG()
+	for name, deps := range tx.dependencies {
+		_, err := s.Meta.dag.Add(name, deps)
+		if err != nil {
+			result.Errors = append(result.Errors, &runtimev1.ReconcileError{
+				Code:     runtimev1.ReconcileError_CODE_OLAP,
+				Message:  err.Error(),
+				FilePath: tx.nameToPath[name],
+			})
+		}
+	}
+	s.Meta.NameToPath = tx.nameToPath
+}
+
+// dropStaging drops a staging table or view. The type isn't tracked, so it tries to drop a table first.
+func (s *Service) dropStaging(ctx context.Context, name string) error {
+	err := s.Olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP TABLE IF EXISTS %s", migrator.SafeName(name)),
+		Priority: 100,
+	})
+	if err == nil {
+		return nil
+	}
+	return s.Olap.Exec(ctx, &drivers.Statement{
+		Query:    fmt.Sprintf("DROP VIEW IF EXISTS %s", migrator.SafeName(name)),
+		Priority: 100,
+	})
+}
+
+func txError(item *MigrationItem, err error) *runtimev1.ReconcileError {
+	return &runtimev1.ReconcileError{
+		Code:     runtimev1.ReconcileError_CODE_OLAP,
+		Message:  err.Error(),
+		FilePath: item.Path,
+	}
+}
@@ -0,0 +1,90 @@
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rilldata/rill/runtime/drivers"
+)
+
+// InstanceUsage describes the resources used by an instance.
+// The query counts are cumulative since CountersStartedOn, so consumers should compute deltas and detect resets by a change of CountersStartedOn.
+type InstanceUsage struct {
+	BytesIngested     int64
+	Queries           int64
+	CachedQueries     int64
+	CountersStartedOn time.Time
+}
+
+// Usage returns the resources used by an instance.
+// The bytes ingested is the sum of the bytes ingested by the instance's sources when they were last ingested.
+func (r *Runtime) Usage(ctx context.Context, instanceID string) (*InstanceUsage, error) {
+	sources, err := r.ListCatalogEntries(ctx, instanceID, drivers.ObjectTypeSource)
+	if err != nil {
+		return nil, err
+	}
+
+	var bytesIngested int64
+	for _, s := range sources {
+		bytesIngested += s.BytesIngested
+	}
+
+	qc := r.queryCounts.get(instanceID)
+	return &InstanceUsage{
+		BytesIngested:     bytesIngested,
+		Queries:           qc.queries,
+		CachedQueries:     qc.cached,
+		CountersStartedOn: qc.startedOn,
+	}, nil
+}
+
+// queryCounts counts the queries served for each instance, alongside the query cache hit and miss counters.
+type queryCounts struct {
+	lock   sync.Mutex
+	counts map[string]*queryCount
+}
+
+type queryCount struct {
+	queries   int64
+	cached    int64
+	startedOn time.Time
+}
+
+func newQueryCounts() *queryCounts {
+	return &queryCounts{counts: make(map[string]*queryCount)}
+}
+
+func (c *queryCounts) add(instanceID string, cached bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	qc := c.getOrCreate(instanceID)
+	qc.queries++
+	if cached {
+		qc.cached++
+	}
+}
+
+// get returns a copy of an instance's counts. It starts counting for the instance if it hasn't already,
+// so an instance that hasn't served any queries reports the same start time until its counts are reset.
+func (c *queryCounts) get(instanceID string) queryCount {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return *c.getOrCreate(instanceID)
+}
+
+func (c *queryCounts) getOrCreate(instanceID string) *queryCount {
+	qc, ok := c.counts[instanceID]
+	if !ok {
+		qc = &queryCount{startedOn: time.Now()}
+		c.counts[instanceID] = qc
+	}
+	return qc
+}
+
+func (c *queryCounts) delete(instanceID string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.counts, instanceID)
+}
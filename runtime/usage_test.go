@@ -0,0 +1,34 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueryCounts(t *testing.T) {
+	qc := newQueryCounts()
+
+	qc.add("default", false)
+	qc.add("default", true)
+	qc.add("default", true)
+	qc.add("other", false)
+
+	c := qc.get("default")
+	require.Equal(t, int64(3), c.queries)
+	require.Equal(t, int64(2), c.cached)
+	startedOn := c.startedOn
+	require.False(t, startedOn.IsZero())
+
+	c = qc.get("other")
+	require.Equal(t, int64(1), c.queries)
+	require.Equal(t, int64(0), c.cached)
+
+	// The start time is stable until the counts are reset
+	require.Equal(t, startedOn, qc.get("default").startedOn)
+
+	qc.delete("default")
+	c = qc.get("default")
+	require.Equal(t, int64(0), c.queries)
+	require.Equal(t, int64(0), c.cached)
+}
@@ -14,9 +14,14 @@ import type {
   QueryKey,
 } from "@tanstack/svelte-query";
 import type {
-  V1GetGithubRepoStatusResponse,
+  V1ListDeploymentRevisionsResponse,
   RpcStatus,
+  V1GetDeploymentRevisionResponse,
+  V1RollbackDeploymentResponse,
+  AdminServiceRollbackDeploymentBody,
+  V1GetGithubRepoStatusResponse,
   AdminServiceGetGithubRepoStatusParams,
+  V1GetJobResponse,
   V1ListOrganizationsResponse,
   AdminServiceListOrganizationsParams,
   V1CreateOrganizationResponse,
@@ -25,6 +30,8 @@ import type {
   V1DeleteOrganizationResponse,
   V1UpdateOrganizationResponse,
   AdminServiceUpdateOrganizationBody,
+  V1ListAuditLogEntriesResponse,
+  AdminServiceListAuditLogEntriesParams,
   V1ListOrganizationMembersResponse,
   AdminServiceListOrganizationMembersParams,
   V1AddOrganizationMemberResponse,
@@ -32,31 +39,251 @@ import type {
   V1RemoveOrganizationMemberResponse,
   V1SetOrganizationMemberRoleResponse,
   AdminServiceSetOrganizationMemberRoleBodyBody,
+  V1GetOrganizationMemberAttributesResponse,
+  V1SetOrganizationMemberAttributesResponse,
+  AdminServiceSetOrganizationMemberAttributesBody,
   V1LeaveOrganizationResponse,
   V1ListProjectMembersResponse,
   AdminServiceListProjectMembersParams,
   V1AddProjectMemberResponse,
   V1RemoveProjectMemberResponse,
   V1SetProjectMemberRoleResponse,
+  V1UpdateOrganizationQuotasResponse,
+  AdminServiceUpdateOrganizationQuotasBody,
+  V1ListServiceAccountsResponse,
+  V1CreateServiceAccountResponse,
+  AdminServiceCreateServiceAccountBody,
+  V1DeleteServiceAccountResponse,
+  V1ListServiceAuthTokensResponse,
+  V1IssueServiceAuthTokenResponse,
+  AdminServiceIssueServiceAuthTokenBody,
+  V1RevokeServiceAuthTokenResponse,
+  V1GetOrganizationSSOResponse,
+  V1DeleteOrganizationSSOResponse,
+  V1SetOrganizationSSOResponse,
+  AdminServiceSetOrganizationSSOBody,
+  V1RemoveOrganizationSSOGroupResponse,
+  AdminServiceRemoveOrganizationSSOGroupParams,
+  V1AddOrganizationSSOGroupResponse,
+  AdminServiceAddOrganizationSSOGroupBody,
+  V1GetOrganizationUsageResponse,
   V1ListProjectsForOrganizationResponse,
   AdminServiceListProjectsForOrganizationParams,
   V1CreateProjectResponse,
   AdminServiceCreateProjectBody,
   V1GetProjectResponse,
+  AdminServiceGetProjectParams,
   V1DeleteProjectResponse,
   V1UpdateProjectResponse,
   AdminServiceUpdateProjectBody,
+  V1ListProjectJobsResponse,
   V1GetProjectVariablesResponse,
+  AdminServiceGetProjectVariablesParams,
   V1UpdateProjectVariablesResponse,
   AdminServiceUpdateProjectVariablesBody,
+  V1ListEnvironmentsResponse,
+  V1CreateEnvironmentResponse,
+  AdminServiceCreateEnvironmentBody,
+  V1DeleteEnvironmentResponse,
+  V1UpdateEnvironmentResponse,
+  AdminServiceUpdateEnvironmentBody,
+  V1PromoteEnvironmentResponse,
+  AdminServicePromoteEnvironmentBody,
   V1ListProjectsForOrganizationAndGithubURLResponse,
   AdminServiceListProjectsForOrganizationAndGithubURLParams,
   V1PingResponse,
+  V1ListRuntimesResponse,
+  V1DrainRuntimeResponse,
+  V1DrainRuntimeRequest,
+  V1HeartbeatRuntimeResponse,
+  V1HeartbeatRuntimeRequest,
+  V1UncordonRuntimeResponse,
+  V1UncordonRuntimeRequest,
   V1RevokeCurrentAuthTokenResponse,
   V1GetCurrentUserResponse,
 } from "../index.schemas";
 import { httpClient } from "../../http-client";
 
+/**
+ * @summary ListDeploymentRevisions lists the most recent reconciles of a deployment, with the commit they deployed and their outcome
+ */
+export const adminServiceListDeploymentRevisions = (
+  deploymentId: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListDeploymentRevisionsResponse>({
+    url: `/v1/deployments/${deploymentId}/revisions`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListDeploymentRevisionsQueryKey = (
+  deploymentId: string
+) => [`/v1/deployments/${deploymentId}/revisions`] as const;
+
+export type AdminServiceListDeploymentRevisionsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListDeploymentRevisions>>
+>;
+export type AdminServiceListDeploymentRevisionsQueryError = RpcStatus;
+
+export const createAdminServiceListDeploymentRevisions = <
+  TData = Awaited<ReturnType<typeof adminServiceListDeploymentRevisions>>,
+  TError = RpcStatus
+>(
+  deploymentId: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListDeploymentRevisions>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListDeploymentRevisionsQueryKey(deploymentId);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListDeploymentRevisions>>
+  > = ({ signal }) => adminServiceListDeploymentRevisions(deploymentId, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListDeploymentRevisions>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!deploymentId,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary GetDeploymentRevision returns a reconcile of a deployment, including the results for each file and its logs
+ */
+export const adminServiceGetDeploymentRevision = (
+  deploymentId: string,
+  id: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetDeploymentRevisionResponse>({
+    url: `/v1/deployments/${deploymentId}/revisions/${id}`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetDeploymentRevisionQueryKey = (
+  deploymentId: string,
+  id: string
+) => [`/v1/deployments/${deploymentId}/revisions/${id}`] as const;
+
+export type AdminServiceGetDeploymentRevisionQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetDeploymentRevision>>
+>;
+export type AdminServiceGetDeploymentRevisionQueryError = RpcStatus;
+
+export const createAdminServiceGetDeploymentRevision = <
+  TData = Awaited<ReturnType<typeof adminServiceGetDeploymentRevision>>,
+  TError = RpcStatus
+>(
+  deploymentId: string,
+  id: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetDeploymentRevision>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetDeploymentRevisionQueryKey(deploymentId, id);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetDeploymentRevision>>
+  > = ({ signal }) =>
+    adminServiceGetDeploymentRevision(deploymentId, id, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetDeploymentRevision>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(deploymentId && id),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary RollbackDeployment redeploys the commit of a previous successful revision of a deployment.
+It pins the deployment's environment to the commit until the environment's branch is updated.
+ */
+export const adminServiceRollbackDeployment = (
+  deploymentId: string,
+  adminServiceRollbackDeploymentBody: AdminServiceRollbackDeploymentBody
+) => {
+  return httpClient<V1RollbackDeploymentResponse>({
+    url: `/v1/deployments/${deploymentId}/rollback`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceRollbackDeploymentBody,
+  });
+};
+
+export type AdminServiceRollbackDeploymentMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRollbackDeployment>>
+>;
+export type AdminServiceRollbackDeploymentMutationBody =
+  AdminServiceRollbackDeploymentBody;
+export type AdminServiceRollbackDeploymentMutationError = RpcStatus;
+
+export const createAdminServiceRollbackDeployment = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRollbackDeployment>>,
+    TError,
+    { deploymentId: string; data: AdminServiceRollbackDeploymentBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRollbackDeployment>>,
+    { deploymentId: string; data: AdminServiceRollbackDeploymentBody }
+  > = (props) => {
+    const { deploymentId, data } = props ?? {};
+
+    return adminServiceRollbackDeployment(deploymentId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRollbackDeployment>>,
+    TError,
+    { deploymentId: string; data: AdminServiceRollbackDeploymentBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary GetGithubRepoRequest returns info about a Github repo based on the caller's installations.
 If the caller has not granted access to the repository, instructions for granting access are returned.
@@ -119,6 +346,62 @@ export const createAdminServiceGetGithubRepoStatus = <
   return query;
 };
 
+/**
+ * @summary GetJob returns the status of a background job
+ */
+export const adminServiceGetJob = (id: string, signal?: AbortSignal) => {
+  return httpClient<V1GetJobResponse>({
+    url: `/v1/jobs/${id}`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetJobQueryKey = (id: string) =>
+  [`/v1/jobs/${id}`] as const;
+
+export type AdminServiceGetJobQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetJob>>
+>;
+export type AdminServiceGetJobQueryError = RpcStatus;
+
+export const createAdminServiceGetJob = <
+  TData = Awaited<ReturnType<typeof adminServiceGetJob>>,
+  TError = RpcStatus
+>(
+  id: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetJob>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey = queryOptions?.queryKey ?? getAdminServiceGetJobQueryKey(id);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetJob>>
+  > = ({ signal }) => adminServiceGetJob(id, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetJob>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!id,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
 /**
  * @summary ListOrganizations lists all the organizations currently managed by the admin
  */
@@ -385,6 +668,77 @@ export const createAdminServiceUpdateOrganization = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary ListAuditLogEntries lists the audit log of administrative actions in an organization, newest first
+ */
+export const adminServiceListAuditLogEntries = (
+  organization: string,
+  params?: AdminServiceListAuditLogEntriesParams,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListAuditLogEntriesResponse>({
+    url: `/v1/organizations/${organization}/audit`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListAuditLogEntriesQueryKey = (
+  organization: string,
+  params?: AdminServiceListAuditLogEntriesParams
+) =>
+  [
+    `/v1/organizations/${organization}/audit`,
+    ...(params ? [params] : []),
+  ] as const;
+
+export type AdminServiceListAuditLogEntriesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListAuditLogEntries>>
+>;
+export type AdminServiceListAuditLogEntriesQueryError = RpcStatus;
+
+export const createAdminServiceListAuditLogEntries = <
+  TData = Awaited<ReturnType<typeof adminServiceListAuditLogEntries>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  params?: AdminServiceListAuditLogEntriesParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListAuditLogEntries>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListAuditLogEntriesQueryKey(organization, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListAuditLogEntries>>
+  > = ({ signal }) =>
+    adminServiceListAuditLogEntries(organization, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListAuditLogEntries>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
 /**
  * @summary ListOrganizationMembers lists all the org members
  */
@@ -620,39 +974,178 @@ export const createAdminServiceSetOrganizationMemberRole = <
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary LeaveOrganization removes the current user from the organization
+ * @summary GetOrganizationMemberAttributes returns the custom attributes of a member, which are available to metrics view policies
  */
-export const adminServiceLeaveOrganization = (organization: string) => {
-  return httpClient<V1LeaveOrganizationResponse>({
-    url: `/v1/organizations/${organization}/members/current`,
-    method: "delete",
+export const adminServiceGetOrganizationMemberAttributes = (
+  organization: string,
+  email: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetOrganizationMemberAttributesResponse>({
+    url: `/v1/organizations/${organization}/members/${email}/attributes`,
+    method: "get",
+    signal,
   });
 };
 
-export type AdminServiceLeaveOrganizationMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceLeaveOrganization>>
->;
-
-export type AdminServiceLeaveOrganizationMutationError = RpcStatus;
+export const getAdminServiceGetOrganizationMemberAttributesQueryKey = (
+  organization: string,
+  email: string
+) => [`/v1/organizations/${organization}/members/${email}/attributes`] as const;
 
-export const createAdminServiceLeaveOrganization = <
-  TError = RpcStatus,
-  TContext = unknown
->(options?: {
-  mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceLeaveOrganization>>,
-    TError,
-    { organization: string },
-    TContext
+export type AdminServiceGetOrganizationMemberAttributesQueryResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationMemberAttributes>>
   >;
-}) => {
-  const { mutation: mutationOptions } = options ?? {};
+export type AdminServiceGetOrganizationMemberAttributesQueryError = RpcStatus;
 
-  const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceLeaveOrganization>>,
-    { organization: string }
-  > = (props) => {
-    const { organization } = props ?? {};
+export const createAdminServiceGetOrganizationMemberAttributes = <
+  TData = Awaited<
+    ReturnType<typeof adminServiceGetOrganizationMemberAttributes>
+  >,
+  TError = RpcStatus
+>(
+  organization: string,
+  email: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetOrganizationMemberAttributes>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetOrganizationMemberAttributesQueryKey(organization, email);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationMemberAttributes>>
+  > = ({ signal }) =>
+    adminServiceGetOrganizationMemberAttributes(organization, email, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationMemberAttributes>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organization && email),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary SetOrganizationMemberAttributes replaces the custom attributes of a member
+ */
+export const adminServiceSetOrganizationMemberAttributes = (
+  organization: string,
+  email: string,
+  adminServiceSetOrganizationMemberAttributesBody: AdminServiceSetOrganizationMemberAttributesBody
+) => {
+  return httpClient<V1SetOrganizationMemberAttributesResponse>({
+    url: `/v1/organizations/${organization}/members/${email}/attributes`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationMemberAttributesBody,
+  });
+};
+
+export type AdminServiceSetOrganizationMemberAttributesMutationResult =
+  NonNullable<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberAttributes>>
+  >;
+export type AdminServiceSetOrganizationMemberAttributesMutationBody =
+  AdminServiceSetOrganizationMemberAttributesBody;
+export type AdminServiceSetOrganizationMemberAttributesMutationError =
+  RpcStatus;
+
+export const createAdminServiceSetOrganizationMemberAttributes = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberAttributes>>,
+    TError,
+    {
+      organization: string;
+      email: string;
+      data: AdminServiceSetOrganizationMemberAttributesBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberAttributes>>,
+    {
+      organization: string;
+      email: string;
+      data: AdminServiceSetOrganizationMemberAttributesBody;
+    }
+  > = (props) => {
+    const { organization, email, data } = props ?? {};
+
+    return adminServiceSetOrganizationMemberAttributes(
+      organization,
+      email,
+      data
+    );
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationMemberAttributes>>,
+    TError,
+    {
+      organization: string;
+      email: string;
+      data: AdminServiceSetOrganizationMemberAttributesBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary LeaveOrganization removes the current user from the organization
+ */
+export const adminServiceLeaveOrganization = (organization: string) => {
+  return httpClient<V1LeaveOrganizationResponse>({
+    url: `/v1/organizations/${organization}/members/current`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceLeaveOrganizationMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceLeaveOrganization>>
+>;
+
+export type AdminServiceLeaveOrganizationMutationError = RpcStatus;
+
+export const createAdminServiceLeaveOrganization = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceLeaveOrganization>>,
+    TError,
+    { organization: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceLeaveOrganization>>,
+    { organization: string }
+  > = (props) => {
+    const { organization } = props ?? {};
 
     return adminServiceLeaveOrganization(organization);
   };
@@ -920,44 +1413,87 @@ export const createAdminServiceSetProjectMemberRole = <
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary ListProjectsForOrganization lists all the projects currently available for given organizations
+ * @summary UpdateOrganizationQuotas sets the quotas of an organization (superusers only)
  */
-export const adminServiceListProjectsForOrganization = (
-  organizationName: string,
-  params?: AdminServiceListProjectsForOrganizationParams,
+export const adminServiceUpdateOrganizationQuotas = (
+  organization: string,
+  adminServiceUpdateOrganizationQuotasBody: AdminServiceUpdateOrganizationQuotasBody
+) => {
+  return httpClient<V1UpdateOrganizationQuotasResponse>({
+    url: `/v1/organizations/${organization}/quotas`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceUpdateOrganizationQuotasBody,
+  });
+};
+
+export type AdminServiceUpdateOrganizationQuotasMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateOrganizationQuotas>>
+>;
+export type AdminServiceUpdateOrganizationQuotasMutationBody =
+  AdminServiceUpdateOrganizationQuotasBody;
+export type AdminServiceUpdateOrganizationQuotasMutationError = RpcStatus;
+
+export const createAdminServiceUpdateOrganizationQuotas = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationQuotas>>,
+    TError,
+    { organization: string; data: AdminServiceUpdateOrganizationQuotasBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationQuotas>>,
+    { organization: string; data: AdminServiceUpdateOrganizationQuotasBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceUpdateOrganizationQuotas(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUpdateOrganizationQuotas>>,
+    TError,
+    { organization: string; data: AdminServiceUpdateOrganizationQuotasBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListServiceAccounts lists the service accounts of an organization
+ */
+export const adminServiceListServiceAccounts = (
+  organization: string,
   signal?: AbortSignal
 ) => {
-  return httpClient<V1ListProjectsForOrganizationResponse>({
-    url: `/v1/organizations/${organizationName}/projects`,
+  return httpClient<V1ListServiceAccountsResponse>({
+    url: `/v1/organizations/${organization}/services`,
     method: "get",
-    params,
     signal,
   });
 };
 
-export const getAdminServiceListProjectsForOrganizationQueryKey = (
-  organizationName: string,
-  params?: AdminServiceListProjectsForOrganizationParams
-) =>
-  [
-    `/v1/organizations/${organizationName}/projects`,
-    ...(params ? [params] : []),
-  ] as const;
+export const getAdminServiceListServiceAccountsQueryKey = (
+  organization: string
+) => [`/v1/organizations/${organization}/services`] as const;
 
-export type AdminServiceListProjectsForOrganizationQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>
+export type AdminServiceListServiceAccountsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListServiceAccounts>>
 >;
-export type AdminServiceListProjectsForOrganizationQueryError = RpcStatus;
+export type AdminServiceListServiceAccountsQueryError = RpcStatus;
 
-export const createAdminServiceListProjectsForOrganization = <
-  TData = Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>,
+export const createAdminServiceListServiceAccounts = <
+  TData = Awaited<ReturnType<typeof adminServiceListServiceAccounts>>,
   TError = RpcStatus
 >(
-  organizationName: string,
-  params?: AdminServiceListProjectsForOrganizationParams,
+  organization: string,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>,
+      Awaited<ReturnType<typeof adminServiceListServiceAccounts>>,
       TError,
       TData
     >;
@@ -967,24 +1503,20 @@ export const createAdminServiceListProjectsForOrganization = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceListProjectsForOrganizationQueryKey(
-      organizationName,
-      params
-    );
+    getAdminServiceListServiceAccountsQueryKey(organization);
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>
-  > = ({ signal }) =>
-    adminServiceListProjectsForOrganization(organizationName, params, signal);
+    Awaited<ReturnType<typeof adminServiceListServiceAccounts>>
+  > = ({ signal }) => adminServiceListServiceAccounts(organization, signal);
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>,
+    Awaited<ReturnType<typeof adminServiceListServiceAccounts>>,
     TError,
     TData
   >({
     queryKey,
     queryFn,
-    enabled: !!organizationName,
+    enabled: !!organization,
     ...queryOptions,
   }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
 
@@ -994,90 +1526,138 @@ export const createAdminServiceListProjectsForOrganization = <
 };
 
 /**
- * @summary CreateProject creates a new project
+ * @summary CreateServiceAccount creates a service account for an organization, e.g. for a CI pipeline
  */
-export const adminServiceCreateProject = (
-  organizationName: string,
-  adminServiceCreateProjectBody: AdminServiceCreateProjectBody
+export const adminServiceCreateServiceAccount = (
+  organization: string,
+  adminServiceCreateServiceAccountBody: AdminServiceCreateServiceAccountBody
 ) => {
-  return httpClient<V1CreateProjectResponse>({
-    url: `/v1/organizations/${organizationName}/projects`,
+  return httpClient<V1CreateServiceAccountResponse>({
+    url: `/v1/organizations/${organization}/services`,
     method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceCreateProjectBody,
+    data: adminServiceCreateServiceAccountBody,
   });
 };
 
-export type AdminServiceCreateProjectMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceCreateProject>>
+export type AdminServiceCreateServiceAccountMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateServiceAccount>>
 >;
-export type AdminServiceCreateProjectMutationBody =
-  AdminServiceCreateProjectBody;
-export type AdminServiceCreateProjectMutationError = RpcStatus;
+export type AdminServiceCreateServiceAccountMutationBody =
+  AdminServiceCreateServiceAccountBody;
+export type AdminServiceCreateServiceAccountMutationError = RpcStatus;
 
-export const createAdminServiceCreateProject = <
+export const createAdminServiceCreateServiceAccount = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceCreateProject>>,
+    Awaited<ReturnType<typeof adminServiceCreateServiceAccount>>,
     TError,
-    { organizationName: string; data: AdminServiceCreateProjectBody },
+    { organization: string; data: AdminServiceCreateServiceAccountBody },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceCreateProject>>,
-    { organizationName: string; data: AdminServiceCreateProjectBody }
+    Awaited<ReturnType<typeof adminServiceCreateServiceAccount>>,
+    { organization: string; data: AdminServiceCreateServiceAccountBody }
   > = (props) => {
-    const { organizationName, data } = props ?? {};
+    const { organization, data } = props ?? {};
 
-    return adminServiceCreateProject(organizationName, data);
+    return adminServiceCreateServiceAccount(organization, data);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceCreateProject>>,
+    Awaited<ReturnType<typeof adminServiceCreateServiceAccount>>,
     TError,
-    { organizationName: string; data: AdminServiceCreateProjectBody },
+    { organization: string; data: AdminServiceCreateServiceAccountBody },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary GetProject returns information about a specific project
+ * @summary DeleteServiceAccount deletes a service account and revokes its tokens
  */
-export const adminServiceGetProject = (
-  organizationName: string,
+export const adminServiceDeleteServiceAccount = (
+  organization: string,
+  name: string
+) => {
+  return httpClient<V1DeleteServiceAccountResponse>({
+    url: `/v1/organizations/${organization}/services/${name}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteServiceAccountMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteServiceAccount>>
+>;
+
+export type AdminServiceDeleteServiceAccountMutationError = RpcStatus;
+
+export const createAdminServiceDeleteServiceAccount = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteServiceAccount>>,
+    TError,
+    { organization: string; name: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteServiceAccount>>,
+    { organization: string; name: string }
+  > = (props) => {
+    const { organization, name } = props ?? {};
+
+    return adminServiceDeleteServiceAccount(organization, name);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteServiceAccount>>,
+    TError,
+    { organization: string; name: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListServiceAuthTokens lists the auth tokens of a service account
+ */
+export const adminServiceListServiceAuthTokens = (
+  organization: string,
   name: string,
   signal?: AbortSignal
 ) => {
-  return httpClient<V1GetProjectResponse>({
-    url: `/v1/organizations/${organizationName}/projects/${name}`,
+  return httpClient<V1ListServiceAuthTokensResponse>({
+    url: `/v1/organizations/${organization}/services/${name}/tokens`,
     method: "get",
     signal,
   });
 };
 
-export const getAdminServiceGetProjectQueryKey = (
-  organizationName: string,
+export const getAdminServiceListServiceAuthTokensQueryKey = (
+  organization: string,
   name: string
-) => [`/v1/organizations/${organizationName}/projects/${name}`] as const;
+) => [`/v1/organizations/${organization}/services/${name}/tokens`] as const;
 
-export type AdminServiceGetProjectQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetProject>>
+export type AdminServiceListServiceAuthTokensQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListServiceAuthTokens>>
 >;
-export type AdminServiceGetProjectQueryError = RpcStatus;
+export type AdminServiceListServiceAuthTokensQueryError = RpcStatus;
 
-export const createAdminServiceGetProject = <
-  TData = Awaited<ReturnType<typeof adminServiceGetProject>>,
+export const createAdminServiceListServiceAuthTokens = <
+  TData = Awaited<ReturnType<typeof adminServiceListServiceAuthTokens>>,
   TError = RpcStatus
 >(
-  organizationName: string,
+  organization: string,
   name: string,
   options?: {
     query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetProject>>,
+      Awaited<ReturnType<typeof adminServiceListServiceAuthTokens>>,
       TError,
       TData
     >;
@@ -1087,20 +1667,21 @@ export const createAdminServiceGetProject = <
 
   const queryKey =
     queryOptions?.queryKey ??
-    getAdminServiceGetProjectQueryKey(organizationName, name);
+    getAdminServiceListServiceAuthTokensQueryKey(organization, name);
 
   const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGetProject>>
-  > = ({ signal }) => adminServiceGetProject(organizationName, name, signal);
+    Awaited<ReturnType<typeof adminServiceListServiceAuthTokens>>
+  > = ({ signal }) =>
+    adminServiceListServiceAuthTokens(organization, name, signal);
 
   const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceGetProject>>,
+    Awaited<ReturnType<typeof adminServiceListServiceAuthTokens>>,
     TError,
     TData
   >({
     queryKey,
     queryFn,
-    enabled: !!(organizationName && name),
+    enabled: !!(organization && name),
     ...queryOptions,
   }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
 
@@ -1110,87 +1691,1182 @@ export const createAdminServiceGetProject = <
 };
 
 /**
- * @summary DeleteProject deletes an project
+ * @summary IssueServiceAuthToken issues an auth token for a service account.
+The token is restricted to the given projects (or all projects of the org if none are given) and permission scopes.
  */
-export const adminServiceDeleteProject = (
-  organizationName: string,
-  name: string
+export const adminServiceIssueServiceAuthToken = (
+  organization: string,
+  name: string,
+  adminServiceIssueServiceAuthTokenBody: AdminServiceIssueServiceAuthTokenBody
 ) => {
-  return httpClient<V1DeleteProjectResponse>({
-    url: `/v1/organizations/${organizationName}/projects/${name}`,
-    method: "delete",
-  });
+  return httpClient<V1IssueServiceAuthTokenResponse>({
+    url: `/v1/organizations/${organization}/services/${name}/tokens`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceIssueServiceAuthTokenBody,
+  });
+};
+
+export type AdminServiceIssueServiceAuthTokenMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceIssueServiceAuthToken>>
+>;
+export type AdminServiceIssueServiceAuthTokenMutationBody =
+  AdminServiceIssueServiceAuthTokenBody;
+export type AdminServiceIssueServiceAuthTokenMutationError = RpcStatus;
+
+export const createAdminServiceIssueServiceAuthToken = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceIssueServiceAuthToken>>,
+    TError,
+    {
+      organization: string;
+      name: string;
+      data: AdminServiceIssueServiceAuthTokenBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceIssueServiceAuthToken>>,
+    {
+      organization: string;
+      name: string;
+      data: AdminServiceIssueServiceAuthTokenBody;
+    }
+  > = (props) => {
+    const { organization, name, data } = props ?? {};
+
+    return adminServiceIssueServiceAuthToken(organization, name, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceIssueServiceAuthToken>>,
+    TError,
+    {
+      organization: string;
+      name: string;
+      data: AdminServiceIssueServiceAuthTokenBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RevokeServiceAuthToken revokes an auth token of a service account
+ */
+export const adminServiceRevokeServiceAuthToken = (
+  organization: string,
+  name: string,
+  tokenId: string
+) => {
+  return httpClient<V1RevokeServiceAuthTokenResponse>({
+    url: `/v1/organizations/${organization}/services/${name}/tokens/${tokenId}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceRevokeServiceAuthTokenMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRevokeServiceAuthToken>>
+>;
+
+export type AdminServiceRevokeServiceAuthTokenMutationError = RpcStatus;
+
+export const createAdminServiceRevokeServiceAuthToken = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRevokeServiceAuthToken>>,
+    TError,
+    { organization: string; name: string; tokenId: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRevokeServiceAuthToken>>,
+    { organization: string; name: string; tokenId: string }
+  > = (props) => {
+    const { organization, name, tokenId } = props ?? {};
+
+    return adminServiceRevokeServiceAuthToken(organization, name, tokenId);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRevokeServiceAuthToken>>,
+    TError,
+    { organization: string; name: string; tokenId: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GetOrganizationSSO returns the identity provider of an organization and the mappings of its groups to usergroups
+ */
+export const adminServiceGetOrganizationSSO = (
+  organization: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetOrganizationSSOResponse>({
+    url: `/v1/organizations/${organization}/sso`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetOrganizationSSOQueryKey = (
+  organization: string
+) => [`/v1/organizations/${organization}/sso`] as const;
+
+export type AdminServiceGetOrganizationSSOQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetOrganizationSSO>>
+>;
+export type AdminServiceGetOrganizationSSOQueryError = RpcStatus;
+
+export const createAdminServiceGetOrganizationSSO = <
+  TData = Awaited<ReturnType<typeof adminServiceGetOrganizationSSO>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetOrganizationSSO>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetOrganizationSSOQueryKey(organization);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationSSO>>
+  > = ({ signal }) => adminServiceGetOrganizationSSO(organization, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationSSO>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary DeleteOrganizationSSO removes the identity provider of an organization and the usergroups mapped to its groups
+ */
+export const adminServiceDeleteOrganizationSSO = (organization: string) => {
+  return httpClient<V1DeleteOrganizationSSOResponse>({
+    url: `/v1/organizations/${organization}/sso`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteOrganizationSSOMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteOrganizationSSO>>
+>;
+
+export type AdminServiceDeleteOrganizationSSOMutationError = RpcStatus;
+
+export const createAdminServiceDeleteOrganizationSSO = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationSSO>>,
+    TError,
+    { organization: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationSSO>>,
+    { organization: string }
+  > = (props) => {
+    const { organization } = props ?? {};
+
+    return adminServiceDeleteOrganizationSSO(organization);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteOrganizationSSO>>,
+    TError,
+    { organization: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary SetOrganizationSSO configures the OIDC identity provider that users with an email address in one of the domains sign in with.
+Each domain must have a DNS TXT record with the verification record returned by GetOrganizationSSO.
+ */
+export const adminServiceSetOrganizationSSO = (
+  organization: string,
+  adminServiceSetOrganizationSSOBody: AdminServiceSetOrganizationSSOBody
+) => {
+  return httpClient<V1SetOrganizationSSOResponse>({
+    url: `/v1/organizations/${organization}/sso`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceSetOrganizationSSOBody,
+  });
+};
+
+export type AdminServiceSetOrganizationSSOMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceSetOrganizationSSO>>
+>;
+export type AdminServiceSetOrganizationSSOMutationBody =
+  AdminServiceSetOrganizationSSOBody;
+export type AdminServiceSetOrganizationSSOMutationError = RpcStatus;
+
+export const createAdminServiceSetOrganizationSSO = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationSSO>>,
+    TError,
+    { organization: string; data: AdminServiceSetOrganizationSSOBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationSSO>>,
+    { organization: string; data: AdminServiceSetOrganizationSSOBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceSetOrganizationSSO(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceSetOrganizationSSO>>,
+    TError,
+    { organization: string; data: AdminServiceSetOrganizationSSOBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary RemoveOrganizationSSOGroup deletes the usergroup mapped to a group of the identity provider
+ */
+export const adminServiceRemoveOrganizationSSOGroup = (
+  organization: string,
+  params?: AdminServiceRemoveOrganizationSSOGroupParams
+) => {
+  return httpClient<V1RemoveOrganizationSSOGroupResponse>({
+    url: `/v1/organizations/${organization}/sso/groups`,
+    method: "delete",
+    params,
+  });
+};
+
+export type AdminServiceRemoveOrganizationSSOGroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceRemoveOrganizationSSOGroup>>
+>;
+
+export type AdminServiceRemoveOrganizationSSOGroupMutationError = RpcStatus;
+
+export const createAdminServiceRemoveOrganizationSSOGroup = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceRemoveOrganizationSSOGroup>>,
+    TError,
+    {
+      organization: string;
+      params?: AdminServiceRemoveOrganizationSSOGroupParams;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceRemoveOrganizationSSOGroup>>,
+    {
+      organization: string;
+      params?: AdminServiceRemoveOrganizationSSOGroupParams;
+    }
+  > = (props) => {
+    const { organization, params } = props ?? {};
+
+    return adminServiceRemoveOrganizationSSOGroup(organization, params);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceRemoveOrganizationSSOGroup>>,
+    TError,
+    {
+      organization: string;
+      params?: AdminServiceRemoveOrganizationSSOGroupParams;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary AddOrganizationSSOGroup creates a usergroup with organization and project roles, whose members are the users in a group of the identity provider
+ */
+export const adminServiceAddOrganizationSSOGroup = (
+  organization: string,
+  adminServiceAddOrganizationSSOGroupBody: AdminServiceAddOrganizationSSOGroupBody
+) => {
+  return httpClient<V1AddOrganizationSSOGroupResponse>({
+    url: `/v1/organizations/${organization}/sso/groups`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceAddOrganizationSSOGroupBody,
+  });
+};
+
+export type AdminServiceAddOrganizationSSOGroupMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceAddOrganizationSSOGroup>>
+>;
+export type AdminServiceAddOrganizationSSOGroupMutationBody =
+  AdminServiceAddOrganizationSSOGroupBody;
+export type AdminServiceAddOrganizationSSOGroupMutationError = RpcStatus;
+
+export const createAdminServiceAddOrganizationSSOGroup = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceAddOrganizationSSOGroup>>,
+    TError,
+    { organization: string; data: AdminServiceAddOrganizationSSOGroupBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceAddOrganizationSSOGroup>>,
+    { organization: string; data: AdminServiceAddOrganizationSSOGroupBody }
+  > = (props) => {
+    const { organization, data } = props ?? {};
+
+    return adminServiceAddOrganizationSSOGroup(organization, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceAddOrganizationSSOGroup>>,
+    TError,
+    { organization: string; data: AdminServiceAddOrganizationSSOGroupBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GetOrganizationUsage returns the current usage and the quotas of an organization
+ */
+export const adminServiceGetOrganizationUsage = (
+  organization: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetOrganizationUsageResponse>({
+    url: `/v1/organizations/${organization}/usage`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceGetOrganizationUsageQueryKey = (
+  organization: string
+) => [`/v1/organizations/${organization}/usage`] as const;
+
+export type AdminServiceGetOrganizationUsageQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetOrganizationUsage>>
+>;
+export type AdminServiceGetOrganizationUsageQueryError = RpcStatus;
+
+export const createAdminServiceGetOrganizationUsage = <
+  TData = Awaited<ReturnType<typeof adminServiceGetOrganizationUsage>>,
+  TError = RpcStatus
+>(
+  organization: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetOrganizationUsage>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetOrganizationUsageQueryKey(organization);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationUsage>>
+  > = ({ signal }) => adminServiceGetOrganizationUsage(organization, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetOrganizationUsage>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organization,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ListProjectsForOrganization lists all the projects currently available for given organizations
+ */
+export const adminServiceListProjectsForOrganization = (
+  organizationName: string,
+  params?: AdminServiceListProjectsForOrganizationParams,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListProjectsForOrganizationResponse>({
+    url: `/v1/organizations/${organizationName}/projects`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectsForOrganizationQueryKey = (
+  organizationName: string,
+  params?: AdminServiceListProjectsForOrganizationParams
+) =>
+  [
+    `/v1/organizations/${organizationName}/projects`,
+    ...(params ? [params] : []),
+  ] as const;
+
+export type AdminServiceListProjectsForOrganizationQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>
+>;
+export type AdminServiceListProjectsForOrganizationQueryError = RpcStatus;
+
+export const createAdminServiceListProjectsForOrganization = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  params?: AdminServiceListProjectsForOrganizationParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectsForOrganizationQueryKey(
+      organizationName,
+      params
+    );
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>
+  > = ({ signal }) =>
+    adminServiceListProjectsForOrganization(organizationName, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectsForOrganization>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!organizationName,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateProject creates a new project
+ */
+export const adminServiceCreateProject = (
+  organizationName: string,
+  adminServiceCreateProjectBody: AdminServiceCreateProjectBody
+) => {
+  return httpClient<V1CreateProjectResponse>({
+    url: `/v1/organizations/${organizationName}/projects`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateProjectBody,
+  });
+};
+
+export type AdminServiceCreateProjectMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateProject>>
+>;
+export type AdminServiceCreateProjectMutationBody =
+  AdminServiceCreateProjectBody;
+export type AdminServiceCreateProjectMutationError = RpcStatus;
+
+export const createAdminServiceCreateProject = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateProject>>,
+    TError,
+    { organizationName: string; data: AdminServiceCreateProjectBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateProject>>,
+    { organizationName: string; data: AdminServiceCreateProjectBody }
+  > = (props) => {
+    const { organizationName, data } = props ?? {};
+
+    return adminServiceCreateProject(organizationName, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateProject>>,
+    TError,
+    { organizationName: string; data: AdminServiceCreateProjectBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary GetProject returns information about a specific project
+ */
+export const adminServiceGetProject = (
+  organizationName: string,
+  name: string,
+  params?: AdminServiceGetProjectParams,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetProjectResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceGetProjectQueryKey = (
+  organizationName: string,
+  name: string,
+  params?: AdminServiceGetProjectParams
+) =>
+  [
+    `/v1/organizations/${organizationName}/projects/${name}`,
+    ...(params ? [params] : []),
+  ] as const;
+
+export type AdminServiceGetProjectQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetProject>>
+>;
+export type AdminServiceGetProjectQueryError = RpcStatus;
+
+export const createAdminServiceGetProject = <
+  TData = Awaited<ReturnType<typeof adminServiceGetProject>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  name: string,
+  params?: AdminServiceGetProjectParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetProject>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetProjectQueryKey(organizationName, name, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetProject>>
+  > = ({ signal }) =>
+    adminServiceGetProject(organizationName, name, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetProject>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organizationName && name),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary DeleteProject deletes an project
+ */
+export const adminServiceDeleteProject = (
+  organizationName: string,
+  name: string
+) => {
+  return httpClient<V1DeleteProjectResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}`,
+    method: "delete",
+  });
+};
+
+export type AdminServiceDeleteProjectMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteProject>>
+>;
+
+export type AdminServiceDeleteProjectMutationError = RpcStatus;
+
+export const createAdminServiceDeleteProject = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDeleteProject>>,
+    TError,
+    { organizationName: string; name: string },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDeleteProject>>,
+    { organizationName: string; name: string }
+  > = (props) => {
+    const { organizationName, name } = props ?? {};
+
+    return adminServiceDeleteProject(organizationName, name);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDeleteProject>>,
+    TError,
+    { organizationName: string; name: string },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary UpdateProject updates a project
+ */
+export const adminServiceUpdateProject = (
+  organizationName: string,
+  name: string,
+  adminServiceUpdateProjectBody: AdminServiceUpdateProjectBody
+) => {
+  return httpClient<V1UpdateProjectResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceUpdateProjectBody,
+  });
+};
+
+export type AdminServiceUpdateProjectMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateProject>>
+>;
+export type AdminServiceUpdateProjectMutationBody =
+  AdminServiceUpdateProjectBody;
+export type AdminServiceUpdateProjectMutationError = RpcStatus;
+
+export const createAdminServiceUpdateProject = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
+    TError,
+    {
+      organizationName: string;
+      name: string;
+      data: AdminServiceUpdateProjectBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
+    {
+      organizationName: string;
+      name: string;
+      data: AdminServiceUpdateProjectBody;
+    }
+  > = (props) => {
+    const { organizationName, name, data } = props ?? {};
+
+    return adminServiceUpdateProject(organizationName, name, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
+    TError,
+    {
+      organizationName: string;
+      name: string;
+      data: AdminServiceUpdateProjectBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListProjectJobs lists the most recent background jobs of a project, such as reconciles of its deployments
+ */
+export const adminServiceListProjectJobs = (
+  organizationName: string,
+  name: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListProjectJobsResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}/jobs`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListProjectJobsQueryKey = (
+  organizationName: string,
+  name: string
+) => [`/v1/organizations/${organizationName}/projects/${name}/jobs`] as const;
+
+export type AdminServiceListProjectJobsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListProjectJobs>>
+>;
+export type AdminServiceListProjectJobsQueryError = RpcStatus;
+
+export const createAdminServiceListProjectJobs = <
+  TData = Awaited<ReturnType<typeof adminServiceListProjectJobs>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  name: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListProjectJobs>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListProjectJobsQueryKey(organizationName, name);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListProjectJobs>>
+  > = ({ signal }) =>
+    adminServiceListProjectJobs(organizationName, name, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListProjectJobs>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organizationName && name),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary GetProjectVariables returns project variables. NOTE: Get project API doesn't return variables.
+ */
+export const adminServiceGetProjectVariables = (
+  organizationName: string,
+  name: string,
+  params?: AdminServiceGetProjectVariablesParams,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetProjectVariablesResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}/variables`,
+    method: "get",
+    params,
+    signal,
+  });
+};
+
+export const getAdminServiceGetProjectVariablesQueryKey = (
+  organizationName: string,
+  name: string,
+  params?: AdminServiceGetProjectVariablesParams
+) =>
+  [
+    `/v1/organizations/${organizationName}/projects/${name}/variables`,
+    ...(params ? [params] : []),
+  ] as const;
+
+export type AdminServiceGetProjectVariablesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceGetProjectVariables>>
+>;
+export type AdminServiceGetProjectVariablesQueryError = RpcStatus;
+
+export const createAdminServiceGetProjectVariables = <
+  TData = Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  name: string,
+  params?: AdminServiceGetProjectVariablesParams,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceGetProjectVariablesQueryKey(organizationName, name, params);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceGetProjectVariables>>
+  > = ({ signal }) =>
+    adminServiceGetProjectVariables(organizationName, name, params, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organizationName && name),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary UpdateProjectVariables updates variables for a project. NOTE: Update project API doesn't update variables.
+ */
+export const adminServiceUpdateProjectVariables = (
+  organizationName: string,
+  name: string,
+  adminServiceUpdateProjectVariablesBody: AdminServiceUpdateProjectVariablesBody
+) => {
+  return httpClient<V1UpdateProjectVariablesResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${name}/variables`,
+    method: "put",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceUpdateProjectVariablesBody,
+  });
+};
+
+export type AdminServiceUpdateProjectVariablesMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>
+>;
+export type AdminServiceUpdateProjectVariablesMutationBody =
+  AdminServiceUpdateProjectVariablesBody;
+export type AdminServiceUpdateProjectVariablesMutationError = RpcStatus;
+
+export const createAdminServiceUpdateProjectVariables = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>,
+    TError,
+    {
+      organizationName: string;
+      name: string;
+      data: AdminServiceUpdateProjectVariablesBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>,
+    {
+      organizationName: string;
+      name: string;
+      data: AdminServiceUpdateProjectVariablesBody;
+    }
+  > = (props) => {
+    const { organizationName, name, data } = props ?? {};
+
+    return adminServiceUpdateProjectVariables(organizationName, name, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>,
+    TError,
+    {
+      organizationName: string;
+      name: string;
+      data: AdminServiceUpdateProjectVariablesBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary ListEnvironments lists the environments of a project, such as prod and staging, with their deployments
+ */
+export const adminServiceListEnvironments = (
+  organizationName: string,
+  projectName: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListEnvironmentsResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${projectName}/environments`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListEnvironmentsQueryKey = (
+  organizationName: string,
+  projectName: string
+) =>
+  [
+    `/v1/organizations/${organizationName}/projects/${projectName}/environments`,
+  ] as const;
+
+export type AdminServiceListEnvironmentsQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListEnvironments>>
+>;
+export type AdminServiceListEnvironmentsQueryError = RpcStatus;
+
+export const createAdminServiceListEnvironments = <
+  TData = Awaited<ReturnType<typeof adminServiceListEnvironments>>,
+  TError = RpcStatus
+>(
+  organizationName: string,
+  projectName: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof adminServiceListEnvironments>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getAdminServiceListEnvironmentsQueryKey(organizationName, projectName);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListEnvironments>>
+  > = ({ signal }) =>
+    adminServiceListEnvironments(organizationName, projectName, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListEnvironments>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!(organizationName && projectName),
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CreateEnvironment creates a named environment for a project and deploys it
+ */
+export const adminServiceCreateEnvironment = (
+  organizationName: string,
+  projectName: string,
+  adminServiceCreateEnvironmentBody: AdminServiceCreateEnvironmentBody
+) => {
+  return httpClient<V1CreateEnvironmentResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${projectName}/environments`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: adminServiceCreateEnvironmentBody,
+  });
+};
+
+export type AdminServiceCreateEnvironmentMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceCreateEnvironment>>
+>;
+export type AdminServiceCreateEnvironmentMutationBody =
+  AdminServiceCreateEnvironmentBody;
+export type AdminServiceCreateEnvironmentMutationError = RpcStatus;
+
+export const createAdminServiceCreateEnvironment = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceCreateEnvironment>>,
+    TError,
+    {
+      organizationName: string;
+      projectName: string;
+      data: AdminServiceCreateEnvironmentBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceCreateEnvironment>>,
+    {
+      organizationName: string;
+      projectName: string;
+      data: AdminServiceCreateEnvironmentBody;
+    }
+  > = (props) => {
+    const { organizationName, projectName, data } = props ?? {};
+
+    return adminServiceCreateEnvironment(organizationName, projectName, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceCreateEnvironment>>,
+    TError,
+    {
+      organizationName: string;
+      projectName: string;
+      data: AdminServiceCreateEnvironmentBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary DeleteEnvironment tears down the deployment of an environment and deletes it. The prod environment can't be deleted.
+ */
+export const adminServiceDeleteEnvironment = (
+  organizationName: string,
+  projectName: string,
+  name: string
+) => {
+  return httpClient<V1DeleteEnvironmentResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${projectName}/environments/${name}`,
+    method: "delete",
+  });
 };
 
-export type AdminServiceDeleteProjectMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceDeleteProject>>
+export type AdminServiceDeleteEnvironmentMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDeleteEnvironment>>
 >;
 
-export type AdminServiceDeleteProjectMutationError = RpcStatus;
+export type AdminServiceDeleteEnvironmentMutationError = RpcStatus;
 
-export const createAdminServiceDeleteProject = <
+export const createAdminServiceDeleteEnvironment = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceDeleteProject>>,
+    Awaited<ReturnType<typeof adminServiceDeleteEnvironment>>,
     TError,
-    { organizationName: string; name: string },
+    { organizationName: string; projectName: string; name: string },
     TContext
   >;
 }) => {
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceDeleteProject>>,
-    { organizationName: string; name: string }
+    Awaited<ReturnType<typeof adminServiceDeleteEnvironment>>,
+    { organizationName: string; projectName: string; name: string }
   > = (props) => {
-    const { organizationName, name } = props ?? {};
+    const { organizationName, projectName, name } = props ?? {};
 
-    return adminServiceDeleteProject(organizationName, name);
+    return adminServiceDeleteEnvironment(organizationName, projectName, name);
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceDeleteProject>>,
+    Awaited<ReturnType<typeof adminServiceDeleteEnvironment>>,
     TError,
-    { organizationName: string; name: string },
+    { organizationName: string; projectName: string; name: string },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary UpdateProject updates a project
+ * @summary UpdateEnvironment changes the branch an environment is deployed from. It unpins the environment from a promoted commit.
  */
-export const adminServiceUpdateProject = (
+export const adminServiceUpdateEnvironment = (
   organizationName: string,
+  projectName: string,
   name: string,
-  adminServiceUpdateProjectBody: AdminServiceUpdateProjectBody
+  adminServiceUpdateEnvironmentBody: AdminServiceUpdateEnvironmentBody
 ) => {
-  return httpClient<V1UpdateProjectResponse>({
-    url: `/v1/organizations/${organizationName}/projects/${name}`,
+  return httpClient<V1UpdateEnvironmentResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${projectName}/environments/${name}`,
     method: "put",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceUpdateProjectBody,
+    data: adminServiceUpdateEnvironmentBody,
   });
 };
 
-export type AdminServiceUpdateProjectMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceUpdateProject>>
+export type AdminServiceUpdateEnvironmentMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUpdateEnvironment>>
 >;
-export type AdminServiceUpdateProjectMutationBody =
-  AdminServiceUpdateProjectBody;
-export type AdminServiceUpdateProjectMutationError = RpcStatus;
+export type AdminServiceUpdateEnvironmentMutationBody =
+  AdminServiceUpdateEnvironmentBody;
+export type AdminServiceUpdateEnvironmentMutationError = RpcStatus;
 
-export const createAdminServiceUpdateProject = <
+export const createAdminServiceUpdateEnvironment = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
+    Awaited<ReturnType<typeof adminServiceUpdateEnvironment>>,
     TError,
     {
       organizationName: string;
+      projectName: string;
       name: string;
-      data: AdminServiceUpdateProjectBody;
+      data: AdminServiceUpdateEnvironmentBody;
     },
     TContext
   >;
@@ -1198,130 +2874,72 @@ export const createAdminServiceUpdateProject = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
+    Awaited<ReturnType<typeof adminServiceUpdateEnvironment>>,
     {
       organizationName: string;
+      projectName: string;
       name: string;
-      data: AdminServiceUpdateProjectBody;
+      data: AdminServiceUpdateEnvironmentBody;
     }
   > = (props) => {
-    const { organizationName, name, data } = props ?? {};
+    const { organizationName, projectName, name, data } = props ?? {};
 
-    return adminServiceUpdateProject(organizationName, name, data);
+    return adminServiceUpdateEnvironment(
+      organizationName,
+      projectName,
+      name,
+      data
+    );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceUpdateProject>>,
+    Awaited<ReturnType<typeof adminServiceUpdateEnvironment>>,
     TError,
     {
       organizationName: string;
+      projectName: string;
       name: string;
-      data: AdminServiceUpdateProjectBody;
+      data: AdminServiceUpdateEnvironmentBody;
     },
     TContext
   >(mutationFn, mutationOptions);
 };
 /**
- * @summary GetProjectVariables returns project variables. NOTE: Get project API doesn't return variables.
- */
-export const adminServiceGetProjectVariables = (
-  organizationName: string,
-  name: string,
-  signal?: AbortSignal
-) => {
-  return httpClient<V1GetProjectVariablesResponse>({
-    url: `/v1/organizations/${organizationName}/projects/${name}/variables`,
-    method: "get",
-    signal,
-  });
-};
-
-export const getAdminServiceGetProjectVariablesQueryKey = (
-  organizationName: string,
-  name: string
-) =>
-  [`/v1/organizations/${organizationName}/projects/${name}/variables`] as const;
-
-export type AdminServiceGetProjectVariablesQueryResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceGetProjectVariables>>
->;
-export type AdminServiceGetProjectVariablesQueryError = RpcStatus;
-
-export const createAdminServiceGetProjectVariables = <
-  TData = Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
-  TError = RpcStatus
->(
-  organizationName: string,
-  name: string,
-  options?: {
-    query?: CreateQueryOptions<
-      Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
-      TError,
-      TData
-    >;
-  }
-): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
-  const { query: queryOptions } = options ?? {};
-
-  const queryKey =
-    queryOptions?.queryKey ??
-    getAdminServiceGetProjectVariablesQueryKey(organizationName, name);
-
-  const queryFn: QueryFunction<
-    Awaited<ReturnType<typeof adminServiceGetProjectVariables>>
-  > = ({ signal }) =>
-    adminServiceGetProjectVariables(organizationName, name, signal);
-
-  const query = createQuery<
-    Awaited<ReturnType<typeof adminServiceGetProjectVariables>>,
-    TError,
-    TData
-  >({
-    queryKey,
-    queryFn,
-    enabled: !!(organizationName && name),
-    ...queryOptions,
-  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
-
-  query.queryKey = queryKey;
-
-  return query;
-};
-
-/**
- * @summary UpdateProjectVariables updates variables for a project. NOTE: Update project API doesn't update variables.
+ * @summary PromoteEnvironment deploys the commit of an environment to another environment, e.g. from staging to prod
  */
-export const adminServiceUpdateProjectVariables = (
+export const adminServicePromoteEnvironment = (
   organizationName: string,
+  projectName: string,
   name: string,
-  adminServiceUpdateProjectVariablesBody: AdminServiceUpdateProjectVariablesBody
+  adminServicePromoteEnvironmentBody: AdminServicePromoteEnvironmentBody
 ) => {
-  return httpClient<V1UpdateProjectVariablesResponse>({
-    url: `/v1/organizations/${organizationName}/projects/${name}/variables`,
-    method: "put",
+  return httpClient<V1PromoteEnvironmentResponse>({
+    url: `/v1/organizations/${organizationName}/projects/${projectName}/environments/${name}/promote`,
+    method: "post",
     headers: { "Content-Type": "application/json" },
-    data: adminServiceUpdateProjectVariablesBody,
+    data: adminServicePromoteEnvironmentBody,
   });
 };
 
-export type AdminServiceUpdateProjectVariablesMutationResult = NonNullable<
-  Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>
+export type AdminServicePromoteEnvironmentMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServicePromoteEnvironment>>
 >;
-export type AdminServiceUpdateProjectVariablesMutationBody =
-  AdminServiceUpdateProjectVariablesBody;
-export type AdminServiceUpdateProjectVariablesMutationError = RpcStatus;
+export type AdminServicePromoteEnvironmentMutationBody =
+  AdminServicePromoteEnvironmentBody;
+export type AdminServicePromoteEnvironmentMutationError = RpcStatus;
 
-export const createAdminServiceUpdateProjectVariables = <
+export const createAdminServicePromoteEnvironment = <
   TError = RpcStatus,
   TContext = unknown
 >(options?: {
   mutation?: CreateMutationOptions<
-    Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>,
+    Awaited<ReturnType<typeof adminServicePromoteEnvironment>>,
     TError,
     {
       organizationName: string;
+      projectName: string;
       name: string;
-      data: AdminServiceUpdateProjectVariablesBody;
+      data: AdminServicePromoteEnvironmentBody;
     },
     TContext
   >;
@@ -1329,25 +2947,32 @@ export const createAdminServiceUpdateProjectVariables = <
   const { mutation: mutationOptions } = options ?? {};
 
   const mutationFn: MutationFunction<
-    Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>,
+    Awaited<ReturnType<typeof adminServicePromoteEnvironment>>,
     {
       organizationName: string;
+      projectName: string;
       name: string;
-      data: AdminServiceUpdateProjectVariablesBody;
+      data: AdminServicePromoteEnvironmentBody;
     }
   > = (props) => {
-    const { organizationName, name, data } = props ?? {};
+    const { organizationName, projectName, name, data } = props ?? {};
 
-    return adminServiceUpdateProjectVariables(organizationName, name, data);
+    return adminServicePromoteEnvironment(
+      organizationName,
+      projectName,
+      name,
+      data
+    );
   };
 
   return createMutation<
-    Awaited<ReturnType<typeof adminServiceUpdateProjectVariables>>,
+    Awaited<ReturnType<typeof adminServicePromoteEnvironment>>,
     TError,
     {
       organizationName: string;
+      projectName: string;
       name: string;
-      data: AdminServiceUpdateProjectVariablesBody;
+      data: AdminServicePromoteEnvironmentBody;
     },
     TContext
   >(mutationFn, mutationOptions);
@@ -1488,6 +3113,207 @@ export const createAdminServicePing = <
   return query;
 };
 
+/**
+ * @summary ListRuntimes lists the runtime servers that registered with the dynamic provisioner (superusers only)
+ */
+export const adminServiceListRuntimes = (signal?: AbortSignal) => {
+  return httpClient<V1ListRuntimesResponse>({
+    url: `/v1/runtimes`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getAdminServiceListRuntimesQueryKey = () =>
+  [`/v1/runtimes`] as const;
+
+export type AdminServiceListRuntimesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceListRuntimes>>
+>;
+export type AdminServiceListRuntimesQueryError = RpcStatus;
+
+export const createAdminServiceListRuntimes = <
+  TData = Awaited<ReturnType<typeof adminServiceListRuntimes>>,
+  TError = RpcStatus
+>(options?: {
+  query?: CreateQueryOptions<
+    Awaited<ReturnType<typeof adminServiceListRuntimes>>,
+    TError,
+    TData
+  >;
+}): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ?? getAdminServiceListRuntimesQueryKey();
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof adminServiceListRuntimes>>
+  > = ({ signal }) => adminServiceListRuntimes(signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof adminServiceListRuntimes>>,
+    TError,
+    TData
+  >({ queryKey, queryFn, ...queryOptions }) as CreateQueryResult<
+    TData,
+    TError
+  > & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary DrainRuntime stops new deployments from being provisioned on a runtime and migrates its deployments to other runtimes (superusers only)
+ */
+export const adminServiceDrainRuntime = (
+  v1DrainRuntimeRequest: V1DrainRuntimeRequest
+) => {
+  return httpClient<V1DrainRuntimeResponse>({
+    url: `/v1/runtimes/drain`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: v1DrainRuntimeRequest,
+  });
+};
+
+export type AdminServiceDrainRuntimeMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceDrainRuntime>>
+>;
+export type AdminServiceDrainRuntimeMutationBody = V1DrainRuntimeRequest;
+export type AdminServiceDrainRuntimeMutationError = RpcStatus;
+
+export const createAdminServiceDrainRuntime = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceDrainRuntime>>,
+    TError,
+    { data: V1DrainRuntimeRequest },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceDrainRuntime>>,
+    { data: V1DrainRuntimeRequest }
+  > = (props) => {
+    const { data } = props ?? {};
+
+    return adminServiceDrainRuntime(data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceDrainRuntime>>,
+    TError,
+    { data: V1DrainRuntimeRequest },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary HeartbeatRuntime registers a runtime server with the dynamic provisioner, or records that a registered runtime is alive.
+It's called periodically by runtime servers, which authenticate with the shared runtime secret.
+ */
+export const adminServiceHeartbeatRuntime = (
+  v1HeartbeatRuntimeRequest: V1HeartbeatRuntimeRequest
+) => {
+  return httpClient<V1HeartbeatRuntimeResponse>({
+    url: `/v1/runtimes/heartbeat`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: v1HeartbeatRuntimeRequest,
+  });
+};
+
+export type AdminServiceHeartbeatRuntimeMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceHeartbeatRuntime>>
+>;
+export type AdminServiceHeartbeatRuntimeMutationBody =
+  V1HeartbeatRuntimeRequest;
+export type AdminServiceHeartbeatRuntimeMutationError = RpcStatus;
+
+export const createAdminServiceHeartbeatRuntime = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceHeartbeatRuntime>>,
+    TError,
+    { data: V1HeartbeatRuntimeRequest },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceHeartbeatRuntime>>,
+    { data: V1HeartbeatRuntimeRequest }
+  > = (props) => {
+    const { data } = props ?? {};
+
+    return adminServiceHeartbeatRuntime(data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceHeartbeatRuntime>>,
+    TError,
+    { data: V1HeartbeatRuntimeRequest },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary UncordonRuntime allows new deployments to be provisioned on a drained runtime again (superusers only)
+ */
+export const adminServiceUncordonRuntime = (
+  v1UncordonRuntimeRequest: V1UncordonRuntimeRequest
+) => {
+  return httpClient<V1UncordonRuntimeResponse>({
+    url: `/v1/runtimes/uncordon`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: v1UncordonRuntimeRequest,
+  });
+};
+
+export type AdminServiceUncordonRuntimeMutationResult = NonNullable<
+  Awaited<ReturnType<typeof adminServiceUncordonRuntime>>
+>;
+export type AdminServiceUncordonRuntimeMutationBody = V1UncordonRuntimeRequest;
+export type AdminServiceUncordonRuntimeMutationError = RpcStatus;
+
+export const createAdminServiceUncordonRuntime = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof adminServiceUncordonRuntime>>,
+    TError,
+    { data: V1UncordonRuntimeRequest },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof adminServiceUncordonRuntime>>,
+    { data: V1UncordonRuntimeRequest }
+  > = (props) => {
+    const { data } = props ?? {};
+
+    return adminServiceUncordonRuntime(data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof adminServiceUncordonRuntime>>,
+    TError,
+    { data: V1UncordonRuntimeRequest },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary RevokeCurrentAuthToken revoke the current auth token
  */
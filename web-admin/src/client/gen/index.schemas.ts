@@ -10,12 +10,38 @@ export type AdminServiceListProjectsForOrganizationAndGithubURLParams = {
   pageToken?: string;
 };
 
+export type AdminServicePromoteEnvironmentBody = {
+  target?: string;
+};
+
+export type AdminServiceUpdateEnvironmentBody = {
+  branch?: string;
+};
+
+export type AdminServiceCreateEnvironmentBodyVariables = {
+  [key: string]: string;
+};
+
+export type AdminServiceCreateEnvironmentBody = {
+  name?: string;
+  branch?: string;
+  olapDriver?: string;
+  olapDsn?: string;
+  slots?: string;
+  variables?: AdminServiceCreateEnvironmentBodyVariables;
+};
+
 export type AdminServiceUpdateProjectVariablesBodyVariables = {
   [key: string]: string;
 };
 
 export type AdminServiceUpdateProjectVariablesBody = {
   variables?: AdminServiceUpdateProjectVariablesBodyVariables;
+  environment?: string;
+};
+
+export type AdminServiceGetProjectVariablesParams = {
+  environment?: string;
 };
 
 export type AdminServiceUpdateProjectBody = {
@@ -26,6 +52,10 @@ export type AdminServiceUpdateProjectBody = {
   githubUrl?: string;
 };
 
+export type AdminServiceGetProjectParams = {
+  pullRequest?: string;
+};
+
 export type AdminServiceCreateProjectBodyVariables = { [key: string]: string };
 
 export type AdminServiceCreateProjectBody = {
@@ -46,6 +76,47 @@ export type AdminServiceListProjectsForOrganizationParams = {
   pageToken?: string;
 };
 
+export type AdminServiceAddOrganizationSSOGroupBodyProjectRoles = {
+  [key: string]: string;
+};
+
+export type AdminServiceAddOrganizationSSOGroupBody = {
+  idpGroup?: string;
+  usergroup?: string;
+  /** Organization role of the usergroup. If empty, the usergroup only has the project roles. */
+  orgRole?: string;
+  projectRoles?: AdminServiceAddOrganizationSSOGroupBodyProjectRoles;
+};
+
+export type AdminServiceRemoveOrganizationSSOGroupParams = {
+  idpGroup?: string;
+};
+
+export type AdminServiceSetOrganizationSSOBody = {
+  issuerUrl?: string;
+  clientId?: string;
+  /** Required when the identity provider is first configured. If empty, the current client secret is kept. */
+  clientSecret?: string;
+  groupsClaim?: string;
+  domains?: string[];
+};
+
+export type AdminServiceIssueServiceAuthTokenBody = {
+  displayName?: string;
+  projects?: string[];
+  scopes?: string[];
+  expiresOn?: string;
+};
+
+export type AdminServiceCreateServiceAccountBody = {
+  name?: string;
+  description?: string;
+};
+
+export type AdminServiceUpdateOrganizationQuotasBody = {
+  quotas?: V1OrganizationQuotas;
+};
+
 export type AdminServiceListProjectMembersParams = {
   pageSize?: number;
   pageToken?: string;
@@ -56,6 +127,14 @@ export type AdminServiceListOrganizationMembersParams = {
   pageToken?: string;
 };
 
+export type AdminServiceListAuditLogEntriesParams = {
+  project?: string;
+  actor?: string;
+  after?: string;
+  before?: string;
+  pageSize?: number;
+};
+
 export type AdminServiceUpdateOrganizationBody = {
   id?: string;
   description?: string;
@@ -70,6 +149,10 @@ export type AdminServiceGetGithubRepoStatusParams = {
   githubUrl?: string;
 };
 
+export type AdminServiceRollbackDeploymentBody = {
+  revisionId?: string;
+};
+
 export type AdminServiceAddOrganizationMemberBodyBody = {
   email?: string;
   role?: string;
@@ -79,6 +162,14 @@ export type AdminServiceSetOrganizationMemberRoleBodyBody = {
   role?: string;
 };
 
+export type AdminServiceSetOrganizationMemberAttributesBodyAttributes = {
+  [key: string]: any;
+};
+
+export type AdminServiceSetOrganizationMemberAttributesBody = {
+  attributes?: AdminServiceSetOrganizationMemberAttributesBodyAttributes;
+};
+
 export interface V1UserInvite {
   email?: string;
   role?: string;
@@ -110,14 +201,108 @@ export interface V1UpdateOrganizationResponse {
   organization?: V1Organization;
 }
 
+export interface V1UpdateOrganizationQuotasResponse {
+  quotas?: V1OrganizationQuotas;
+}
+
+export interface V1UpdateEnvironmentResponse {
+  environment?: V1Environment;
+}
+
+export interface V1UncordonRuntimeResponse {
+  runtime?: V1Runtime;
+}
+
+export interface V1UncordonRuntimeRequest {
+  host?: string;
+}
+
 export interface V1SetProjectMemberRoleResponse {
   [key: string]: any;
 }
 
+export interface V1SetOrganizationSSOResponse {
+  sso?: V1OrganizationSSO;
+}
+
 export interface V1SetOrganizationMemberRoleResponse {
   [key: string]: any;
 }
 
+export interface V1SetOrganizationMemberAttributesResponse {
+  [key: string]: any;
+}
+
+export type V1GetOrganizationMemberAttributesResponseAttributes = {
+  [key: string]: any;
+};
+
+export interface V1GetOrganizationMemberAttributesResponse {
+  attributes?: V1GetOrganizationMemberAttributesResponseAttributes;
+}
+
+export interface V1ServiceAuthToken {
+  id?: string;
+  displayName?: string;
+  projects?: string[];
+  scopes?: string[];
+  expiresOn?: string;
+  createdOn?: string;
+}
+
+export interface V1ServiceAccount {
+  id?: string;
+  name?: string;
+  description?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
+export type V1SSOGroupMappingProjectRoles = { [key: string]: string };
+
+/**
+ * SSOGroupMapping maps a group of an organization's identity provider to a usergroup.
+Users are added to or removed from the usergroup when they sign in with the identity provider.
+ */
+export interface V1SSOGroupMapping {
+  idpGroup?: string;
+  usergroup?: string;
+  orgRole?: string;
+  projectRoles?: V1SSOGroupMappingProjectRoles;
+}
+
+export type V1RuntimeStatus =
+  typeof V1RuntimeStatus[keyof typeof V1RuntimeStatus];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1RuntimeStatus = {
+  RUNTIME_STATUS_UNSPECIFIED: "RUNTIME_STATUS_UNSPECIFIED",
+  RUNTIME_STATUS_HEALTHY: "RUNTIME_STATUS_HEALTHY",
+  RUNTIME_STATUS_UNHEALTHY: "RUNTIME_STATUS_UNHEALTHY",
+} as const;
+
+export interface V1Runtime {
+  host?: string;
+  audienceUrl?: string;
+  region?: string;
+  slots?: string;
+  slotsUsed?: string;
+  dataDir?: string;
+  status?: V1RuntimeStatus;
+  draining?: boolean;
+  lastHeartbeatOn?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
+export interface V1RollbackDeploymentResponse {
+  environment?: V1Environment;
+}
+
+export interface V1RevokeServiceAuthTokenResponse {
+  [key: string]: any;
+}
+
 export interface V1RevokeCurrentAuthTokenResponse {
   tokenId?: string;
 }
@@ -126,10 +311,18 @@ export interface V1RemoveProjectMemberResponse {
   [key: string]: any;
 }
 
+export interface V1RemoveOrganizationSSOGroupResponse {
+  [key: string]: any;
+}
+
 export interface V1RemoveOrganizationMemberResponse {
   [key: string]: any;
 }
 
+export interface V1PromoteEnvironmentResponse {
+  environment?: V1Environment;
+}
+
 export interface V1ProjectPermissions {
   readProject?: boolean;
   manageProject?: boolean;
@@ -152,11 +345,6 @@ export interface V1Project {
   public?: boolean;
   region?: string;
   githubUrl?: string;
-  prodBranch?: string;
-  prodOlapDriver?: string;
-  prodOlapDsn?: string;
-  prodSlots?: string;
-  prodDeploymentId?: string;
   createdOn?: string;
   updatedOn?: string;
 }
@@ -166,6 +354,37 @@ export interface V1PingResponse {
   time?: string;
 }
 
+export interface V1OrganizationUsage {
+  projects?: number;
+  slotsTotal?: number;
+  ingestedBytes?: string;
+  queries?: string;
+  periodStart?: string;
+}
+
+/**
+ * OrganizationSSO is the OIDC identity provider of an organization. The client secret is never returned.
+ */
+export interface V1OrganizationSSO {
+  issuerUrl?: string;
+  clientId?: string;
+  groupsClaim?: string;
+  domains?: string[];
+  redirectUrl?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
+/**
+ * OrganizationQuotas are limits on the resources used by an organization. A negative quota means the resource is not limited.
+ */
+export interface V1OrganizationQuotas {
+  projects?: number;
+  slotsTotal?: number;
+  ingestedBytes?: string;
+  queriesPerMonth?: string;
+}
+
 export interface V1OrganizationPermissions {
   readOrg?: boolean;
   manageOrg?: boolean;
@@ -193,6 +412,18 @@ export interface V1Member {
   updatedOn?: string;
 }
 
+export interface V1ListServiceAuthTokensResponse {
+  tokens?: V1ServiceAuthToken[];
+}
+
+export interface V1ListServiceAccountsResponse {
+  serviceAccounts?: V1ServiceAccount[];
+}
+
+export interface V1ListRuntimesResponse {
+  runtimes?: V1Runtime[];
+}
+
 export interface V1ListProjectsForOrganizationResponse {
   projects?: V1Project[];
   nextPageToken?: string;
@@ -209,6 +440,10 @@ export interface V1ListProjectMembersResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListProjectJobsResponse {
+  jobs?: V1Job[];
+}
+
 export interface V1ListOrganizationsResponse {
   organizations?: V1Organization[];
   nextPageToken?: string;
@@ -220,10 +455,64 @@ export interface V1ListOrganizationMembersResponse {
   nextPageToken?: string;
 }
 
+export interface V1ListEnvironmentsResponse {
+  environments?: V1Environment[];
+}
+
+export interface V1ListDeploymentRevisionsResponse {
+  revisions?: V1DeploymentRevision[];
+}
+
+export interface V1ListAuditLogEntriesResponse {
+  entries?: V1AuditLogEntry[];
+}
+
 export interface V1LeaveOrganizationResponse {
   [key: string]: any;
 }
 
+export type V1JobStatus = typeof V1JobStatus[keyof typeof V1JobStatus];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const V1JobStatus = {
+  JOB_STATUS_UNSPECIFIED: "JOB_STATUS_UNSPECIFIED",
+  JOB_STATUS_PENDING: "JOB_STATUS_PENDING",
+  JOB_STATUS_RUNNING: "JOB_STATUS_RUNNING",
+  JOB_STATUS_SUCCEEDED: "JOB_STATUS_SUCCEEDED",
+  JOB_STATUS_FAILED: "JOB_STATUS_FAILED",
+} as const;
+
+export interface V1Job {
+  id?: string;
+  type?: string;
+  projectId?: string;
+  status?: V1JobStatus;
+  attempts?: number;
+  maxAttempts?: number;
+  error?: string;
+  runAfter?: string;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
+export interface V1IssueServiceAuthTokenResponse {
+  token?: V1ServiceAuthToken;
+  tokenString?: string;
+}
+
+export interface V1HeartbeatRuntimeResponse {
+  runtime?: V1Runtime;
+}
+
+export interface V1HeartbeatRuntimeRequest {
+  secret?: string;
+  host?: string;
+  audienceUrl?: string;
+  region?: string;
+  slots?: string;
+  dataDir?: string;
+}
+
 export type V1GetProjectVariablesResponseVariables = { [key: string]: string };
 
 export interface V1GetProjectVariablesResponse {
@@ -235,6 +524,18 @@ export interface V1GetProjectResponse {
   prodDeployment?: V1Deployment;
   jwt?: string;
   projectPermissions?: V1ProjectPermissions;
+  previewDeployment?: V1Deployment;
+}
+
+export interface V1GetOrganizationUsageResponse {
+  usage?: V1OrganizationUsage;
+  quotas?: V1OrganizationQuotas;
+}
+
+export interface V1GetOrganizationSSOResponse {
+  sso?: V1OrganizationSSO;
+  groups?: V1SSOGroupMapping[];
+  verificationRecord?: string;
 }
 
 export interface V1GetOrganizationResponse {
@@ -242,16 +543,46 @@ export interface V1GetOrganizationResponse {
   permissions?: V1OrganizationPermissions;
 }
 
+export interface V1GetJobResponse {
+  job?: V1Job;
+}
+
 export interface V1GetGithubRepoStatusResponse {
   hasAccess?: boolean;
   grantAccessUrl?: string;
   defaultBranch?: string;
 }
 
+export interface V1GetDeploymentRevisionResponse {
+  revision?: V1DeploymentRevision;
+}
+
 export interface V1GetCurrentUserResponse {
   user?: V1User;
 }
 
+export interface V1Environment {
+  id?: string;
+  projectId?: string;
+  name?: string;
+  branch?: string;
+  commit?: string;
+  olapDriver?: string;
+  olapDsn?: string;
+  slots?: string;
+  deployment?: V1Deployment;
+  createdOn?: string;
+  updatedOn?: string;
+}
+
+export interface V1DrainRuntimeResponse {
+  runtime?: V1Runtime;
+}
+
+export interface V1DrainRuntimeRequest {
+  host?: string;
+}
+
 export type V1DeploymentStatus =
   typeof V1DeploymentStatus[keyof typeof V1DeploymentStatus];
 
@@ -264,6 +595,23 @@ export const V1DeploymentStatus = {
   DEPLOYMENT_STATUS_ERROR: "DEPLOYMENT_STATUS_ERROR",
 } as const;
 
+export interface V1DeploymentRevisionResult {
+  path?: string;
+  errors?: string[];
+}
+
+export interface V1DeploymentRevision {
+  id?: string;
+  deploymentId?: string;
+  commit?: string;
+  triggeredBy?: string;
+  status?: V1DeploymentStatus;
+  results?: V1DeploymentRevisionResult[];
+  logs?: string;
+  startedOn?: string;
+  finishedOn?: string;
+}
+
 export interface V1Deployment {
   id?: string;
   projectId?: string;
@@ -275,9 +623,19 @@ export interface V1Deployment {
   logs?: string;
   createdOn?: string;
   updatedOn?: string;
+  pullRequest?: string;
+  expiresOn?: string;
+}
+
+export interface V1DeleteServiceAccountResponse {
+  [key: string]: any;
 }
 
 export interface V1DeleteProjectResponse {
+  job?: V1Job;
+}
+
+export interface V1DeleteOrganizationSSOResponse {
   [key: string]: any;
 }
 
@@ -285,6 +643,14 @@ export interface V1DeleteOrganizationResponse {
   [key: string]: any;
 }
 
+export interface V1DeleteEnvironmentResponse {
+  [key: string]: any;
+}
+
+export interface V1CreateServiceAccountResponse {
+  serviceAccount?: V1ServiceAccount;
+}
+
 export interface V1CreateProjectResponse {
   project?: V1Project;
   projectUrl?: string;
@@ -299,10 +665,37 @@ export interface V1CreateOrganizationRequest {
   description?: string;
 }
 
+export interface V1CreateEnvironmentResponse {
+  environment?: V1Environment;
+}
+
+export type V1AuditLogEntryBefore = { [key: string]: string };
+
+export type V1AuditLogEntryAfter = { [key: string]: string };
+
+export interface V1AuditLogEntry {
+  id?: string;
+  actorType?: string;
+  actorId?: string;
+  actorName?: string;
+  action?: string;
+  targetType?: string;
+  targetId?: string;
+  targetName?: string;
+  project?: string;
+  before?: V1AuditLogEntryBefore;
+  after?: V1AuditLogEntryAfter;
+  createdOn?: string;
+}
+
 export interface V1AddProjectMemberResponse {
   pendingSignup?: boolean;
 }
 
+export interface V1AddOrganizationSSOGroupResponse {
+  group?: V1SSOGroupMapping;
+}
+
 export interface V1AddOrganizationMemberResponse {
   pendingSignup?: boolean;
 }
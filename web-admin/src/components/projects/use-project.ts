@@ -5,7 +5,7 @@ const PollTimeDuringError = 5000;
 const PollTimeWhenProjectReady = 60 * 1000;
 
 export function useProject(orgName: string, projName: string) {
-  return createAdminServiceGetProject(orgName, projName, {
+  return createAdminServiceGetProject(orgName, projName, undefined, {
     query: {
       refetchInterval: (data) => {
         switch (data?.prodDeployment?.status) {
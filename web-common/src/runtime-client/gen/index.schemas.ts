@@ -4,6 +4,13 @@
  * rill/runtime/v1/schema.proto
  * OpenAPI spec version: version not set
  */
+export type RuntimeServiceWatchReconcile200 = {
+  result?: V1WatchReconcileResponse;
+  error?: RpcStatus;
+};
+
+export type RuntimeServiceCancelRunningQueryBody = { [key: string]: any };
+
 export type RuntimeServiceReconcileBody = {
   /** Changed paths provides a way to "hint" what files have changed in the repo, enabling
 reconciliation to execute faster by not scanning all code artifacts for changes. */
@@ -15,6 +22,11 @@ This is mainly used by UI to reconcile paths missing in catalog and get errors i
   strict?: boolean;
 };
 
+export type RuntimeServicePlanReconcileBody = {
+  changedPaths?: string[];
+  forcedPaths?: string[];
+};
+
 export type QueryServiceQueryBody = {
   sql?: string;
   args?: unknown[];
@@ -40,6 +52,8 @@ export type QueryServiceColumnTimeSeriesBody = {
   pixels?: number;
   sampleSize?: number;
   priority?: number;
+  /** Optional IANA time zone to bucket in. Defaults to UTC. */
+  timeZone?: string;
 };
 
 export type QueryServiceColumnTimeRangeParams = {
@@ -98,6 +112,8 @@ export type QueryServiceMetricsViewTotalsBody = {
   timeEnd?: string;
   filter?: V1MetricsViewFilter;
   priority?: number;
+  /** Optional time dimension to filter on. Defaults to the metrics view's primary time dimension. */
+  timeDimension?: string;
 };
 
 export type QueryServiceMetricsViewToplistBody = {
@@ -110,6 +126,8 @@ export type QueryServiceMetricsViewToplistBody = {
   sort?: V1MetricsViewSort[];
   filter?: V1MetricsViewFilter;
   priority?: number;
+  /** Optional time dimension to filter on. Defaults to the metrics view's primary time dimension. */
+  timeDimension?: string;
 };
 
 export type QueryServiceMetricsViewTimeSeriesBody = {
@@ -119,6 +137,10 @@ export type QueryServiceMetricsViewTimeSeriesBody = {
   timeGranularity?: V1TimeGrain;
   filter?: V1MetricsViewFilter;
   priority?: number;
+  /** Optional time dimension to bucket by. Defaults to the metrics view's primary time dimension. */
+  timeDimension?: string;
+  /** Optional IANA time zone to bucket in. Defaults to the metrics view's default time zone. */
+  timeZone?: string;
 };
 
 export type QueryServiceColumnDescriptiveStatisticsParams = {
@@ -182,6 +204,8 @@ export type RuntimeServiceEditInstanceBody = {
   embedCatalog?: boolean;
   variables?: RuntimeServiceEditInstanceBodyVariables;
   ingestionLimitBytes?: string;
+  reconcileConcurrency?: number;
+  profile?: string;
 };
 
 export type RuntimeServiceDeleteInstanceBody = {
@@ -193,6 +217,15 @@ export type RuntimeServiceListInstancesParams = {
   pageToken?: string;
 };
 
+export interface V1WatchReconcileResponse {
+  /** Paths of the changed files that triggered the reconcile */
+  changedPaths?: string[];
+  /** Errors encountered during reconciliation (see ReconcileResponse) */
+  errors?: V1ReconcileError[];
+  /** Paths considered while executing the reconciliation (see ReconcileResponse) */
+  affectedPaths?: string[];
+}
+
 export type V1TypeCode = typeof V1TypeCode[keyof typeof V1TypeCode];
 
 // eslint-disable-next-line @typescript-eslint/no-redeclare
@@ -323,6 +356,18 @@ export interface V1Source {
   timeoutSeconds?: number;
 }
 
+export interface V1RunningQuery {
+  id?: string;
+  instanceId?: string;
+  sql?: string;
+  priority?: number;
+  /** Subject that issued the query (usually a user or service ID). Empty if unknown. */
+  subject?: string;
+  startedOn?: string;
+  elapsedMs?: string;
+  queued?: boolean;
+}
+
 export interface V1RenameFileResponse {
   [key: string]: any;
 }
@@ -391,6 +436,22 @@ Only applicable if file_path is set. */
   endLocation?: ReconcileErrorCharLocation;
 }
 
+export interface V1ReconcilePlanItem {
+  name?: string;
+  path?: string;
+  type?: V1ObjectType;
+  action?: ReconcilePlanItemAction;
+  reasons?: ReconcilePlanItemReason[];
+  fromName?: string;
+  estimatedBytes?: string;
+  downstream?: string[];
+}
+
+export interface V1PlanReconcileResponse {
+  items?: V1ReconcilePlanItem[];
+  errors?: V1ReconcileError[];
+}
+
 export interface V1RenameFileAndReconcileResponse {
   /** Errors encountered during reconciliation. If strict = false, any path in
 affected_paths without an error can be assumed to have been reconciled succesfully. */
@@ -409,6 +470,9 @@ affected_paths without an error can be assumed to have been reconciled succesful
 executing the reconciliation. If changed_paths was empty, this will include all
 code artifacts in the repo. */
   affectedPaths?: string[];
+  /** commit is the commit of the instance's repo that was reconciled.
+It's empty if the repo isn't checked out from version control. */
+  commit?: string;
 }
 
 export type V1QueryResponseDataItem = { [key: string]: any };
@@ -495,12 +559,31 @@ export interface V1NumericSummary {
   numericOutliers?: V1NumericOutliers;
 }
 
+export interface V1ModelProfile {
+  materialize?: boolean;
+  rowLimit?: string;
+  sampleFraction?: number;
+}
+
+export type V1ModelProfiles = { [key: string]: V1ModelProfile };
+
 export interface V1Model {
   name?: string;
   sql?: string;
   dialect?: ModelDialect;
   schema?: V1StructType;
   materialize?: boolean;
+  /** SQL after rendering the template (including macros, ref and variables).
+The sql field is derived from it by removing comments and redundant whitespace. */
+  renderedSql?: string;
+  refs?: string[];
+  profiles?: V1ModelProfiles;
+  /** Max number of rows in the model, 0 means no limit.
+Resolved from the instance's profile. */
+  rowLimit?: string;
+  /** Fraction of rows sampled from the model's result, 0 means no sampling.
+Resolved from the instance's profile. */
+  sampleFraction?: number;
 }
 
 export type V1MetricsViewTotalsResponseData = { [key: string]: any };
@@ -549,6 +632,12 @@ export interface V1MetricsView {
   smallestTimeGrain?: V1TimeGrain;
   /** Default time range for the dashboard. It should be a valid ISO 8601 duration string. */
   defaultTimeRange?: string;
+  /** Additional timestamp dimensions that can be used instead of time_dimension for filtering and bucketing */
+  timeDimensions?: string[];
+  /** Default IANA time zone used for bucketing time series (e.g. "America/New_York"). Defaults to UTC. */
+  defaultTimeZone?: string;
+  rollups?: MetricsViewRollup[];
+  policy?: MetricsViewPolicy;
 }
 
 export interface V1MapType {
@@ -556,6 +645,10 @@ export interface V1MapType {
   valueType?: Runtimev1Type;
 }
 
+export interface V1ListRunningQueriesResponse {
+  queries?: V1RunningQuery[];
+}
+
 export interface V1ListInstancesResponse {
   instances?: V1Instance[];
   nextPageToken?: string;
@@ -599,6 +692,8 @@ of in the runtime's metadata store. Currently only supported for the duckdb driv
   variables?: V1InstanceVariables;
   projectVariables?: V1InstanceProjectVariables;
   ingestionLimitBytes?: string;
+  reconcileConcurrency?: number;
+  profile?: string;
 }
 
 export type V1HistogramMethod =
@@ -611,6 +706,13 @@ export const V1HistogramMethod = {
   HISTOGRAM_METHOD_DIAGNOSTIC: "HISTOGRAM_METHOD_DIAGNOSTIC",
 } as const;
 
+export interface V1GetInstanceUsageResponse {
+  bytesIngested?: string;
+  queries?: string;
+  cachedQueries?: string;
+  countersStartedOn?: string;
+}
+
 export interface V1GetInstanceResponse {
   instance?: V1Instance;
 }
@@ -673,6 +775,8 @@ export interface V1CreateInstanceRequest {
   embedCatalog?: boolean;
   variables?: V1CreateInstanceRequestVariables;
   ingestionLimitBytes?: string;
+  reconcileConcurrency?: number;
+  profile?: string;
 }
 
 /**
@@ -736,6 +840,10 @@ export interface V1ColumnCardinalityResponse {
   categoricalSummary?: V1CategoricalSummary;
 }
 
+export interface V1CancelRunningQueryResponse {
+  [key: string]: any;
+}
+
 export interface V1CatalogEntry {
   name?: string;
   table?: V1Table;
@@ -839,6 +947,21 @@ export const ModelDialect = {
   DIALECT_DUCKDB: "DIALECT_DUCKDB",
 } as const;
 
+export interface MetricsViewRollup {
+  name?: string;
+  dimensions?: string[];
+  measures?: string[];
+  timeGrain?: V1TimeGrain;
+  table?: string;
+  rowCount?: string;
+}
+
+export interface MetricsViewPolicy {
+  hasAccess?: string;
+  rowFilter?: string;
+  exclude?: MetricsViewFieldCondition[];
+}
+
 export interface MetricsViewMeasure {
   name?: string;
   label?: string;
@@ -853,6 +976,11 @@ export interface MetricsViewFilterCond {
   like?: string[];
 }
 
+export interface MetricsViewFieldCondition {
+  condition?: string;
+  names?: string[];
+}
+
 export interface MetricsViewDimension {
   name?: string;
   label?: string;
@@ -869,6 +997,55 @@ export const ExtractPolicyStrategy = {
   STRATEGY_TAIL: "STRATEGY_TAIL",
 } as const;
 
+/**
+ * - REASON_NEW: The object doesn't exist in the catalog
+ - REASON_DEFINITION_CHANGED: The artifact's definition changed
+ - REASON_SCHEMA_CHANGED: A model's SQL returns different columns
+ - REASON_UPSTREAM_CHANGED: An object it depends on would be migrated
+ - REASON_FORCED: The path was passed in forced_paths
+ - REASON_DATA_CHANGED: The local file read by a source was updated
+ - REASON_MISSING_IN_OLAP: The object is in the catalog, but missing in the OLAP database
+ - REASON_RENAMED: The artifact was renamed
+ - REASON_DELETED: The artifact was deleted
+ - REASON_INVALID: The artifact is invalid
+ - REASON_UNREFERENCED: An embedded source is no longer referenced
+ */
+export type ReconcilePlanItemReason =
+  typeof ReconcilePlanItemReason[keyof typeof ReconcilePlanItemReason];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ReconcilePlanItemReason = {
+  REASON_UNSPECIFIED: "REASON_UNSPECIFIED",
+  REASON_NEW: "REASON_NEW",
+  REASON_DEFINITION_CHANGED: "REASON_DEFINITION_CHANGED",
+  REASON_SCHEMA_CHANGED: "REASON_SCHEMA_CHANGED",
+  REASON_UPSTREAM_CHANGED: "REASON_UPSTREAM_CHANGED",
+  REASON_FORCED: "REASON_FORCED",
+  REASON_DATA_CHANGED: "REASON_DATA_CHANGED",
+  REASON_MISSING_IN_OLAP: "REASON_MISSING_IN_OLAP",
+  REASON_RENAMED: "REASON_RENAMED",
+  REASON_DELETED: "REASON_DELETED",
+  REASON_INVALID: "REASON_INVALID",
+  REASON_UNREFERENCED: "REASON_UNREFERENCED",
+} as const;
+
+/**
+ * - ACTION_REFRESH: Re-ingest a source that's unchanged
+ */
+export type ReconcilePlanItemAction =
+  typeof ReconcilePlanItemAction[keyof typeof ReconcilePlanItemAction];
+
+// eslint-disable-next-line @typescript-eslint/no-redeclare
+export const ReconcilePlanItemAction = {
+  ACTION_UNSPECIFIED: "ACTION_UNSPECIFIED",
+  ACTION_NO_OP: "ACTION_NO_OP",
+  ACTION_CREATE: "ACTION_CREATE",
+  ACTION_UPDATE: "ACTION_UPDATE",
+  ACTION_RENAME: "ACTION_RENAME",
+  ACTION_DROP: "ACTION_DROP",
+  ACTION_REFRESH: "ACTION_REFRESH",
+} as const;
+
 export type ConnectorPropertyType =
   typeof ConnectorPropertyType[keyof typeof ConnectorPropertyType];
 
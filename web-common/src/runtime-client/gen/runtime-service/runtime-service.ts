@@ -41,7 +41,14 @@ import type {
   RuntimeServiceRenameFileBody,
   V1ReconcileResponse,
   RuntimeServiceReconcileBody,
+  V1PlanReconcileResponse,
+  RuntimeServicePlanReconcileBody,
+  RuntimeServiceWatchReconcile200,
+  V1ListRunningQueriesResponse,
+  V1CancelRunningQueryResponse,
+  RuntimeServiceCancelRunningQueryBody,
   V1TriggerSyncResponse,
+  V1GetInstanceUsageResponse,
   V1PingResponse,
   V1PutFileAndReconcileResponse,
   V1PutFileAndReconcileRequest,
@@ -941,6 +948,247 @@ export const createRuntimeServiceReconcile = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary PlanReconcile previews the migrations Reconcile would apply without executing them.
+For each object, it returns the action, the reasons for it, the estimated size of the data a source would ingest,
+and the downstream objects that would be migrated as a consequence.
+ */
+export const runtimeServicePlanReconcile = (
+  instanceId: string,
+  runtimeServicePlanReconcileBody: RuntimeServicePlanReconcileBody
+) => {
+  return httpClient<V1PlanReconcileResponse>({
+    url: `/v1/instances/${instanceId}/reconcile/plan`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: runtimeServicePlanReconcileBody,
+  });
+};
+
+export type RuntimeServicePlanReconcileMutationResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServicePlanReconcile>>
+>;
+export type RuntimeServicePlanReconcileMutationBody =
+  RuntimeServicePlanReconcileBody;
+export type RuntimeServicePlanReconcileMutationError = RpcStatus;
+
+export const createRuntimeServicePlanReconcile = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServicePlanReconcile>>,
+    TError,
+    { instanceId: string; data: RuntimeServicePlanReconcileBody },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof runtimeServicePlanReconcile>>,
+    { instanceId: string; data: RuntimeServicePlanReconcileBody }
+  > = (props) => {
+    const { instanceId, data } = props ?? {};
+
+    return runtimeServicePlanReconcile(instanceId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof runtimeServicePlanReconcile>>,
+    TError,
+    { instanceId: string; data: RuntimeServicePlanReconcileBody },
+    TContext
+  >(mutationFn, mutationOptions);
+};
+/**
+ * @summary WatchReconcile watches the instance's repo for changes made outside of the runtime (e.g. in an editor),
+reconciles the changed files and streams the results. Only repos that can detect changes (i.e. "file") are supported.
+ */
+export const runtimeServiceWatchReconcile = (
+  instanceId: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<RuntimeServiceWatchReconcile200>({
+    url: `/v1/instances/${instanceId}/reconcile/watch`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getRuntimeServiceWatchReconcileQueryKey = (instanceId: string) =>
+  [`/v1/instances/${instanceId}/reconcile/watch`] as const;
+
+export type RuntimeServiceWatchReconcileQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceWatchReconcile>>
+>;
+export type RuntimeServiceWatchReconcileQueryError = RpcStatus;
+
+export const createRuntimeServiceWatchReconcile = <
+  TData = Awaited<ReturnType<typeof runtimeServiceWatchReconcile>>,
+  TError = RpcStatus
+>(
+  instanceId: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceWatchReconcile>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceWatchReconcileQueryKey(instanceId);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceWatchReconcile>>
+  > = ({ signal }) => runtimeServiceWatchReconcile(instanceId, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceWatchReconcile>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!instanceId,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary ListRunningQueries lists the OLAP queries currently queued or executing for an instance
+ */
+export const runtimeServiceListRunningQueries = (
+  instanceId: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1ListRunningQueriesResponse>({
+    url: `/v1/instances/${instanceId}/running-queries`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getRuntimeServiceListRunningQueriesQueryKey = (
+  instanceId: string
+) => [`/v1/instances/${instanceId}/running-queries`] as const;
+
+export type RuntimeServiceListRunningQueriesQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceListRunningQueries>>
+>;
+export type RuntimeServiceListRunningQueriesQueryError = RpcStatus;
+
+export const createRuntimeServiceListRunningQueries = <
+  TData = Awaited<ReturnType<typeof runtimeServiceListRunningQueries>>,
+  TError = RpcStatus
+>(
+  instanceId: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceListRunningQueries>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceListRunningQueriesQueryKey(instanceId);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceListRunningQueries>>
+  > = ({ signal }) => runtimeServiceListRunningQueries(instanceId, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceListRunningQueries>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!instanceId,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
+/**
+ * @summary CancelRunningQuery cancels a queued or executing OLAP query
+ */
+export const runtimeServiceCancelRunningQuery = (
+  instanceId: string,
+  queryId: string,
+  runtimeServiceCancelRunningQueryBody: RuntimeServiceCancelRunningQueryBody
+) => {
+  return httpClient<V1CancelRunningQueryResponse>({
+    url: `/v1/instances/${instanceId}/running-queries/${queryId}/cancel`,
+    method: "post",
+    headers: { "Content-Type": "application/json" },
+    data: runtimeServiceCancelRunningQueryBody,
+  });
+};
+
+export type RuntimeServiceCancelRunningQueryMutationResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceCancelRunningQuery>>
+>;
+export type RuntimeServiceCancelRunningQueryMutationBody =
+  RuntimeServiceCancelRunningQueryBody;
+export type RuntimeServiceCancelRunningQueryMutationError = RpcStatus;
+
+export const createRuntimeServiceCancelRunningQuery = <
+  TError = RpcStatus,
+  TContext = unknown
+>(options?: {
+  mutation?: CreateMutationOptions<
+    Awaited<ReturnType<typeof runtimeServiceCancelRunningQuery>>,
+    TError,
+    {
+      instanceId: string;
+      queryId: string;
+      data: RuntimeServiceCancelRunningQueryBody;
+    },
+    TContext
+  >;
+}) => {
+  const { mutation: mutationOptions } = options ?? {};
+
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof runtimeServiceCancelRunningQuery>>,
+    {
+      instanceId: string;
+      queryId: string;
+      data: RuntimeServiceCancelRunningQueryBody;
+    }
+  > = (props) => {
+    const { instanceId, queryId, data } = props ?? {};
+
+    return runtimeServiceCancelRunningQuery(instanceId, queryId, data);
+  };
+
+  return createMutation<
+    Awaited<ReturnType<typeof runtimeServiceCancelRunningQuery>>,
+    TError,
+    {
+      instanceId: string;
+      queryId: string;
+      data: RuntimeServiceCancelRunningQueryBody;
+    },
+    TContext
+  >(mutationFn, mutationOptions);
+};
 /**
  * @summary TriggerSync syncronizes the instance's catalog with the underlying OLAP's information schema.
 If the instance has exposed=true, tables found in the information schema will be added to the catalog.
@@ -987,6 +1235,67 @@ export const createRuntimeServiceTriggerSync = <
     TContext
   >(mutationFn, mutationOptions);
 };
+/**
+ * @summary GetInstanceUsage returns the resources used by an instance
+ */
+export const runtimeServiceGetInstanceUsage = (
+  instanceId: string,
+  signal?: AbortSignal
+) => {
+  return httpClient<V1GetInstanceUsageResponse>({
+    url: `/v1/instances/${instanceId}/usage`,
+    method: "get",
+    signal,
+  });
+};
+
+export const getRuntimeServiceGetInstanceUsageQueryKey = (instanceId: string) =>
+  [`/v1/instances/${instanceId}/usage`] as const;
+
+export type RuntimeServiceGetInstanceUsageQueryResult = NonNullable<
+  Awaited<ReturnType<typeof runtimeServiceGetInstanceUsage>>
+>;
+export type RuntimeServiceGetInstanceUsageQueryError = RpcStatus;
+
+export const createRuntimeServiceGetInstanceUsage = <
+  TData = Awaited<ReturnType<typeof runtimeServiceGetInstanceUsage>>,
+  TError = RpcStatus
+>(
+  instanceId: string,
+  options?: {
+    query?: CreateQueryOptions<
+      Awaited<ReturnType<typeof runtimeServiceGetInstanceUsage>>,
+      TError,
+      TData
+    >;
+  }
+): CreateQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {};
+
+  const queryKey =
+    queryOptions?.queryKey ??
+    getRuntimeServiceGetInstanceUsageQueryKey(instanceId);
+
+  const queryFn: QueryFunction<
+    Awaited<ReturnType<typeof runtimeServiceGetInstanceUsage>>
+  > = ({ signal }) => runtimeServiceGetInstanceUsage(instanceId, signal);
+
+  const query = createQuery<
+    Awaited<ReturnType<typeof runtimeServiceGetInstanceUsage>>,
+    TError,
+    TData
+  >({
+    queryKey,
+    queryFn,
+    enabled: !!instanceId,
+    ...queryOptions,
+  }) as CreateQueryResult<TData, TError> & { queryKey: QueryKey };
+
+  query.queryKey = queryKey;
+
+  return query;
+};
+
 /**
  * @summary Ping returns information about the runtime
  */